@@ -89,6 +89,8 @@ split-row-threshold = 1000
 imbalance-factor = 0.3
 `, dir, ip, ip, ip), c)
 	c.JoinPeers = []string{ip + ":9092"}
+	// the raft and the mux logs are written to the test directory instead of the working directory
+	c.Logging.Path = dir
 	return c, err
 }
 
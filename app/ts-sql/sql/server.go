@@ -136,6 +136,7 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 
 	s.PointsWriter = coordinator.NewPointsWriter(time.Duration(c.Coordinator.ShardWriterTimeout))
 	s.PointsWriter.TSDBStore = s.TSDBStore
+	s.PointsWriter.SetShardWriters(c.Coordinator.ShardWriters)
 	go s.PointsWriter.ApplyTimeRangeLimit(c.Coordinator.TimeRangeLimit)
 	coordinator.SetTagLimit(c.Coordinator.TagLimit)
 
@@ -296,6 +297,8 @@ func (s *Server) Open() error {
 
 	s.httpService.Handler.QueryExecutor.PointsWriter = s.PointsWriter
 	s.httpService.Handler.PointsWriter = s.PointsWriter
+	s.httpService.Handler.StreamManager = s.PointsWriter
+	s.PointsWriter.StreamSource = &coordinator.QueryStreamSource{QueryExecutor: s.QueryExecutor}
 	if s.SubscriberManager != nil {
 		s.httpService.Handler.SubscriberManager = s.SubscriberManager
 		s.SubscriberManager.InitWriters()
@@ -376,6 +379,15 @@ func (s *Server) Close() error {
 		util.MustClose(s.arrowFlightService)
 	}
 
+	// the open windows of the streams are written while the meta client is still open
+	if s.PointsWriter != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(s.config.Coordinator.ShardWriterTimeout))
+		if err := s.PointsWriter.FlushStreams(ctx); err != nil {
+			s.Logger.Error("flush streams failed", zap.Error(err))
+		}
+		cancel()
+	}
+
 	if s.RecordWriter != nil {
 		util.MustClose(s.RecordWriter)
 	}
@@ -458,6 +470,7 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitExecutorStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
+	stat.InitStreamTaskStatistics(globalTags)
 
 	s.statisticsPusher.Register(
 		stat.CollectHandlerStatistics,
@@ -467,6 +480,7 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectExecutorStatistics,
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
+		stat.StreamTaskStat.Collect,
 	)
 
 	s.statisticsPusher.RegisterOps(stat.CollectOpsHandlerStatistics)
@@ -475,6 +489,7 @@ func (s *Server) initStatisticsPusher() {
 	s.statisticsPusher.RegisterOps(stat.CollectOpsRuntimeStatistics)
 	s.statisticsPusher.RegisterOps(stat.CollectExecutorStatisticsOps)
 	s.statisticsPusher.RegisterOps(stat.NewErrnoStat().CollectOps)
+	s.statisticsPusher.RegisterOps(stat.StreamTaskStat.CollectOps)
 
 	s.statisticsPusher.Start()
 }
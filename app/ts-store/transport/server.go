@@ -152,17 +152,70 @@ func (ww *WritePointsWork) reset() {
 }
 
 func (ww *WritePointsWork) decodePoints() (db string, rp string, ptId uint32, shard uint64, streamShardIdList []uint64, binaryRows []byte, err error) {
-	tail := ww.reqBuf
-
 	start := time.Now()
 
+	var tail []byte
+	db, rp, tail, err = ww.decodeHeader(netstorage.PackageTypeFast)
+	if err != nil {
+		return
+	}
+
+	if len(tail) < 16 {
+		err = errors.New("no data for points data")
+		ww.logger.Error(err.Error())
+		return
+	}
+	ptId = encoding.UnmarshalUint32(tail)
+	tail = tail[4:]
+
+	shard = encoding.UnmarshalUint64(tail)
+	tail = tail[8:]
+
+	sdLen := encoding.UnmarshalUint32(tail)
+	tail = tail[4:]
+
+	streamShardIdList = make([]uint64, sdLen)
+	tail, err = encoding.UnmarshalVarUint64s(streamShardIdList, tail)
+	if err != nil {
+		ww.logger.Error(err.Error())
+		return
+	}
+
+	binaryRows = tail
+
+	if err = ww.unmarshalRows(db, rp, ptId, shard, binaryRows); err != nil {
+		return
+	}
+
+	if len(streamShardIdList) > 0 {
+		// set stream vars into the rows
+		if len(ww.rows) != len(ww.streamVars) {
+			errStr := "unmarshal rows failed, the num of the rows is not equal to the stream vars"
+			ww.logger.Error(errStr, zap.String("db", db),
+				zap.String("rp", rp), zap.Uint32("ptId", ptId), zap.Uint64("shardId", shard), zap.Error(err))
+			err = errors.New(errStr)
+			return
+		}
+		for i := 0; i < len(ww.rows); i++ {
+			ww.rows[i].StreamOnly = ww.streamVars[i].Only
+			ww.rows[i].StreamId = ww.streamVars[i].Id
+		}
+	}
+
+	atomic.AddInt64(&statistics.PerfStat.WriteUnmarshalNs, time.Since(start).Nanoseconds())
+	return
+}
+
+// decodeHeader decodes the package type, the db and the rp of the request, it returns the rest of the request.
+func (ww *WritePointsWork) decodeHeader(packageType byte) (db string, rp string, tail []byte, err error) {
+	tail = ww.reqBuf
 	if len(tail) < 2 {
 		err = errors.New("invalid points buffer")
 		ww.logger.Error(err.Error())
 		return
 	}
 	ty := tail[0]
-	if ty != netstorage.PackageTypeFast {
+	if ty != packageType {
 		err = errors.New("not a fast marshal points package")
 		ww.logger.Error(err.Error())
 		return
@@ -189,30 +242,12 @@ func (ww *WritePointsWork) decodePoints() (db string, rp string, ptId uint32, sh
 	rp = util.Bytes2str(tail[:l])
 
 	tail = tail[l:]
+	return
+}
 
-	if len(tail) < 16 {
-		err = errors.New("no data for points data")
-		ww.logger.Error(err.Error())
-		return
-	}
-	ptId = encoding.UnmarshalUint32(tail)
-	tail = tail[4:]
-
-	shard = encoding.UnmarshalUint64(tail)
-	tail = tail[8:]
-
-	sdLen := encoding.UnmarshalUint32(tail)
-	tail = tail[4:]
-
-	streamShardIdList = make([]uint64, sdLen)
-	tail, err = encoding.UnmarshalVarUint64s(streamShardIdList, tail)
-	if err != nil {
-		ww.logger.Error(err.Error())
-		return
-	}
-
-	binaryRows = tail
-
+// unmarshalRows unmarshals the rows of the shard into the rows of the work.
+func (ww *WritePointsWork) unmarshalRows(db, rp string, ptId uint32, shard uint64, binaryRows []byte) error {
+	var err error
 	ww.rows = ww.rows[:0]
 	ww.tagpools = ww.tagpools[:0]
 	ww.fieldpools = ww.fieldpools[:0]
@@ -222,33 +257,64 @@ func (ww *WritePointsWork) decodePoints() (db string, rp string, ptId uint32, sh
 	}
 	ww.indexOptionpools = ww.indexOptionpools[:0]
 	ww.rows, ww.tagpools, ww.fieldpools, ww.indexOptionpools, ww.indexKeypools, err =
-		influx.FastUnmarshalMultiRows(tail, ww.rows, ww.tagpools, ww.fieldpools, ww.indexOptionpools, ww.indexKeypools)
+		influx.FastUnmarshalMultiRows(binaryRows, ww.rows, ww.tagpools, ww.fieldpools, ww.indexOptionpools, ww.indexKeypools)
 	if err != nil {
 		ww.logger.Error("unmarshal rows failed", zap.String("db", db),
 			zap.String("rp", rp), zap.Uint32("ptId", ptId), zap.Uint64("shardId", shard), zap.Error(err))
-		return
 	}
+	return err
+}
+
+// writeShardsPoints writes the rows of the shards of the pt carried by a single request, the shards are written
+// in the order of the request and the first failure fails the request.
+func (ww *WritePointsWork) writeShardsPoints() error {
+	db, rp, tail, err := ww.decodeHeader(netstorage.PackageTypeFastShards)
+	if err != nil {
+		return errno.NewError(errno.ErrUnmarshalPoints, err)
+	}
+	if len(tail) < 8 {
+		err = errors.New("no data for points data")
+		ww.logger.Error(err.Error())
+		return errno.NewError(errno.ErrUnmarshalPoints, err)
+	}
+	ptId := encoding.UnmarshalUint32(tail)
+	n := int(encoding.UnmarshalUint32(tail[4:]))
+	tail = tail[8:]
+	for i := 0; i < n; i++ {
+		if len(tail) < 12 {
+			err = errors.New("no data for shard points")
+			ww.logger.Error(err.Error())
+			return errno.NewError(errno.ErrUnmarshalPoints, err)
+		}
+		shard := encoding.UnmarshalUint64(tail)
+		l := int(encoding.UnmarshalUint32(tail[8:]))
+		tail = tail[12:]
+		if len(tail) < l {
+			err = errors.New("no data for shard points")
+			ww.logger.Error(err.Error())
+			return errno.NewError(errno.ErrUnmarshalPoints, err)
+		}
+		binaryRows := tail[:l]
+		tail = tail[l:]
 
-	if len(streamShardIdList) > 0 {
-		// set stream vars into the rows
-		if len(ww.rows) != len(ww.streamVars) {
-			errStr := "unmarshal rows failed, the num of the rows is not equal to the stream vars"
-			ww.logger.Error(errStr, zap.String("db", db),
-				zap.String("rp", rp), zap.Uint32("ptId", ptId), zap.Uint64("shardId", shard), zap.Error(err))
-			err = errors.New(errStr)
-			return
+		start := time.Now()
+		if err = ww.unmarshalRows(db, rp, ptId, shard, binaryRows); err != nil {
+			return errno.NewError(errno.ErrUnmarshalPoints, err)
 		}
-		for i := 0; i < len(ww.rows); i++ {
-			ww.rows[i].StreamOnly = ww.streamVars[i].Only
-			ww.rows[i].StreamId = ww.streamVars[i].Id
+		atomic.AddInt64(&statistics.PerfStat.WriteUnmarshalNs, time.Since(start).Nanoseconds())
+		if err = ww.storage.WriteRows(db, rp, ptId, shard, ww.rows, binaryRows); err != nil {
+			ww.logger.Error("write rows failed", zap.String("db", db),
+				zap.String("rp", rp), zap.Uint32("ptId", ptId), zap.Uint64("shardId", shard), zap.Error(err))
+			return err
 		}
 	}
-
-	atomic.AddInt64(&statistics.PerfStat.WriteUnmarshalNs, time.Since(start).Nanoseconds())
-	return
+	return nil
 }
 
 func (ww *WritePointsWork) WritePoints() error {
+	if len(ww.reqBuf) > 0 && ww.reqBuf[0] == netstorage.PackageTypeFastShards {
+		return ww.writeShardsPoints()
+	}
 	db, rp, ptId, shard, _, binaryRows, err := ww.decodePoints()
 	if err != nil {
 		err = errno.NewError(errno.ErrUnmarshalPoints, err)
@@ -181,6 +181,25 @@ func TestWritePointsWork_decodePoints(t *testing.T) {
 	}
 }
 
+func TestWritePointsWork_writeShardsPoints(t *testing.T) {
+	ctxs := []*netstorage.WriteContext{
+		{Rows: mockRows(), Shard: &meta.ShardInfo{ID: 1}},
+		{Rows: mockRows(), Shard: &meta.ShardInfo{ID: 2}},
+	}
+	buf, err := netstorage.MarshalShardsRows(ctxs, "db0", "rp0", 1)
+	require.NoError(t, err)
+	require.Equal(t, netstorage.PackageTypeFastShards, buf[0])
+
+	// the header, the pt and the first shard of the request are truncated
+	for _, cut := range []int{1, 12, 20, 40} {
+		ww := GetWritePointsWork()
+		ww.reqBuf = buf[:cut]
+		err = ww.WritePoints()
+		require.Error(t, err)
+		ww.PutWritePointsWork()
+	}
+}
+
 var storageDataPath = "/tmp/data/"
 var metaPath = "/tmp/meta"
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/VictoriaMetrics/VictoriaMetrics/lib/fasttime"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
@@ -52,6 +53,19 @@ type injestionCtx struct {
 	aliveShardIdxes []int
 
 	stream *Stream
+	// streamChainDepth is the number of the streams the rows of the context are emitted by one after another
+	streamChainDepth int
+	// streamSourceRP is the retention policy of the source rows of the stream tasks
+	streamSourceRP string
+	// streamSourceShard is the shard of the source rows of the stream tasks, 0 if unknown
+	streamSourceShard uint64
+	// streamWriter is the stream task mapping its rows, streamShards record the rows of the tasks by shard id
+	streamWriter streamWriter
+	streamShards map[uint64]*streamShard
+	// streamWritten are the bytes of the rows of the stream tasks mapped to the shards,
+	// they are credited to the statistics of the tasks once the rows are written
+	streamWritten map[*statistics.StreamTaskStats]int64
+	streamRowBuf  []byte
 
 	writeCtx []*netstorage.WriteContext
 }
@@ -84,6 +98,27 @@ func (s *injestionCtx) setShardRow(shardInfo *meta.ShardInfo, row *influx.Row) {
 	}
 }
 
+// addStreamWritten adds the marshaled size of the row mapped by the stream task to the bytes to be credited.
+func (s *injestionCtx) addStreamWritten(stats *statistics.StreamTaskStats, r *influx.Row) {
+	var err error
+	s.streamRowBuf, err = r.FastMarshalBinary(s.streamRowBuf[:0])
+	if err != nil {
+		return
+	}
+	if s.streamWritten == nil {
+		s.streamWritten = make(map[*statistics.StreamTaskStats]int64)
+	}
+	s.streamWritten[stats] += int64(len(s.streamRowBuf))
+}
+
+// commitStreamWritten credits the bytes of the rows written to the statistics of the stream tasks.
+func (s *injestionCtx) commitStreamWritten() {
+	for stats, n := range s.streamWritten {
+		stats.AddBytesWritten(n)
+		delete(s.streamWritten, stats)
+	}
+}
+
 func (s *injestionCtx) Reset() {
 	s.fieldToCreatePool = s.fieldToCreatePool[:0]
 	s.shardRowMap = s.shardRowMap[:0]
@@ -96,7 +131,17 @@ func (s *injestionCtx) Reset() {
 		s.mstShardIdRowMap = map[string]map[uint64]*[]*influx.Row{}
 	}
 	if s.stream != nil {
-		s.stream.tasks = map[string]*streamTask{}
+		s.stream.resetTasks()
+	}
+	s.streamChainDepth = 0
+	s.streamSourceRP = ""
+	s.streamSourceShard = 0
+	s.resetStreamWriter()
+	for k := range s.streamShards {
+		delete(s.streamShards, k)
+	}
+	for k := range s.streamWritten {
+		delete(s.streamWritten, k)
 	}
 
 	s.streamInfos = s.streamInfos[:0]
@@ -193,6 +238,8 @@ func (s *injestionCtx) initStreamVar(w *PointsWriter) (err error) {
 	if s.stream == nil {
 		s.stream = NewStream(w.TSDBStore, w.MetaClient, w.logger, w.timeout)
 	}
+	s.stream.now = w.getStreamClock()
+	s.stream.states = &w.streamTaskStates
 
 	s.initStreamDBs(streamLen)
 	s.initStreamMSTs(streamLen)
@@ -205,6 +252,9 @@ func (s *injestionCtx) initStreamVar(w *PointsWriter) (err error) {
 	streamWHs := s.getWriteHelpers()
 
 	for i := 0; i < streamLen; i++ {
+		if err = w.createStreamDestinations((*dstSis)[i]); err != nil {
+			return
+		}
 		(*streamDBS)[i], err = w.MetaClient.Database((*dstSis)[i].DesMst.Database)
 		if err != nil {
 			return
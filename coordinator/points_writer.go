@@ -80,6 +80,8 @@ type PWMetaClient interface {
 	GetAliveShards(database string, sgi *meta2.ShardGroupInfo) []int
 	GetStreamInfos() map[string]*meta2.StreamInfo
 	GetDstStreamInfos(db, rp string, dstSis *[]*meta2.StreamInfo) bool
+	SetStreamOptions(name, options string) error
+	PauseStream(name string, paused bool) error
 	DBRepGroups(database string) []meta2.ReplicaGroup
 	GetReplicaN(database string) (int, error)
 }
@@ -95,7 +97,19 @@ type PointsWriter struct {
 
 	TSDBStore TSDBStore
 
+	// StreamSource is used to read the historical rows when backfilling a stream
+	StreamSource StreamSource
+
 	logger *logger.Logger
+
+	streamTaskOptions streamTaskOptionsMap
+	streamTaskStates  streamTaskStateMap
+	// streamClock replaces the clock of the stream tasks, which is used to replay the rows deterministically
+	streamClock func() int64
+	// streamFlushMu is held for reading by the batches calculating the streams and for writing by FlushStreams
+	streamFlushMu sync.RWMutex
+	// shardWriters bounds the shards written concurrently by a batch, 0 means all the shards are written at once
+	shardWriters int
 }
 
 // NewPointsWriter returns a new instance of PointsWriter for a node.
@@ -278,10 +292,14 @@ func (w *PointsWriter) writePointRows(database, retentionPolicy string, rows []i
 	if retentionPolicy == "" {
 		retentionPolicy = ctx.db.DefaultRetentionPolicy
 	}
+	ctx.streamSourceRP = retentionPolicy
 
 	dstSis := ctx.getDstSis()
 	exist := w.MetaClient.GetDstStreamInfos(database, retentionPolicy, dstSis)
 	if exist {
+		// the stream rows of the batch are written before the flush of the streams
+		w.streamFlushMu.RLock()
+		defer w.streamFlushMu.RUnlock()
 		err = ctx.initStreamVar(w)
 		if err != nil {
 			return err
@@ -312,45 +330,137 @@ func (w *PointsWriter) writePointRows(database, retentionPolicy string, rows []i
 		}
 		return err
 	}
+	ctx.commitStreamWritten()
 	if partialErr != nil {
 		return netstorage.PartialWriteError{Reason: partialErr, Dropped: dropped}
 	}
 	return partialErr
 }
 
+// SetShardWriters bounds the shards written concurrently by a batch, n <= 0 writes all the shards at once.
+// The rows of a shard are always written by a single request to each owner of it.
+func (w *PointsWriter) SetShardWriters(n int) {
+	w.shardWriters = n
+}
+
+// shardsWriter is implemented by the stores writing the rows of the shards of a pt by a single request.
+type shardsWriter interface {
+	WriteShardsRows(ctxs []*netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error
+}
+
+// shardsWrite is the unit written by a writer of writeShardMap, which is a single shard or the shards of a pt.
+type shardsWrite struct {
+	nodeID   uint64
+	pt       uint32
+	ctxs     []*netstorage.WriteContext
+	timeouts []time.Duration
+	ss       *streamShard
+	retry    *streamWriteRetry
+}
+
 func (w *PointsWriter) writeShardMap(database, retentionPolicy string, ctx *injestionCtx) error {
 	shardRowMap := ctx.getShardRowMap()
 	var err error
 	var mutex sync.Mutex
 	var wg sync.WaitGroup
 	var writeCtx *netstorage.WriteContext
+	var writers chan struct{}
 
-	wg.Add(shardRowMap.Len())
+	// the shards of the same pt without streams are written by a single request
+	sw, _ := w.TSDBStore.(shardsWriter)
+	var ptView meta2.DBPtInfos
+	if sw != nil && shardRowMap.Len() > 1 {
+		ptView, _ = w.MetaClient.DBPtView(database)
+	}
+	units := make([]*shardsWrite, 0, shardRowMap.Len())
+	groups := make(map[uint32]*shardsWrite)
 	for i := range shardRowMap {
-		writeCtx = ctx.allocWriteContext(shardRowMap[i].shardInfo, shardRowMap[i].rows)
+		sh := shardRowMap[i].shardInfo
+		writeCtx = ctx.allocWriteContext(sh, shardRowMap[i].rows)
 
 		// get the streamId and dstShardId that is associated with the srcShardId.
-		if streamDstShardIdMap, ok := ctx.getSrcStreamDstShardIdMap()[shardRowMap[i].shardInfo.ID]; ok {
+		if streamDstShardIdMap, ok := ctx.getSrcStreamDstShardIdMap()[sh.ID]; ok {
 			for streamId, dstShardId := range streamDstShardIdMap {
 				writeCtx.StreamShards = append(writeCtx.StreamShards, streamId, dstShardId)
 			}
 		}
 
-		go func(wCtx *netstorage.WriteContext) {
-			innerErr := w.writeRowToShard(wCtx, database, retentionPolicy)
+		ss := ctx.streamShards[sh.ID]
+		timeout := ctx.shardWriteTimeout(&shardRowMap[i], w.timeout)
+		if ss == nil && len(writeCtx.StreamShards) == 0 && len(sh.Owners) == 1 && int(sh.Owners[0]) < len(ptView) {
+			pt := sh.Owners[0]
+			g, ok := groups[pt]
+			if !ok {
+				g = &shardsWrite{nodeID: ptView[pt].Owner.NodeID, pt: pt}
+				groups[pt] = g
+				units = append(units, g)
+			}
+			g.ctxs = append(g.ctxs, writeCtx)
+			g.timeouts = append(g.timeouts, timeout)
+			continue
+		}
+		units = append(units, &shardsWrite{ctxs: []*netstorage.WriteContext{writeCtx}, timeouts: []time.Duration{timeout},
+			ss: ss, retry: ss.writeRetry(&shardRowMap[i])})
+	}
+
+	if w.shardWriters > 0 && w.shardWriters < len(units) {
+		writers = make(chan struct{}, w.shardWriters)
+	}
+	wg.Add(len(units))
+	for _, u := range units {
+		if writers != nil {
+			writers <- struct{}{}
+		}
+		go func(u *shardsWrite) {
+			if writers != nil {
+				defer func() { <-writers }()
+			}
+			innerErr := w.writeShards(u, database, retentionPolicy, sw)
 			if innerErr != nil {
+				if u.ss != nil {
+					failStreamShard(u.ss, u.ctxs[0].Shard, innerErr, w.getStreamClock()())
+				}
 				mutex.Lock()
 				err = innerErr
 				mutex.Unlock()
+			} else if u.ss != nil {
+				succeedStreamShard(u.ss)
 			}
 			wg.Done()
-		}(writeCtx)
+		}(u)
 	}
 	wg.Wait()
 
 	return err
 }
 
+// writeShards writes the shards of the unit, the shards of a pt failing to be written by a single request
+// are written one by one, which retries each shard as the other writes do.
+func (w *PointsWriter) writeShards(u *shardsWrite, database, retentionPolicy string, sw shardsWriter) error {
+	if len(u.ctxs) > 1 {
+		timeout := u.timeouts[0]
+		for _, t := range u.timeouts[1:] {
+			if t > timeout {
+				timeout = t
+			}
+		}
+		err := sw.WriteShardsRows(u.ctxs, u.nodeID, u.pt, database, retentionPolicy, timeout)
+		if err == nil {
+			return nil
+		}
+		w.logger.Error("[coordinator] write the rows of the shards failed, write them one by one", zap.String("db", database),
+			zap.Uint32("pt", u.pt), zap.Int("shards", len(u.ctxs)), zap.Error(err))
+	}
+
+	var err error
+	for i, wCtx := range u.ctxs {
+		if innerErr := w.writeRowToShardWithRetry(wCtx, database, retentionPolicy, u.timeouts[i], u.retry); innerErr != nil {
+			err = innerErr
+		}
+	}
+	return err
+}
+
 func (w *PointsWriter) isPartialErr(err error) bool {
 	return strings.Contains(err.Error(), "field type conflict") ||
 		strings.Contains(err.Error(), "duplicate tag") ||
@@ -518,7 +628,11 @@ func (w *PointsWriter) routeAndCalculateStreamRows(ctx *injestionCtx) (err error
 	for mst, shardIdRowMap := range mstShardIdRowMap {
 		var dstSisIdxes []int
 		for i := 0; i < len(*dstSis); i++ {
-			if (*dstSis)[i].SrcMst.Name == mst {
+			if streamHasSource((*dstSis)[i], w.getStreamTaskOptions((*dstSis)[i]), mst) {
+				if w.streamPaused((*dstSis)[i], shardRowsLen(shardIdRowMap)) || w.streamBroken((*dstSis)[i], shardRowsLen(shardIdRowMap)) {
+					// the rows are neither calculated at the sql layer nor marked for the store
+					continue
+				}
 				dstSisIdxes = append(dstSisIdxes, i)
 			}
 		}
@@ -527,14 +641,20 @@ func (w *PointsWriter) routeAndCalculateStreamRows(ctx *injestionCtx) (err error
 		}
 
 		var mi *meta2.MeasurementInfo
-		mi, err = ctx.writeHelper.createMeasurement((*dstSis)[dstSisIdxes[0]].SrcMst.Database, (*dstSis)[dstSisIdxes[0]].SrcMst.RetentionPolicy, mst)
+		// the streams of other source retention policies read the measurement in the one written to
+		mi, err = ctx.writeHelper.createMeasurement((*dstSis)[dstSisIdxes[0]].SrcMst.Database, ctx.streamSourceRP, mst)
 		if err != nil {
 			return
 		}
 
 		for _, idx := range dstSisIdxes {
+			taskOpt := w.getStreamTaskOptions((*dstSis)[idx])
+			sqlOnly := streamNeedsSQLLayer((*dstSis)[idx], taskOpt)
 			for shardId, rs := range shardIdRowMap {
-				if len((*dstSis)[idx].Dims) != 0 {
+				if !streamHasShard(taskOpt, shardId) {
+					continue
+				}
+				if len((*dstSis)[idx].Dims) != 0 && !sqlOnly {
 					// Case1: same distribution, same shard, which db set shardKey with same db and rp,
 					// so dst measurement of the stream share the same shardId with src measurement.
 					if len(ctx.db.ShardKey.ShardKey) > 0 && (*dstSis)[idx].SrcMst.Database == (*dstSis)[idx].DesMst.Database &&
@@ -567,16 +687,25 @@ func (w *PointsWriter) routeAndCalculateStreamRows(ctx *injestionCtx) (err error
 				// Case4: different distribution, if the source table and the target table are not belong to the same distribution,
 				// the two-tier computing framework based on sql-store is adopted,
 				// the following is calculated at the sql layer.
-				if _, ok := ctx.stream.tasks[(*dstSis)[idx].Name]; !ok {
-					task, err := newStreamTask((*dstSis)[idx], mi.Schema, (*mis)[idx].Schema)
+				task, ok := ctx.stream.getTask((*dstSis)[idx].Name)
+				if !ok {
+					task, err = w.buildTask((*dstSis)[idx], mi.Schema, (*mis)[idx].Schema)
 					if err != nil {
 						return err
 					}
-					ctx.stream.tasks[(*dstSis)[idx].Name] = task
+					ctx.stream.registerTask((*dstSis)[idx].Name, task)
 				}
-				err = ctx.stream.calculate(*rs, (*dstSis)[idx], w, ctx, idx)
+				var res streamResult
+				ctx.streamSourceShard = shardId
+				res, err = ctx.stream.calculate(*rs, (*dstSis)[idx], w, ctx, idx)
+				w.logStreamResult((*dstSis)[idx].Name, &res)
 				if err != nil {
-					return
+					// the task may be rebuilt or removed by the batch
+					if task, ok = ctx.stream.getTask((*dstSis)[idx].Name); !ok || task.opt.errorAction(err) != StreamErrorDrop {
+						return
+					}
+					w.logger.Error("stream task dropped the rows", zap.String("stream", (*dstSis)[idx].Name), zap.Error(err))
+					err = nil
 				}
 			}
 		}
@@ -735,8 +864,14 @@ func (w *PointsWriter) MapRowToMeasurement(ctx *injestionCtx, id uint64, mst str
 	return nil
 }
 
-// writeRowToShard writes row to a shard.
-func (w *PointsWriter) writeRowToShard(ctx *netstorage.WriteContext, database, retentionPolicy string) error {
+// writeRowToShard writes row to a shard within the timeout.
+func (w *PointsWriter) writeRowToShard(ctx *netstorage.WriteContext, database, retentionPolicy string, timeout time.Duration) error {
+	return w.writeRowToShardWithRetry(ctx, database, retentionPolicy, timeout, nil)
+}
+
+// writeRowToShardWithRetry writes row to a shard within the timeout, the retries after the transient errors
+// are bounded by retry as well.
+func (w *PointsWriter) writeRowToShardWithRetry(ctx *netstorage.WriteContext, database, retentionPolicy string, timeout time.Duration, retry *streamWriteRetry) error {
 	start := time.Now()
 	var err error
 	var ptView meta2.DBPtInfos
@@ -744,7 +879,7 @@ func (w *PointsWriter) writeRowToShard(ctx *netstorage.WriteContext, database, r
 RETRY:
 	for {
 		// retry timeout
-		if time.Since(start).Nanoseconds() >= w.timeout.Nanoseconds() {
+		if time.Since(start).Nanoseconds() >= timeout.Nanoseconds() {
 			w.logger.Error("[coordinator] write rows timeout", zap.String("db", database), zap.Uint32s("ptIds", ctx.Shard.Owners), zap.Error(err))
 			break
 		}
@@ -753,7 +888,7 @@ RETRY:
 			break
 		}
 		for _, ptId := range ctx.Shard.Owners {
-			err = w.TSDBStore.WriteRows(ctx, ptView[ptId].Owner.NodeID, ptId, database, retentionPolicy, w.timeout)
+			err = w.TSDBStore.WriteRows(ctx, ptView[ptId].Owner.NodeID, ptId, database, retentionPolicy, timeout)
 			if err != nil && errno.Equal(err, errno.ShardMetaNotFound) {
 				w.logger.Error("[coordinator] store write failed", zap.String("db", database), zap.Uint32("pt", ptId), zap.Error(err))
 				break RETRY
@@ -763,7 +898,9 @@ RETRY:
 				w.logger.Error("[coordinator] retry write rows", zap.String("db", database), zap.Uint32("pt", ptId), zap.Error(err))
 
 				// The retry interval is added to avoid excessive error logs
-				time.Sleep(100 * time.Millisecond)
+				if !retry.wait() {
+					break RETRY
+				}
 				goto RETRY
 			}
 			if err != nil {
@@ -66,6 +66,10 @@ type MockMetaClient struct {
 	GetShardInfoByTimeFn func(database, retentionPolicy string, t time.Time, ptIdx int, nodeId uint64, engineType config.EngineType) (*meta2.ShardInfo, error)
 	DBRepGroupsFn        func(database string) []meta2.ReplicaGroup
 	GetReplicaNFn        func(database string) (int, error)
+	// streamOptions are the options of the streams set by SetStreamOptions
+	streamOptions map[string]string
+	// streamPaused are the streams paused by PauseStream
+	streamPaused map[string]bool
 }
 
 func (mmc *MockMetaClient) Database(name string) (di *meta2.DatabaseInfo, err error) {
@@ -157,7 +161,7 @@ func (mmc *MockMetaClient) GetDstStreamInfos(db, rp string, dstSis *[]*meta2.Str
 	i := 0
 	*dstSis = (*dstSis)[:cap(*dstSis)]
 	for _, si := range sis {
-		if si.SrcMst.Database == db && si.SrcMst.RetentionPolicy == rp {
+		if si.SrcMst.Database == db && si.HasSourceRP(rp) {
 			if len(*dstSis) < i+1 {
 				*dstSis = append(*dstSis, si)
 			} else {
@@ -204,6 +208,8 @@ func (mmc *MockMetaClient) GetStreamInfos() map[string]*meta2.StreamInfo {
 	groupKeys = append(groupKeys, "tk1")
 	info.Dims = groupKeys
 	info.Name = "t"
+	info.Options = mmc.streamOptions[info.Name]
+	info.Paused = mmc.streamPaused[info.Name]
 	info.Interval = time.Duration(5)
 	info.Calls = []*meta2.StreamCall{
 		{
@@ -236,6 +242,22 @@ func (mmc *MockMetaClient) GetStreamInfos() map[string]*meta2.StreamInfo {
 	return infos
 }
 
+func (mmc *MockMetaClient) SetStreamOptions(name, options string) error {
+	if mmc.streamOptions == nil {
+		mmc.streamOptions = map[string]string{}
+	}
+	mmc.streamOptions[name] = options
+	return nil
+}
+
+func (mmc *MockMetaClient) PauseStream(name string, paused bool) error {
+	if mmc.streamPaused == nil {
+		mmc.streamPaused = map[string]bool{}
+	}
+	mmc.streamPaused[name] = paused
+	return nil
+}
+
 func NewMockMetaClient() *MockMetaClient {
 	mc := &MockMetaClient{}
 	rpInfo := NewRetentionPolicy("rp0", time.Hour, engineType)
@@ -1220,10 +1242,10 @@ func TestName(t *testing.T) {
 		},
 	})
 
-	require.NoError(t, pw.writeRowToShard(ctx, "db", "rp"))
+	require.NoError(t, pw.writeRowToShard(ctx, "db", "rp", pw.timeout))
 
 	localStore.err = errors.New("some error")
-	require.EqualError(t, pw.writeRowToShard(ctx, "db", "rp"), localStore.err.Error())
+	require.EqualError(t, pw.writeRowToShard(ctx, "db", "rp", pw.timeout), localStore.err.Error())
 }
 
 type MockLocalStore struct {
@@ -1233,3 +1255,129 @@ type MockLocalStore struct {
 func (s *MockLocalStore) WriteRows(db, rp string, ptId uint32, shardID uint64, rows []influx.Row, binaryRows []byte) error {
 	return s.err
 }
+
+func TestPointsWriter_writeShardMapWriters(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	// a shard group of a shard per hour
+	groups := map[int64]*meta2.ShardGroupInfo{}
+	env.pw.MetaClient.(*MockMetaClient).CreateShardGroupFn = func(database, policy string, timestamp time.Time, version uint32, engineType config.EngineType) (*meta2.ShardGroupInfo, error) {
+		start := timestamp.Truncate(time.Hour)
+		if g, ok := groups[start.UnixNano()]; ok {
+			return g, nil
+		}
+		g := &meta2.ShardGroupInfo{ID: nextShardID(), StartTime: start, EndTime: start.Add(time.Hour - 1), EngineType: engineType,
+			Shards: []meta2.ShardInfo{{ID: nextShardID(), Owners: []uint32{0}}}}
+		groups[start.UnixNano()] = g
+		return g, nil
+	}
+	var calls, rows, inFlight, maxInFlight int64
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		n := atomic.AddInt64(&inFlight, 1)
+		for m := atomic.LoadInt64(&maxInFlight); n > m && !atomic.CompareAndSwapInt64(&maxInFlight, m, n); m = atomic.LoadInt64(&maxInFlight) {
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt64(&inFlight, -1)
+		atomic.AddInt64(&calls, 1)
+		atomic.AddInt64(&rows, int64(len(ctx.Rows)))
+		return nil
+	}
+	write := func(writers int) (int, int64) {
+		atomic.StoreInt64(&calls, 0)
+		atomic.StoreInt64(&rows, 0)
+		atomic.StoreInt64(&maxInFlight, 0)
+		env.pw.SetShardWriters(writers)
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		var src []*influx.Row
+		// the windows of 3 hours are mapped to 3 shards
+		for h := 0; h < 3; h++ {
+			for g := 0; g < 20; g++ {
+				src = append(src, newStreamTestRow(env.base+int64(h)*int64(time.Hour), []influx.Tag{{Key: "tk1", Value: fmt.Sprint(g)}}, floatField("fk1", 1)))
+			}
+		}
+		_, err := ctx.stream.calculate(src, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		shards := ctx.getShardRowMap().Len()
+		require.NoError(t, env.pw.writeShardMap("db0", "rp0", ctx))
+		return shards, atomic.LoadInt64(&maxInFlight)
+	}
+
+	// the rows of a shard are written by a single request whatever the number of the rows
+	shards, concurrent := write(0)
+	require.Equal(t, 3, shards)
+	require.Equal(t, int64(shards), atomic.LoadInt64(&calls))
+	require.Equal(t, int64(60), atomic.LoadInt64(&rows))
+	require.Equal(t, int64(3), concurrent)
+
+	shards, concurrent = write(1)
+	require.Equal(t, int64(shards), atomic.LoadInt64(&calls))
+	require.Equal(t, int64(60), atomic.LoadInt64(&rows))
+	require.Equal(t, int64(1), concurrent)
+}
+
+type mockShardsNetStore struct {
+	MockNetStore
+	WriteShardsRowsFn func(ctxs []*netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error
+}
+
+func (mns *mockShardsNetStore) WriteShardsRows(ctxs []*netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+	return mns.WriteShardsRowsFn(ctxs, nodeID, pt, database, rp, timeout)
+}
+
+func TestPointsWriter_writeShardMapShardsOfPt(t *testing.T) {
+	env := newStreamTestEnv()
+	env.pw.MetaClient.(*MockMetaClient).DBPtViewFn = func(database string) (meta2.DBPtInfos, error) {
+		return meta2.DBPtInfos{{PtId: 0, Owner: meta2.PtOwner{NodeID: 4}, Status: meta2.Online}}, nil
+	}
+	var rowCalls, shardsCalls, rows int64
+	var shardsErr error
+	store := &mockShardsNetStore{}
+	store.WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		atomic.AddInt64(&rowCalls, 1)
+		atomic.AddInt64(&rows, int64(len(ctx.Rows)))
+		return nil
+	}
+	store.WriteShardsRowsFn = func(ctxs []*netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		require.Equal(t, uint64(4), nodeID)
+		require.Equal(t, uint32(0), pt)
+		atomic.AddInt64(&shardsCalls, 1)
+		if shardsErr != nil {
+			return shardsErr
+		}
+		for _, ctx := range ctxs {
+			atomic.AddInt64(&rows, int64(len(ctx.Rows)))
+		}
+		return nil
+	}
+	env.pw.TSDBStore = store
+	write := func() {
+		atomic.StoreInt64(&rowCalls, 0)
+		atomic.StoreInt64(&shardsCalls, 0)
+		atomic.StoreInt64(&rows, 0)
+		ctx := getInjestionCtx()
+		defer putInjestionCtx(ctx)
+		// the rows of 3 hours are mapped to 3 shards of pt 0
+		for h := 0; h < 3; h++ {
+			sr := ShardRow{shardInfo: &meta2.ShardInfo{ID: nextShardID(), Owners: []uint32{0}}}
+			for g := 0; g < 20; g++ {
+				sr.rows = append(sr.rows, newStreamTestRow(env.base+int64(h)*int64(time.Hour), []influx.Tag{{Key: "tk1", Value: fmt.Sprint(g)}}, floatField("fk1", 1)))
+			}
+			ctx.shardRowMap = append(ctx.shardRowMap, sr)
+		}
+		require.NoError(t, env.pw.writeShardMap("db0", "rp0", ctx))
+	}
+
+	// the 3 shards of the pt are written by a single request
+	write()
+	require.Equal(t, int64(1), atomic.LoadInt64(&shardsCalls))
+	require.Equal(t, int64(0), atomic.LoadInt64(&rowCalls))
+	require.Equal(t, int64(60), atomic.LoadInt64(&rows))
+
+	// the shards failing to be written together are written one by one
+	shardsErr = errors.New("write failed")
+	write()
+	require.Equal(t, int64(1), atomic.LoadInt64(&shardsCalls))
+	require.Equal(t, int64(3), atomic.LoadInt64(&rowCalls))
+	require.Equal(t, int64(60), atomic.LoadInt64(&rows))
+}
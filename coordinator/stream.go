@@ -20,6 +20,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.uber.org/zap"
@@ -45,24 +49,302 @@ type streamTask struct {
 	calls          []*streamLib.FieldCall
 	tagDimKeys     []string
 	fieldIndexKeys []string
+	opt            *StreamTaskOptions
+	normalizers    []*tagNormalizer
+	// groupSep separates the values in the group keys of the task
+	groupSep   byte
+	fanOutMsts map[string]string
+	accCalls   []newAccumulatorFunc
+	// sourceTag carries the source measurement of the rows in union mode, shardDims are the keys of the shard key
+	sourceTag string
+	shardDims []string
+	// sourceRPTag carries the source retention policy of the rows of the streams with SrcRPs, empty means the rows
+	// of all the source retention policies are grouped together
+	sourceRPTag string
+	// sourceShardTag carries the source shard of the rows, empty means the rows of all the shards are grouped together
+	sourceShardTag string
+	// sketchShares are the sketches of the percentile calls read by the other calls of the same rows, nil if none
+	sketchShares []sketchShare
+	// sliding indicates that the windows overlap
+	sliding bool
+	// direct indicates that the whole windows are aggregated at the sql layer and the results of them are written
+	// to the destination directly, which is true for the sliding and shifted windows and the destinations besides DesMst
+	direct bool
+	// windowOpt computes the windows of the rows
+	windowOpt *query.ProcessorOptions
+	// destinations are the tasks of the destinations besides DesMst, which aggregate the same rows at their intervals
+	destinations []*streamTask
+	// filter skips the rows not matching the condition of the stream, nil if the stream has no condition
+	filter streamFilter
+	// callFilters skip the rows not matching the conditions of the calls, nil if no call has a condition
+	callFilters []streamFilter
+	// missingKeys are the dims and the call fields missing from the source schema when the task is built
+	missingKeys []string
+	// passthrough indicates that the rows are copied instead of aggregated, copyNormalizers rewrite the tags of them
+	passthrough     bool
+	copyNormalizers map[string]*tagNormalizer
+	// groupOnlyDims are the tag dims omitted from the tags of the agg rows
+	groupOnlyDims map[string]struct{}
+	// bucket replaces the bucketed dims with the hash bucket of their values, nil means the groups are not bucketed
+	bucket *streamBucket
+	// roundings are the scales rounding the results of the calls, nil means no call is rounded
+	roundings []float64
+	// coercions are how the float results of the calls are written to the integer fields, nil means no call is coerced
+	coercions []StreamIntCoercion
+	// carries are how long the calls carry their values into the empty windows, nil means no call carries its values
+	carries []time.Duration
+	// deltas are how the calls write their results as the deltas from the previous windows, nil means no call does
+	deltas []streamDeltaCall
+	// timeScale is the nanoseconds of the unit of the timestamps of the source rows
+	timeScale int64
+	// weights are the weight fields of the weighted_mean calls, nil means the stream has no weighted_mean call
+	weights []string
+	// missingFields are how the calls handle the rows missing their fields, nil means all the calls skip them
+	missingFields []StreamMissingField
+	// singleGroup indicates that the rows of the task with one call and no dims are aggregated by the fast path,
+	// singleWindows are the windows of the batch aggregated by it, which are reused by the next batches
+	singleGroup   bool
+	singleWindows []streamSingleWindow
+	// options are the options of the stream in meta when the task is built
+	options string
+	// skipErr is why the windows of the task do not suit the retention policy of the destination, the rows are
+	// skipped if not nil
+	skipErr error
 }
 
-func newStreamTask(info *meta2.StreamInfo, srcSchema, dstSchema map[string]int32) (*streamTask, error) {
+func newStreamTask(info *meta2.StreamInfo, srcSchema, dstSchema map[string]int32, opt *StreamTaskOptions) (*streamTask, error) {
+	if opt == nil {
+		opt = defaultStreamTaskOptions
+	}
+	w, err := buildStreamTask(info, srcSchema, dstSchema, opt,
+		streamSliding(info) || streamShifted(info) || streamStampsWindows(opt) || streamPassthrough(info) || streamCountsSamples(opt) ||
+			streamMarksComplete(opt) || streamWritesDimFields(opt) || streamSumsInts(opt))
+	if err != nil {
+		return nil, err
+	}
+	if err = w.checkDestinationSchema(dstSchema); err != nil {
+		return nil, err
+	}
+	w.missingKeys = missingSourceKeys(info, srcSchema)
+	w.options = info.Options
+	for _, d := range info.Destinations {
+		dw, err := buildStreamTask(destinationInfo(info, d), srcSchema, dstSchema, opt, true)
+		if err != nil {
+			return nil, err
+		}
+		w.destinations = append(w.destinations, dw)
+	}
+	return w, nil
+}
+
+func buildStreamTask(info *meta2.StreamInfo, srcSchema, dstSchema map[string]int32, opt *StreamTaskOptions, direct bool) (*streamTask, error) {
+	info, err := expandStreamCalls(info)
+	if err != nil {
+		return nil, err
+	}
+	info, err = withSampleCount(info, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkStreamTask(info, opt); err != nil {
+		return nil, err
+	}
 	w := &streamTask{
-		info: info,
+		info:        info,
+		opt:         opt,
+		direct:      direct,
+		passthrough: streamPassthrough(info),
+		sliding:     streamSliding(info),
 	}
-	var err error
-	w.calls, err = BuildFieldCall(info, srcSchema, dstSchema)
+	w.timeScale, err = buildTimeScale(info, opt.Window.InputPrecision)
 	if err != nil {
 		return nil, err
 	}
+	if w.passthrough {
+		w.copyNormalizers = buildCopyNormalizers(opt.Group.TagNormalizations)
+	}
+	w.windowOpt, err = buildStreamWindowOptions(info)
+	if err != nil {
+		return nil, err
+	}
+	if err = w.buildCalls(srcSchema, dstSchema); err != nil {
+		return nil, err
+	}
+	if err = w.buildDims(srcSchema); err != nil {
+		return nil, err
+	}
+	if err = w.checkOutput(); err != nil {
+		return nil, err
+	}
+	w.groupSep = streamGroupSeparator(opt)
+	w.singleGroup = w.isSingleGroup()
+	return w, nil
+}
+
+// streamNeedsSQLLayer reports whether the rows of the task are calculated at the sql layer even if the source and the
+// destination share the distribution. The store aggregates the raw rows by the definition of the stream only, so the
+// tasks filtering the rows, keeping their state or setting any option but the checks of their creation are not.
+func streamNeedsSQLLayer(si *meta2.StreamInfo, opt *StreamTaskOptions) bool {
+	if si.Condition != nil || streamHasCallCondition(si) || streamKeepsState(si) || streamPassthrough(si) || streamHasSourceRPs(si) {
+		return true
+	}
+	rest := *opt
+	rest.Limits.Workers = 0
+	rest.Limits.MaxDimValueLength = 0
+	rest.Limits.MaxInterval = 0
+	rest.Limits.MinRetentionWindows = 0
+	rest.Limits.MaxShardWindows = 0
+	rest.Limits.BackfillChunk = 0
+	return !isZeroStreamOption(reflect.ValueOf(rest))
+}
+
+// isZeroStreamOption returns whether the option is not set, the empty slices and maps are not set either.
+func isZeroStreamOption(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isZeroStreamOption(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// checkStreamTask checks the definition and the options of the stream task which do not depend on its calls.
+func checkStreamTask(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if err := checkStreamInterval(info, opt); err != nil {
+		return err
+	}
+	if err := checkStreamSlide(info); err != nil {
+		return err
+	}
+	if err := checkStreamWriteTimeout(info, opt); err != nil {
+		return err
+	}
+	if err := checkMissingDims(info, opt); err != nil {
+		return err
+	}
+	if err := checkNonFinite(info, opt); err != nil {
+		return err
+	}
+	if err := checkEmptyWindows(info, opt); err != nil {
+		return err
+	}
+	if err := checkWindowTime(info, opt); err != nil {
+		return err
+	}
+	if err := checkBreaker(info, opt); err != nil {
+		return err
+	}
+	if err := checkWarmUp(info, opt); err != nil {
+		return err
+	}
+	if err := checkStreamPassthrough(info, opt); err != nil {
+		return err
+	}
+	if err := checkGroupSeparator(info, opt); err != nil {
+		return err
+	}
+	return checkWindowStartField(info, opt)
+}
+
+// buildCalls builds the calls of the task and the state they keep across the windows.
+func (w *streamTask) buildCalls(srcSchema, dstSchema map[string]int32) error {
+	var err error
+	info, opt := w.info, w.opt
+	w.calls, err = BuildFieldCall(info, opt, srcSchema, dstSchema)
+	if err != nil {
+		return err
+	}
+	w.buildSampleCount()
+	w.roundings, err = buildCallRoundings(info, w.calls, w.direct)
+	if err != nil {
+		return err
+	}
+	w.filter, err = buildStreamFilter(info)
+	if err != nil {
+		return err
+	}
+	w.callFilters, err = buildCallFilters(info)
+	if err != nil {
+		return err
+	}
+	w.accCalls, err = buildAccumulatorCalls(info, w.calls, opt.CallOptions, w.direct)
+	if err != nil {
+		return err
+	}
+	w.buildSketchShares()
+	w.missingFields, err = buildMissingFieldCalls(info, w.calls, opt.CallOptions)
+	if err != nil {
+		return err
+	}
+	w.weights, err = buildWeightedMeanCalls(info, srcSchema)
+	if err != nil {
+		return err
+	}
+	w.carries, err = buildCarryCalls(info, opt.CallOptions)
+	if err != nil {
+		return err
+	}
+	w.deltas, err = buildDeltaCalls(info, w.calls, opt.CallOptions, w.direct)
+	return err
+}
+
+// buildDims builds the dims the rows are grouped by and the tags derived from them.
+func (w *streamTask) buildDims(srcSchema map[string]int32) error {
+	var err error
+	info, opt := w.info, w.opt
 	tagDimKeys, fieldIndexKeys := buildTagsFields(info, srcSchema)
+	if !opt.Group.GroupByFields {
+		// the dims of the fields are not grouped by
+		fieldIndexKeys = nil
+	}
 	w.tagDimKeys = make([]string, len(tagDimKeys))
 	w.fieldIndexKeys = make([]string, len(fieldIndexKeys))
 
 	copy(w.tagDimKeys, tagDimKeys)
 	copy(w.fieldIndexKeys, fieldIndexKeys)
-	return w, nil
+	if err = w.buildBucket(); err != nil {
+		return err
+	}
+	if err = w.buildSourceRPTag(); err != nil {
+		return err
+	}
+	if err = w.buildSourceShardTag(); err != nil {
+		return err
+	}
+	w.normalizers = buildTagNormalizers(w.tagDimKeys, opt.Group.TagNormalizations)
+	w.fanOutMsts = buildFanOutMsts(info, opt)
+	w.sourceTag, w.shardDims, err = buildSourceTag(info, w.sourceRPDims(w.bucketDims(w.aggDims())), opt)
+	if err != nil {
+		return err
+	}
+	if err = w.buildGroupOnlyDims(); err != nil {
+		return err
+	}
+	return w.buildDimFields()
+}
+
+// checkOutput checks the options of the task bound to its calls and dims.
+func (w *streamTask) checkOutput() error {
+	if err := w.checkCompleteField(); err != nil {
+		return err
+	}
+	if err := w.checkDenseFields(); err != nil {
+		return err
+	}
+	if err := w.checkFlushGroupPoints(); err != nil {
+		return err
+	}
+	if err := w.checkGroupSpill(); err != nil {
+		return err
+	}
+	return w.checkShardKeySize()
 }
 
 type TSDBStore interface {
@@ -75,7 +357,13 @@ type Stream struct {
 	MetaClient PWMetaClient
 	logger     *logger.Logger
 	timeout    time.Duration
-	tasks      map[string]*streamTask
+	// mu guards the tasks, which are registered by the batches and read by the snapshots of them
+	mu    sync.RWMutex
+	tasks map[string]*streamTask
+	// now returns the current time in nanoseconds
+	now func() int64
+	// states are the states of the tasks kept across the batches, nil if the stream is not bound to a writer
+	states *streamTaskStateMap
 }
 
 func NewStream(tsdbStore TSDBStore, metaClient PWMetaClient, logger *logger.Logger, timeout time.Duration) *Stream {
@@ -85,9 +373,14 @@ func NewStream(tsdbStore TSDBStore, metaClient PWMetaClient, logger *logger.Logg
 		logger:     logger,
 		timeout:    timeout,
 		tasks:      map[string]*streamTask{},
+		now:        streamNow,
 	}
 }
 
+func streamNow() int64 {
+	return int64(fasttime.UnixTimestamp() * 1e9)
+}
+
 var streamCtxPool sync.Pool
 
 func GetStreamCtx() *streamCtx {
@@ -104,16 +397,79 @@ func PutStreamCtx(s *streamCtx) {
 }
 
 type streamCtx struct {
-	minTime         int64
-	bp              *streamLib.BuilderPool
-	db              *meta2.DatabaseInfo
-	rp              *meta2.RetentionPolicyInfo
-	ms              *meta2.MeasurementInfo
-	shardKeyInfo    *meta2.ShardKeyInfo
-	writeHelper     *writeHelper
-	opt             *query.ProcessorOptions
-	aliveShardIdxes []int
-	dataCache       map[string]map[int64][]*float64
+	minTime     int64
+	bp          *streamLib.BuilderPool
+	db          *meta2.DatabaseInfo
+	rp          *meta2.RetentionPolicyInfo
+	ms          *meta2.MeasurementInfo
+	writeHelper *writeHelper
+	opt         *query.ProcessorOptions
+	dataCache   map[string]map[int64][]*float64
+	deadLetters []streamDeadLetter
+
+	// shardGroups caches the shard groups of the rows mapped by the context
+	shardGroups streamShardGroups
+	// closedCache holds the windows force-closed by the limit of the open windows per group
+	closedCache map[string]map[int64][]*float64
+	// starts is the buffer of the start times of the windows of a row
+	starts []int64
+	// filled holds the start times of the empty windows filled for the groups
+	filled map[string]map[int64]struct{}
+
+	// backfill indicates that the rows in [startTime, endTime) are recomputed
+	backfill  bool
+	startTime int64
+	endTime   int64
+	// windowsFrom and windowsTo bound the start times of the windows recomputed by the backfill
+	windowsFrom int64
+	windowsTo   int64
+	// partial indicates that the open windows are written before they are complete by the flush of the stream
+	partial bool
+	// closing indicates that the windows ended before the watermark advanced by the caller are written as complete
+	closing bool
+	// intOverflowErr is the error of the integer sums overflowed by the batch
+	intOverflowErr error
+	// result counts the outcome of the batch for the caller of calculate
+	result streamResult
+
+	// fieldToCreate and fanOutCtxs are used to write rows to the measurements other than the destination
+	fieldToCreate []*proto2.FieldSchema
+	fanOutCtxs    map[string]*streamCtx
+
+	// reorder holds the rows to deliver in time order, which is the buffer of the task kept across the batches
+	// or the buffer of a backfill
+	reorder *streamReorderBuffer
+	// taskOpt is the options of the task the rows are written for
+	taskOpt *StreamTaskOptions
+	// accumulators hold the states of the calls aggregated by accumulators, accResults are the results to fill of the batch
+	accumulators *streamAccumulators
+	accResults   []accumulatorResult
+	state        *streamTaskState
+	// deltas hold the latest results of the groups written as the deltas
+	deltas *streamDeltaState
+	// strResults are the strings selected by the calls, keyed by the slots of the results
+	strResults map[*float64]string
+	// intResults are the exact results of the calls aggregating the integers, keyed by the slots of the results
+	intResults map[*float64]int64
+
+	// groupKeyBuf is the buffer to build the group keys, groupKeys interns the keys built in the batch
+	groupKeyBuf []byte
+	groupKeys   map[string]string
+	// limitedGroups are the groups held in the batch counted by the group limits, groupBytes is their estimated size
+	limitedGroups map[string]struct{}
+	groupBytes    int64
+	// groupLimitLogged is true once the group limit of the batch is logged
+	groupLimitLogged bool
+	// chained indicates that other streams read the destination, chainRows are the rows emitted to the store for them
+	chained   bool
+	chainRows []*influx.Row
+	// groupPoints are the rows of the groups in the batch counted by the flush points
+	groupPoints map[string]int
+	// scaledRows are the copies of the source rows with their timestamps scaled to nanoseconds or replaced by the
+	// times they arrive
+	scaledRows []influx.Row
+	// spill holds the groups of the batch spilled to disk by the group limits
+	spill *streamGroupSpill
 }
 
 func (s *streamCtx) reset() {
@@ -122,10 +478,53 @@ func (s *streamCtx) reset() {
 	s.rp = nil
 	s.ms = nil
 	s.writeHelper = nil
-	s.shardKeyInfo = nil
 	s.opt = nil
-	s.aliveShardIdxes = s.aliveShardIdxes[:0]
+	s.shardGroups = s.shardGroups[:0]
 	s.dataCache = make(map[string]map[int64][]*float64)
+	s.deadLetters = s.deadLetters[:0]
+	s.backfill = false
+	s.partial = false
+	s.closing = false
+	s.intOverflowErr = nil
+	s.result = streamResult{}
+	s.startTime = 0
+	s.endTime = 0
+	s.windowsFrom = 0
+	s.windowsTo = 0
+	s.fieldToCreate = s.fieldToCreate[:0]
+	for _, c := range s.fanOutCtxs {
+		PutStreamCtx(c)
+	}
+	s.fanOutCtxs = nil
+	s.reorder = nil
+	s.taskOpt = nil
+	s.accumulators = nil
+	s.accResults = s.accResults[:0]
+	s.state = nil
+	s.deltas = nil
+	s.strResults = nil
+	s.intResults = nil
+	s.closedCache = nil
+	s.filled = nil
+	s.groupKeyBuf = s.groupKeyBuf[:0]
+	for k := range s.groupKeys {
+		delete(s.groupKeys, k)
+	}
+	s.limitedGroups = nil
+	s.groupBytes = 0
+	s.groupLimitLogged = false
+	s.chained = false
+	s.chainRows = s.chainRows[:0]
+	s.groupPoints = nil
+	for i := range s.scaledRows {
+		// the copies share the tags and the fields of the source rows
+		s.scaledRows[i] = influx.Row{}
+	}
+	s.scaledRows = s.scaledRows[:0]
+	if s.spill != nil {
+		s.spill.close()
+		s.spill = nil
+	}
 }
 
 func (s *streamCtx) SetBP(bp *streamLib.BuilderPool) {
@@ -150,7 +549,7 @@ func (s *streamCtx) checkDBRP(database, retentionPolicy string, w *Stream) (err
 	}
 
 	if s.rp.Duration > 0 {
-		s.minTime = int64(fasttime.UnixTimestamp()*1e9) - s.rp.Duration.Nanoseconds()
+		s.minTime = w.now() - s.rp.Duration.Nanoseconds()
 	}
 	return
 }
@@ -166,7 +565,7 @@ func (s *streamCtx) initVar(w *PointsWriter, si *meta2.StreamInfo) (err error) {
 	}
 
 	if s.opt == nil {
-		s.opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: si.Interval}}
+		s.opt = &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: si.Interval, Offset: si.Offset}}
 	}
 
 	if s.dataCache == nil {
@@ -182,214 +581,928 @@ func (s *streamCtx) initVar(w *PointsWriter, si *meta2.StreamInfo) (err error) {
 	return
 }
 
+// calculate aggregates the rows for the task and its destinations. The result counts the windows emitted and
+// the rows dropped by the partial errors of the batch, it is returned with the fatal error if any.
 func (s *Stream) calculate(
 	rows []*influx.Row, si *meta2.StreamInfo, pw *PointsWriter, iCtx *injestionCtx, idx int,
+) (streamResult, error) {
+	if pw.streamPaused(si, len(rows)) {
+		s.removeTask(si.Name)
+		return streamResult{}, nil
+	}
+	if pw.streamBroken(si, len(rows)) {
+		return streamResult{}, nil
+	}
+	ctx := GetStreamCtx()
+	defer PutStreamCtx(ctx)
+	err := s.process(rows, si, pw, iCtx, idx, ctx)
+	return ctx.takeResult(), err
+}
+
+// backfill recomputes the windows of the rows in the time range [start, end),
+// the results are written to the destination measurement directly instead of the store stream.
+func (s *Stream) backfill(
+	rows []*influx.Row, si *meta2.StreamInfo, pw *PointsWriter, iCtx *injestionCtx, idx int, r *streamBackfillRange,
 ) error {
 	ctx := GetStreamCtx()
 	defer PutStreamCtx(ctx)
+	ctx.backfill = true
+	ctx.startTime, ctx.endTime = r.start, r.end
+	ctx.windowsFrom, ctx.windowsTo = r.windowsFrom, r.windowsTo
+	// all the rows of the windows are recomputed at once, so nothing is shared with the forward computation
+	ctx.accumulators = &streamAccumulators{}
+	ctx.deltas = r.deltas
+	return s.process(rows, si, pw, iCtx, idx, ctx)
+}
 
-	task, ok := s.tasks[si.Name]
-	if !ok {
-		return fmt.Errorf("%s have no task", si.Name)
+func (s *Stream) process(
+	rows []*influx.Row, si *meta2.StreamInfo, pw *PointsWriter, iCtx *injestionCtx, idx int, ctx *streamCtx,
+) error {
+	task, err := s.loadTask(si, pw, iCtx, idx)
+	if err != nil {
+		return err
+	}
+	// the destination measurement is created with the injestion context
+	ctx.ms = iCtx.streamMSTs[idx]
+	// the rows mapped by the task, its dead letters and destinations are written with its write options
+	iCtx.setStreamWriter(si, pw.getStreamTaskState(si.Name), task.opt)
+	defer iCtx.resetStreamWriter()
+	if !ctx.backfill {
+		iCtx.releaseThrottledRows()
+		iCtx.mapSpilledRows()
+		iCtx.jitterStreamFlush(si, task.opt)
+	}
+	rows = s.dropMalformedRows(rows, si, pw.getStreamTaskState(si.Name))
+	if task.timeScale > 1 {
+		if rows, err = ctx.scaleTimes(rows, si, task); err != nil {
+			return err
+		}
+	}
+	if streamDedups(task.opt) {
+		rows = ctx.dedupRows(rows, si, task, pw.getStreamTaskState(si.Name), iCtx.streamSourceRP)
 	}
+	if streamUsesProcessingTime(task.opt) {
+		rows = ctx.stampArrivals(rows, task, s.now())
+	}
+	var children []*meta2.StreamInfo
+	if !ctx.backfill && !task.direct {
+		children = pw.streamChildren(si)
+		ctx.chained = len(children) > 0
+	}
+	err = s.aggregate(rows, si, task, pw, iCtx, ctx)
+	if err != nil {
+		return err
+	}
+
+	// the failure of the dead-letter sink never fails the batch
+	s.mapDeadLettersToShard(si, task, ctx, pw, iCtx)
+
+	for _, d := range task.destinations {
+		if err = s.aggregateDestination(rows, d, pw, iCtx, ctx); err != nil {
+			return err
+		}
+	}
+	if len(ctx.chainRows) > 0 {
+		pw.calculateStreamChildren(children, ctx.chainRows, pw.streamRP(si.DesMst), iCtx.streamChainDepth+1)
+	}
+	return nil
+}
+
+// getTask returns the task registered to the stream.
+func (s *Stream) getTask(name string) (*streamTask, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	task, ok := s.tasks[name]
+	return task, ok
+}
+
+// registerTask registers the task to the stream, replacing the one of the same name.
+func (s *Stream) registerTask(name string, task *streamTask) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[name] = task
+}
+
+// removeTask removes the task from the stream, it is built again by the following batch.
+func (s *Stream) removeTask(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tasks, name)
+}
+
+// resetTasks removes all the tasks from the stream.
+func (s *Stream) resetTasks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks = map[string]*streamTask{}
+}
+
+// loadTask returns the task of the stream. The task of a stream synced from meta may not be registered yet,
+// which is built from the current schemas of the source and the destination instead of failing the batch.
+// The task is rebuilt as well once a key it references is added to the source, so the new field is aggregated
+// with its type, and once the options of the stream are changed.
+func (s *Stream) loadTask(si *meta2.StreamInfo, pw *PointsWriter, iCtx *injestionCtx, idx int) (*streamTask, error) {
+	task, ok := s.getTask(si.Name)
+	if ok && task.options == si.Options && len(task.missingKeys) == 0 {
+		return task, nil
+	}
+	srcMst, err := iCtx.writeHelper.createMeasurement(si.SrcMst.Database, si.SrcMst.RetentionPolicy, si.SrcMst.Name)
+	if err != nil {
+		if ok {
+			// the schema is checked again by the next batch
+			return task, nil
+		}
+		return nil, fmt.Errorf("%s have no task: %v", si.Name, err)
+	}
+	if ok && task.options == si.Options && !task.sourceKeysAdded(srcMst.Schema) {
+		return task, nil
+	}
+	if ok {
+		// the fields of the calls may be added to the destination by the same change
+		if dstMst, err := pw.MetaClient.Measurement(si.DesMst.Database, si.DesMst.RetentionPolicy, si.DesMst.Name); err == nil {
+			iCtx.streamMSTs[idx] = dstMst
+		}
+	}
+	rebuilt, err := pw.buildTask(si, srcMst.Schema, iCtx.streamMSTs[idx].Schema)
+	if err != nil {
+		if ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s have no task: %v", si.Name, err)
+	}
+	s.registerTask(si.Name, rebuilt)
+	if ok {
+		pw.getStreamTaskState(si.Name).addSchemaRebuild()
+	} else {
+		pw.getStreamTaskState(si.Name).addLazyTaskBuild()
+	}
+	return rebuilt, nil
+}
+
+// aggregate calculates the windows of the rows for the destination of the task and maps the results to the shards.
+func (s *Stream) aggregate(
+	rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, iCtx *injestionCtx, ctx *streamCtx,
+) error {
+	ctx.bindTask(si, task, pw, s.now())
+	ctx.state.stats.AddRowsIn(int64(len(rows)))
+	start := time.Now()
+	defer func() {
+		ctx.state.stats.AddCalculation(time.Since(start).Nanoseconds())
+	}()
 
 	err := ctx.checkDBRP(si.DesMst.Database, si.DesMst.RetentionPolicy, s)
 	if err != nil {
 		return err
 	}
+	if task.skipErr != nil {
+		return nil
+	}
 
+	if ctx.opt == nil {
+		ctx.opt = task.windowOpt
+	}
 	err = ctx.initVar(pw, si)
 	if err != nil {
 		return err
 	}
 
-	err = s.calculateWindow(rows, si, task, ctx)
+	if task.passthrough {
+		return s.copyRows(rows, si, task, pw, ctx, iCtx)
+	}
+	if task.singleGroup && !ctx.backfill {
+		// the windows of the single group are only emitted by the batch aggregating them
+		defer task.resetSingleWindows()
+		err = s.calculateSingleGroup(rows, si, task, ctx)
+	} else {
+		err = s.calculateWindow(rows, si, task, ctx, iCtx)
+	}
 	if err != nil {
 		return err
 	}
+	return s.emitWindows(si, task, ctx, iCtx)
+}
 
-	err = s.mapRowsToShard(si, task, ctx, iCtx, idx)
-	if err != nil {
+// bindTask binds the state of the task kept across the batches to the context.
+func (ctx *streamCtx) bindTask(si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, now int64) {
+	ctx.taskOpt = task.opt
+	ctx.state = pw.getStreamTaskState(si.Name)
+	if ctx.accumulators == nil {
+		ctx.accumulators = &ctx.state.accumulators
+	}
+	if ctx.deltas == nil {
+		ctx.deltas = &ctx.state.delta
+	}
+	ctx.state.reconcile(task)
+	if streamWarmsUp(task.opt) && !ctx.backfill {
+		ctx.state.startWarmUp(si, task.opt, now)
+	}
+}
+
+// emitWindows fills or carries the empty windows if needed, replaces the results of the delta calls with their
+// deltas and maps the windows to the shards.
+func (s *Stream) emitWindows(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx) error {
+	if !ctx.backfill && streamFills(si) {
+		s.fillWindows(si, task, ctx)
+	}
+	if !ctx.backfill && task.carries != nil {
+		s.carryWindows(si, task, ctx)
+	}
+	if task.deltas != nil {
+		ctx.applyDeltas(si, task)
+	}
+	return s.mapRowsToShard(si, task, ctx, iCtx)
+}
+
+func (s *Stream) calculateWindow(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx) error {
+	if task.accCalls != nil {
+		ctx.accumulators.mu.Lock()
+		defer ctx.accumulators.mu.Unlock()
+	}
+	var maxTime int64 = math.MinInt64
+	var aggregated int64
+	var workers streamWorkerRows
+	if task.parallel() {
+		workers = make(streamWorkerRows, task.opt.Limits.Workers)
+	}
+	// the watermark is the max time seen by the task, the backfill rows are never late
+	watermark := maxTime
+	if !ctx.backfill {
+		watermark = ctx.state.loadWatermark()
+	}
+	for _, r := range rows {
+		if ctx.backfill && (r.Timestamp < ctx.startTime || r.Timestamp >= ctx.endTime) {
+			continue
+		}
+		if task.filter != nil && !task.filter(r) {
+			continue
+		}
+		if fv := task.unsupportedField(r); fv != nil {
+			if task.opt.Errors.DeadLetterMst == "" {
+				return fmt.Errorf("the %s %s type is not supported for stream task %s", fv.Key, influx.FieldTypeString(fv.Type), si.Name)
+			}
+			ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterTypeError})
+			continue
+		}
+		if streamHasSourceRPs(si) {
+			if fv := task.mismatchedSourceField(r); fv != nil {
+				if task.opt.Errors.DeadLetterMst == "" {
+					return fmt.Errorf("the %s %s type of the source retention policy %s differs from the source schema of stream task %s",
+						fv.Key, influx.FieldTypeString(fv.Type), iCtx.streamSourceRP, si.Name)
+				}
+				ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterTypeError})
+				continue
+			}
+		}
+		if i := task.missingFieldCall(r); i >= 0 {
+			if task.opt.Errors.DeadLetterMst == "" {
+				return fmt.Errorf("the field %s of the %s call %s is missing from the row of stream task %s",
+					task.calls[i].Name, task.info.Calls[i].Call, task.info.Calls[i].Alias, si.Name)
+			}
+			ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterMissingField})
+			continue
+		}
+		if task.opt.Group.MissingDims == StreamMissingDimSkip && task.missingDim(r) {
+			if task.opt.Errors.DeadLetterMst != "" {
+				ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterMissingDim})
+			}
+			continue
+		}
+		starts := ctx.windowStarts(si, r.Timestamp)
+		if !ctx.backfill && task.isLate(ctx.windowEnd(si, starts[0])-1, watermark) {
+			ctx.state.addLateRow()
+			if task.opt.Errors.DeadLetterMst != "" {
+				ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterLate})
+			}
+			continue
+		}
+		buf := ctx.groupKeyBuf[:0]
+		// the source shard leads the key of the rows grouped by it, followed by the source retention policy
+		if task.sourceShardTag != "" {
+			buf = appendSourceShard(buf, iCtx.streamSourceShard)
+			buf = append(buf, task.groupSep)
+		}
+		// the source retention policy leads the key of the rows grouped by it
+		if task.sourceRPTag != "" {
+			buf = appendGroupValue(buf, iCtx.streamSourceRP, task.groupSep)
+			buf = append(buf, task.groupSep)
+		}
+		// the source measurement leads the key of the union rows carrying it, the rows of different measurements
+		// with the same tag values are grouped apart. The keys of the other streams are the keys of the dims only.
+		if task.sourceTag != "" {
+			buf = appendGroupValue(buf, influx.GetOriginMstName(r.Name), task.groupSep)
+			buf = append(buf, task.groupSep)
+		}
+		// the bucket follows the source measurement, the bucketed dims are not in the key
+		if task.bucket != nil {
+			buf = task.appendBucket(buf, r)
+		}
+		ctx.groupKeyBuf = task.appendGroupKey(buf, r)
+		groupKey := ctx.internGroupKey(ctx.groupKeyBuf)
+		if task.limitsGroups() && !ctx.admitGroup(task, groupKey) {
+			if task.spillsGroups() {
+				spilled, err := s.spillGroups(si, task, ctx)
+				if err != nil {
+					return err
+				}
+				if !spilled || !ctx.admitGroup(task, groupKey) {
+					s.rejectGroupRow(si, task, ctx, r)
+					continue
+				}
+			} else if !task.opt.Limits.FlushOnGroupLimit {
+				s.rejectGroupRow(si, task, ctx, r)
+				continue
+			} else {
+				if err := s.flushGroups(si, task, ctx, iCtx, workers); err != nil {
+					return err
+				}
+				ctx.admitGroup(task, groupKey)
+				// the buffer of the starts may be reused by the flush
+				starts = ctx.windowStarts(si, r.Timestamp)
+			}
+		}
+		if task.spillsGroups() {
+			ctx.touchGroup(groupKey)
+		}
+		aggregated++
+		if r.Timestamp > maxTime {
+			maxTime = r.Timestamp
+		}
+		if r.Timestamp > watermark {
+			watermark = r.Timestamp
+		}
+		if workers != nil {
+			workers.add(r, groupKey)
+			continue
+		}
+		s.addToWindows(r, si, task, ctx, groupKey, starts)
+		if ctx.intOverflowErr != nil {
+			return ctx.intOverflowErr
+		}
+		if ctx.countGroupPoint(task, groupKey) {
+			if err := s.flushGroup(si, task, ctx, iCtx, groupKey); err != nil {
+				return err
+			}
+		}
+	}
+	if workers != nil {
+		s.aggregateParallel(workers, si, task, ctx)
+		if err := s.flushWorkerGroups(si, task, ctx, iCtx); err != nil {
+			return err
+		}
+	}
+	if err := ctx.reloadSpilledGroups(task); err != nil {
 		return err
 	}
+	ctx.finishWindows()
+	ctx.state.stats.AddRowsAggregated(aggregated)
+	if maxTime == math.MinInt64 {
+		return nil
+	}
+	if !ctx.backfill {
+		ctx.state.advanceWatermark(maxTime)
+	}
+	if task.accCalls != nil {
+		// the rows of the windows ended before the delay and the lateness are not accepted any more
+		before := watermark - int64(si.Delay) - int64(task.opt.Window.AllowedLateness)
+		if streamMarksComplete(task.opt) {
+			ctx.addCompletedWindows(task, before)
+		}
+		ctx.accumulators.expire(before)
+	}
 	return nil
 }
 
-func (s *Stream) calculateWindow(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
-	for _, r := range rows {
-		groupKey := s.GenerateGroupKey(ctx, si.Dims, r)
+// finishWindows merges the closed windows into the windows to emit and fills the results of the accumulators.
+func (s *streamCtx) finishWindows() {
+	// the closed windows are emitted with the open ones, they never share the time with the open windows of the group
+	for k, tv := range s.closedCache {
+		for t, v := range tv {
+			s.dataCache[k][t] = v
+		}
+	}
+	for i := range s.accResults {
+		s.accResults[i].fill(s)
+	}
+}
+
+// addToWindows aggregates the row into the windows of the starts.
+func (s *Stream) addToWindows(r *influx.Row, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, groupKey string, starts []int64) {
+	for _, st := range starts {
+		if ctx.backfill && (st < ctx.windowsFrom || st >= ctx.windowsTo) {
+			// the window is recomputed by another chunk of the backfill
+			continue
+		}
 		// get the end time of the window corresponding to this time,
 		// and subtract 1 to avoid this time from expiring.
-		_, et := ctx.opt.Window(r.Timestamp)
-		et = et - 1
-		v, ok := ctx.dataCache[groupKey]
-		if !ok {
-			ctx.dataCache[groupKey] = make(map[int64][]*float64)
-			v = ctx.dataCache[groupKey]
-			ctx.dataCache[groupKey][et] = make([]*float64, len(task.calls))
-		} else if _, ok := v[et]; !ok {
-			v[et] = make([]*float64, len(task.calls))
+		et := st + int64(si.Interval) - 1
+		if ctx.backfill || task.direct {
+			// the backfill rows and the direct windows are the results of the whole windows,
+			// which are written at the start time of the window as the store does
+			et = st
 		}
-		for i := range task.calls {
+		s.addToWindow(r, si, task, ctx, groupKey, st, et)
+	}
+}
+
+// addToWindow aggregates the row into the window of the group.
+func (s *Stream) addToWindow(r *influx.Row, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, groupKey string, st, et int64) {
+	if _, ok := ctx.closedCache[groupKey][et]; ok {
+		ctx.state.addClosedWindowRow()
+		return
+	}
+	v, ok := ctx.dataCache[groupKey]
+	if !ok {
+		ctx.dataCache[groupKey] = make(map[int64][]*float64)
+		v = ctx.dataCache[groupKey]
+		ctx.dataCache[groupKey][et] = make([]*float64, len(task.calls))
+	} else if _, ok := v[et]; !ok {
+		if !ctx.backfill && task.opt.Limits.MaxGroupWindows > 0 && len(v) >= task.opt.Limits.MaxGroupWindows {
+			if !ctx.closeOldestWindow(groupKey, v, et) {
+				// the window is older than all the open windows of the group
+				ctx.state.addClosedWindowRow()
+				return
+			}
+			ctx.state.addForcedClose()
+		}
+		v[et] = make([]*float64, len(task.calls))
+	}
+	var sampled bool
+	for i := range task.calls {
+		var fv influx.Field
+		var weight float64
+		if task.isSampleCount(i) {
+			// the row is counted once if it is aggregated by any call of the window
+			if !sampled {
+				continue
+			}
+			fv = sampleCountField
+		} else {
 			id, ok := r.ColumnToIndex[task.calls[i].Name]
-			if !ok {
+			if !ok && !task.zeroesMissing(i) {
 				//miss field value
 				continue
 			}
-			fv := r.Fields[id-r.Tags.Len()]
-			if fv.Type == influx.Field_Type_String {
-				// the computation of string type is not supported
-				return fmt.Errorf("the %s string type is not supported for stream task %s", fv.Key, si.Name)
+			if task.callFilters != nil && task.callFilters[i] != nil && !task.callFilters[i](r) {
+				// the slot of the call is left to the other rows of the window
+				continue
 			}
-			curVal := fv.NumValue
-			if task.calls[i].Call == "count" {
-				curVal = 1
+			if ok {
+				fv = r.Fields[id-r.Tags.Len()]
+			} else {
+				fv = influx.Field{Key: task.calls[i].Name, Type: task.calls[i].InFieldType}
 			}
-			if v[et][i] == nil {
-				var t float64
-				if task.calls[i].Call == "min" {
-					t = math.MaxFloat64
-				} else if task.calls[i].Call == "max" {
-					t = -math.MaxFloat64
+			if task.weights != nil && task.weights[i] != "" {
+				if weight, ok = task.weightOf(r, i); !ok {
+					// the rows without the weight are only skipped by the weighted_mean call
+					continue
 				}
-				v[et][i] = &t
 			}
-			*v[et][i] = task.calls[i].SingleThreadFunc(*v[et][i], curVal)
+			sampled = true
+		}
+		if task.accCalls != nil && task.accCalls[i] != nil {
+			key := accumulatorKey{group: groupKey, call: i, start: st}
+			var acc streamLib.Accumulator
+			if task.sharesSketch(i) {
+				acc = ctx.accumulators.share(key, task.sketchShares[i], ctx.windowEnd(si, st), task.accCalls[i], &fv, r.Timestamp)
+			} else if task.weights != nil && task.weights[i] != "" {
+				acc = ctx.accumulators.addWeighted(key, ctx.windowEnd(si, st), task.accCalls[i], fv.NumValue, weight)
+			} else {
+				acc = ctx.accumulators.add(key, ctx.windowEnd(si, st), task.accCalls[i], &fv, r.Timestamp)
+			}
+			if sum, ok := acc.(*intSumAccumulator); ok && sum.overflows > 0 {
+				ctx.countIntOverflows(task, i, sum)
+			}
+			if v[et][i] == nil {
+				v[et][i] = new(float64)
+				ctx.accResults = append(ctx.accResults, accumulatorResult{window: v[et], call: i, acc: acc,
+					str: task.calls[i].OutFieldType == influx.Field_Type_String, group: groupKey})
+			}
+			continue
+		}
+		if task.calls[i].Call == "count" {
+			if v[et][i] == nil {
+				v[et][i] = new(float64)
+			}
+			ctx.addCount(v[et][i])
+			continue
+		}
+		curVal := fv.NumValue
+		if v[et][i] == nil {
+			var t float64
+			if task.calls[i].Call == "min" {
+				t = math.MaxFloat64
+			} else if task.calls[i].Call == "max" {
+				t = -math.MaxFloat64
+			}
+			v[et][i] = &t
+		}
+		*v[et][i] = task.calls[i].SingleThreadFunc(*v[et][i], curVal)
+	}
+}
+
+// closeOldestWindow moves the oldest open window of the group to the closed windows to open the window of et,
+// it returns false if the window of et is older than all the open windows, which is not opened then.
+func (s *streamCtx) closeOldestWindow(groupKey string, windows map[int64][]*float64, et int64) bool {
+	oldest := int64(math.MaxInt64)
+	for t := range windows {
+		if t < oldest {
+			oldest = t
+		}
+	}
+	if et < oldest {
+		return false
+	}
+	if s.closedCache == nil {
+		s.closedCache = make(map[string]map[int64][]*float64)
+	}
+	closed, ok := s.closedCache[groupKey]
+	if !ok {
+		closed = make(map[int64][]*float64)
+		s.closedCache[groupKey] = closed
+	}
+	closed[oldest] = windows[oldest]
+	delete(windows, oldest)
+	return true
+}
+
+// unsupportedField returns the first field of the row used by the calls which can not be aggregated.
+// The strings are only selected by first and last, the booleans are only aggregated by the boolean calls and count.
+func (w *streamTask) unsupportedField(r *influx.Row) *influx.Field {
+	for i := range w.calls {
+		id, ok := r.ColumnToIndex[w.calls[i].Name]
+		if !ok {
+			continue
+		}
+		fv := &r.Fields[id-r.Tags.Len()]
+		switch {
+		case fv.Type == influx.Field_Type_String && !supportsString(w.calls[i].Call):
+			return fv
+		case fv.Type == influx.Field_Type_Boolean && !supportsBoolean(w.calls[i].Call):
+			return fv
+		case fv.Type != influx.Field_Type_Boolean && streamLib.IsBooleanCall(w.calls[i].Call):
+			return fv
 		}
 	}
 	return nil
 }
 
+// supportsString returns whether the call selects the string fields.
+func supportsString(call string) bool {
+	return call == "first" || call == "last"
+}
+
+// supportsBoolean returns whether the call aggregates the boolean fields.
+func supportsBoolean(call string) bool {
+	return call == "count" || streamLib.IsBooleanCall(call)
+}
+
 func (s *Stream) mapRowsToShard(
-	si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx, idx int,
+	si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx,
 ) error {
 	wRows := iCtx.getPRowsPool()
 
 	size := 0
 	dimLen := len(task.tagDimKeys) + len(task.fieldIndexKeys)
 	callLen := len(task.calls)
-	srcStreamDstShardIdMap := iCtx.getSrcStreamDstShardIdMap()
-	mstName := iCtx.streamMSTs[idx].Name
+	mstName := ctx.ms.Name
 	oriLen, oriCap := len(*wRows), cap(*wRows)
 	*wRows = (*wRows)[:oriCap]
 	for i := oriLen; i < oriCap; i++ {
 		(*wRows)[i] = &influx.Row{}
 	}
-	for k, tv := range ctx.dataCache {
-		var groupValue []string
-		if len(k) != 0 {
-			groupValue = strings.Split(k, config.StreamGroupValueStrSeparator)
-			if len(groupValue) != dimLen {
-				errStr := fmt.Sprintf("group value is mssing for stream task %s, groupValue %v, tagDimKeys %v, fieldIndexKeys %v groupLen %v dimLen %v",
-					si.Name, groupValue, task.tagDimKeys, task.fieldIndexKeys, len(groupValue), dimLen)
-				return errors.New(errStr)
-			}
+	if streamStampsWindows(task.opt) && task.fanOutMsts == nil {
+		if err := s.ensureWindowStartField(si, task, ctx); err != nil {
+			return err
 		}
-		for t, v := range tv {
-			size++
-			if len(*wRows) < size {
-				*wRows = append(*wRows, &influx.Row{})
+	}
+	if streamCountsSamples(task.opt) && task.fanOutMsts == nil {
+		if err := s.ensureSampleCountField(si, task, ctx); err != nil {
+			return err
+		}
+	}
+	if streamWritesDimFields(task.opt) {
+		if err := s.ensureDimFields(si, task, ctx); err != nil {
+			return err
+		}
+	}
+	var completeBefore int64
+	if streamMarksComplete(task.opt) {
+		if task.fanOutMsts == nil {
+			if err := s.ensureCompleteField(si, task, ctx); err != nil {
+				return err
 			}
-			r := (*wRows)[size-1]
-			r.Reset()
+		}
+		completeBefore = ctx.completeBefore(si, task)
+	}
+	ordered := task.opt.Output.ReorderBufferSize > 0
+	if ordered {
+		ctx.bindReorder()
+		ctx.reorder.init(task.opt.Output.ReorderBufferSize, int64(task.opt.Output.ReorderLateness))
+	}
+	// emitWindow maps the window of the group the variables below are set to, the windows of the single group
+	// are kept on the task instead of the data cache
+	var filled map[int64]struct{}
+	var sourceShard, sourceRP, source, bucket string
+	var groupValue []string
+	emitWindow := func(t int64, v []*float64) error {
+		if ctx.minTime != 0 && t < ctx.minTime {
+			// the window is out of the retention policy of the destination, the store drops it anyway
+			ctx.state.addExpiredWindow()
+			return nil
+		}
+		if ctx.warmsUpWindow(si, task, t) {
+			return nil
+		}
+		size++
+		if len(*wRows) < size {
+			*wRows = append(*wRows, &influx.Row{})
+		}
+		r := (*wRows)[size-1]
+		r.Reset()
 
-			// update the fields of the agg row
-			if r.Fields == nil || cap(r.Fields) < callLen {
-				r.Fields = make([]influx.Field, len(task.calls))
+		// update the fields of the agg row
+		if r.Fields == nil || cap(r.Fields) < callLen {
+			r.Fields = make([]influx.Field, len(task.calls))
+		}
+		var fieldCount, valueCount int
+		r.Fields = r.Fields[:len(task.calls)]
+		for i := range task.calls {
+			if v[i] == nil && !task.opt.Output.DenseFields {
+				// the fields of the calls without values are skipped
+				continue
+			}
+			f := &r.Fields[fieldCount]
+			f.Key = task.calls[i].Alias
+			f.NumValue = 0
+			f.StrValue = ""
+			f.Type = task.outFieldType(i)
+			fieldCount++
+			if v[i] == nil {
+				// the dense layout writes the zero of the type for the calls without values
+				continue
 			}
-			var fieldCount int
-			r.Fields = r.Fields[:len(task.calls)]
-			for i := range task.calls {
-				if v[i] == nil {
+			f.NumValue = *v[i]
+			if f.Type == influx.Field_Type_String {
+				f.NumValue, f.StrValue = 0, ctx.strResults[v[i]]
+			} else if n, ok := ctx.intResults[v[i]]; ok && f.Type == influx.Field_Type_Int {
+				f.SetInt(n)
+			} else if isNonFinite(f.NumValue) {
+				ctx.state.addNonFiniteValue()
+				switch task.opt.Output.NonFinite {
+				case StreamNonFiniteError:
+					return task.nonFiniteError(i, f.NumValue)
+				case StreamNonFiniteZero:
+					f.NumValue = 0
+				default:
+					f.NumValue = 0
+					if !task.opt.Output.DenseFields {
+						fieldCount--
+					}
 					continue
 				}
-				r.Fields[i].Key = task.calls[i].Alias
-				r.Fields[i].NumValue = *v[i]
-				r.Fields[i].Type = task.calls[i].OutFieldType
-				fieldCount++
+			} else if task.roundings != nil && task.roundings[i] != 0 {
+				f.NumValue = roundHalfEven(f.NumValue, task.roundings[i])
 			}
-			if fieldCount == 0 {
-				//no field, skip group
-				continue
+			valueCount++
+			if task.coercions != nil {
+				f.NumValue = coerceToInt(f.NumValue, task.coercions[i])
+			}
+		}
+		if valueCount == 0 {
+			// no value, the empty window is skipped unless the task writes it
+			if !task.emptyWindowFields(r) {
+				return nil
 			}
+		} else {
 			r.Fields = r.Fields[:fieldCount]
+		}
 
-			if dimLen != 0 {
-				// update the tags and columnToIndex of the agg row
-				if r.Tags == nil || cap(r.Tags) < dimLen {
-					r.Tags = make([]influx.Tag, dimLen)
-				}
-				r.Tags = r.Tags[:dimLen]
-				if r.ColumnToIndex == nil {
-					r.ColumnToIndex = make(map[string]int)
-				}
-				index := 0
-				for i := range task.tagDimKeys {
-					r.Tags[index].Key = task.tagDimKeys[i]
-					r.Tags[index].Value = groupValue[i]
-					r.ColumnToIndex[r.Tags[index].Key] = index
-					index++
-				}
+		if dimLen != 0 {
+			// update the tags and columnToIndex of the agg row
+			if r.Tags == nil || cap(r.Tags) < dimLen {
+				r.Tags = make([]influx.Tag, dimLen)
+			}
+			r.Tags = r.Tags[:dimLen]
+			if r.ColumnToIndex == nil {
+				r.ColumnToIndex = make(map[string]int)
+			}
+			index := 0
+			for i := range task.tagDimKeys {
+				r.Tags[index].Key = task.tagDimKeys[i]
+				r.Tags[index].Value = task.dimValue(groupValue[i])
+				r.ColumnToIndex[r.Tags[index].Key] = index
+				index++
 			}
+			if len(task.fieldIndexKeys) > 0 {
+				task.addFieldDimTags(r, groupValue[index:])
+			}
+			if task.groupOnlyDims != nil {
+				task.dropGroupOnlyTags(r)
+			}
+			if streamWritesDimFields(task.opt) {
+				task.moveDimsToFields(r)
+			}
+		}
+		if task.sourceShardTag != "" {
+			task.addSourceShardTag(r, sourceShard)
+		}
+		if task.sourceRPTag != "" {
+			task.addSourceRPTag(r, sourceRP)
+		}
+		if task.sourceTag != "" {
+			task.addSourceTag(r, source)
+		}
+		if task.bucket != nil {
+			task.addBucketTag(r, bucket)
+		}
 
-			// update the mst, timestamp and shardKey of the agg row
-			r.Name = mstName
-			r.Timestamp = t
-			r.StreamOnly = true
-			err, sh, pErr := s.updateShardGroupAndShardKey(si.DesMst.Database, si.DesMst.RetentionPolicy, r, ctx, si.Dims)
-			if err != nil {
+		// update the mst, timestamp and shardKey of the agg row
+		r.Name = mstName
+		r.Timestamp = t
+		if streamMarksComplete(task.opt) {
+			markComplete(r, task.opt.Output.CompleteField, ctx.windowEnd(si, t) < completeBefore)
+		}
+		if task.fanOutMsts != nil {
+			if err := s.fanOutRow(si, task, ctx, iCtx, r); err != nil {
 				return err
 			}
-			if pErr != nil {
-				continue
+			ctx.addWindowEmitted()
+			return nil
+		}
+		if task.opt.Output.SafeMode {
+			if err := checkRowSchema(r, ctx.ms.Schema); err != nil {
+				s.logger.Debug("stream row violates the destination schema", zap.String("stream", si.Name), zap.Error(err))
+				ctx.state.addSchemaViolation()
+				if task.opt.Errors.DeadLetterMst != "" {
+					ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterSchemaViolation})
+				}
+				return nil
 			}
-			r.StreamId = append(r.StreamId, si.ID)
-			m, exist := srcStreamDstShardIdMap[sh.ID]
-			if !exist {
-				m = map[uint64]uint64{}
+		}
+		if streamStampsWindows(task.opt) {
+			// the windows of the stream are written directly, which are keyed by their start times
+			addWindowStart(r, task.opt.Window.StartField, t)
+		}
+		if ctx.partial || ctx.closing {
+			return s.writePartialWindow(si, task, ctx, iCtx, r)
+		}
+		_, isFilled := filled[t]
+		direct := ctx.backfill || task.direct || isFilled
+		r.StreamOnly = !direct
+		err, sh, pErr := s.updateShardGroupAndShardKey(si.DesMst.Database, si.DesMst.RetentionPolicy, r, ctx, task.shardDims)
+		if err != nil {
+			return err
+		}
+		if pErr != nil {
+			ctx.addPartialError(pErr)
+			return nil
+		}
+		s.placeWindow(si, ctx, iCtx, sh, r, direct, ordered)
+		return nil
+	}
+	for k, tv := range ctx.dataCache {
+		filled = ctx.filled[k]
+		sourceShard, sourceRP, source, bucket, groupValue = "", "", "", "", nil
+		if task.sourceShardTag != "" {
+			sourceShard, k = cutGroupKey(k, task.groupSep)
+		}
+		if task.sourceRPTag != "" {
+			sourceRP, k = cutGroupKey(k, task.groupSep)
+			sourceRP = unescapeGroupValue(sourceRP, task.groupSep)
+		}
+		if task.sourceTag != "" {
+			source, k = cutGroupKey(k, task.groupSep)
+			source = unescapeGroupValue(source, task.groupSep)
+		}
+		if task.bucket != nil {
+			bucket, k = cutGroupKey(k, task.groupSep)
+		}
+		if dimLen != 0 {
+			groupValue = splitGroupKey(k, task.groupSep)
+			if len(groupValue) != dimLen {
+				errStr := fmt.Sprintf("group value is mssing for stream task %s, groupValue %v, tagDimKeys %v, fieldIndexKeys %v groupLen %v dimLen %v",
+					si.Name, groupValue, task.tagDimKeys, task.fieldIndexKeys, len(groupValue), dimLen)
+				return errors.New(errStr)
+			}
+		}
+		for t, v := range tv {
+			if err := emitWindow(t, v); err != nil {
+				return err
 			}
-			m[si.ID] = sh.ID
-			srcStreamDstShardIdMap[sh.ID] = m
-			iCtx.setShardRow(sh, r)
+		}
+	}
+	filled, sourceShard, sourceRP, source, bucket, groupValue = ctx.filled[singleGroupKey], "", "", "", "", nil
+	for _, w := range task.singleWindows {
+		if err := emitWindow(w.end, w.v); err != nil {
+			return err
+		}
+	}
+	if ordered {
+		// the rows are held until the watermark of the task passes them by the lateness, all the rows of a backfill
+		// and of a flush are delivered at once
+		before := int64(math.MaxInt64)
+		if !ctx.backfill && !ctx.partial {
+			before = ctx.state.loadWatermark() - int64(task.opt.Output.ReorderLateness)
+		}
+		if _, late := ctx.reorder.flush(iCtx, before); late > 0 {
+			s.logger.Warn("stream rows delivered out of order", zap.String("stream", si.Name), zap.Int("late", late))
 		}
 	}
 	*wRows = (*wRows)[:size]
 	return nil
 }
 
-func (s *Stream) updateShardGroupAndShardKey(database, retentionPolicy string, r *influx.Row, ctx *streamCtx,
-	dims []string) (err error, sh *meta2.ShardInfo, partialErr error) {
-	var wh *writeHelper
-	var di *meta2.DatabaseInfo
-	var shardKeyInfo **meta2.ShardKeyInfo
-	var mi *meta2.MeasurementInfo
-	var aliveShardIdxes *[]int
-
-	wh = ctx.writeHelper
-	di = ctx.db
-	shardKeyInfo = &ctx.shardKeyInfo
-	mi = ctx.ms
-	aliveShardIdxes = &ctx.aliveShardIdxes
-	engineType := mi.EngineType
-	sg, sameSg, err := wh.createShardGroup(database, retentionPolicy, time.Unix(0, r.Timestamp), engineType)
-	if err != nil {
+// placeWindow maps the agg row of the window to the shard sh. The rows merged by the store are marked by
+// the stream and recorded with sh, and the ordered rows are held by the reorder buffer until they are released.
+func (s *Stream) placeWindow(si *meta2.StreamInfo, ctx *streamCtx, iCtx *injestionCtx, sh *meta2.ShardInfo, r *influx.Row, direct, ordered bool) {
+	ctx.addWindowEmitted()
+	iCtx.addStreamWritten(ctx.state.stats, r)
+	if !direct {
+		if ctx.chained {
+			ctx.addChainRow(r)
+		}
+		r.StreamId = append(r.StreamId, si.ID)
+	}
+	if ordered {
+		// the shards of the stream are recorded by the context releasing the row
+		ctx.reorder.push(iCtx, sh, r)
 		return
 	}
-
-	if !sameSg {
-		if len(di.ShardKey.ShardKey) > 0 {
-			*shardKeyInfo = &di.ShardKey
-		} else {
-			*shardKeyInfo = mi.GetShardKey(sg.ID)
+	if !direct {
+		srcStreamDstShardIdMap := iCtx.getSrcStreamDstShardIdMap()
+		m, exist := srcStreamDstShardIdMap[sh.ID]
+		if !exist {
+			m = map[uint64]uint64{}
 		}
+		m[si.ID] = sh.ID
+		srcStreamDstShardIdMap[sh.ID] = m
+	}
+	iCtx.setStreamShardRow(sh, r)
+}
+
+// newStreamWriteCtx returns a context to write rows to the measurements of the database and retention policy
+// through the normal write path, the context should be put back by the caller.
+func (s *Stream) newStreamWriteCtx(pw *PointsWriter, database, retentionPolicy string) (*streamCtx, error) {
+	ctx := GetStreamCtx()
+	if err := ctx.checkDBRP(database, retentionPolicy, s); err != nil {
+		PutStreamCtx(ctx)
+		return nil, err
+	}
+	ctx.writeHelper = newWriteHelper(pw)
+	return ctx, nil
+}
 
-		if *shardKeyInfo == nil {
-			err = errno.NewError(errno.WriteNoShardKey)
+// mapWriteRow maps the row to the shard of the measurement as a normal write, the schema of the measurement
+// is updated if needed. partialErr is returned if the row is dropped but the batch can go on.
+func (s *Stream) mapWriteRow(ctx *streamCtx, iCtx *injestionCtx, mst string, r *influx.Row, dims []string) (err error, partialErr error) {
+	database, retentionPolicy := ctx.db.Name, ctx.rp.Name
+	ctx.ms, err = ctx.writeHelper.createMeasurement(database, retentionPolicy, mst)
+	if err != nil {
+		return
+	}
+	r.Name = ctx.ms.Name
+
+	var isDropRow bool
+	ctx.fieldToCreate, isDropRow, err = ctx.writeHelper.updateSchemaIfNeeded(database, retentionPolicy, r, ctx.ms, mst, ctx.fieldToCreate[:0])
+	if err != nil {
+		if !ctx.writeHelper.pw.isPartialErr(err) {
 			return
 		}
+		if isDropRow {
+			return nil, err
+		}
+		err = nil
+	}
+	updateIndexOptions(r, ctx.ms.GetIndexRelation())
+
+	err, sh, pErr := s.updateShardGroupAndShardKey(database, retentionPolicy, r, ctx, dims)
+	if err != nil {
+		return
+	}
+	if pErr != nil {
+		return nil, pErr
+	}
+	iCtx.setStreamShardRow(sh, r)
+	return nil, nil
+}
+
+func (s *Stream) updateShardGroupAndShardKey(database, retentionPolicy string, r *influx.Row, ctx *streamCtx,
+	dims []string) (err error, sh *meta2.ShardInfo, partialErr error) {
+	defer func() {
+		err, partialErr = ctx.taskOpt.handleRouteError(err, partialErr)
+	}()
+
+	g, err := s.shardGroupOf(database, retentionPolicy, r.Timestamp, ctx)
+	if err != nil {
+		return
+	}
+	// the shard key info stays nil for the shard group if the error is dropped
+	if g.shardKeyInfo == nil {
+		err = errno.NewError(errno.WriteNoShardKey)
+		return
 	}
 
-	if err = r.UnmarshalShardKeyByDimOrTag((*shardKeyInfo).ShardKey, dims); err != nil {
+	if err = r.UnmarshalShardKeyByDimOrTag(g.shardKeyInfo.ShardKey, dims); err != nil {
 		if err != influx.ErrPointShouldHaveAllShardKey {
 			return
 		}
@@ -404,17 +1517,13 @@ func (s *Stream) updateShardGroupAndShardKey(database, retentionPolicy string, r
 		return
 	}
 
-	if !sameSg {
-		*aliveShardIdxes = s.MetaClient.GetAliveShards(database, sg)
-	}
-
-	if (*shardKeyInfo).Type == influxql.RANGE {
-		sh = sg.DestShard(bytesutil.ToUnsafeString(r.ShardKey))
+	if g.shardKeyInfo.Type == influxql.RANGE {
+		sh = g.sg.DestShard(bytesutil.ToUnsafeString(r.ShardKey))
 	} else {
-		if len((*shardKeyInfo).ShardKey) > 0 {
+		if len(g.shardKeyInfo.ShardKey) > 0 {
 			r.ShardKey = r.ShardKey[len(r.Name)+1:]
 		}
-		sh = sg.ShardFor(meta2.HashID(r.ShardKey), *aliveShardIdxes)
+		sh = g.sg.ShardFor(meta2.HashID(r.ShardKey), g.aliveShardIdxes)
 	}
 	if sh == nil {
 		err = errno.NewError(errno.WritePointMap2Shard)
@@ -423,39 +1532,223 @@ func (s *Stream) updateShardGroupAndShardKey(database, retentionPolicy string, r
 }
 
 func (s *Stream) GenerateGroupKey(ctx *streamCtx, keys []string, value *influx.Row) string {
+	return s.generateGroupKey(ctx, keys, nil, value)
+}
+
+// generateGroupKey builds the group key by the tag values of the keys,
+// the value is normalized first if the normalizer of the key is not nil.
+func (s *Stream) generateGroupKey(ctx *streamCtx, keys []string, normalizers []*tagNormalizer, value *influx.Row) string {
 	if len(keys) == 0 {
 		return ""
 	}
-	builder := ctx.bp.Get()
-	defer func() {
-		builder.Reset()
-		ctx.bp.Put(builder)
-	}()
+	ctx.groupKeyBuf = appendGroupKey(ctx.groupKeyBuf[:0], keys, normalizers, value, false, config.StreamGroupValueSeparator)
+	return ctx.internGroupKey(ctx.groupKeyBuf)
+}
+
+// aggDims returns the sorted keys of the tags of the agg rows.
+func (w *streamTask) aggDims() []string {
+	if len(w.fieldIndexKeys) == 0 && len(w.tagDimKeys) == len(w.info.Dims) {
+		return w.info.Dims
+	}
+	dims := make([]string, 0, len(w.tagDimKeys)+len(w.fieldIndexKeys))
+	dims = append(dims, w.tagDimKeys...)
+	dims = append(dims, w.fieldIndexKeys...)
+	sort.Strings(dims)
+	return dims
+}
+
+// appendGroupKey appends the group key of the row to dst, the values of the tag dims are followed by
+// the values of the field dims.
+func (w *streamTask) appendGroupKey(dst []byte, r *influx.Row) []byte {
+	distinct := w.opt.Group.MissingDims == StreamMissingDimDistinct
+	dst = appendGroupKey(dst, w.tagDimKeys, w.normalizers, r, distinct, w.groupSep)
+	for i := range w.fieldIndexKeys {
+		if i > 0 || len(w.tagDimKeys) > 0 {
+			dst = append(dst, w.groupSep)
+		}
+		dst = appendFieldGroupValue(dst, r, w.fieldIndexKeys[i], distinct, w.groupSep)
+	}
+	return dst
+}
+
+// appendFieldGroupValue appends the value of the field of the key in a stable string form,
+// a missing field is appended as the empty string, or as streamGroupNull if distinct.
+func appendFieldGroupValue(dst []byte, r *influx.Row, key string, distinct bool, sep byte) []byte {
+	idx, ok := r.ColumnToIndex[key]
+	if !ok || idx < r.Tags.Len() {
+		if distinct {
+			dst = append(dst, streamGroupNull...)
+		}
+		return dst
+	}
+	f := &r.Fields[idx-r.Tags.Len()]
+	switch f.Type {
+	case influx.Field_Type_String:
+		return appendGroupValue(dst, f.StrValue, sep)
+	case influx.Field_Type_Int:
+		return strconv.AppendInt(dst, f.Int(), 10)
+	case influx.Field_Type_Boolean:
+		return strconv.AppendBool(dst, f.NumValue != 0)
+	}
+	return strconv.AppendFloat(dst, f.NumValue, 'g', -1, 64)
+}
 
+// addFieldDimTags sets the values of the field dims as the tags of the agg row after the tags of the tag dims,
+// and keeps the tags sorted.
+func (w *streamTask) addFieldDimTags(r *influx.Row, values []string) {
+	index := len(w.tagDimKeys)
+	for i := range w.fieldIndexKeys {
+		r.Tags[index+i].Key = w.fieldIndexKeys[i]
+		r.Tags[index+i].Value = w.dimValue(values[i])
+	}
+	sort.Sort(&r.Tags)
+	for i := range r.Tags {
+		r.ColumnToIndex[r.Tags[i].Key] = i
+	}
+}
+
+// appendGroupKey appends the tag values of the keys separated by sep to dst,
+// a missing tag is appended as the empty string, or as streamGroupNull if distinct. The values are escaped
+// by appendGroupValue.
+func appendGroupKey(dst []byte, keys []string, normalizers []*tagNormalizer, value *influx.Row, distinct bool, sep byte) []byte {
 	tagIndex := 0
 	for i := range keys {
+		if i > 0 {
+			dst = append(dst, sep)
+		}
 		idx := util.Search(tagIndex, len(value.Tags), func(j int) bool { return value.Tags[j].Key >= keys[i] })
 		if idx < len(value.Tags) && value.Tags[idx].Key == keys[i] {
-			builder.AppendString(value.Tags[idx].Value)
-			if i < len(keys)-1 {
-				builder.AppendByte(config.StreamGroupValueSeparator)
+			if normalizers != nil && normalizers[i] != nil {
+				dst = appendGroupValue(dst, normalizers[i].normalize(value.Tags[idx].Value), sep)
+			} else {
+				dst = appendGroupValue(dst, value.Tags[idx].Value, sep)
 			}
 			tagIndex = idx + 1
 			continue
 		}
-		if i < len(keys)-1 {
-			builder.AppendByte(config.StreamGroupValueSeparator)
+		// the tag after the missing one may be the next key
+		tagIndex = idx
+		if distinct {
+			dst = append(dst, streamGroupNull...)
+		}
+	}
+	return dst
+}
+
+// streamGroupValueEscape escapes the separator and itself in the values of the group key,
+// they are encoded as the escape followed by escapedSeparator and escapedEscape
+const (
+	streamGroupValueEscape byte = 1
+	escapedSeparator       byte = '0'
+	escapedEscape          byte = '1'
+)
+
+// appendGroupValue appends the value escaped to dst, the value without the separator
+// and the escape is appended as it is.
+func appendGroupValue(dst []byte, v string, sep byte) []byte {
+	if strings.IndexByte(v, sep) < 0 && strings.IndexByte(v, streamGroupValueEscape) < 0 {
+		return append(dst, v...)
+	}
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case sep:
+			dst = append(dst, streamGroupValueEscape, escapedSeparator)
+		case streamGroupValueEscape:
+			dst = append(dst, streamGroupValueEscape, escapedEscape)
+		default:
+			dst = append(dst, v[i])
+		}
+	}
+	return dst
+}
+
+// unescapeGroupValue returns the value appended by appendGroupValue, streamGroupNull is kept as it is.
+func unescapeGroupValue(v string, sep byte) string {
+	if strings.IndexByte(v, streamGroupValueEscape) < 0 || v == streamGroupNull {
+		return v
+	}
+	b := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] != streamGroupValueEscape || i == len(v)-1 {
+			b = append(b, v[i])
+			continue
+		}
+		i++
+		if v[i] == escapedSeparator {
+			b = append(b, sep)
+		} else {
+			b = append(b, streamGroupValueEscape)
+		}
+	}
+	return string(b)
+}
+
+// splitGroupKey returns the values of the group key built by appendGroupKey.
+func splitGroupKey(k string, sep byte) []string {
+	values := make([]string, 0, strings.Count(k, string(rune(sep)))+1)
+	for {
+		i := strings.IndexByte(k, sep)
+		if i < 0 {
+			return append(values, unescapeGroupValue(k, sep))
 		}
-		tagIndex = idx + 1
+		values = append(values, unescapeGroupValue(k[:i], sep))
+		k = k[i+1:]
+	}
+}
+
+// cutGroupKey cuts the group key around the first separator, the rest is empty without the separator.
+func cutGroupKey(k string, sep byte) (string, string) {
+	if i := strings.IndexByte(k, sep); i >= 0 {
+		return k[:i], k[i+1:]
+	}
+	return k, ""
+}
+
+// internGroupKey returns the key of the bytes, the key is allocated once per group in a batch.
+func (s *streamCtx) internGroupKey(b []byte) string {
+	if k, ok := s.groupKeys[string(b)]; ok {
+		return k
+	}
+	if s.groupKeys == nil {
+		s.groupKeys = make(map[string]string)
 	}
-	return builder.NewString()
+	k := string(b)
+	s.groupKeys[k] = k
+	return k
 }
 
-func BuildFieldCall(info *meta2.StreamInfo, srcSchema map[string]int32, destSchema map[string]int32) ([]*streamLib.FieldCall, error) {
+// BuildFieldCall builds the calls of the stream, the results of which are written to the output aliases of the calls.
+// No two calls can be written to the same field.
+func BuildFieldCall(info *meta2.StreamInfo, opt *StreamTaskOptions, srcSchema map[string]int32, destSchema map[string]int32) ([]*streamLib.FieldCall, error) {
 	calls := make([]*streamLib.FieldCall, len(info.Calls))
+	aliases := make(map[string]string, len(info.Calls))
 	var err error
 	for i, v := range info.Calls {
-		calls[i], err = streamLib.NewFieldCall(srcSchema[v.Field], destSchema[v.Alias], v.Field, v.Alias, v.Call, false)
+		alias := outputAlias(opt, v.Alias)
+		if other, ok := aliases[alias]; ok {
+			return nil, fmt.Errorf("the calls %s and %s of stream task %s are both written to the field %s", other, v.Alias, info.Name, alias)
+		}
+		aliases[alias] = v.Alias
+		if srcSchema[v.Field] == influx.Field_Type_String {
+			if !supportsString(v.Call) {
+				return nil, fmt.Errorf("the %s string type is not supported for stream task %s", v.Field, info.Name)
+			}
+			if streamFills(info) {
+				return nil, fmt.Errorf("the %s call %s of stream task %s can not fill the empty windows with strings", v.Call, v.Alias, info.Name)
+			}
+		}
+		if streamLib.IsBooleanCall(v.Call) && srcSchema[v.Field] != influx.Field_Type_Boolean {
+			return nil, fmt.Errorf("the %s call %s of stream task %s only supports boolean fields", v.Call, v.Alias, info.Name)
+		}
+		if srcSchema[v.Field] == influx.Field_Type_Boolean && !supportsBoolean(v.Call) {
+			return nil, fmt.Errorf("the %s boolean type is not supported by the %s call of stream task %s", v.Field, v.Call, info.Name)
+		}
+		outFieldType := destSchema[alias]
+		if v.Call == "count" && outFieldType == influx.Field_Type_Unknown {
+			// the counts are integers unless the destination already keeps them as floats
+			outFieldType = influx.Field_Type_Int
+		}
+		calls[i], err = streamLib.NewFieldCallWithArgs(srcSchema[v.Field], outFieldType, v.Field, alias, v.Call, v.Args, false)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,242 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"sync"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// newAccumulatorFunc creates the accumulator of a call for the window [start, end).
+type newAccumulatorFunc func(start, end int64) streamLib.Accumulator
+
+// buildAccumulatorCalls returns the accumulator constructors indexed by the calls of the stream,
+// nil if no call of the stream is aggregated by an accumulator. All the calls are aggregated by the accumulators
+// if the whole windows are aggregated at the sql layer.
+func buildAccumulatorCalls(info *meta2.StreamInfo, calls []*streamLib.FieldCall, callOptions map[string]*StreamCallOptions, whole bool) ([]newAccumulatorFunc, error) {
+	var accCalls []newAccumulatorFunc
+	for i, c := range info.Calls {
+		var fn newAccumulatorFunc
+		if c.Call == coverageCall {
+			coverage, err := buildCoverageCall(info, c, callOptions)
+			if err != nil {
+				return nil, err
+			}
+			fn = coverage.newAccumulator
+		} else if c.Call == twaCall {
+			twa, err := buildTWACall(info, c, callOptions)
+			if err != nil {
+				return nil, err
+			}
+			// the weighted mean of the integers is a float as well
+			calls[i].OutFieldType = influx.Field_Type_Float
+			fn = twa.newAccumulator
+		} else if c.Call == weightedMeanCall {
+			calls[i].OutFieldType = influx.Field_Type_Float
+			fn = newWeightedMean
+		} else if c.Call == histogramCall {
+			calls[i].OutFieldType = influx.Field_Type_Int
+			fn = newHistogramBucket(c)
+		} else if opt, ok := callOptions[c.Alias]; ok && opt.IntSum {
+			var err error
+			if fn, err = buildIntSumCall(info, c, calls[i], opt); err != nil {
+				return nil, err
+			}
+		} else if ok && opt.IntMinMax {
+			var err error
+			if fn, err = buildIntMinMaxCall(info, c, calls[i]); err != nil {
+				return nil, err
+			}
+		} else if newAcc := calls[i].NewAccumulator; newAcc != nil {
+			fn = func(int64, int64) streamLib.Accumulator { return newAcc() }
+		} else if whole {
+			call := calls[i]
+			fn = func(int64, int64) streamLib.Accumulator { return streamLib.NewFuncAccumulator(call) }
+		} else {
+			continue
+		}
+		if accCalls == nil {
+			accCalls = make([]newAccumulatorFunc, len(info.Calls))
+		}
+		accCalls[i] = fn
+	}
+	return accCalls, nil
+}
+
+// streamKeepsState returns whether the stream has a call aggregated by an accumulator or more destinations,
+// the state of which is only kept by the sql layer.
+func streamKeepsState(info *meta2.StreamInfo) bool {
+	if streamSliding(info) || streamShifted(info) || len(info.Destinations) > 0 {
+		return true
+	}
+	for _, c := range info.Calls {
+		if c.Call == coverageCall || c.Call == twaCall || c.Call == histogramCall || c.Call == percentilesCall || c.Call == weightedMeanCall ||
+			streamLib.IsAccumulatorCall(c.Call) {
+			return true
+		}
+	}
+	return false
+}
+
+type accumulatorKey struct {
+	group string
+	call  int
+	start int64
+}
+
+type accumulatorWindow struct {
+	acc streamLib.Accumulator
+	end int64
+}
+
+// accumulatorResult is the slot of the result of an accumulator, which is filled once all the rows of the batch are added.
+type accumulatorResult struct {
+	window []*float64
+	call   int
+	acc    streamLib.Accumulator
+	// str indicates that the call selects a string, which is kept by the strings of the results
+	str bool
+	// group is the key of the group of the window
+	group string
+}
+
+// fill sets the result of the accumulator, the call of the window emits no value if the accumulator has no result.
+func (r *accumulatorResult) fill(s *streamCtx) {
+	v := r.acc.Value()
+	if math.IsNaN(v) {
+		r.window[r.call] = nil
+		return
+	}
+	*r.window[r.call] = v
+	s.keepAccResult(r.window[r.call], r.acc, r.str)
+}
+
+// keepAccResult keeps the string selected by the accumulator or its exact integer with the slot of its result.
+func (s *streamCtx) keepAccResult(slot *float64, acc streamLib.Accumulator, str bool) {
+	if str {
+		if s.strResults == nil {
+			s.strResults = make(map[*float64]string)
+		}
+		s.strResults[slot] = acc.(streamLib.StringAccumulator).StringValue()
+		return
+	}
+	if ia, ok := acc.(streamLib.IntAccumulator); ok {
+		if s.intResults == nil {
+			s.intResults = make(map[*float64]int64)
+		}
+		s.intResults[slot] = ia.IntValue()
+	}
+}
+
+// addCount counts a value in the slot of the count call, the count is kept exactly by an int64 with the slot
+// as a float64 count stops growing at 2^53.
+func (s *streamCtx) addCount(slot *float64) {
+	if s.intResults == nil {
+		s.intResults = make(map[*float64]int64)
+	}
+	n := s.intResults[slot] + 1
+	s.intResults[slot] = n
+	*slot = float64(n)
+}
+
+// mergeCount adds the count of src to the count of dst, the counts without the exact value are taken from the slots.
+func (s *streamCtx) mergeCount(dst, src *float64) {
+	n, ok := s.intResults[dst]
+	if !ok {
+		n = int64(*dst)
+	}
+	if m, ok := s.intResults[src]; ok {
+		n += m
+		delete(s.intResults, src)
+	} else {
+		n += int64(*src)
+	}
+	if s.intResults == nil {
+		s.intResults = make(map[*float64]int64)
+	}
+	s.intResults[dst] = n
+	*dst = float64(n)
+}
+
+// streamAccumulators holds the accumulators of the windows of the groups.
+// The windows are kept across the batches until the rows of them can no longer arrive,
+// the result of a window is emitted with every batch and replaces the former one at the store.
+type streamAccumulators struct {
+	mu      sync.Mutex
+	windows map[accumulatorKey]*accumulatorWindow
+}
+
+// add adds the value of the field to the accumulator of the window and returns the accumulator.
+// The strings are only added to the accumulators selecting them.
+func (a *streamAccumulators) add(key accumulatorKey, end int64, newAcc newAccumulatorFunc, f *influx.Field, timestamp int64) streamLib.Accumulator {
+	acc := a.window(key, end, newAcc)
+	if f.Type == influx.Field_Type_String {
+		if sa, ok := acc.(streamLib.StringAccumulator); ok {
+			sa.AddString(f.StrValue, timestamp)
+		}
+	} else if ia, ok := acc.(streamLib.IntAccumulator); ok && f.Type == influx.Field_Type_Int {
+		ia.AddInt(f.Int(), timestamp)
+	} else {
+		acc.Add(f.NumValue, timestamp)
+	}
+	return acc
+}
+
+// window returns the accumulator of the window, which is created if the window is not open.
+func (a *streamAccumulators) window(key accumulatorKey, end int64, newAcc newAccumulatorFunc) streamLib.Accumulator {
+	w, ok := a.windows[key]
+	if !ok {
+		if a.windows == nil {
+			a.windows = make(map[accumulatorKey]*accumulatorWindow)
+		}
+		w = &accumulatorWindow{acc: newAcc(key.start, end), end: end}
+		a.windows[key] = w
+	}
+	return w.acc
+}
+
+// open returns the number of the windows of the accumulators.
+func (a *streamAccumulators) open() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.windows)
+}
+
+// endsBefore returns whether any window ends before the time.
+func (a *streamAccumulators) endsBefore(t int64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, w := range a.windows {
+		if w.end < t {
+			return true
+		}
+	}
+	return false
+}
+
+// expire drops the windows which end before the time.
+func (a *streamAccumulators) expire(before int64) {
+	for k, w := range a.windows {
+		if w.end < before {
+			delete(a.windows, k)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamPercentile(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p95_fk1", Args: []string{"95"}},
+		&meta2.StreamCall{Call: "median", Field: "fk1", Alias: "median_fk1"},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	require.True(t, streamKeepsState(si))
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	rows := func(from, to int) []*influx.Row {
+		var rs []*influx.Row
+		for i := from; i <= to; i++ {
+			rs = append(rs, newStreamTestRow(start+int64(i)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", float64(i))))
+		}
+		return rs
+	}
+	valueOf := func(rows []*influx.Row, key string) float64 {
+		rs := rowsOfMst(rows, "mst2")
+		require.Len(t, rs, 1)
+		v, ok := fieldValue(rs[0], key)
+		require.True(t, ok)
+		return v
+	}
+
+	out := env.calculate(t, si, rows(1, 50)...)
+	require.InDelta(t, 47.5, valueOf(out, "p95_fk1"), 1)
+	require.Equal(t, 25.5, valueOf(out, "median_fk1"))
+
+	// the accumulators of the window are kept across the batches, the result covers all the rows of the window
+	out = env.calculate(t, si, rows(51, 100)...)
+	require.InDelta(t, 95, valueOf(out, "p95_fk1"), 1)
+	require.Equal(t, 50.5, valueOf(out, "median_fk1"))
+	require.Equal(t, float64(50), valueOf(out, "count_fk1"))
+	require.Len(t, env.pw.getStreamTaskState(si.Name).accumulators.windows, 2)
+}
+
+func TestStreamPercentileArgs(t *testing.T) {
+	for args, msg := range map[string]string{
+		"":    "the percentile call p_fk1 needs exactly one quantile argument",
+		"-1":  "the quantile -1 of the percentile call p_fk1 is not in [0, 100]",
+		"100": "",
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p_fk1"})
+		if args != "" {
+			si.Calls[0].Args = []string{args}
+		}
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+		if msg == "" {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, msg)
+		}
+	}
+}
+
+func TestStreamStddev(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "stddev", Field: "fk1", Alias: "stddev_fk1"},
+		&meta2.StreamCall{Call: "variance", Field: "fk1", Alias: "variance_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	srcSchema["fk1"] = influx.Field_Type_String
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the fk1 string type is not supported for stream task t")
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	valueOf := func(rows []*influx.Row, key string) float64 {
+		rs := rowsOfMst(rows, "mst2")
+		require.Len(t, rs, 1)
+		v, ok := fieldValue(rs[0], key)
+		require.True(t, ok)
+		return v
+	}
+
+	// a single point has no deviation
+	out := env.calculate(t, si, row(0, 3))
+	require.Equal(t, float64(0), valueOf(out, "stddev_fk1"))
+	require.Equal(t, float64(0), valueOf(out, "variance_fk1"))
+
+	out = env.calculate(t, si, row(1, 5), row(2, 7), row(3, 9))
+	require.InDelta(t, 20.0/3, valueOf(out, "variance_fk1"), 1e-9)
+	require.InDelta(t, math.Sqrt(20.0/3), valueOf(out, "stddev_fk1"), 1e-9)
+}
+
+func TestStreamMean(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "mean", Field: "fk2", Alias: "mean_fk2"})
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, fields ...influx.Field) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, fields...)
+	}
+	intField := func(v int64) influx.Field {
+		return influx.Field{Key: "fk2", NumValue: float64(v), Type: influx.Field_Type_Int}
+	}
+
+	// the window without the field is skipped
+	require.Empty(t, rowsOfMst(env.calculate(t, si, row(0, floatField("fk1", 1))), "mst2"))
+
+	out := rowsOfMst(env.calculate(t, si, row(1, intField(1)), row(2, intField(2))), "mst2")
+	require.Len(t, out, 1)
+	v, ok := fieldValue(out[0], "mean_fk2")
+	require.True(t, ok)
+	require.Equal(t, 1.5, v)
+	require.Equal(t, int32(influx.Field_Type_Float), out[0].Fields[0].Type)
+}
+
+func TestStreamBoolean(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "any", Field: "fk3", Alias: "any_fk3"},
+		&meta2.StreamCall{Call: "all", Field: "fk3", Alias: "all_fk3"},
+		&meta2.StreamCall{Call: "count_true", Field: "fk3", Alias: "count_true_fk3"},
+		&meta2.StreamCall{Call: "count", Field: "fk3", Alias: "count_fk3"})
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v bool) *influx.Row {
+		f := influx.Field{Key: "fk3", Type: influx.Field_Type_Boolean}
+		if v {
+			f.NumValue = 1
+		}
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, f)
+	}
+	check := func(rows []*influx.Row, expect map[string]float64) {
+		rs := rowsOfMst(rows, "mst2")
+		require.Len(t, rs, 1)
+		for k, e := range expect {
+			v, ok := fieldValue(rs[0], k)
+			require.True(t, ok, k)
+			require.Equal(t, e, v, k)
+		}
+		for _, f := range rs[0].Fields {
+			switch f.Key {
+			case "any_fk3", "all_fk3":
+				require.Equal(t, int32(influx.Field_Type_Boolean), f.Type)
+			case "count_true_fk3":
+				require.Equal(t, int32(influx.Field_Type_Int), f.Type)
+			}
+		}
+	}
+
+	check(env.calculate(t, si, row(0, true), row(1, true)),
+		map[string]float64{"any_fk3": 1, "all_fk3": 1, "count_true_fk3": 2, "count_fk3": 2})
+	check(env.calculate(t, si, row(2, false)),
+		map[string]float64{"any_fk3": 1, "all_fk3": 0, "count_true_fk3": 2, "count_fk3": 1})
+
+	// the boolean calls only aggregate the boolean fields
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err := ctx.stream.calculate([]*influx.Row{newStreamTestRow(start, nil, floatField("fk3", 1))}, si, env.pw, ctx, 0)
+	require.EqualError(t, err, "the fk3 float type is not supported for stream task t")
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	srcSchema["fk3"] = influx.Field_Type_Float
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the any call any_fk3 of stream task t only supports boolean fields")
+
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk3", Alias: "sum_fk3"})
+	srcSchema, dstSchema = streamTestSchema(si)
+	srcSchema["fk3"] = influx.Field_Type_Boolean
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the fk3 boolean type is not supported by the sum call of stream task t")
+}
+
+func TestStreamRate(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "rate", Field: "fk1", Alias: "rate_fk1"},
+		&meta2.StreamCall{Call: "derivative", Field: "fk1", Alias: "derivative_fk1", Args: []string{"1ms"}})
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+
+	// a window with a single point emits no value
+	require.Empty(t, rowsOfMst(env.calculate(t, si, row(0, 100)), "mst2"))
+
+	out := rowsOfMst(env.calculate(t, si, row(500, 150)), "mst2")
+	require.Len(t, out, 1)
+	v, ok := fieldValue(out[0], "rate_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(100), v)
+	v, ok = fieldValue(out[0], "derivative_fk1")
+	require.True(t, ok)
+	require.Equal(t, 0.1, v)
+	require.Equal(t, int32(influx.Field_Type_Float), out[0].Fields[0].Type)
+
+	// the counter is reset
+	out = rowsOfMst(env.calculate(t, si, row(1000-1, 50)), "mst2")
+	require.Len(t, out, 1)
+	v, _ = fieldValue(out[0], "rate_fk1")
+	require.InDelta(t, 50/0.999, v, 1e-9)
+	v, _ = fieldValue(out[0], "derivative_fk1")
+	require.InDelta(t, -50.0/999, v, 1e-9)
+}
+
+func TestStreamSpread(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "spread", Field: "fk1", Alias: "spread_fk1"})
+	require.True(t, streamKeepsState(si))
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, tk string, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: tk}}, floatField("fk1", v))
+	}
+
+	// the spread of a single point is 0
+	out := rowsOfMst(env.calculate(t, si, row(0, "a", 3), row(1, "b", -2), row(2, "b", 4)), "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []float64{0, 6} {
+		v, ok := fieldValue(out[i], "spread_fk1")
+		require.True(t, ok)
+		require.Equal(t, exp, v)
+	}
+
+	// the min and the max of the window are kept across the batches
+	out = rowsOfMst(env.calculate(t, si, row(3, "a", 10)), "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "spread_fk1")
+	require.Equal(t, float64(7), v)
+}
+
+func TestStreamExtremumTime(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "min", Field: "fk1", Alias: "min_fk1"},
+		&meta2.StreamCall{Call: "min_ts", Field: "fk1", Alias: "min_fk1_time"},
+		&meta2.StreamCall{Call: "max_ts", Field: "fk1", Alias: "max_fk1_time"},
+	)
+	require.True(t, streamKeepsState(si))
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	ts := func(ms int) int64 {
+		return start + int64(ms)*int64(time.Millisecond)
+	}
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(ts(ms), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+
+	out := rowsOfMst(env.calculate(t, si, row(5, 3), row(2, 1), row(9, 7), row(1, 7)), "mst2")
+	require.Len(t, out, 1)
+	for alias, exp := range map[string]float64{"min_fk1": 1, "min_fk1_time": float64(ts(2)), "max_fk1_time": float64(ts(1))} {
+		v, ok := fieldValue(out[0], alias)
+		require.True(t, ok)
+		require.Equal(t, exp, v)
+	}
+	for _, f := range out[0].Fields {
+		if f.Key != "min_fk1" {
+			require.Equal(t, int32(influx.Field_Type_Int), f.Type)
+		}
+	}
+
+	// the extremes of the window are kept across the batches, the earlier row of the same value wins
+	out = rowsOfMst(env.calculate(t, si, row(0, 1), row(3, 0.5)), "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "min_fk1_time")
+	require.Equal(t, float64(ts(3)), v)
+	v, _ = fieldValue(out[0], "max_fk1_time")
+	require.Equal(t, float64(ts(1)), v)
+}
+
+func TestStreamFirstLastString(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"},
+		&meta2.StreamCall{Call: "first", Field: "host", Alias: "first_host"},
+		&meta2.StreamCall{Call: "last", Field: "host", Alias: "last_host"},
+		&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"},
+	)
+	require.True(t, streamKeepsState(si))
+	srcSchema, dstSchema := streamTestSchema(si)
+	srcSchema["host"] = influx.Field_Type_String
+	dstSchema["first_host"], dstSchema["last_host"] = influx.Field_Type_String, influx.Field_Type_String
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	task, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	ctx.stream.tasks[si.Name] = task
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, host string, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}},
+			floatField("fk1", v), influx.Field{Key: "host", StrValue: host, Type: influx.Field_Type_String})
+	}
+	_, err = ctx.stream.calculate([]*influx.Row{row(5, "h2", 3), row(1, "h1", 7), row(9, "h3", 2)}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	out := rowsOfMst(ctx.shardRowMap[0].rows, "mst2")
+	require.Len(t, out, 1)
+	fields := map[string]influx.Field{}
+	for _, f := range out[0].Fields {
+		fields[f.Key] = f
+	}
+	require.Equal(t, influx.Field{Key: "first_host", StrValue: "h1", Type: influx.Field_Type_String}, fields["first_host"])
+	require.Equal(t, influx.Field{Key: "last_host", StrValue: "h3", Type: influx.Field_Type_String}, fields["last_host"])
+	require.Equal(t, influx.Field{Key: "last_fk1", NumValue: 2, Type: influx.Field_Type_Float}, fields["last_fk1"])
+	require.Equal(t, float64(7), fields["max_fk1"].NumValue)
+
+	// the numeric calls still reject the strings
+	si.Calls = append(si.Calls, &meta2.StreamCall{Call: "max", Field: "host", Alias: "max_host"})
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the host string type is not supported for stream task t")
+	si.Calls = si.Calls[:len(si.Calls)-1]
+	si.Fill = influxql.PreviousFill
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the first call first_host of stream task t can not fill the empty windows with strings")
+}
+
+func TestStreamCountDistinct(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "count_distinct", Field: "fk1", Alias: "distinct_fk1", Args: []string{"10"}})
+	require.True(t, streamKeepsState(si))
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	check := func(rows []*influx.Row, expect float64) {
+		out := rowsOfMst(rows, "mst2")
+		require.Len(t, out, 1)
+		v, ok := fieldValue(out[0], "distinct_fk1")
+		require.True(t, ok)
+		require.Equal(t, expect, v)
+		require.Equal(t, int32(influx.Field_Type_Int), out[0].Fields[0].Type)
+	}
+
+	check(env.calculate(t, si, row(0, 1), row(1, 2), row(2, 1)), 2)
+	// the sketch of the window is kept across the batches
+	check(env.calculate(t, si, row(3, 2), row(4, 3)), 3)
+}
+
+func TestStreamSumSquares(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk2", Alias: "sum_fk2"},
+		&meta2.StreamCall{Call: "count", Field: "fk2", Alias: "count_fk2"},
+		&meta2.StreamCall{Call: "sum_sq", Field: "fk2", Alias: "sum_sq_fk2"},
+	)
+	require.True(t, streamKeepsState(si))
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v int64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}},
+			influx.Field{Key: "fk2", NumValue: float64(v), Type: influx.Field_Type_Int})
+	}
+
+	out := rowsOfMst(env.calculate(t, si, row(0, 1), row(1, 2), row(1000, 3), row(1001, 6)), "mst2")
+	require.Len(t, out, 2)
+	// the variance of all the values is merged from the sums of the windows
+	var sum, count, sumSq float64
+	for i, exp := range []float64{5, 45} {
+		v, ok := fieldValue(out[i], "sum_sq_fk2")
+		require.True(t, ok)
+		require.Equal(t, exp, v)
+		sumSq += v
+		v, _ = fieldValue(out[i], "sum_fk2")
+		sum += v
+		v, _ = fieldValue(out[i], "count_fk2")
+		count += v
+	}
+	require.Equal(t, float64(14)/3, (sumSq-sum*sum/count)/(count-1))
+	for _, f := range out[0].Fields {
+		if f.Key == "sum_sq_fk2" {
+			require.Equal(t, int32(influx.Field_Type_Float), f.Type)
+		}
+	}
+}
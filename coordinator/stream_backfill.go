@@ -0,0 +1,190 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamSource reads the historical rows of the source measurement of a stream.
+type StreamSource interface {
+	// ReadRows returns the rows of the measurement whose time is in [start, end).
+	ReadRows(database, retentionPolicy, mst string, start, end int64) ([]*influx.Row, error)
+}
+
+// BackfillStream recomputes the output of the stream over the time range [start, end) from the historical rows
+// of the source measurement. The range is extended to the window boundaries, and the windows recomputed overwrite
+// the points of the destination measurement, so backfilling the same range again gives the same result. The points
+// of the destination are not deleted first, the windows of the groups without rows in the range are left as they are.
+func (w *PointsWriter) BackfillStream(name string, start, end int64) error {
+	if w.StreamSource == nil {
+		return errors.New("no stream source to backfill from")
+	}
+	if start >= end {
+		return fmt.Errorf("invalid backfill time range [%d, %d)", start, end)
+	}
+	si, ok := w.MetaClient.GetStreamInfos()[name]
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+
+	if streamUsesProcessingTime(w.getStreamTaskOptions(si)) {
+		return fmt.Errorf("the windows of stream task %s by the processing time can not be backfilled from the historical rows", si.Name)
+	}
+	opt, err := buildStreamWindowOptions(si)
+	if err != nil {
+		return err
+	}
+	start, _ = opt.Window(start)
+	_, end = opt.Window(end - 1)
+	chunk := w.getStreamTaskOptions(si).Limits.BackfillChunk
+	if chunk <= 0 {
+		chunk = defaultStreamBackfillChunk
+	}
+	srcRP, err := w.backfillSourceRP(si)
+	if err != nil {
+		return err
+	}
+	overlap := int64(streamBackfillOverlap(si))
+	// the chunks end at the window boundaries, and the rows after a chunk are read with it up to the end of the windows
+	// starting in it, so every window is recomputed from all its rows by one chunk
+	r := &streamBackfillRange{srcRP: srcRP, windowsFrom: math.MinInt64, deltas: &streamDeltaState{}}
+	for start < end {
+		_, chunkEnd := opt.Window(start + int64(chunk) - 1)
+		if chunkEnd > end {
+			chunkEnd = end
+		}
+		r.start, r.end, r.windowsTo = start, chunkEnd+overlap, chunkEnd
+		if r.end > end {
+			r.end = end
+		}
+		if err = w.backfillStreamRange(si, r); err != nil {
+			return err
+		}
+		start, r.windowsFrom = chunkEnd, chunkEnd
+	}
+	return nil
+}
+
+// streamBackfillRange is a chunk of the backfill, which reads the rows in [start, end) to recompute the windows
+// starting in [windowsFrom, windowsTo).
+type streamBackfillRange struct {
+	// srcRP is the source retention policy resolved once for all the chunks
+	srcRP                  string
+	start, end             int64
+	windowsFrom, windowsTo int64
+	// deltas are shared by the chunks, so the first windows of a chunk follow the last ones of the previous chunk
+	deltas *streamDeltaState
+}
+
+// streamBackfillOverlap returns how long the windows of the stream and of its destinations last after the chunk
+// they start in, the sliding windows overlap the next ones and the destinations are not aligned to the chunks.
+func streamBackfillOverlap(si *meta2.StreamInfo) time.Duration {
+	var overlap time.Duration
+	if streamSliding(si) {
+		overlap = si.Interval - si.Slide
+	}
+	for _, d := range si.Destinations {
+		if d.Interval > overlap {
+			overlap = d.Interval
+		}
+	}
+	return overlap
+}
+
+// backfillStreamRange recomputes the windows of the stream in the chunk of the backfill. The rows of all the source retention policies are recomputed together, or one retention policy after another
+// if the task groups them apart.
+func (w *PointsWriter) backfillStreamRange(si *meta2.StreamInfo, r *streamBackfillRange) error {
+	ctx := getInjestionCtx()
+	defer putInjestionCtx(ctx)
+	ctx.writeHelper = newWriteHelper(w)
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	if err := ctx.initStreamVar(w); err != nil {
+		return err
+	}
+
+	srcRP := r.srcRP
+	srcMst, err := ctx.writeHelper.createMeasurement(si.SrcMst.Database, srcRP, si.SrcMst.Name)
+	if err != nil {
+		return err
+	}
+	task, err := w.buildTask(si, srcMst.Schema, (*ctx.getStreamMSTs())[0].Schema)
+	if err != nil {
+		return err
+	}
+	ctx.stream.registerTask(si.Name, task)
+
+	var rows []*influx.Row
+	for _, rp := range append([]string{srcRP}, si.SrcRPs...) {
+		rpRows, err := w.StreamSource.ReadRows(si.SrcMst.Database, rp, si.SrcMst.Name, r.start, r.end)
+		if err != nil {
+			return err
+		}
+		for _, row := range rpRows {
+			if row.ColumnToIndex == nil {
+				buildColumnToIndex(row)
+			}
+		}
+		if task.sourceRPTag != "" {
+			ctx.streamSourceRP = rp
+			if err = ctx.stream.backfill(rpRows, si, w, ctx, 0, r); err != nil {
+				return err
+			}
+			continue
+		}
+		rows = append(rows, rpRows...)
+	}
+	if task.sourceRPTag == "" {
+		ctx.streamSourceRP = srcRP
+		if err = ctx.stream.backfill(rows, si, w, ctx, 0, r); err != nil {
+			return err
+		}
+	}
+
+	retentionPolicy := si.DesMst.RetentionPolicy
+	if retentionPolicy == "" {
+		retentionPolicy = (*ctx.getStreamDBs())[0].DefaultRetentionPolicy
+	}
+	if err = w.writeShardMap(si.DesMst.Database, retentionPolicy, ctx); err != nil {
+		return err
+	}
+	ctx.commitStreamWritten()
+	return nil
+}
+
+// backfillSourceRP returns the source retention policy of the stream, the empty one is the default retention policy
+// of the source database, which the rows written without a retention policy are written to.
+func (w *PointsWriter) backfillSourceRP(si *meta2.StreamInfo) (string, error) {
+	if si.SrcMst.RetentionPolicy != "" {
+		return si.SrcMst.RetentionPolicy, nil
+	}
+	dbi, err := w.MetaClient.Database(si.SrcMst.Database)
+	if err != nil {
+		return "", err
+	}
+	if dbi.DefaultRetentionPolicy == "" {
+		return "", fmt.Errorf("the source database %s of stream task %s has no default retention policy", si.SrcMst.Database, si.Name)
+	}
+	return dbi.DefaultRetentionPolicy, nil
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStreamSource struct {
+	rows       []*influx.Row
+	start, end int64
+	rps        []string
+}
+
+func (m *mockStreamSource) ReadRows(database, retentionPolicy, mst string, start, end int64) ([]*influx.Row, error) {
+	m.start, m.end = start, end
+	m.rps = append(m.rps, retentionPolicy)
+	return m.rows, nil
+}
+
+func TestStreamBackfill(t *testing.T) {
+	env := newStreamTestEnv()
+	// the window of the mock stream is 5ns
+	si := env.pw.MetaClient.GetStreamInfos()["t"]
+	intField := func(key string, v float64) influx.Field {
+		return influx.Field{Key: key, NumValue: v, Type: influx.Field_Type_Int}
+	}
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1), intField("fk2", 3)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2), intField("fk2", 1)),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 5)),
+		newStreamTestRow(env.base+7, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4), intField("fk2", 2)),
+	}
+	forward := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, forward, 3)
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	outside := newStreamTestRow(env.base+20, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 100))
+	src := &mockStreamSource{rows: append(rows, outside)}
+
+	require.EqualError(t, env.pw.BackfillStream("t", env.base+1, env.base+7), "no stream source to backfill from")
+	env.pw.StreamSource = src
+	require.True(t, errno.Equal(env.pw.BackfillStream("unknown", env.base+1, env.base+7), errno.StreamNotFound))
+
+	for n := 0; n < 2; n++ {
+		written = written[:0]
+		require.NoError(t, env.pw.BackfillStream("t", env.base+1, env.base+7))
+		require.Equal(t, env.base, src.start)
+		require.Equal(t, env.base+10, src.end)
+
+		out := rowsOfMst(written, "mst2")
+		require.Len(t, out, len(forward))
+		for i := range out {
+			require.False(t, out[i].StreamOnly)
+			require.Empty(t, out[i].StreamId)
+			require.Equal(t, forward[i].Timestamp+1-int64(si.Interval), out[i].Timestamp)
+			require.Equal(t, tagValue(forward[i], "tk1"), tagValue(out[i], "tk1"))
+			require.Equal(t, len(forward[i].Fields), len(out[i].Fields))
+			for _, f := range forward[i].Fields {
+				v, ok := fieldValue(out[i], f.Key)
+				require.True(t, ok)
+				require.Equal(t, f.NumValue, v)
+			}
+		}
+	}
+}
+
+func TestStreamBackfillDefaultRP(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.SrcMst.RetentionPolicy = ""
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	env.pw.MetaClient = &streamDataMetaClient{MockMetaClient: mc,
+		data: &meta2.Data{Streams: map[string]*meta2.StreamInfo{si.Name: si}, MaxStreamID: si.ID + 1}}
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		return nil
+	}
+	src := &mockStreamSource{rows: []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+	}}
+	env.pw.StreamSource = src
+
+	dbi, err := mc.DatabaseFn("db0")
+	require.NoError(t, err)
+	require.EqualError(t, env.pw.BackfillStream(si.Name, env.base, env.base+int64(si.Interval)),
+		"the source database db0 of stream task t has no default retention policy")
+	require.Empty(t, src.rps)
+
+	// the rows of the empty retention policy are read from the default one
+	dbi.DefaultRetentionPolicy = "rp0"
+	require.NoError(t, env.pw.BackfillStream(si.Name, env.base, env.base+int64(si.Interval)))
+	require.Equal(t, []string{"rp0"}, src.rps)
+}
+
+// rangeStreamSource returns the rows in the time range read, and records the ranges and the retention policies.
+type rangeStreamSource struct {
+	rows   []*influx.Row
+	ranges [][2]int64
+	rps    []string
+}
+
+func (m *rangeStreamSource) ReadRows(database, retentionPolicy, mst string, start, end int64) ([]*influx.Row, error) {
+	m.ranges = append(m.ranges, [2]int64{start, end})
+	m.rps = append(m.rps, retentionPolicy)
+	var rows []*influx.Row
+	for _, r := range m.rows {
+		if r.Timestamp >= start && r.Timestamp < end {
+			rows = append(rows, r)
+		}
+	}
+	return rows, nil
+}
+
+func TestStreamBackfillChunks(t *testing.T) {
+	env := newStreamTestEnv()
+	// the window of the mock stream is 5ns
+	si := env.pw.MetaClient.GetStreamInfos()["t"]
+	src := &rangeStreamSource{rows: []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+4, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(env.base+7, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+		newStreamTestRow(env.base+12, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 8)),
+	}}
+	env.pw.StreamSource = src
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	backfill := func(opt *StreamTaskOptions) []*influx.Row {
+		written, src.ranges, src.rps = written[:0], src.ranges[:0], src.rps[:0]
+		require.NoError(t, env.pw.SetStreamTaskOptions(si.Name, opt))
+		require.NoError(t, env.pw.BackfillStream(si.Name, env.base, env.base+13))
+		out := rowsOfMst(written, "mst2")
+		sort.Slice(out, func(i, j int) bool {
+			if out[i].Timestamp != out[j].Timestamp {
+				return out[i].Timestamp < out[j].Timestamp
+			}
+			return tagValue(out[i], "tk1") < tagValue(out[j], "tk1")
+		})
+		return out
+	}
+	chunked := func(chunk time.Duration) *StreamTaskOptions {
+		return &StreamTaskOptions{Limits: StreamLimitOptions{BackfillChunk: chunk}}
+	}
+	requireSame := func(whole, chunked []*influx.Row) {
+		require.Len(t, chunked, len(whole))
+		for i := range whole {
+			require.Equal(t, whole[i].Timestamp, chunked[i].Timestamp)
+			require.Equal(t, tagValue(whole[i], "tk1"), tagValue(chunked[i], "tk1"))
+			require.Equal(t, whole[i].Fields, chunked[i].Fields)
+		}
+	}
+
+	// the range is read at once by the default chunk
+	whole := backfill(nil)
+	require.Equal(t, [][2]int64{{env.base, env.base + 15}}, src.ranges)
+	require.Len(t, whole, 3)
+
+	// the chunks are rounded up to the windows, and the results are the same as the whole range
+	requireSame(whole, backfill(chunked(7)))
+	require.Equal(t, [][2]int64{{env.base, env.base + 10}, {env.base + 10, env.base + 15}}, src.ranges)
+
+	// the rows after a chunk are read with it up to the end of the sliding windows starting in it
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Interval, si.Slide = 5, 1
+	env.pw.MetaClient = &streamDataMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient),
+		data: &meta2.Data{Streams: map[string]*meta2.StreamInfo{si.Name: si}, MaxStreamID: si.ID + 1}}
+	whole = backfill(nil)
+	require.Equal(t, [][2]int64{{env.base, env.base + 15}}, src.ranges)
+	requireSame(whole, backfill(chunked(7)))
+	require.Equal(t, [][2]int64{{env.base, env.base + 14}, {env.base + 10, env.base + 15}}, src.ranges)
+
+	// the deltas of a chunk follow the windows of the previous chunks
+	delta := &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk1": {Delta: StreamDeltaSkipFirst}}}
+	whole = backfill(delta)
+	delta.Limits.BackfillChunk = 5
+	requireSame(whole, backfill(delta))
+	require.Len(t, src.ranges, 3)
+
+	// the empty source retention policy is the default one for all the chunks
+	mc := env.pw.MetaClient.(*streamDataMetaClient)
+	dbi, err := mc.DatabaseFn("db0")
+	require.NoError(t, err)
+	dbi.DefaultRetentionPolicy = "rp0"
+	mc.data.Streams[si.Name].SrcMst.RetentionPolicy = ""
+	requireSame(whole, backfill(delta))
+	require.Equal(t, []string{"rp0", "rp0", "rp0"}, src.rps)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// defaultStreamBreakerCooldown is the time the breaker of a task without BreakerCooldown stays open.
+const defaultStreamBreakerCooldown = time.Minute
+
+// streamBreaks returns whether the task opens its breaker after its writes fail, the windows are written by the sql
+// layer so that their failures are seen by the task.
+func streamBreaks(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Errors.BreakerFailures > 0
+}
+
+func checkBreaker(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt.Errors.BreakerFailures < 0 {
+		return fmt.Errorf("the breaker failures %d of stream task %s is negative", opt.Errors.BreakerFailures, info.Name)
+	}
+	if opt.Errors.BreakerCooldown < 0 {
+		return fmt.Errorf("the breaker cooldown %v of stream task %s is negative", opt.Errors.BreakerCooldown, info.Name)
+	}
+	return nil
+}
+
+func breakerCooldown(opt *StreamTaskOptions) int64 {
+	if opt.Errors.BreakerCooldown > 0 {
+		return int64(opt.Errors.BreakerCooldown)
+	}
+	return int64(defaultStreamBreakerCooldown)
+}
+
+// streamBreaker counts the consecutive failed writes of the windows of a task and the time of the latest one.
+type streamBreaker struct {
+	failures int64
+	failedAt int64
+}
+
+func (b *streamBreaker) fail(now int64) {
+	atomic.StoreInt64(&b.failedAt, now)
+	atomic.AddInt64(&b.failures, 1)
+}
+
+func (b *streamBreaker) succeed() {
+	atomic.StoreInt64(&b.failures, 0)
+}
+
+// open returns whether the breaker is open at now, it is until the cooldown passes after the latest failure once
+// the failures reach the breaker failures of the task. The breaker is half-open after the cooldown: the batches are
+// calculated again, and the next write either closes it or opens it for another cooldown.
+func (b *streamBreaker) open(opt *StreamTaskOptions, now int64) bool {
+	if !streamBreaks(opt) || atomic.LoadInt64(&b.failures) < int64(opt.Errors.BreakerFailures) {
+		return false
+	}
+	return now < atomic.LoadInt64(&b.failedAt)+breakerCooldown(opt)
+}
+
+// streamBroken returns whether the breaker of the stream task is open and records the state in the statistics of the
+// task. The rows of the task are dropped while the breaker is open, unlike the pause its windows are kept.
+func (w *PointsWriter) streamBroken(si *meta2.StreamInfo, rows int) bool {
+	opt := w.getStreamTaskOptions(si)
+	if !streamBreaks(opt) {
+		return false
+	}
+	state := w.getStreamTaskState(si.Name)
+	open := state.breaker.open(opt, w.getStreamClock()())
+	state.stats.SetBreakerOpen(open)
+	if open && rows > 0 {
+		state.addBreakerRows(int64(rows))
+	}
+	return open
+}
+
+// succeedStreamShard resets the failures of the tasks whose windows are written to the shard.
+func succeedStreamShard(ss *streamShard) {
+	for state := range ss.windows {
+		state.breaker.succeed()
+	}
+}
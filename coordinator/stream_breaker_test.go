@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamBreaker(t *testing.T) {
+	env := newStreamTestEnv()
+	sec := int64(time.Second)
+	now := env.base
+	env.pw.streamClock = func() int64 { return now }
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Errors: StreamErrorOptions{BreakerFailures: 2, BreakerCooldown: 10 * time.Second}})
+	state := env.pw.getStreamTaskState(si.Name)
+
+	var writeErr error
+	var writes int64
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		atomic.AddInt64(&writes, 1)
+		return writeErr
+	}
+	write := func(ts int64) (int64, error) {
+		atomic.StoreInt64(&writes, 0)
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		_, err := ctx.stream.calculate([]*influx.Row{
+			newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		}, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		err = env.pw.writeShardMap("db0", "rp0", ctx)
+		return atomic.LoadInt64(&writes), err
+	}
+	writeErr = errors.New("disk full")
+
+	// the breaker opens after the consecutive failures, and the rows are dropped while it is open
+	for i := int64(0); i < 2; i++ {
+		n, err := write(env.base + i*sec)
+		require.Error(t, err)
+		require.Equal(t, int64(1), n)
+	}
+	n, err := write(env.base + 2*sec)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Equal(t, int64(1), state.breakerRows)
+	require.Equal(t, int64(1), state.stats.BreakerOpen)
+
+	ctx := env.prepare(t, si)
+	tasks := ctx.stream.Tasks()
+	putInjestionCtx(ctx)
+	require.True(t, tasks[0].BreakerOpen)
+	require.Equal(t, int64(2), tasks[0].WriteFailures)
+
+	// the failed probe after the cooldown opens the breaker for another cooldown
+	now += 10 * sec
+	n, err = write(env.base + 3*sec)
+	require.Error(t, err)
+	require.Equal(t, int64(1), n)
+	n, _ = write(env.base + 4*sec)
+	require.Zero(t, n)
+
+	// the successful probe closes the breaker
+	now += 10 * sec
+	writeErr = nil
+	for i := int64(5); i < 8; i++ {
+		n, err = write(env.base + i*sec)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+	}
+	require.Zero(t, state.breaker.failures)
+	require.Equal(t, int64(0), state.stats.BreakerOpen)
+	require.Equal(t, int64(2), state.breakerRows)
+}
+
+func TestStreamBreakerInvalid(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Errors: StreamErrorOptions{BreakerFailures: -1}})
+	require.EqualError(t, err, "the breaker failures -1 of stream task t is negative")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Errors: StreamErrorOptions{BreakerFailures: 1, BreakerCooldown: -time.Second}})
+	require.EqualError(t, err, "the breaker cooldown -1s of stream task t is negative")
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// defaultStreamBucketTag is the tag of the buckets of the tasks without BucketTag.
+const defaultStreamBucketTag = "bucket"
+
+// streamBucket replaces the bucketed dims of a task with the hash bucket of their values.
+type streamBucket struct {
+	tag   string
+	dims  []string
+	count uint64
+}
+
+// buildBucket checks the bucketed dims of the task and removes them from the tag dims. The rows are grouped by
+// the bucket of the values of the bucketed dims instead, which is written as the bucket tag of the windows.
+func (w *streamTask) buildBucket() error {
+	if len(w.opt.Group.BucketDims) == 0 {
+		return nil
+	}
+	if w.passthrough {
+		return fmt.Errorf("stream task %s without calls has no groups to bucket", w.info.Name)
+	}
+	if w.opt.Group.BucketCount <= 0 {
+		return fmt.Errorf("the bucket count %d of stream task %s is not positive", w.opt.Group.BucketCount, w.info.Name)
+	}
+	b := &streamBucket{tag: w.opt.Group.BucketTag, count: uint64(w.opt.Group.BucketCount)}
+	if b.tag == "" {
+		b.tag = defaultStreamBucketTag
+	}
+	bucketed := make(map[string]struct{}, len(w.opt.Group.BucketDims))
+	for _, d := range w.opt.Group.BucketDims {
+		if !w.isTagDim(d) {
+			return fmt.Errorf("the bucketed dim %s is not a tag dim of stream task %s", d, w.info.Name)
+		}
+		bucketed[d] = struct{}{}
+	}
+	tagDimKeys := make([]string, 0, len(w.tagDimKeys))
+	for _, d := range w.tagDimKeys {
+		if _, ok := bucketed[d]; ok {
+			// the dims are in the order of the tags of the rows
+			b.dims = append(b.dims, d)
+			continue
+		}
+		tagDimKeys = append(tagDimKeys, d)
+	}
+	for _, d := range append(tagDimKeys, w.fieldIndexKeys...) {
+		if d == b.tag {
+			return fmt.Errorf("the bucket tag %s conflicts with the group by tags of stream task %s", d, w.info.Name)
+		}
+	}
+	if b.tag == w.opt.Group.SourceTag {
+		return fmt.Errorf("the bucket tag %s of stream task %s is the source tag", b.tag, w.info.Name)
+	}
+	w.tagDimKeys = tagDimKeys
+	w.bucket = b
+	return nil
+}
+
+// bucketDims returns the sorted dims with the bucket tag if the task buckets its groups.
+func (w *streamTask) bucketDims(dims []string) []string {
+	if w.bucket == nil {
+		return dims
+	}
+	withBucket := make([]string, 0, len(dims)+1)
+	withBucket = append(withBucket, dims...)
+	withBucket = append(withBucket, w.bucket.tag)
+	sort.Strings(withBucket)
+	return withBucket
+}
+
+// appendBucket appends the bucket of the values of the bucketed dims of the row followed by the separator to dst.
+// The values are appended to dst to hash them, and replaced by the bucket then.
+func (w *streamTask) appendBucket(dst []byte, r *influx.Row) []byte {
+	n := len(dst)
+	dst = appendGroupKey(dst, w.bucket.dims, nil, r, w.opt.Group.MissingDims == StreamMissingDimDistinct, w.groupSep)
+	bucket := xxhash.Sum64(dst[n:]) % w.bucket.count
+	dst = strconv.AppendUint(dst[:n], bucket, 10)
+	return append(dst, w.groupSep)
+}
+
+// addBucketTag adds the bucket tag to the tags of the agg row and keeps the tags sorted.
+func (w *streamTask) addBucketTag(r *influx.Row, bucket string) {
+	addAggTag(r, w.bucket.tag, bucket)
+}
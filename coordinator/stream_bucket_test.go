@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamBucket(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "bucket"
+	si.Dims = []string{"tk1", "tk2"}
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{BucketDims: []string{"tk2"}, BucketCount: 3, BucketTag: "tk2_bucket"}})
+
+	var rows []*influx.Row
+	for i := 0; i < 30; i++ {
+		tags := []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: fmt.Sprintf("host%d", i)}}
+		rows = append(rows, newStreamTestRow(env.base, tags, floatField("fk1", 1)))
+	}
+	// the same values go to the same bucket
+	rows = append(rows, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "host0"}}, floatField("fk1", 1)))
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 3)
+	var sum float64
+	for _, r := range out {
+		require.Equal(t, 2, len(r.Tags))
+		require.Equal(t, "a", tagValue(r, "tk1"))
+		require.Contains(t, []string{"0", "1", "2"}, tagValue(r, "tk2_bucket"))
+		require.Equal(t, "tk2_bucket", r.Tags[1].Key)
+		v, ok := fieldValue(r, "sum_fk1")
+		require.True(t, ok)
+		sum += v
+	}
+	require.Equal(t, float64(31), sum)
+
+	// the buckets are stable across the batches
+	task := &streamTask{bucket: &streamBucket{dims: []string{"tk2"}, count: 3}, opt: defaultStreamTaskOptions}
+	row := newStreamTestRow(env.base, []influx.Tag{{Key: "tk2", Value: "host0"}})
+	require.Equal(t, string(task.appendBucket(nil, row)), string(task.appendBucket([]byte("x"), row)[1:]))
+}
+
+func TestStreamBucketCheck(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	srcSchema, dstSchema := streamTestSchema(si)
+	for opt, msg := range map[*StreamTaskOptions]string{
+		{Group: StreamGroupOptions{BucketDims: []string{"tk2"}}}:                                   "the bucket count 0 of stream task t is not positive",
+		{Group: StreamGroupOptions{BucketDims: []string{"tk3"}, BucketCount: 2}}:                   "the bucketed dim tk3 is not a tag dim of stream task t",
+		{Group: StreamGroupOptions{BucketDims: []string{"tk2"}, BucketCount: 2, BucketTag: "tk1"}}: "the bucket tag tk1 conflicts with the group by tags of stream task t",
+		{Group: StreamGroupOptions{BucketDims: []string{"tk2"}, BucketCount: 2, BucketTag: "tk2"}}: "",
+		{Group: StreamGroupOptions{BucketDims: []string{"tk1", "tk2"}, BucketCount: 2}}:            "",
+	} {
+		task, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		if msg != "" {
+			require.EqualError(t, err, msg)
+			continue
+		}
+		require.NoError(t, err)
+		require.Contains(t, task.shardDims, task.bucket.tag)
+	}
+}
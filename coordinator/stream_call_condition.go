@@ -0,0 +1,55 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// streamHasCallCondition returns whether a call of the stream only aggregates the rows matching its condition.
+// The store aggregates all the rows of the source, so the rows of such a stream are always filtered at the sql layer.
+func streamHasCallCondition(info *meta2.StreamInfo) bool {
+	for _, c := range info.Calls {
+		if c.Condition != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildCallFilters compiles the conditions of the calls, the filters are indexed as the calls and nil
+// if no call has a condition. The values of the rows not matching the filter of a call are not aggregated
+// by the call, but still by the other calls.
+func buildCallFilters(info *meta2.StreamInfo) ([]streamFilter, error) {
+	if !streamHasCallCondition(info) {
+		return nil, nil
+	}
+	filters := make([]streamFilter, len(info.Calls))
+	for i, c := range info.Calls {
+		if c.Condition == nil {
+			continue
+		}
+		f, err := compileStreamFilter(c.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("the condition %s of the call %s of stream task %s is not supported: %v", c.Condition, c.Alias, info.Name, err)
+		}
+		filters[i] = f
+	}
+	return filters, nil
+}
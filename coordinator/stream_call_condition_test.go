@@ -0,0 +1,65 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCallCondition(t *testing.T) {
+	env := newStreamTestEnv()
+	cond := influxql.MustParseExpr("fk2 >= 500")
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1_5xx", Condition: cond},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1_5xx", Condition: cond},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1_404", Condition: influxql.MustParseExpr("fk2 = 404")},
+	)
+	require.True(t, streamHasCallCondition(si))
+	other := &meta2.StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	require.Nil(t, other.Calls[0].Condition)
+	require.Equal(t, cond.String(), other.Calls[1].Condition.String())
+
+	tags := []influx.Tag{{Key: "tk1", Value: "a"}}
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, tags, floatField("fk1", 1), floatField("fk2", 200)),
+		newStreamTestRow(env.base+1, tags, floatField("fk1", 2), floatField("fk2", 500)),
+		newStreamTestRow(env.base+2, tags, floatField("fk1", 4), floatField("fk2", 503)),
+		// the row without the key of the condition never matches
+		newStreamTestRow(env.base+3, tags, floatField("fk1", 8)),
+	), "mst2")
+	require.Len(t, out, 1)
+	for key, exp := range map[string]float64{"sum_fk1": 15, "sum_fk1_5xx": 6, "count_fk1_5xx": 2} {
+		v, ok := fieldValue(out[0], key)
+		require.True(t, ok, key)
+		require.Equal(t, exp, v, key)
+	}
+	// no row matches the condition of the call
+	_, ok := fieldValue(out[0], "max_fk1_404")
+	require.False(t, ok)
+
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1", Condition: influxql.MustParseExpr("time > 0")})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.ErrorContains(t, err, "the condition time > 0 of the call sum_fk1 of stream task t is not supported")
+}
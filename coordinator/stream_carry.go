@@ -0,0 +1,188 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// buildCarryCalls returns how long the last calls carry their values forward into the empty windows,
+// indexed by the calls of the stream, nil if no call carries its values.
+func buildCarryCalls(info *meta2.StreamInfo, callOptions map[string]*StreamCallOptions) ([]time.Duration, error) {
+	var carries []time.Duration
+	for i, c := range info.Calls {
+		opt, ok := callOptions[c.Alias]
+		if !ok || opt.CarryForward == 0 {
+			continue
+		}
+		if opt.CarryForward < 0 {
+			return nil, fmt.Errorf("the carry %v of the call %s of stream task %s is negative", opt.CarryForward, c.Alias, info.Name)
+		}
+		if c.Call != "last" {
+			return nil, fmt.Errorf("the %s call %s of stream task %s can not carry its values forward, only last can", c.Call, c.Alias, info.Name)
+		}
+		if streamFills(info) {
+			return nil, fmt.Errorf("the empty windows of stream task %s are filled, the values of the call %s can not be carried into them", info.Name, c.Alias)
+		}
+		if info.TimeZone != "" {
+			return nil, fmt.Errorf("the values of the call %s of stream task %s can not be carried forward in the time zone %s", c.Alias, info.Name, info.TimeZone)
+		}
+		if carries == nil {
+			carries = make([]time.Duration, len(info.Calls))
+		}
+		carries[i] = opt.CarryForward
+	}
+	return carries, nil
+}
+
+// carryGroup is the latest values of the carried calls of a group.
+type carryGroup struct {
+	// starts are the windows of the values, the calls without values are never carried
+	starts []int64
+	values []float64
+	strs   []string
+	has    []bool
+	// emitted is the latest window of the group emitted with data or a carried value
+	emitted int64
+}
+
+func newCarryGroup(calls int, start int64) *carryGroup {
+	return &carryGroup{
+		starts:  make([]int64, calls),
+		values:  make([]float64, calls),
+		strs:    make([]string, calls),
+		has:     make([]bool, calls),
+		emitted: start,
+	}
+}
+
+// update keeps the values of the carried calls of the window with data at st.
+func (g *carryGroup) update(task *streamTask, ctx *streamCtx, st int64, values []*float64) {
+	for i, v := range values {
+		if task.carries[i] == 0 || v == nil || (g.has[i] && st < g.starts[i]) {
+			continue
+		}
+		g.starts[i], g.values[i], g.has[i] = st, *v, true
+		if task.calls[i].OutFieldType == influx.Field_Type_String {
+			g.strs[i] = ctx.strResults[v]
+		}
+	}
+	if st > g.emitted {
+		g.emitted = st
+	}
+}
+
+// valuesAt returns the carried values of the empty window w, nil if the values of all the calls are carried for too long.
+func (g *carryGroup) valuesAt(task *streamTask, ctx *streamCtx, w int64) []*float64 {
+	var values []*float64
+	for i := range g.has {
+		if !g.has[i] || w-g.starts[i] > int64(task.carries[i]) {
+			continue
+		}
+		if values == nil {
+			values = make([]*float64, len(g.has))
+		}
+		v := g.values[i]
+		values[i] = &v
+		if task.calls[i].OutFieldType == influx.Field_Type_String {
+			if ctx.strResults == nil {
+				ctx.strResults = make(map[*float64]string)
+			}
+			ctx.strResults[&v] = g.strs[i]
+		}
+	}
+	return values
+}
+
+// streamCarryState holds the latest values of the groups carried forward by the task.
+type streamCarryState struct {
+	mu     sync.Mutex
+	groups map[string]*carryGroup
+}
+
+// carryWindows adds the empty windows of the groups after their latest values to the cache, with the values of the
+// carried calls. The windows between the windows with data are carried once the later ones arrive, and the windows
+// after them once they end before the watermark. A group is dropped once its values are carried for too long,
+// so the dead series stop emitting. The carried windows are final and written at their start times like the filled ones.
+func (s *Stream) carryWindows(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) {
+	carry := &ctx.state.carry
+	carry.mu.Lock()
+	defer carry.mu.Unlock()
+	step, interval := int64(si.Interval), int64(si.Interval)
+	if task.sliding {
+		step = int64(si.Slide)
+	}
+	var starts []int64
+	for k, tv := range ctx.dataCache {
+		starts = starts[:0]
+		for t := range tv {
+			starts = append(starts, t)
+		}
+		sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+		g, seen := carry.groups[k]
+		for _, t := range starts {
+			st := t
+			if !task.direct {
+				// the windows are cached at the end time in the forward computation
+				st = t + 1 - interval
+			}
+			if !seen {
+				if carry.groups == nil {
+					carry.groups = make(map[string]*carryGroup)
+				}
+				g = newCarryGroup(len(task.calls), st)
+				carry.groups[k] = g
+				seen = true
+			} else {
+				ctx.addCarriedWindows(task, k, g, st, step)
+			}
+			g.update(task, ctx, st, tv[t])
+		}
+	}
+
+	watermark := ctx.state.loadWatermark() - int64(si.Delay)
+	for k, g := range carry.groups {
+		// the windows after the latest one are carried once they end
+		next := g.emitted + step
+		if ended := watermark - interval - next; ended >= 0 {
+			next += (ended/step + 1) * step
+		}
+		if !ctx.addCarriedWindows(task, k, g, next, step) {
+			delete(carry.groups, k)
+		}
+	}
+}
+
+// addCarriedWindows adds the empty windows of the group from the latest emitted window to the window before the end,
+// at most maxStreamFillWindows of them. It returns false if no value of the group can be carried any more.
+func (s *streamCtx) addCarriedWindows(task *streamTask, groupKey string, g *carryGroup, end, step int64) bool {
+	for w, n := g.emitted+step, 0; w < end && n < maxStreamFillWindows; w, n = w+step, n+1 {
+		values := g.valuesAt(task, s, w)
+		if values == nil {
+			return false
+		}
+		s.addFilledWindow(groupKey, w, values)
+		g.emitted = w
+	}
+	return g.valuesAt(task, s, g.emitted+step) != nil
+}
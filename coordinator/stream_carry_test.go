@@ -0,0 +1,94 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCarryForward(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "carry_forward"
+	setStreamTestOptions(si, &StreamTaskOptions{
+		CallOptions: map[string]*StreamCallOptions{"last_fk1": {CarryForward: 2 * time.Second}},
+	})
+	sec := int64(time.Second)
+	row := func(ts int64, group string, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+	carried := func(rows []*influx.Row, group string) map[int64]float64 {
+		m := map[int64]float64{}
+		for _, r := range rowsOfMst(rows, "mst2") {
+			if tagValue(r, "tk1") != group {
+				continue
+			}
+			if _, ok := fieldValue(r, "sum_fk1"); ok {
+				// the window with data
+				continue
+			}
+			require.False(t, r.StreamOnly)
+			m[r.Timestamp], _ = fieldValue(r, "last_fk1")
+		}
+		return m
+	}
+
+	// the empty windows between the values are carried up to 2 seconds after the value
+	out := env.calculate(t, si, row(env.base, "a", 1), row(env.base+1, "a", 3), row(env.base+4*sec, "a", 5))
+	require.Equal(t, map[int64]float64{env.base + sec: 3, env.base + 2*sec: 3}, carried(out, "a"))
+
+	// the empty windows after the latest value are carried once they end
+	out = env.calculate(t, si, row(env.base+6*sec+1, "b", 1))
+	require.Equal(t, map[int64]float64{env.base + 5*sec: 5}, carried(out, "a"))
+	out = env.calculate(t, si, row(env.base+9*sec, "b", 1))
+	require.Equal(t, map[int64]float64{env.base + 6*sec: 5}, carried(out, "a"))
+	require.Equal(t, map[int64]float64{env.base + 7*sec: 1, env.base + 8*sec: 1}, carried(out, "b"))
+
+	// the dead series stop emitting
+	out = env.calculate(t, si, row(env.base+20*sec, "b", 1))
+	require.Empty(t, carried(out, "a"))
+	require.Len(t, env.pw.getStreamTaskState(si.Name).carry.groups, 1)
+
+	for call, msg := range map[string]string{
+		"sum":  "the sum call c of stream task t can not carry its values forward, only last can",
+		"fill": "the empty windows of stream task t are filled, the values of the call c can not be carried into them",
+		"neg":  "the carry -1s of the call c of stream task t is negative",
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "c"})
+		carry := time.Second
+		switch call {
+		case "sum":
+			si.Calls[0].Call = "sum"
+		case "fill":
+			si.Fill = influxql.PreviousFill
+		case "neg":
+			carry = -time.Second
+		}
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{
+			CallOptions: map[string]*StreamCallOptions{"c": {CarryForward: carry}},
+		})
+		require.ErrorContains(t, err, msg)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// maxStreamChainDepth bounds the streams fed by the rows of a batch one after another, the cycles of the streams
+// are rejected by meta at the creation, it only stops the cycles which may be seen before the meta is synced.
+const maxStreamChainDepth = 8
+
+// streamChildren returns the streams reading the destination measurement of the stream, such as the rollup of
+// an hour reading the rollup of a minute. The rows emitted by the stream to the store are the partial results
+// of the windows seen by the batch, which are aggregated by the children as the rows of their source.
+// The whole windows written directly by the stream are rewritten by the following batches, so they never
+// feed the children.
+func (w *PointsWriter) streamChildren(si *meta2.StreamInfo) []*meta2.StreamInfo {
+	var children []*meta2.StreamInfo
+	for _, c := range w.MetaClient.GetStreamInfos() {
+		if c.Name == si.Name || c.SrcMst.Database != si.DesMst.Database {
+			continue
+		}
+		if !w.streamReadsRP(c, si.DesMst) || !streamHasSource(c, w.getStreamTaskOptions(c), si.DesMst.Name) {
+			continue
+		}
+		children = append(children, c)
+	}
+	return children
+}
+
+// sameStreamRP returns whether the measurements of the same database are in the same retention policy,
+// the empty retention policy is the default one of the database.
+func (w *PointsWriter) sameStreamRP(a, b *meta2.StreamMeasurementInfo) bool {
+	if a.RetentionPolicy == b.RetentionPolicy {
+		return true
+	}
+	return w.streamRP(a) == w.streamRP(b)
+}
+
+// streamReadsRP returns whether the retention policy of the measurement is a source retention policy of the stream.
+func (w *PointsWriter) streamReadsRP(si *meta2.StreamInfo, m *meta2.StreamMeasurementInfo) bool {
+	if w.sameStreamRP(si.SrcMst, m) {
+		return true
+	}
+	for _, rp := range si.SrcRPs {
+		if w.sameStreamRP(&meta2.StreamMeasurementInfo{Database: si.SrcMst.Database, RetentionPolicy: rp}, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// streamRP returns the retention policy of the measurement, the empty one is resolved to the default one of the
+// database, which is empty if the database is not found.
+func (w *PointsWriter) streamRP(m *meta2.StreamMeasurementInfo) string {
+	if m.RetentionPolicy != "" {
+		return m.RetentionPolicy
+	}
+	db, err := w.MetaClient.Database(m.Database)
+	if err != nil {
+		return ""
+	}
+	return db.DefaultRetentionPolicy
+}
+
+// addChainRow keeps a copy of the row emitted to the store for the children of the stream.
+func (s *streamCtx) addChainRow(r *influx.Row) {
+	c := &influx.Row{Name: r.Name, Timestamp: r.Timestamp}
+	c.Tags = append(c.Tags, r.Tags...)
+	c.Fields = append(c.Fields, r.Fields...)
+	buildColumnToIndex(c)
+	s.chainRows = append(s.chainRows, c)
+}
+
+// calculateStreamChildren aggregates the rows emitted by a stream for its children, the failures of the children
+// never fail the batch of the parent. The rows are the source rows of the retention policy of its destination.
+func (w *PointsWriter) calculateStreamChildren(children []*meta2.StreamInfo, rows []*influx.Row, rp string, depth int) {
+	for _, c := range children {
+		if err := w.calculateStreamChild(c, rows, rp, depth); err != nil {
+			w.logger.Error("stream task failed to aggregate the rows of its source stream", zap.String("stream", c.Name), zap.Error(err))
+		}
+	}
+}
+
+// calculateStreamChild aggregates the rows for the stream and writes the results with an injestion context of
+// its own, the rows emitted by it feed its children in turn.
+func (w *PointsWriter) calculateStreamChild(si *meta2.StreamInfo, rows []*influx.Row, rp string, depth int) error {
+	if depth > maxStreamChainDepth {
+		return fmt.Errorf("the streams fed by stream task %s are chained deeper than %d", si.Name, maxStreamChainDepth)
+	}
+	ctx := getInjestionCtx()
+	defer putInjestionCtx(ctx)
+	ctx.writeHelper = newWriteHelper(w)
+	ctx.streamChainDepth = depth
+	ctx.streamSourceRP = rp
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	if err := ctx.initStreamVar(w); err != nil {
+		return err
+	}
+
+	srcMst, err := ctx.writeHelper.createMeasurement(si.SrcMst.Database, si.SrcMst.RetentionPolicy, si.SrcMst.Name)
+	if err != nil {
+		return err
+	}
+	task, err := w.buildTask(si, srcMst.Schema, (*ctx.getStreamMSTs())[0].Schema)
+	if err != nil {
+		return err
+	}
+	ctx.stream.registerTask(si.Name, task)
+
+	res, err := ctx.stream.calculate(rows, si, w, ctx, 0)
+	w.logStreamResult(si.Name, &res)
+	if err != nil {
+		return err
+	}
+	retentionPolicy := si.DesMst.RetentionPolicy
+	if retentionPolicy == "" {
+		retentionPolicy = (*ctx.getStreamDBs())[0].DefaultRetentionPolicy
+	}
+	if err = w.writeShardMap(si.DesMst.Database, retentionPolicy, ctx); err != nil {
+		return err
+	}
+	ctx.commitStreamWritten()
+	return nil
+}
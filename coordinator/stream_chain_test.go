@@ -0,0 +1,84 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamChain(t *testing.T) {
+	env := newStreamTestEnv()
+	minute := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	hour := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "sum_fk1", Alias: "sum_sum_fk1"})
+	hour.Name = "t_rollup"
+	hour.SrcMst = &meta2.StreamMeasurementInfo{Name: "mst2", Database: "db0"}
+	hour.DesMst = &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp0"}
+	hour.Interval = 4 * time.Second
+	// the source of the rollup is in the default retention policy
+	db, _ := env.pw.MetaClient.Database("db0")
+	db.DefaultRetentionPolicy = "rp0"
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient),
+		infos: map[string]*meta2.StreamInfo{minute.Name: minute, hour.Name: hour}}
+	require.Equal(t, []*meta2.StreamInfo{hour}, env.pw.streamChildren(minute))
+	require.Empty(t, env.pw.streamChildren(hour))
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+
+	sec := int64(time.Second)
+	row := func(ts int64, tk string, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: tk}}, floatField("fk1", v))
+	}
+	out := rowsOfMst(env.calculate(t, minute, row(env.base, "a", 1), row(env.base+1, "a", 2), row(env.base+sec, "a", 4),
+		row(env.base, "b", 8)), "mst2")
+	require.Len(t, out, 3)
+
+	// the partial results of the minutes are aggregated by the hours as the rows of their source
+	rollup := rowsOfMst(written, "mst3")
+	require.NotEmpty(t, rollup)
+	sums := map[string]float64{}
+	for _, r := range rollup {
+		require.True(t, r.StreamOnly)
+		v, ok := fieldValue(r, "sum_sum_fk1")
+		require.True(t, ok)
+		sums[tagValue(r, "tk1")] += v
+	}
+	require.Equal(t, map[string]float64{"a": 7, "b": 8}, sums)
+
+	// the whole windows written directly are rewritten by the following batches, they never feed the children
+	written = written[:0]
+	setStreamTestOptions(minute, &StreamTaskOptions{Window: StreamWindowOptions{StartField: "_window_start"}})
+	env.calculate(t, minute, row(env.base, "a", 1))
+	require.Empty(t, rowsOfMst(written, "mst3"))
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamMarksComplete returns whether the windows of the task carry whether they are complete. The store merges
+// the windows without the mark, so the windows of such a task are aggregated at the sql layer and written at
+// their start times, the results of a window replace the former ones whenever it is written again.
+func streamMarksComplete(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Output.CompleteField != ""
+}
+
+// checkCompleteField rejects the complete field which is also written by a call, a dim or another mark of the task.
+func (w *streamTask) checkCompleteField() error {
+	key := w.opt.Output.CompleteField
+	if key == "" {
+		return nil
+	}
+	for _, c := range w.info.Calls {
+		if outputAlias(w.opt, c.Alias) == key {
+			return fmt.Errorf("the complete field %s of stream task %s is written by the call %s", key, w.info.Name, c.Alias)
+		}
+	}
+	for _, d := range w.info.Dims {
+		if d == key {
+			return fmt.Errorf("the complete field %s of stream task %s is a dim", key, w.info.Name)
+		}
+	}
+	if key == w.opt.Window.StartField || key == w.opt.Output.PartialField {
+		return fmt.Errorf("the complete field %s of stream task %s is written by another mark of the windows", key, w.info.Name)
+	}
+	return nil
+}
+
+// completeBefore returns the time the windows ended before are complete at, the rows of them are no longer
+// accepted after the delay and the lateness of the task. All the backfill windows and the windows closed by the
+// watermark are complete.
+func (s *streamCtx) completeBefore(si *meta2.StreamInfo, task *streamTask) int64 {
+	if s.backfill || s.closing {
+		return math.MaxInt64
+	}
+	return s.state.loadWatermark() - int64(si.Delay) - int64(task.opt.Window.AllowedLateness)
+}
+
+// addCompletedWindows adds the results of the open windows of the accumulators ended before the time to the windows
+// to emit, which are dropped then. The windows are written again as complete without the rows of the batch.
+// It returns the number of the windows added.
+func (s *streamCtx) addCompletedWindows(task *streamTask, before int64) int {
+	n := 0
+	for k, w := range s.accumulators.windows {
+		if w.end >= before {
+			continue
+		}
+		windows, ok := s.dataCache[k.group]
+		if !ok {
+			windows = make(map[int64][]*float64)
+			s.dataCache[k.group] = windows
+		}
+		values, ok := windows[k.start]
+		if !ok {
+			values = make([]*float64, len(task.calls))
+			windows[k.start] = values
+			n++
+		}
+		if values[k.call] != nil {
+			// the window is aggregated by the batch, the result of it is filled already
+			continue
+		}
+		v := w.acc.Value()
+		if math.IsNaN(v) {
+			continue
+		}
+		values[k.call] = &v
+		s.keepAccResult(&v, w.acc, task.calls[k.call].OutFieldType == influx.Field_Type_String)
+	}
+	return n
+}
+
+// ensureCompleteField adds the complete field to the schema of the destination measurement if it is missing,
+// the rows of the windows are routed to the shards without updating the schema.
+func (s *Stream) ensureCompleteField(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
+	key := task.opt.Output.CompleteField
+	typ, ok := ctx.ms.Schema[key]
+	if ok {
+		if typ != influx.Field_Type_Boolean {
+			return fmt.Errorf("the complete field %s of stream task %s is a %s field of the destination",
+				key, si.Name, influx.FieldTypeString(typ))
+		}
+		return nil
+	}
+	fields := appendField(nil, key, influx.Field_Type_Boolean)
+	return s.MetaClient.UpdateSchema(ctx.db.Name, ctx.rp.Name, ctx.ms.OriginName(), fields)
+}
+
+// markComplete adds the boolean field of the key to the row, which is true if the window is complete.
+func markComplete(r *influx.Row, key string, complete bool) {
+	f := influx.Field{Key: key, Type: influx.Field_Type_Boolean}
+	if complete {
+		f.NumValue = 1
+	}
+	r.Fields = append(r.Fields, f)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCompleteField(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "complete"
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{CompleteField: "complete"}})
+	sec := int64(time.Second)
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ts int64, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	windows := func(rows []*influx.Row) map[int64][2]float64 {
+		out := make(map[int64][2]float64)
+		for _, r := range rowsOfMst(rows, "mst2") {
+			require.False(t, r.StreamOnly)
+			sum, _ := fieldValue(r, "sum_fk1")
+			complete, ok := fieldValue(r, "complete")
+			require.True(t, ok)
+			out[r.Timestamp] = [2]float64{sum, complete}
+		}
+		return out
+	}
+
+	// the latest window is provisional
+	require.Equal(t, map[int64][2]float64{start: {3, 0}}, windows(env.calculate(t, si, row(start, 1), row(start+1, 2))))
+	require.Equal(t, map[int64][2]float64{start: {7, 0}}, windows(env.calculate(t, si, row(start+sec-1, 4))))
+
+	// the window is written again as final once the watermark passes it, without the rows of it
+	require.Equal(t, map[int64][2]float64{start: {7, 1}, start + 2*sec: {8, 0}}, windows(env.calculate(t, si, row(start+2*sec, 8))))
+	require.Equal(t, map[int64][2]float64{start + 2*sec: {8, 1}, start + 5*sec: {1, 0}}, windows(env.calculate(t, si, row(start+5*sec, 1))))
+	require.Len(t, env.pw.getStreamTaskState(si.Name).accumulators.windows, 1)
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	for key, msg := range map[string]string{
+		"sum_fk1": "the complete field sum_fk1 of stream task complete is written by the call sum_fk1",
+		"tk1":     "the complete field tk1 of stream task complete is a dim",
+		"partial": "the complete field partial of stream task complete is written by another mark of the windows",
+	} {
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{PartialField: "partial", CompleteField: key}})
+		require.EqualError(t, err, msg)
+	}
+}
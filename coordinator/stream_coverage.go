@@ -0,0 +1,88 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const (
+	coverageCall = "coverage"
+
+	// maxStreamCoverageBuckets bounds the sub-intervals of a window, the bitset of a group and window is 8KB at most
+	maxStreamCoverageBuckets = 1 << 16
+)
+
+// streamCoverageCall is the parameters of a coverage call, the window is split into buckets of subInterval.
+type streamCoverageCall struct {
+	subInterval int64
+	buckets     int
+}
+
+// buildCoverageCall returns the parameters of the coverage call of the stream.
+func buildCoverageCall(info *meta2.StreamInfo, c *meta2.StreamCall, callOptions map[string]*StreamCallOptions) (*streamCoverageCall, error) {
+	opt, ok := callOptions[c.Alias]
+	if !ok || opt.SubInterval <= 0 {
+		return nil, fmt.Errorf("the sub interval of the coverage call %s is not set for stream task %s", c.Alias, info.Name)
+	}
+	sub := int64(opt.SubInterval)
+	buckets := (int64(info.Interval) + sub - 1) / sub
+	if buckets > maxStreamCoverageBuckets {
+		return nil, fmt.Errorf("the coverage call %s of stream task %s has %d sub intervals, the max is %d",
+			c.Alias, info.Name, buckets, maxStreamCoverageBuckets)
+	}
+	return &streamCoverageCall{subInterval: sub, buckets: int(buckets)}, nil
+}
+
+func (c *streamCoverageCall) newAccumulator(start, _ int64) streamLib.Accumulator {
+	return &coverageAccumulator{
+		start:       start,
+		subInterval: c.subInterval,
+		buckets:     c.buckets,
+		bits:        make([]uint64, (c.buckets+63)/64),
+	}
+}
+
+// coverageAccumulator records the sub-intervals of a window which saw data.
+type coverageAccumulator struct {
+	start       int64
+	subInterval int64
+	buckets     int
+	bits        []uint64
+	count       int
+}
+
+// Add marks the bucket of the time as seen, the value is ignored.
+func (c *coverageAccumulator) Add(_ float64, timestamp int64) {
+	bucket := int((timestamp - c.start) / c.subInterval)
+	if bucket < 0 || bucket >= c.buckets {
+		return
+	}
+	mask := uint64(1) << (bucket % 64)
+	if c.bits[bucket/64]&mask == 0 {
+		c.bits[bucket/64] |= mask
+		c.count++
+	}
+}
+
+// Value returns the fraction of the seen buckets.
+func (c *coverageAccumulator) Value() float64 {
+	return float64(c.count) / float64(c.buckets)
+}
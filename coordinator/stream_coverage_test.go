@@ -0,0 +1,74 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCoverage(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "coverage", Field: "fk1", Alias: "coverage_fk1"},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	si.Interval = time.Minute
+	// the first whole minute after base
+	start := time.Unix(0, env.base).Truncate(time.Minute).Add(time.Minute).UnixNano()
+	at := func(sec int, group string) *influx.Row {
+		return newStreamTestRow(start+int64(sec)*int64(time.Second), []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", 1))
+	}
+	coverageOf := func(rows []*influx.Row) map[string]float64 {
+		m := map[string]float64{}
+		for _, r := range rowsOfMst(rows, "mst2") {
+			v, ok := fieldValue(r, "coverage_fk1")
+			require.True(t, ok)
+			m[tagValue(r, "tk1")] = v
+		}
+		return m
+	}
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the sub interval of the coverage call coverage_fk1 is not set for stream task t")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{
+		CallOptions: map[string]*StreamCallOptions{"coverage_fk1": {SubInterval: time.Nanosecond}},
+	})
+	require.EqualError(t, err, "the coverage call coverage_fk1 of stream task t has 60000000000 sub intervals, the max is 65536")
+
+	setStreamTestOptions(si, &StreamTaskOptions{
+		CallOptions: map[string]*StreamCallOptions{"coverage_fk1": {SubInterval: time.Second}},
+	})
+	out := env.calculate(t, si, at(0, "a"), at(1, "a"), at(1, "a"), at(5, "a"), at(59, "a"), at(10, "b"))
+	require.Equal(t, map[string]float64{"a": 4.0 / 60, "b": 1.0 / 60}, coverageOf(out))
+	count, _ := fieldValue(rowsOfMst(out, "mst2")[0], "count_fk1")
+	require.Equal(t, float64(5), count)
+
+	// the seen sub-intervals are kept across the batches
+	out = env.calculate(t, si, at(30, "a"), at(5, "a"))
+	require.Equal(t, map[string]float64{"a": 5.0 / 60}, coverageOf(out))
+
+	// the window is released once the rows of it can no longer arrive
+	accumulators := &env.pw.getStreamTaskState(si.Name).accumulators
+	require.Len(t, accumulators.windows, 2)
+	out = env.calculate(t, si, at(61, "a"))
+	require.Equal(t, map[string]float64{"a": 1.0 / 60}, coverageOf(out))
+	require.Len(t, accumulators.windows, 1)
+}
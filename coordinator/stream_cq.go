@@ -0,0 +1,149 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// TranslateContinuousQuery translates the continuous query into the stream of the same aggregation, which is
+// validated as by Validate. The calls keep the names of the fields the query writes, and the RESAMPLE FOR of the
+// query beyond its interval is the delay of the stream, the windows are recalculated by the late rows until then.
+// RESAMPLE EVERY is dropped since the stream aggregates the rows as they are written. The queries the stream can
+// not run the same, such as the ones of the subqueries, the joins, the expressions and the regexes, are rejected.
+func (s *Stream) TranslateContinuousQuery(cq *influxql.CreateContinuousQueryStatement) (*meta2.StreamInfo, error) {
+	sel := cq.Source
+	fail := func(format string, a ...interface{}) error {
+		return fmt.Errorf("continuous query %s: %s", cq.Name, fmt.Sprintf(format, a...))
+	}
+	if sel == nil || sel.Target == nil || sel.Target.Measurement == nil {
+		return nil, fail("no INTO clause")
+	}
+	if len(sel.Sources) != 1 {
+		return nil, fail("%d sources, a stream has one source measurement", len(sel.Sources))
+	}
+	src, ok := sel.Sources[0].(*influxql.Measurement)
+	switch {
+	case !ok:
+		return nil, fail("the %T sources are not supported", sel.Sources[0])
+	case src.Regex != nil:
+		return nil, fail("the regex source %s is not supported", src.Regex)
+	case sel.Condition != nil && influxql.HasTimeExpr(sel.Condition):
+		return nil, fail("the time conditions are not supported")
+	case sel.HasDimensionWildcard():
+		return nil, fail("the wildcard dims are not supported")
+	}
+
+	interval, err := sel.GroupByInterval()
+	if err != nil {
+		return nil, fail("%v", err)
+	}
+	if interval <= 0 {
+		return nil, fail("no GROUP BY time interval")
+	}
+	delay := cq.ResampleFor - interval
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > 10*interval {
+		return nil, fail("the RESAMPLE FOR %s delays the windows more than 10 times of the interval %s", cq.ResampleFor, interval)
+	}
+	fields := make(map[string]struct{}, len(sel.Fields))
+	for _, f := range sel.Fields {
+		c, ok := f.Expr.(*influxql.Call)
+		if !ok {
+			return nil, fail("the field %s is not a call", f.Expr)
+		}
+		if len(c.Args) == 0 {
+			return nil, fail("the call %s has no field", c)
+		}
+		if _, ok = c.Args[0].(*influxql.VarRef); !ok {
+			return nil, fail("the call %s aggregates %s, which is not a field", c, c.Args[0])
+		}
+		for _, arg := range c.Args[1:] {
+			if _, ok = arg.(influxql.Literal); !ok {
+				return nil, fail("the argument %s of the call %s is not a literal", arg, c)
+			}
+		}
+		if _, ok = fields[f.Name()]; ok {
+			return nil, fail("the field %s is written by more than one call", f.Name())
+		}
+		fields[f.Name()] = struct{}{}
+	}
+	for _, d := range sel.Dimensions {
+		switch e := d.Expr.(type) {
+		case *influxql.Call:
+			if e.Name != "time" {
+				return nil, fail("the dim %s is not supported", e)
+			}
+		case *influxql.VarRef:
+		default:
+			return nil, fail("the dim %s is not supported", d.Expr)
+		}
+	}
+
+	src = src.Clone()
+	if src.Database == "" {
+		src.Database = cq.Database
+	}
+	dst := sel.Target.Measurement.Clone()
+	if dst.Database == "" {
+		dst.Database = cq.Database
+	}
+	if dst.Name == "" {
+		// INTO :MEASUREMENT writes to the measurement of the same name as the source
+		dst.Name = src.Name
+	}
+	query := sel.Clone()
+	query.Sources = influxql.Sources{src}
+	query.Fields = make(influxql.Fields, len(sel.Fields))
+	for i, f := range sel.Fields {
+		query.Fields[i] = &influxql.Field{Expr: f.Expr, Alias: f.Name()}
+	}
+	info := meta2.NewStreamInfo(&influxql.CreateStreamStatement{
+		Name:   cq.Name,
+		Target: &influxql.Target{Measurement: dst},
+		Query:  query,
+		Delay:  delay,
+	}, query)
+	if err = s.resolveStreamRPs(info); err != nil {
+		return nil, fail("%v", err)
+	}
+	if err = s.Validate(info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// resolveStreamRPs sets the retention policies of the measurements of the stream left empty to the default ones of
+// their databases.
+func (s *Stream) resolveStreamRPs(info *meta2.StreamInfo) error {
+	for _, m := range []*meta2.StreamMeasurementInfo{info.SrcMst, info.DesMst} {
+		if m.RetentionPolicy != "" {
+			continue
+		}
+		db, err := s.MetaClient.Database(m.Database)
+		if err != nil {
+			return err
+		}
+		m.RetentionPolicy = db.DefaultRetentionPolicy
+	}
+	return nil
+}
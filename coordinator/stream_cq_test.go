@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTranslateContinuousQuery(t *testing.T) {
+	env := newStreamTestEnv()
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	s := NewStream(env.pw.TSDBStore, mc, env.pw.logger, time.Second)
+	translate := func(q string) (*meta2.StreamInfo, error) {
+		stmt, err := influxql.ParseStatement(q)
+		require.NoError(t, err)
+		return s.TranslateContinuousQuery(stmt.(*influxql.CreateContinuousQueryStatement))
+	}
+
+	info, err := translate(`CREATE CONTINUOUS QUERY cq ON db0 RESAMPLE EVERY 10s FOR 3m BEGIN ` +
+		`SELECT sum(fk1), max(fk2) AS top INTO rp0.mst2 FROM rp0.mst0 WHERE tk2 = 'x' GROUP BY time(1m, 10s), tk1 END`)
+	require.NoError(t, err)
+	require.Equal(t, "cq", info.Name)
+	require.Equal(t, &meta2.StreamMeasurementInfo{Name: "mst0", Database: "db0", RetentionPolicy: "rp0"}, info.SrcMst)
+	require.Equal(t, &meta2.StreamMeasurementInfo{Name: "mst2", Database: "db0", RetentionPolicy: "rp0"}, info.DesMst)
+	// the calls write the fields named as by the query
+	require.Equal(t, []*meta2.StreamCall{{Call: "sum", Field: "fk1", Alias: "sum"}, {Call: "max", Field: "fk2", Alias: "top"}}, info.Calls)
+	require.Equal(t, []string{"tk1"}, info.Dims)
+	require.Equal(t, time.Minute, info.Interval)
+	require.Equal(t, 10*time.Second, info.Offset)
+	require.Equal(t, 2*time.Minute, info.Delay)
+	require.Equal(t, "tk2 = 'x'", info.Condition.String())
+
+	// the retention policies default to the ones of the database, the backreference to the source
+	db, err := mc.Database("db0")
+	require.NoError(t, err)
+	db.DefaultRetentionPolicy = "rp0"
+	info, err = translate(`CREATE CONTINUOUS QUERY cq ON db0 BEGIN SELECT mean(fk1) INTO db0..:MEASUREMENT FROM mst0 GROUP BY time(1m) END`)
+	require.NoError(t, err)
+	require.Equal(t, &meta2.StreamMeasurementInfo{Name: "mst0", Database: "db0", RetentionPolicy: "rp0"}, info.DesMst)
+	require.Zero(t, info.Delay)
+
+	for q, msg := range map[string]string{
+		`SELECT mean(fk1) INTO mst2 FROM mst0, mst1 GROUP BY time(1m)`:                   "continuous query cq: 2 sources, a stream has one source measurement",
+		`SELECT mean(fk1) INTO mst2 FROM (SELECT fk1 FROM mst0) GROUP BY time(1m)`:       "continuous query cq: the *influxql.SubQuery sources are not supported",
+		`SELECT mean(fk1) INTO mst2 FROM /mst.*/ GROUP BY time(1m)`:                      "continuous query cq: the regex source /mst.*/ is not supported",
+		`SELECT mean(fk1) INTO mst2 FROM mst0 WHERE time > now() - 1h GROUP BY time(1m)`: "continuous query cq: the time conditions are not supported",
+		`SELECT mean(fk1) INTO mst2 FROM mst0 GROUP BY time(1m), *`:                      "continuous query cq: the wildcard dims are not supported",
+		`SELECT mean(fk1) * 2 INTO mst2 FROM mst0 GROUP BY time(1m)`:                     "continuous query cq: the field mean(fk1) * 2 is not a call",
+		`SELECT mean(*) INTO mst2 FROM mst0 GROUP BY time(1m)`:                           "continuous query cq: the call mean(*) aggregates *, which is not a field",
+		`SELECT max(fk1), max(fk2) INTO mst2 FROM mst0 GROUP BY time(1m)`:                "continuous query cq: the field max is written by more than one call",
+	} {
+		_, err = translate(`CREATE CONTINUOUS QUERY cq ON db0 BEGIN ` + q + ` END`)
+		require.EqualError(t, err, msg, q)
+	}
+	_, err = translate(`CREATE CONTINUOUS QUERY cq ON db0 RESAMPLE FOR 12m BEGIN SELECT mean(fk1) INTO mst2 FROM mst0 GROUP BY time(1m) END`)
+	require.EqualError(t, err, "continuous query cq: the RESAMPLE FOR 12m0s delays the windows more than 10 times of the interval 1m0s")
+	// the translated stream is validated as the streams created
+	_, err = translate(`CREATE CONTINUOUS QUERY cq ON db0 BEGIN SELECT mode(fk1) INTO mst2 FROM mst0 GROUP BY time(1m) END`)
+	require.ErrorContains(t, err, "validate stream task cq: unsupported field")
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/obs"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// streamDBCreator creates the databases and the retention policies, it is implemented by the meta client.
+type streamDBCreator interface {
+	CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error)
+	CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error)
+}
+
+// createStreamDestinations creates the missing databases and retention policies of the destinations of the stream
+// if the task asks for it. It is off by default, so a mistyped destination fails the task instead of adding a database.
+func (w *PointsWriter) createStreamDestinations(si *meta2.StreamInfo) error {
+	if !w.getStreamTaskOptions(si).Output.CreateDestination {
+		return nil
+	}
+	if err := w.createStreamDestination(si, si.DesMst); err != nil {
+		return err
+	}
+	for _, d := range si.Destinations {
+		if err := w.createStreamDestination(si, d.DesMst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createStreamDestination creates the database and the retention policy of the measurement if they are not found.
+// The database takes the replicas and the tag array setting of the source database, and the retention policy
+// keeps the data forever like the default one of a new database.
+func (w *PointsWriter) createStreamDestination(si *meta2.StreamInfo, mst *meta2.StreamMeasurementInfo) error {
+	di, err := w.MetaClient.Database(mst.Database)
+	if err == nil && (mst.RetentionPolicy == "" || di.RetentionPolicy(mst.RetentionPolicy) != nil) {
+		return nil
+	}
+	if err != nil && !errno.Equal(err, errno.DatabaseNotFound) {
+		return err
+	}
+	creator, ok := w.MetaClient.(streamDBCreator)
+	if !ok {
+		return fmt.Errorf("the destination %s.%s of stream task %s can not be created", mst.Database, mst.RetentionPolicy, si.Name)
+	}
+
+	if di == nil {
+		src, err := w.MetaClient.Database(si.SrcMst.Database)
+		if err != nil {
+			return err
+		}
+		di, err = creator.CreateDatabase(mst.Database, src.EnableTagArray, uint32(src.ReplicaN), nil)
+		if err != nil {
+			return err
+		}
+		w.logger.Info("create the destination database of stream task", zap.String("stream", si.Name), zap.String("db", mst.Database))
+	}
+
+	if mst.RetentionPolicy == "" || di.RetentionPolicy(mst.RetentionPolicy) != nil {
+		return nil
+	}
+	if _, err = creator.CreateRetentionPolicy(mst.Database, &meta2.RetentionPolicySpec{Name: mst.RetentionPolicy}, false); err != nil {
+		return err
+	}
+	w.logger.Info("create the destination retention policy of stream task", zap.String("stream", si.Name),
+		zap.String("db", mst.Database), zap.String("rp", mst.RetentionPolicy))
+	return nil
+}
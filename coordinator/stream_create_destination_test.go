@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/obs"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+var _ streamDBCreator = (*metaclient.Client)(nil)
+
+// streamDBCreatorMetaClient keeps the databases created by the stream tasks besides db0 of the mock meta client.
+type streamDBCreatorMetaClient struct {
+	*MockMetaClient
+	dbs     map[string]*meta2.DatabaseInfo
+	created []string
+}
+
+func newStreamDBCreatorMetaClient(mc *MockMetaClient) *streamDBCreatorMetaClient {
+	c := &streamDBCreatorMetaClient{MockMetaClient: mc, dbs: map[string]*meta2.DatabaseInfo{}}
+	db0, _ := mc.DatabaseFn("db0")
+	db0.ReplicaN = 3
+	c.dbs["db0"] = db0
+	mc.DatabaseFn = func(database string) (*meta2.DatabaseInfo, error) {
+		if di, ok := c.dbs[database]; ok {
+			return di, nil
+		}
+		return nil, errno.NewError(errno.DatabaseNotFound, database)
+	}
+	return c
+}
+
+func (c *streamDBCreatorMetaClient) CreateDatabase(name string, enableTagArray bool, replicaN uint32, _ *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
+	c.created = append(c.created, name)
+	rp := NewRetentionPolicy("autogen", time.Hour, engineType)
+	c.dbs[name] = &meta2.DatabaseInfo{Name: name, EnableTagArray: enableTagArray, ReplicaN: int(replicaN),
+		DefaultRetentionPolicy: "autogen", RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{"autogen": rp}}
+	return c.dbs[name], nil
+}
+
+func (c *streamDBCreatorMetaClient) CreateRetentionPolicy(database string, spec *meta2.RetentionPolicySpec, makeDefault bool) (*meta2.RetentionPolicyInfo, error) {
+	c.created = append(c.created, database+"."+spec.Name)
+	rp := NewRetentionPolicy(spec.Name, time.Hour, engineType)
+	c.dbs[database].RetentionPolicies[spec.Name] = rp
+	return rp, nil
+}
+
+func TestStreamCreateDestination(t *testing.T) {
+	env := newStreamTestEnv()
+	mc := newStreamDBCreatorMetaClient(env.pw.MetaClient.(*MockMetaClient))
+	env.pw.MetaClient = mc
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.DesMst = &meta2.StreamMeasurementInfo{Name: "mst2", Database: "rollup", RetentionPolicy: "rp0"}
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp_1d"},
+		Interval: 2 * time.Second,
+	}}
+
+	// the missing destinations fail the task by default
+	require.NoError(t, env.pw.createStreamDestinations(si))
+	ctx := getInjestionCtx()
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	require.True(t, errno.Equal(ctx.initStreamVar(env.pw), errno.DatabaseNotFound))
+	putInjestionCtx(ctx)
+	require.Empty(t, mc.created)
+
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{CreateDestination: true}})
+
+	start := time.Unix(0, env.base).Truncate(2 * time.Second).Add(2 * time.Second).UnixNano()
+	out := env.calculate(t, si, newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)))
+	require.Len(t, rowsOfMst(out, "mst2"), 1)
+	require.Len(t, rowsOfMst(out, "mst3"), 1)
+	require.Equal(t, []string{"rollup", "rollup.rp0", "db0.rp_1d"}, mc.created)
+	require.Equal(t, 3, mc.dbs["rollup"].ReplicaN)
+
+	// the destinations are created once
+	env.calculate(t, si, newStreamTestRow(start+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)))
+	require.Len(t, mc.created, 3)
+
+	// the meta client may not create the databases
+	env.pw.MetaClient = mc.MockMetaClient
+	si.DesMst.Database = "rollup2"
+	require.EqualError(t, env.pw.createStreamDestinations(si), "the destination rollup2.rp0 of stream task t can not be created")
+}
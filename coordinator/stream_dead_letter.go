@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+const (
+	// DeadLetterReasonTag is the tag of the dead-letter rows that records why the source row is rejected
+	DeadLetterReasonTag = "stream_reason"
+
+	deadLetterTypeError = "type_error"
+)
+
+type streamDeadLetter struct {
+	row    *influx.Row
+	reason string
+}
+
+// mapDeadLettersToShard maps the source rows rejected by the task to the shards of the dead-letter measurement,
+// the rows which can not be mapped are dropped and logged.
+func (s *Stream) mapDeadLettersToShard(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, pw *PointsWriter, iCtx *injestionCtx) {
+	if len(ctx.deadLetters) == 0 {
+		return
+	}
+	dropped, err := s.mapDeadLetters(si, task, ctx, pw, iCtx)
+	if err != nil || dropped > 0 {
+		s.logger.Error("write stream dead-letter rows failed", zap.String("stream", si.Name),
+			zap.String("measurement", task.opt.Errors.DeadLetterMst), zap.Int("dropped", dropped), zap.Error(err))
+	}
+}
+
+func (s *Stream) mapDeadLetters(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, pw *PointsWriter, iCtx *injestionCtx) (int, error) {
+	dlCtx, err := s.newStreamWriteCtx(pw, si.DesMst.Database, si.DesMst.RetentionPolicy)
+	if err != nil {
+		return len(ctx.deadLetters), err
+	}
+	defer PutStreamCtx(dlCtx)
+
+	var dropped int
+	var lastErr error
+	for i := range ctx.deadLetters {
+		r := newDeadLetterRow(&ctx.deadLetters[i])
+		err, pErr := s.mapWriteRow(dlCtx, iCtx, task.opt.Errors.DeadLetterMst, r, nil)
+		if err != nil {
+			lastErr = err
+		}
+		if err != nil || pErr != nil {
+			dropped++
+		}
+	}
+	return dropped, lastErr
+}
+
+// newDeadLetterRow copies the source row to a row of the dead-letter measurement, tagged with the reject reason.
+func newDeadLetterRow(dl *streamDeadLetter) *influx.Row {
+	src := dl.row
+	r := &influx.Row{
+		Timestamp: src.Timestamp,
+		Tags:      make(influx.PointTags, 0, len(src.Tags)+1),
+		Fields:    make(influx.Fields, len(src.Fields)),
+	}
+	for i := range src.Tags {
+		if src.Tags[i].Key != DeadLetterReasonTag {
+			r.Tags = append(r.Tags, src.Tags[i])
+		}
+	}
+	r.Tags = append(r.Tags, influx.Tag{Key: DeadLetterReasonTag, Value: dl.reason})
+	sort.Sort(&r.Tags)
+	copy(r.Fields, src.Fields)
+	return r
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDeadLetter(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	strField := influx.Field{Key: "fk1", StrValue: "bad", Type: influx.Field_Type_String}
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, strField),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+	}
+
+	// without the dead-letter sink, the type error fails the batch
+	ctx := env.prepare(t, si)
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.EqualError(t, err, "the fk1 string type is not supported for stream task t")
+	putInjestionCtx(ctx)
+
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		if mstName == "dead_letter" {
+			mi.Schema = nil
+		}
+		return mi, nil
+	}
+	setStreamTestOptions(si, &StreamTaskOptions{Errors: StreamErrorOptions{DeadLetterMst: "dead_letter"}})
+	out := env.calculate(t, si, rows...)
+
+	agg := rowsOfMst(out, "mst2")
+	require.Len(t, agg, 1)
+	v, _ := fieldValue(agg[0], "sum_fk1")
+	require.Equal(t, float64(3), v)
+
+	dl := rowsOfMst(out, "dead_letter")
+	require.Len(t, dl, 1)
+	require.False(t, dl[0].StreamOnly)
+	require.Equal(t, env.base+1, dl[0].Timestamp)
+	require.Equal(t, "a", tagValue(dl[0], "tk1"))
+	require.Equal(t, deadLetterTypeError, tagValue(dl[0], DeadLetterReasonTag))
+	require.Equal(t, "bad", dl[0].Fields[0].StrValue)
+
+	setStreamTestOptions(si, nil)
+	require.Equal(t, defaultStreamTaskOptions, env.pw.getStreamTaskOptions(si))
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"encoding/binary"
+	"sync"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamDedups returns whether the task aggregates the latest row of each point only. The store aggregates all
+// the rows it receives, so the rows of such a task are deduplicated and aggregated at the sql layer.
+func streamDedups(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Window.Dedup
+}
+
+// streamDedup holds the points aggregated by the task, keyed by the ends of their windows. The points of a window
+// are dropped once the window is complete, so only the points of the windows still open are held.
+type streamDedup struct {
+	mu     sync.Mutex
+	points map[int64]map[string]struct{}
+}
+
+// add returns whether the point of the window ending at end is not aggregated yet, and holds it.
+func (d *streamDedup) add(end int64, point string) bool {
+	if d.points == nil {
+		d.points = make(map[int64]map[string]struct{})
+	}
+	points, ok := d.points[end]
+	if !ok {
+		points = make(map[string]struct{})
+		d.points[end] = points
+	}
+	if _, ok = points[point]; ok {
+		return false
+	}
+	points[point] = struct{}{}
+	return true
+}
+
+// expire drops the points of the windows which end before the time.
+func (d *streamDedup) expire(before int64) {
+	for end := range d.points {
+		if end < before {
+			delete(d.points, end)
+		}
+	}
+}
+
+// appendPointKey appends the key of the point of the row, which is its source retention policy, measurement,
+// tags and timestamp. The strings are prefixed by their lengths, so any value can be told apart.
+func appendPointKey(dst []byte, rp string, r *influx.Row) []byte {
+	dst = appendLenString(dst, rp)
+	dst = appendLenString(dst, r.Name)
+	for i := range r.Tags {
+		dst = appendLenString(dst, r.Tags[i].Key)
+		dst = appendLenString(dst, r.Tags[i].Value)
+	}
+	return binary.BigEndian.AppendUint64(dst, uint64(r.Timestamp))
+}
+
+func appendLenString(dst []byte, s string) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// dedupRows returns the rows without the duplicates of the points, which are counted. The latest row of a point
+// in the batch is kept, and it is dropped as well if a former batch aggregated the point while its window is open.
+// The backfill rows are only deduplicated within the batch, as they recompute the windows.
+func (s *streamCtx) dedupRows(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, state *streamTaskState, rp string) []*influx.Row {
+	keys := make([]string, len(rows))
+	latest := make(map[string]int, len(rows))
+	for i, r := range rows {
+		s.groupKeyBuf = appendPointKey(s.groupKeyBuf[:0], rp, r)
+		keys[i] = string(s.groupKeyBuf)
+		latest[keys[i]] = i
+	}
+	if s.backfill && len(latest) == len(rows) {
+		return rows
+	}
+
+	kept := rows[:0:0]
+	var dropped int64
+	d := &state.dedup
+	d.mu.Lock()
+	if !s.backfill {
+		d.expire(state.loadWatermark() - int64(si.Delay) - int64(task.opt.Window.AllowedLateness))
+	}
+	for i, r := range rows {
+		dup := latest[keys[i]] != i
+		if !dup && !s.backfill {
+			_, end := task.windowOpt.Window(r.Timestamp)
+			dup = !d.add(end, keys[i])
+		}
+		if dup {
+			dropped++
+			continue
+		}
+		kept = append(kept, r)
+	}
+	d.mu.Unlock()
+	if dropped == 0 {
+		return rows
+	}
+	state.addDuplicateRows(dropped)
+	return kept
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDedup(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{Dedup: true}})
+	sec := int64(time.Second)
+	row := func(ts int64, tk1, tk2 string, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: tk1}, {Key: "tk2", Value: tk2}}, floatField("fk1", v))
+	}
+	sums := func(rows ...*influx.Row) map[string]float64 {
+		out := map[string]float64{}
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			out[tagValue(r, "tk1")], _ = fieldValue(r, "sum_fk1")
+		}
+		return out
+	}
+	state := env.pw.getStreamTaskState(si.Name)
+
+	// the latest row of a point is aggregated, the points of the other tags or times are not duplicates
+	require.Equal(t, map[string]float64{"a": 15, "b": 3}, sums(row(env.base, "a", "x", 1), row(env.base, "a", "x", 5),
+		row(env.base+1, "a", "x", 2), row(env.base, "a", "y", 8), row(env.base, "b", "x", 3)))
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.duplicateRows))
+
+	// the point aggregated by a former batch is dropped while its window is open
+	require.Equal(t, map[string]float64{"b": 4}, sums(row(env.base, "a", "x", 100), row(env.base+2, "b", "x", 4)))
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.duplicateRows))
+
+	// the points of the complete windows are no longer held
+	require.Len(t, sums(row(env.base+5*sec, "c", "x", 1)), 1)
+	require.Equal(t, map[string]float64{"a": 100}, sums(row(env.base, "a", "x", 100)))
+	require.Len(t, state.dedup.points, 2)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.duplicateRows))
+}
+
+func TestStreamPointKey(t *testing.T) {
+	r1 := newStreamTestRow(1, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "b"}})
+	r2 := newStreamTestRow(1, []influx.Tag{{Key: "tk1", Value: "a\x01tk2"}})
+	require.NotEqual(t, appendPointKey(nil, "rp0", r1), appendPointKey(nil, "rp0", r2))
+	require.NotEqual(t, appendPointKey(nil, "rp0", r1), appendPointKey(nil, "rp1", r1))
+	r2 = newStreamTestRow(2, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "b"}})
+	require.NotEqual(t, appendPointKey(nil, "rp0", r1), appendPointKey(nil, "rp0", r2))
+}
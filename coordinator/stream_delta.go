@@ -0,0 +1,187 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamDelta is how a call writes its results as the deltas from the results of the previous windows of the groups.
+type StreamDelta uint8
+
+const (
+	// StreamDeltaNone writes the results as they are
+	StreamDeltaNone StreamDelta = iota
+	// StreamDeltaSkipFirst writes the deltas, the first window of a group has no previous result and is written
+	// without the call
+	StreamDeltaSkipFirst
+	// StreamDeltaRawFirst writes the deltas, the first window of a group is written with its result as it is
+	StreamDeltaRawFirst
+)
+
+// streamDeltaCall is how a call writes its deltas.
+type streamDeltaCall struct {
+	first StreamDelta
+	// clamp writes the negative deltas as zeros, which are the resets of the counters
+	clamp bool
+}
+
+// streamWritesDeltas returns whether a call of the task writes its results as the deltas.
+func streamWritesDeltas(opt *StreamTaskOptions) bool {
+	for _, c := range opt.CallOptions {
+		if c.Delta != StreamDeltaNone {
+			return true
+		}
+	}
+	return false
+}
+
+// buildDeltaCalls returns how the calls write their deltas, indexed by the calls of the stream, nil if no call
+// writes the deltas. The partial results merged by the store are not the results of the windows, so only the
+// results of the accumulators and of the tasks writing the windows directly can be written as the deltas.
+func buildDeltaCalls(info *meta2.StreamInfo, calls []*streamLib.FieldCall, callOptions map[string]*StreamCallOptions, direct bool) ([]streamDeltaCall, error) {
+	var deltas []streamDeltaCall
+	for i, c := range info.Calls {
+		opt, ok := callOptions[c.Alias]
+		if !ok || opt.Delta == StreamDeltaNone {
+			continue
+		}
+		if opt.Delta > StreamDeltaRawFirst {
+			return nil, fmt.Errorf("the delta %d of the %s call %s of stream task %s is unknown", opt.Delta, c.Call, c.Alias, info.Name)
+		}
+		if typ := calls[i].OutFieldType; typ == influx.Field_Type_String || typ == influx.Field_Type_Boolean {
+			return nil, fmt.Errorf("the %s results of the %s call %s of stream task %s have no deltas",
+				influx.FieldTypeString(typ), c.Call, c.Alias, info.Name)
+		}
+		if !direct && calls[i].MergeFunc != nil {
+			return nil, fmt.Errorf("the results of the %s call %s of stream task %s are merged by the store, which can not be written as the deltas",
+				c.Call, c.Alias, info.Name)
+		}
+		if deltas == nil {
+			deltas = make([]streamDeltaCall, len(calls))
+		}
+		deltas[i] = streamDeltaCall{first: opt.Delta, clamp: opt.ClampNegativeDelta}
+	}
+	return deltas, nil
+}
+
+// deltaGroup is the latest results of the delta calls of a group, which are the ones emitted for the windows
+// whatever the batches they are aggregated by.
+type deltaGroup struct {
+	// starts are the latest windows of the calls, values are the results of them and prev the results of the
+	// windows before them
+	starts  []int64
+	values  []float64
+	prev    []float64
+	has     []bool
+	hasPrev []bool
+}
+
+func newDeltaGroup(calls int) *deltaGroup {
+	return &deltaGroup{
+		starts:  make([]int64, calls),
+		values:  make([]float64, calls),
+		prev:    make([]float64, calls),
+		has:     make([]bool, calls),
+		hasPrev: make([]bool, calls),
+	}
+}
+
+// apply replaces the results of the window at st with the deltas from the results of the previous windows. The
+// latest window emitted again is written with the delta from the window before it, and the windows older than the
+// latest one are written without the calls as their following windows are written already.
+func (g *deltaGroup) apply(task *streamTask, st int64, values []*float64) {
+	for i, v := range values {
+		d := task.deltas[i]
+		if d.first == StreamDeltaNone || v == nil {
+			continue
+		}
+		switch {
+		case !g.has[i]:
+			g.starts[i], g.values[i], g.has[i] = st, *v, true
+		case st > g.starts[i]:
+			g.prev[i], g.hasPrev[i] = g.values[i], true
+			g.starts[i], g.values[i] = st, *v
+		case st == g.starts[i]:
+			g.values[i] = *v
+		default:
+			values[i] = nil
+			continue
+		}
+		if !g.hasPrev[i] {
+			if d.first == StreamDeltaSkipFirst {
+				values[i] = nil
+			}
+			continue
+		}
+		delta := *v - g.prev[i]
+		if delta < 0 && d.clamp {
+			delta = 0
+		}
+		values[i] = &delta
+	}
+}
+
+// streamDeltaState holds the latest results of the groups written as the deltas by the task.
+type streamDeltaState struct {
+	mu     sync.Mutex
+	groups map[string]*deltaGroup
+}
+
+// applyDeltas replaces the results of the delta calls of the windows to emit with the deltas from the previous
+// windows of the groups, in the order of the windows. It is called once the empty windows are filled or carried.
+func (s *streamCtx) applyDeltas(si *meta2.StreamInfo, task *streamTask) {
+	deltas := s.deltas
+	deltas.mu.Lock()
+	defer deltas.mu.Unlock()
+	interval := int64(si.Interval)
+	type window struct {
+		start int64
+		key   int64
+	}
+	var windows []window
+	for k, tv := range s.dataCache {
+		windows = windows[:0]
+		filled := s.filled[k]
+		for t := range tv {
+			st := t
+			if _, ok := filled[t]; !ok && !task.direct && !s.backfill && !s.partial {
+				// the windows are cached at the end time in the forward computation
+				st = t + 1 - interval
+			}
+			windows = append(windows, window{start: st, key: t})
+		}
+		sort.Slice(windows, func(i, j int) bool { return windows[i].start < windows[j].start })
+		g, ok := deltas.groups[k]
+		if !ok {
+			if deltas.groups == nil {
+				deltas.groups = make(map[string]*deltaGroup)
+			}
+			g = newDeltaGroup(len(task.calls))
+			deltas.groups[k] = g
+		}
+		for _, w := range windows {
+			g.apply(task, w.start, tv[w.key])
+		}
+	}
+}
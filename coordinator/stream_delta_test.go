@@ -0,0 +1,108 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDelta(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_skip"},
+		&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_raw"})
+	si.Name = "delta"
+	setStreamTestOptions(si, &StreamTaskOptions{
+		CallOptions: map[string]*StreamCallOptions{
+			"last_skip": {Delta: StreamDeltaSkipFirst, ClampNegativeDelta: true},
+			"last_raw":  {Delta: StreamDeltaRawFirst},
+		},
+	})
+	sec := int64(time.Second)
+	batch := func(ts int64, v float64) map[string]float64 {
+		out := rowsOfMst(env.calculate(t, si, newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))), "mst2")
+		m := map[string]float64{}
+		for _, r := range out {
+			for _, f := range r.Fields {
+				m[f.Key] = f.NumValue
+			}
+		}
+		return m
+	}
+
+	// the first window of the group has no previous one
+	require.Equal(t, map[string]float64{"last_raw": 10}, batch(env.base, 10))
+	require.Equal(t, map[string]float64{"last_skip": 5, "last_raw": 5}, batch(env.base+sec, 15))
+	// the resets of the counter are clamped to zero
+	require.Equal(t, map[string]float64{"last_skip": 0, "last_raw": -12}, batch(env.base+2*sec, 3))
+	// the latest window written again is the delta from the window before it still
+	require.Equal(t, map[string]float64{"last_skip": 5, "last_raw": 5}, batch(env.base+2*sec+1, 20))
+	require.Equal(t, map[string]float64{"last_skip": 1, "last_raw": 1}, batch(env.base+3*sec, 21))
+}
+
+func TestStreamDeltaCheck(t *testing.T) {
+	build := func(call, alias string, delta StreamDelta) error {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: call, Field: "fk1", Alias: alias})
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{
+			CallOptions: map[string]*StreamCallOptions{alias: {Delta: delta}},
+		})
+		return err
+	}
+	require.NoError(t, build("mean", "mean_fk1", StreamDeltaSkipFirst))
+	require.EqualError(t, build("mean", "mean_fk1", StreamDeltaRawFirst+1), "the delta 3 of the mean call mean_fk1 of stream task t is unknown")
+	require.EqualError(t, build("sum", "sum_fk1", StreamDeltaRawFirst),
+		"the results of the sum call sum_fk1 of stream task t are merged by the store, which can not be written as the deltas")
+	require.EqualError(t, build("any", "any_fk1", StreamDeltaRawFirst),
+		"the boolean results of the any call any_fk1 of stream task t have no deltas")
+
+	// the windows written directly are the results of the whole windows
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	calls, err := BuildFieldCall(si, nil, srcSchema, dstSchema)
+	require.NoError(t, err)
+	deltas, err := buildDeltaCalls(si, calls,
+		map[string]*StreamCallOptions{"sum_fk1": {Delta: StreamDeltaRawFirst, ClampNegativeDelta: true}}, true)
+	require.NoError(t, err)
+	require.Equal(t, []streamDeltaCall{{first: StreamDeltaRawFirst, clamp: true}}, deltas)
+}
+
+func TestStreamDeltaWindows(t *testing.T) {
+	task := &streamTask{deltas: []streamDeltaCall{{first: StreamDeltaSkipFirst}, {}}}
+	g := newDeltaGroup(2)
+	value := func(v float64) *float64 { return &v }
+	values := []*float64{value(-3), value(-3)}
+	g.apply(task, 10, values)
+	require.Nil(t, values[0])
+	require.Equal(t, -3.0, *values[1])
+
+	// the negative deltas are kept without the clamp
+	values = []*float64{value(-7), value(-7)}
+	g.apply(task, 20, values)
+	require.Equal(t, -4.0, *values[0])
+	require.Equal(t, -7.0, *values[1])
+
+	// the windows older than the latest one are written without the calls
+	values = []*float64{value(1), value(1)}
+	g.apply(task, 10, values)
+	require.Nil(t, values[0])
+	require.Equal(t, 1.0, *values[1])
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamDestinationSeparator joins the name of the stream and the destination measurement,
+// which names the state of the destination
+const StreamDestinationSeparator = "/"
+
+// destinationInfo returns the stream info aggregating the calls of the stream at the interval of the destination.
+// The store only merges the rows of DesMst, so the windows of the destination are tumbling and aggregated at the sql layer.
+func destinationInfo(info *meta2.StreamInfo, d *meta2.StreamDestination) *meta2.StreamInfo {
+	di := *info
+	di.Name = info.Name + StreamDestinationSeparator + d.DesMst.Name
+	di.DesMst = d.DesMst
+	di.Interval = d.Interval
+	di.Slide = 0
+	di.Destinations = nil
+	return &di
+}
+
+// aggregateDestination aggregates the rows for the destination with a context of its own, so every destination
+// has its own windows. The dead letters are only written for DesMst, which sees the same rows.
+func (s *Stream) aggregateDestination(rows []*influx.Row, task *streamTask, pw *PointsWriter, iCtx *injestionCtx, parent *streamCtx) error {
+	ctx := GetStreamCtx()
+	defer PutStreamCtx(ctx)
+	if parent.backfill {
+		ctx.backfill = true
+		ctx.startTime, ctx.endTime = parent.startTime, parent.endTime
+		ctx.windowsFrom, ctx.windowsTo = parent.windowsFrom, parent.windowsTo
+		ctx.accumulators = &streamAccumulators{}
+	}
+	err := s.aggregate(rows, task.info, task, pw, iCtx, ctx)
+	parent.result.merge(&ctx.result)
+	return err
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// checkDestinationSchema rejects the task whose calls write the fields of other types than the ones of the existing
+// destination, the rows of which would be rejected by the store. The integer results are written to the float
+// fields as floats if the task widens the types, and the float results to the integer fields by the coercions of
+// the calls, the float results of the calls following the integer fields are coerced as well. The other conflicts
+// are rejected whatever the options.
+func (w *streamTask) checkDestinationSchema(dstSchema map[string]int32) error {
+	if err := w.checkIntCoercions(); err != nil {
+		return err
+	}
+	var conflicts []string
+	for i, c := range w.calls {
+		typ, ok := dstSchema[c.Alias]
+		if ok && typ == influx.Field_Type_Int && c.OutFieldType == influx.Field_Type_Int && c.InFieldType == influx.Field_Type_Float {
+			// the call follows the type of the destination, the results of which are floats still
+			if _, err := w.addIntCoercion(i, c); err != nil {
+				return err
+			}
+			continue
+		}
+		if !ok || typ == c.OutFieldType || c.OutFieldType == influx.Field_Type_Unknown {
+			continue
+		}
+		if w.opt.Output.WidenFieldTypes && c.OutFieldType == influx.Field_Type_Int && typ == influx.Field_Type_Float {
+			c.OutFieldType = influx.Field_Type_Float
+			continue
+		}
+		if c.OutFieldType == influx.Field_Type_Float && typ == influx.Field_Type_Int {
+			coerced, err := w.addIntCoercion(i, c)
+			if err != nil {
+				return err
+			}
+			if coerced {
+				continue
+			}
+		}
+		conflicts = append(conflicts, fmt.Sprintf("%s is %s but %s in the destination", c.Alias,
+			influx.FieldTypeString(c.OutFieldType), influx.FieldTypeString(typ)))
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("the fields of stream task %s conflict with the destination %s: %s",
+			w.info.Name, w.info.DesMst.Name, strings.Join(conflicts, ", "))
+	}
+	return nil
+}
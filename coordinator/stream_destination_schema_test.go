@@ -0,0 +1,58 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDestinationSchema(t *testing.T) {
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_fk1"},
+		&meta2.StreamCall{Call: "count_distinct", Field: "fk1", Alias: "distinct_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+	)
+	srcSchema, dstSchema := streamTestSchema(si)
+	// the sum follows the type of the destination
+	dstSchema["sum_fk1"] = influx.Field_Type_Int
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+
+	dstSchema["mean_fk1"] = influx.Field_Type_Int
+	dstSchema["distinct_fk1"] = influx.Field_Type_Float
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the fields of stream task t conflict with the destination mst2: "+
+		"mean_fk1 is float but integer in the destination, distinct_fk1 is integer but float in the destination")
+
+	// the integers are widened to the float field, but the floats are not narrowed
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{WidenFieldTypes: true}})
+	require.EqualError(t, err, "the fields of stream task t conflict with the destination mst2: mean_fk1 is float but integer in the destination")
+	dstSchema["mean_fk1"] = influx.Field_Type_Float
+	task, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{WidenFieldTypes: true}})
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Float), task.calls[1].OutFieldType)
+
+	// the fields missing from the destination are created by the writes
+	delete(dstSchema, "distinct_fk1")
+	task, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Int), task.calls[1].OutFieldType)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDestinations(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp0"},
+		Interval: 2 * time.Second,
+	}}
+	// the windows of the destinations are kept until the delay passes
+	si.Delay = time.Second
+	require.True(t, streamKeepsState(si))
+	// the meta data keeps the destinations
+	other := &meta2.StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	require.True(t, si.Equal(other))
+	require.Equal(t, "mst3", other.Destinations[0].DesMst.Name)
+
+	start := time.Unix(0, env.base).Truncate(2 * time.Second).Add(2 * time.Second).UnixNano()
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	windowsOf := func(rows []*influx.Row, mst string, streamOnly bool) map[int64]float64 {
+		m := map[int64]float64{}
+		for _, r := range rowsOfMst(rows, mst) {
+			require.Equal(t, streamOnly, r.StreamOnly)
+			v, _ := fieldValue(r, "sum_fk1")
+			m[r.Timestamp-start] = v
+		}
+		return m
+	}
+
+	out := env.calculate(t, si, row(500, 1), row(1500, 2), row(2500, 4))
+	// the windows of DesMst are merged by the store at their end times
+	require.Equal(t, map[int64]float64{int64(time.Second) - 1: 1, int64(2*time.Second) - 1: 2, int64(3*time.Second) - 1: 4},
+		windowsOf(out, "mst2", true))
+	// the windows of the other destinations are written at their start times
+	require.Equal(t, map[int64]float64{0: 3, int64(2 * time.Second): 4}, windowsOf(out, "mst3", false))
+
+	// the windows of the destinations are kept across the batches
+	out = env.calculate(t, si, row(1600, 3))
+	require.Equal(t, map[int64]float64{int64(2*time.Second) - 1: 3}, windowsOf(out, "mst2", true))
+	require.Equal(t, map[int64]float64{0: 6}, windowsOf(out, "mst3", false))
+}
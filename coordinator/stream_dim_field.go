@@ -0,0 +1,172 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"strconv"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamDimField is the type of the field a dim of the task is written as instead of a tag.
+type StreamDimField uint8
+
+const (
+	// StreamDimFieldString writes the values of the dim as a string field
+	StreamDimFieldString StreamDimField = iota
+	// StreamDimFieldFloat writes the values of the dim as a float field, the values which are not finite numbers
+	// are not written
+	StreamDimFieldFloat
+	// StreamDimFieldInt writes the values of the dim as an integer field, the values which are not integers
+	// are not written
+	StreamDimFieldInt
+)
+
+// streamWritesDimFields returns whether some dims of the task are written as the fields of the windows. The store
+// merges the windows without them, so the windows of such a task are aggregated at the sql layer and written at
+// their start times.
+func streamWritesDimFields(opt *StreamTaskOptions) bool {
+	return opt != nil && len(opt.Group.DimFields) > 0
+}
+
+func (f StreamDimField) fieldType() int32 {
+	switch f {
+	case StreamDimFieldFloat:
+		return influx.Field_Type_Float
+	case StreamDimFieldInt:
+		return influx.Field_Type_Int
+	default:
+		return influx.Field_Type_String
+	}
+}
+
+// buildDimFields checks the dims written as the fields of the windows and removes them from the shard dims.
+// The windows of the groups differing only by them are written to the same series, where the last one wins,
+// so they should be the dims determined by the other dims of the task.
+func (w *streamTask) buildDimFields() error {
+	if !streamWritesDimFields(w.opt) {
+		return nil
+	}
+	if w.passthrough {
+		return fmt.Errorf("stream task %s without calls has no groups to write the dims of as fields", w.info.Name)
+	}
+	if w.fanOutMsts != nil {
+		return fmt.Errorf("the dims of stream task %s can not be written as fields to the fan-out measurements", w.info.Name)
+	}
+	for d, f := range w.opt.Group.DimFields {
+		if f > StreamDimFieldInt {
+			return fmt.Errorf("the field type %d of the dim %s of stream task %s is unknown", f, d, w.info.Name)
+		}
+		if !w.isTagDim(d) && !w.isFieldDim(d) {
+			return fmt.Errorf("the dim field %s is not a dim of stream task %s", d, w.info.Name)
+		}
+		if _, ok := w.groupOnlyDims[d]; ok {
+			return fmt.Errorf("the dim field %s of stream task %s is a group-only dim", d, w.info.Name)
+		}
+		for _, c := range w.calls {
+			if c.Alias == d {
+				return fmt.Errorf("the dim field %s of stream task %s is written by the call %s", d, w.info.Name, c.Alias)
+			}
+		}
+	}
+	shardDims := make([]string, 0, len(w.shardDims))
+	for _, d := range w.shardDims {
+		if _, ok := w.opt.Group.DimFields[d]; !ok {
+			shardDims = append(shardDims, d)
+		}
+	}
+	w.shardDims = shardDims
+	return nil
+}
+
+func (w *streamTask) isFieldDim(key string) bool {
+	for _, d := range w.fieldIndexKeys {
+		if d == key {
+			return true
+		}
+	}
+	return false
+}
+
+// moveDimsToFields removes the dims written as fields from the tags of the agg row and appends them to its fields.
+// The empty values are not written, as the tags of them are not.
+func (w *streamTask) moveDimsToFields(r *influx.Row) {
+	n := 0
+	for i := range r.Tags {
+		f, ok := w.opt.Group.DimFields[r.Tags[i].Key]
+		if !ok {
+			r.Tags[n] = r.Tags[i]
+			r.ColumnToIndex[r.Tags[n].Key] = n
+			n++
+			continue
+		}
+		delete(r.ColumnToIndex, r.Tags[i].Key)
+		if field, ok := dimField(r.Tags[i].Key, r.Tags[i].Value, f); ok {
+			r.Fields = append(r.Fields, field)
+		}
+	}
+	r.Tags = r.Tags[:n]
+}
+
+// dimField returns the field of the dim value, false if the value is empty or not of the type of the field.
+func dimField(key, value string, f StreamDimField) (influx.Field, bool) {
+	field := influx.Field{Key: key, Type: f.fieldType()}
+	if value == "" {
+		return field, false
+	}
+	switch f {
+	case StreamDimFieldFloat:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || isNonFinite(v) {
+			return field, false
+		}
+		field.NumValue = v
+	case StreamDimFieldInt:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return field, false
+		}
+		field.NumValue = float64(v)
+	default:
+		field.StrValue = value
+	}
+	return field, true
+}
+
+// ensureDimFields adds the dim fields to the schema of the destination measurement if they are missing,
+// the rows of the windows are routed to the shards without updating the schema.
+func (s *Stream) ensureDimFields(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
+	var fields []*proto2.FieldSchema
+	for d, f := range task.opt.Group.DimFields {
+		typ, ok := ctx.ms.Schema[d]
+		if !ok {
+			fields = appendField(fields, d, f.fieldType())
+			continue
+		}
+		if typ != f.fieldType() {
+			return fmt.Errorf("the dim field %s of stream task %s is a %s field of the destination",
+				d, si.Name, influx.FieldTypeString(typ))
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return s.MetaClient.UpdateSchema(ctx.db.Name, ctx.rp.Name, ctx.ms.OriginName(), fields)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func dimFieldOf(r *influx.Row, key string) (influx.Field, bool) {
+	for _, f := range r.Fields {
+		if f.Key == key {
+			return f, true
+		}
+	}
+	return influx.Field{}, false
+}
+
+func TestStreamDimFields(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	var created []string
+	env.pw.MetaClient.(*MockMetaClient).UpdateSchemaFn = func(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error {
+		for _, f := range fieldToCreate {
+			created = append(created, f.GetFieldName())
+		}
+		return nil
+	}
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{DimFields: map[string]StreamDimField{"tk2": StreamDimFieldInt}}})
+	row := func(tk2 string, v float64) *influx.Row {
+		return newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: tk2}}, floatField("fk1", v))
+	}
+
+	// the dim written as a tag of the destination before can not be written as a field
+	ctx := env.prepare(t, si)
+	_, err := ctx.stream.calculate([]*influx.Row{row("12", 1)}, si, env.pw, ctx, 0)
+	putInjestionCtx(ctx)
+	require.EqualError(t, err, "the dim field tk2 of stream task t is a tag field of the destination")
+
+	env.pw.MetaClient.(*MockMetaClient).MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		delete(mi.Schema, "tk2")
+		return mi, nil
+	}
+	out := rowsOfMst(env.calculate(t, si, row("12", 1), row("12", 2), row("x", 10)), "mst2")
+	require.Len(t, out, 2)
+	require.Equal(t, []string{"tk2"}, created)
+	for _, r := range out {
+		// the windows are written directly at their start times without the tag
+		require.False(t, r.StreamOnly)
+		require.Equal(t, env.base, r.Timestamp)
+		require.Equal(t, []influx.Tag{{Key: "tk1", Value: "a"}}, []influx.Tag(r.Tags))
+		require.False(t, strings.Contains(string(r.ShardKey), "tk2"), string(r.ShardKey))
+		f, ok := dimFieldOf(r, "tk2")
+		sum, _ := fieldValue(r, "sum_fk1")
+		if sum == 10 {
+			// the value which is not an integer is not written
+			require.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		require.Equal(t, influx.Field{Key: "tk2", NumValue: 12, Type: influx.Field_Type_Int}, f)
+	}
+
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{DimFields: map[string]StreamDimField{"tk2": StreamDimFieldString}}})
+	out = rowsOfMst(env.calculate(t, si, row("x", 4)), "mst2")
+	require.Len(t, out, 1)
+	f, ok := dimFieldOf(out[0], "tk2")
+	require.True(t, ok)
+	require.Equal(t, influx.Field{Key: "tk2", StrValue: "x", Type: influx.Field_Type_String}, f)
+
+	f, ok = dimField("tk2", "1.5", StreamDimFieldFloat)
+	require.True(t, ok)
+	require.Equal(t, 1.5, f.NumValue)
+	_, ok = dimField("tk2", "NaN", StreamDimFieldFloat)
+	require.False(t, ok)
+	_, ok = dimField("tk2", "", StreamDimFieldString)
+	require.False(t, ok)
+}
+
+func TestStreamDimFieldsInvalid(t *testing.T) {
+	for msg, opt := range map[string]*StreamTaskOptions{
+		"the dim field tk3 is not a dim of stream task t":                                    {Group: StreamGroupOptions{DimFields: map[string]StreamDimField{"tk3": StreamDimFieldString}}},
+		"the field type 3 of the dim tk1 of stream task t is unknown":                        {Group: StreamGroupOptions{DimFields: map[string]StreamDimField{"tk1": StreamDimFieldInt + 1}}},
+		"the dims of stream task t can not be written as fields to the fan-out measurements": {Group: StreamGroupOptions{DimFields: map[string]StreamDimField{"tk1": StreamDimFieldString}}, Output: StreamOutputOptions{FanOutMst: "{mst}_{alias}"}},
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		require.EqualError(t, err, msg)
+	}
+}
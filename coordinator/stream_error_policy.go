@@ -0,0 +1,67 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// StreamErrorAction is how a stream task handles an error.
+type StreamErrorAction uint8
+
+const (
+	// StreamErrorDefault keeps the built-in behavior of the error
+	StreamErrorDefault StreamErrorAction = iota
+	// StreamErrorFatal fails the write of the batch
+	StreamErrorFatal
+	// StreamErrorDrop drops the rows of the error and goes on with the batch
+	StreamErrorDrop
+)
+
+// defaultStreamErrorActions is the built-in behavior of the routing errors of a stream task.
+var defaultStreamErrorActions = map[errno.Errno]StreamErrorAction{
+	errno.WriteNoShardKey:            StreamErrorFatal,
+	errno.WritePointShardKeyTooLarge: StreamErrorDrop,
+	errno.WritePointMap2Shard:        StreamErrorFatal,
+}
+
+// errorAction returns the action of the error, the error not classified by the options or the defaults
+// gets StreamErrorDefault.
+func (o *StreamTaskOptions) errorAction(err error) StreamErrorAction {
+	e, ok := err.(*errno.Error)
+	if !ok {
+		return StreamErrorDefault
+	}
+	if o != nil {
+		if action, ok := o.Errors.ErrorActions[e.Errno()]; ok && action != StreamErrorDefault {
+			return action
+		}
+	}
+	return defaultStreamErrorActions[e.Errno()]
+}
+
+// handleRouteError applies the error actions to the result of routing a row to the shard,
+// a fatal error is returned as err and a droppable error as partialErr.
+func (o *StreamTaskOptions) handleRouteError(err, partialErr error) (error, error) {
+	if err != nil && o.errorAction(err) == StreamErrorDrop {
+		return nil, err
+	}
+	if partialErr != nil && o.errorAction(partialErr) == StreamErrorFatal {
+		return partialErr, nil
+	}
+	return err, partialErr
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamErrorAction(t *testing.T) {
+	var opt *StreamTaskOptions
+	require.Equal(t, StreamErrorFatal, opt.errorAction(errno.NewError(errno.WriteNoShardKey)))
+	require.Equal(t, StreamErrorDrop, opt.errorAction(errno.NewError(errno.WritePointShardKeyTooLarge)))
+	require.Equal(t, StreamErrorFatal, opt.errorAction(errno.NewError(errno.WritePointMap2Shard)))
+	require.Equal(t, StreamErrorDefault, opt.errorAction(errno.NewError(errno.StreamNotFound)))
+	require.Equal(t, StreamErrorDefault, opt.errorAction(errors.New("unknown")))
+
+	opt = &StreamTaskOptions{Errors: StreamErrorOptions{ErrorActions: map[errno.Errno]StreamErrorAction{
+		errno.WriteNoShardKey:            StreamErrorDrop,
+		errno.WritePointShardKeyTooLarge: StreamErrorDefault,
+		errno.StreamNotFound:             StreamErrorDrop,
+	}}}
+	require.Equal(t, StreamErrorDrop, opt.errorAction(errno.NewError(errno.WriteNoShardKey)))
+	require.Equal(t, StreamErrorDrop, opt.errorAction(errno.NewError(errno.WritePointShardKeyTooLarge)))
+	require.Equal(t, StreamErrorDrop, opt.errorAction(errno.NewError(errno.StreamNotFound)))
+}
+
+func TestStreamErrorPolicy(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	cases := []struct {
+		code  errno.Errno
+		fatal bool
+		setup func(mc *MockMetaClient)
+		value string
+	}{
+		{
+			code:  errno.WriteNoShardKey,
+			fatal: true,
+			setup: func(mc *MockMetaClient) {
+				mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+					mi := NewMeasurement(mstName, engineType)
+					mi.ShardKeys = nil
+					return mi, nil
+				}
+			},
+		},
+		{
+			code:  errno.WritePointShardKeyTooLarge,
+			fatal: false,
+			setup: func(mc *MockMetaClient) {
+				mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+					mi := NewMeasurement(mstName, engineType)
+					mi.ShardKeys = []meta2.ShardKeyInfo{{ShardKey: []string{"tk1"}, Type: "hash"}}
+					return mi, nil
+				}
+			},
+			value: strings.Repeat("a", MaxShardKey),
+		},
+		{
+			code:  errno.WritePointMap2Shard,
+			fatal: true,
+			setup: func(mc *MockMetaClient) {
+				mc.GetAliveShardsFn = func(database string, sgi *meta2.ShardGroupInfo) []int {
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, c := range cases {
+		for _, action := range []StreamErrorAction{StreamErrorDefault, StreamErrorFatal, StreamErrorDrop} {
+			env := newStreamTestEnv()
+			c.setup(env.pw.MetaClient.(*MockMetaClient))
+			setStreamTestOptions(si, &StreamTaskOptions{Errors: StreamErrorOptions{ErrorActions: map[errno.Errno]StreamErrorAction{c.code: action}}})
+			value := c.value
+			if value == "" {
+				value = "a"
+			}
+			rows := []*influx.Row{
+				newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: value}}, floatField("fk1", 1)),
+			}
+
+			ctx := env.prepare(t, si)
+			res, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+			fatal := action == StreamErrorFatal || (action == StreamErrorDefault && c.fatal)
+			if fatal {
+				require.True(t, errno.Equal(err, c.code), "errno %d action %d: %v", c.code, action, err)
+			} else {
+				require.NoError(t, err, "errno %d action %d", c.code, action)
+				require.Empty(t, ctx.shardRowMap)
+				require.Equal(t, streamResult{partialErrors: 1, errnos: map[errno.Errno]int64{c.code: 1}}, res)
+			}
+			putInjestionCtx(ctx)
+		}
+	}
+}
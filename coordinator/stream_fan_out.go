@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	FanOutMstPlaceholder   = "{mst}"
+	FanOutAliasPlaceholder = "{alias}"
+)
+
+// buildFanOutMsts returns the fan-out measurement of each call keyed by the output field of the call.
+func buildFanOutMsts(info *meta2.StreamInfo, opt *StreamTaskOptions) map[string]string {
+	template := opt.Output.FanOutMst
+	if template == "" {
+		return nil
+	}
+	msts := make(map[string]string, len(info.Calls))
+	for _, c := range info.Calls {
+		r := strings.NewReplacer(FanOutMstPlaceholder, info.DesMst.Name, FanOutAliasPlaceholder, c.Alias)
+		msts[outputAlias(opt, c.Alias)] = r.Replace(template)
+	}
+	return msts
+}
+
+// fanOutRow splits the agg row into one row per call, and maps each of them to its own measurement.
+// The rows are written as normal points at the start time of the window, which hold the result of the rows
+// of this batch only, because the store stream merges the rows of the destination measurement only.
+func (s *Stream) fanOutRow(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx, r *influx.Row) error {
+	st, _ := ctx.opt.Window(r.Timestamp)
+	for i := range r.Fields {
+		mst := task.fanOutMsts[r.Fields[i].Key]
+		fCtx, err := ctx.fanOutCtx(s, si, mst)
+		if err != nil {
+			return err
+		}
+
+		fr := &influx.Row{
+			Timestamp: st,
+			Tags:      make(influx.PointTags, len(r.Tags)),
+			Fields:    influx.Fields{r.Fields[i]},
+		}
+		copy(fr.Tags, r.Tags)
+		buildColumnToIndex(fr)
+		err, pErr := s.mapWriteRow(fCtx, iCtx, mst, fr, task.shardDims)
+		if err != nil {
+			return err
+		}
+		if pErr != nil {
+			ctx.addPartialError(pErr)
+			continue
+		}
+		iCtx.addStreamWritten(ctx.state.stats, fr)
+	}
+	return nil
+}
+
+func (s *streamCtx) fanOutCtx(w *Stream, si *meta2.StreamInfo, mst string) (*streamCtx, error) {
+	if c, ok := s.fanOutCtxs[mst]; ok {
+		return c, nil
+	}
+	c, err := w.newStreamWriteCtx(s.writeHelper.pw, si.DesMst.Database, si.DesMst.RetentionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	c.opt = s.opt
+	c.taskOpt = s.taskOpt
+	if s.fanOutCtxs == nil {
+		s.fanOutCtxs = make(map[string]*streamCtx)
+	}
+	s.fanOutCtxs[mst] = c
+	return c, nil
+}
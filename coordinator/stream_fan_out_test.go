@@ -0,0 +1,51 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFanOut(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{FanOutMst: "{mst}_{alias}"}})
+
+	out := env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 2)),
+	)
+	require.Empty(t, rowsOfMst(out, "mst2"))
+
+	for mst, exp := range map[string][]float64{"mst2_sum_fk1": {5, 2}, "mst2_max_fk1": {4, 2}} {
+		rows := rowsOfMst(out, mst)
+		require.Len(t, rows, 2, mst)
+		for i, group := range []string{"a", "b"} {
+			require.Equal(t, group, tagValue(rows[i], "tk1"))
+			require.Len(t, rows[i].Fields, 1)
+			require.Equal(t, exp[i], rows[i].Fields[0].NumValue)
+			require.Equal(t, env.base, rows[i].Timestamp)
+			require.False(t, rows[i].StreamOnly)
+		}
+	}
+}
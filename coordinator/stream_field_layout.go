@@ -0,0 +1,90 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamEmptyWindow is how a task writes the windows none of whose calls has a value, such as the windows whose
+// results are all non-finite and dropped.
+type StreamEmptyWindow uint8
+
+const (
+	// StreamEmptyWindowSkip skips the empty windows
+	StreamEmptyWindowSkip StreamEmptyWindow = iota
+	// StreamEmptyWindowNull writes the empty windows without the fields of the calls, which the readers see as the
+	// nulls of the calls. A row is not written without fields, so the windows carry the complete field.
+	StreamEmptyWindowNull
+	// StreamEmptyWindowZero writes the calls of the empty windows as the zeros of their types
+	StreamEmptyWindowZero
+)
+
+func checkEmptyWindows(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	switch opt.Output.EmptyWindows {
+	case StreamEmptyWindowSkip, StreamEmptyWindowZero:
+		return nil
+	case StreamEmptyWindowNull:
+		if !streamMarksComplete(opt) {
+			return fmt.Errorf("stream task %s writes the empty windows as nulls without the complete field", info.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("the empty window policy %d of stream task %s is unknown", opt.Output.EmptyWindows, info.Name)
+}
+
+// checkDenseFields rejects the dense layout and the zero empty windows of the windows merged by the store with the
+// min or max calls, the zero of an empty call would be merged as a value of the window.
+func (w *streamTask) checkDenseFields() error {
+	if !w.opt.Output.DenseFields && w.opt.Output.EmptyWindows != StreamEmptyWindowZero || w.direct {
+		return nil
+	}
+	for _, c := range w.info.Calls {
+		if c.Call == "min" || c.Call == "max" {
+			return fmt.Errorf("the %s call %s of stream task %s is merged by the store, which can not write the empty windows densely", c.Call, c.Alias, w.info.Name)
+		}
+	}
+	return nil
+}
+
+// outFieldType returns the type of the field written for the call i.
+func (w *streamTask) outFieldType(i int) int32 {
+	if w.coercions != nil && w.coercions[i] != StreamCoerceNone {
+		return influx.Field_Type_Int
+	}
+	return w.calls[i].OutFieldType
+}
+
+// emptyWindowFields sets the fields of the agg row of an empty window by the empty window policy of the task,
+// false if the window is skipped. The shard key of the row is built by its tags, the fields are not needed.
+func (w *streamTask) emptyWindowFields(r *influx.Row) bool {
+	switch w.opt.Output.EmptyWindows {
+	case StreamEmptyWindowNull:
+		r.Fields = r.Fields[:0]
+		return true
+	case StreamEmptyWindowZero:
+		r.Fields = r.Fields[:len(w.calls)]
+		for i := range w.calls {
+			r.Fields[i] = influx.Field{Key: w.calls[i].Alias, Type: w.outFieldType(i)}
+		}
+		return true
+	}
+	return false
+}
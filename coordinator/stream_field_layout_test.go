@@ -0,0 +1,104 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDenseFields(t *testing.T) {
+	env := newStreamTestEnv()
+	keys := func(name string, opt *StreamTaskOptions) []string {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk3", Alias: "sum_fk3"},
+			&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.Name = name
+		setStreamTestOptions(si, opt)
+		out := rowsOfMst(env.calculate(t, si,
+			newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2))), "mst2")
+		require.Len(t, out, 1)
+		var keys []string
+		for _, f := range out[0].Fields {
+			keys = append(keys, f.Key)
+		}
+		v, _ := fieldValue(out[0], "sum_fk1")
+		require.Equal(t, float64(2), v)
+		v, _ = fieldValue(out[0], "sum_fk3")
+		require.Equal(t, float64(0), v)
+		return keys
+	}
+	require.Equal(t, []string{"sum_fk1"}, keys("sparse_fields", nil))
+	// the empty call is written as zero in the order of the calls
+	require.Equal(t, []string{"sum_fk3", "sum_fk1"}, keys("dense_fields", &StreamTaskOptions{Output: StreamOutputOptions{DenseFields: true}}))
+
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "min", Field: "fk1", Alias: "min_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	opt := &StreamTaskOptions{Output: StreamOutputOptions{DenseFields: true}}
+	_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+	require.EqualError(t, err, "the min call min_fk1 of stream task t is merged by the store, which can not write the empty windows densely")
+	// the windows written directly are dense with any call
+	opt.Window.StartField = "window_start"
+	_, err = newStreamTask(si, srcSchema, dstSchema, opt)
+	require.NoError(t, err)
+}
+
+func TestStreamEmptyWindows(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	// the sum of the infinities is NaN, which is dropped and leaves the window of a without values
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", math.Inf(1))),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", math.Inf(-1))),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 1)),
+	}
+	empty := func(opt *StreamTaskOptions) *influx.Row {
+		setStreamTestOptions(si, opt)
+		var row *influx.Row
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			if tagValue(r, "tk1") == "a" {
+				require.Nil(t, row)
+				row = r
+			}
+		}
+		return row
+	}
+	require.Nil(t, empty(nil))
+
+	// the null window has no call field but the complete field, and a shard key by its tags
+	r := empty(&StreamTaskOptions{Output: StreamOutputOptions{CompleteField: "complete", EmptyWindows: StreamEmptyWindowNull}})
+	require.NotNil(t, r)
+	require.Len(t, r.Fields, 1)
+	require.Equal(t, "complete", r.Fields[0].Key)
+	require.Contains(t, string(r.ShardKey), "tk1=a")
+
+	r = empty(&StreamTaskOptions{Output: StreamOutputOptions{EmptyWindows: StreamEmptyWindowZero}})
+	require.NotNil(t, r)
+	require.Equal(t, []influx.Field{{Key: "sum_fk1", Type: influx.Field_Type_Float}}, []influx.Field(r.Fields))
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{EmptyWindows: StreamEmptyWindowNull}})
+	require.EqualError(t, err, "stream task t writes the empty windows as nulls without the complete field")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{EmptyWindows: StreamEmptyWindowZero + 1}})
+	require.EqualError(t, err, "the empty window policy 3 of stream task t is unknown")
+	max := newStreamTestInfo(&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"})
+	_, err = newStreamTask(max, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{EmptyWindows: StreamEmptyWindowZero}})
+	require.EqualError(t, err, "the max call max_fk1 of stream task t is merged by the store, which can not write the empty windows densely")
+}
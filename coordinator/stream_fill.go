@@ -0,0 +1,163 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// maxStreamFillWindows bounds the empty windows of a group filled at once, the older ones are left empty
+const maxStreamFillWindows = 1024
+
+// streamFills returns whether the empty windows of the stream are filled.
+// The rows without fields can not be written, so the null fill is the same as no fill.
+func streamFills(info *meta2.StreamInfo) bool {
+	switch info.Fill {
+	case influxql.NumberFill, influxql.PreviousFill, influxql.LinearFill:
+		return true
+	}
+	return false
+}
+
+// fillGroup is the latest window with data of a group.
+type fillGroup struct {
+	start  int64
+	values []*float64
+}
+
+func (g *fillGroup) update(start int64, values []*float64) {
+	g.start = start
+	g.values = make([]*float64, len(values))
+	for i, v := range values {
+		if v != nil {
+			value := *v
+			g.values[i] = &value
+		}
+	}
+}
+
+// streamFillState holds the latest windows of the groups seen by the task, only these groups are filled.
+type streamFillState struct {
+	mu     sync.Mutex
+	groups map[string]*fillGroup
+}
+
+// fillWindows adds the empty windows between the latest window of a group and the windows of the batch to the cache.
+// The filled windows are final and written at the start time of the window, the values of previous and linear
+// are the ones emitted for the windows around them.
+func (s *Stream) fillWindows(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) {
+	fills := &ctx.state.fill
+	fills.mu.Lock()
+	defer fills.mu.Unlock()
+	step, interval := int64(si.Interval), int64(si.Interval)
+	if task.sliding {
+		step = int64(si.Slide)
+	}
+	var starts []int64
+	for k, tv := range ctx.dataCache {
+		starts = starts[:0]
+		for t := range tv {
+			starts = append(starts, t)
+		}
+		sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+		g, seen := fills.groups[k]
+		for _, t := range starts {
+			st := t
+			if !task.direct {
+				// the windows are cached at the end time in the forward computation
+				st = t + 1 - interval
+			}
+			cur := tv[t]
+			if !seen {
+				if fills.groups == nil {
+					fills.groups = make(map[string]*fillGroup)
+				}
+				g = &fillGroup{}
+				g.update(st, cur)
+				fills.groups[k] = g
+				seen = true
+				continue
+			}
+			if st <= g.start {
+				continue
+			}
+			from := g.start + step
+			if (st-from)/step > maxStreamFillWindows {
+				from = st - maxStreamFillWindows*step
+			}
+			for w := from; w < st; w += step {
+				if values := fillValues(si, g, cur, w, st); values != nil {
+					ctx.addFilledWindow(k, w, values)
+				}
+			}
+			g.update(st, cur)
+		}
+	}
+}
+
+// fillValues returns the values of the empty window w between the latest window of the group and the window st,
+// nil if no call has a value.
+func fillValues(si *meta2.StreamInfo, g *fillGroup, cur []*float64, w, st int64) []*float64 {
+	values := make([]*float64, len(cur))
+	var n int
+	for i := range values {
+		var v float64
+		switch si.Fill {
+		case influxql.NumberFill:
+			v = si.FillValue
+		case influxql.PreviousFill:
+			if g.values[i] == nil {
+				continue
+			}
+			v = *g.values[i]
+		case influxql.LinearFill:
+			if g.values[i] == nil || cur[i] == nil {
+				continue
+			}
+			v = *g.values[i] + (*cur[i]-*g.values[i])*float64(w-g.start)/float64(st-g.start)
+		}
+		values[i] = &v
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	return values
+}
+
+func (s *streamCtx) addFilledWindow(groupKey string, start int64, values []*float64) {
+	windows, ok := s.dataCache[groupKey]
+	if !ok {
+		// the carried groups may have no rows in the batch
+		windows = make(map[int64][]*float64)
+		s.dataCache[groupKey] = windows
+	}
+	windows[start] = values
+	if s.filled == nil {
+		s.filled = make(map[string]map[int64]struct{})
+	}
+	filled, ok := s.filled[groupKey]
+	if !ok {
+		filled = make(map[int64]struct{})
+		s.filled[groupKey] = filled
+	}
+	filled[start] = struct{}{}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFill(t *testing.T) {
+	row := func(env *streamTestEnv, sec int, group string, v float64) *influx.Row {
+		return newStreamTestRow(env.base+int64(sec)*int64(time.Second), []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+	// filledOf returns the values of the filled windows keyed by group and the second of the window start
+	filledOf := func(env *streamTestEnv, rows []*influx.Row) map[string]float64 {
+		m := map[string]float64{}
+		for _, r := range rowsOfMst(rows, "mst2") {
+			if r.StreamOnly {
+				continue
+			}
+			v, ok := fieldValue(r, "sum_fk1")
+			require.True(t, ok)
+			m[tagValue(r, "tk1")+"/"+time.Duration(r.Timestamp-env.base).String()] = v
+		}
+		return m
+	}
+
+	cases := []struct {
+		fill   influxql.FillOption
+		value  float64
+		expect map[string]float64
+	}{
+		{fill: influxql.NullFill, expect: map[string]float64{}},
+		{fill: influxql.NoFill, expect: map[string]float64{}},
+		{fill: influxql.NumberFill, value: -1, expect: map[string]float64{"a/1s": -1, "a/2s": -1}},
+		{fill: influxql.PreviousFill, expect: map[string]float64{"a/1s": 1, "a/2s": 1}},
+		{fill: influxql.LinearFill, expect: map[string]float64{"a/1s": 2, "a/2s": 3}},
+	}
+	for _, c := range cases {
+		env := newStreamTestEnv()
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.Fill, si.FillValue = c.fill, c.value
+		require.Empty(t, filledOf(env, env.calculate(t, si, row(env, 0, "a", 1))))
+		// the group b has never been seen before, so nothing is filled for it
+		out := env.calculate(t, si, row(env, 3, "a", 4), row(env, 5, "b", 1))
+		require.Equal(t, c.expect, filledOf(env, out), "fill %d", c.fill)
+	}
+
+	// the empty windows in a batch are filled as well
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Fill = influxql.PreviousFill
+	out := env.calculate(t, si, row(env, 0, "a", 1), row(env, 2, "a", 2))
+	require.Equal(t, map[string]float64{"a/1s": 1}, filledOf(env, out))
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamFilter returns whether the row is aggregated by the task.
+type streamFilter func(r *influx.Row) bool
+
+// buildStreamFilter compiles the condition of the stream, nil if the stream has no condition.
+// The tags are compared with strings and regular expressions, the numeric fields with numbers,
+// a missing key never matches except that a missing tag equals the empty string.
+func buildStreamFilter(info *meta2.StreamInfo) (streamFilter, error) {
+	if info.Condition == nil {
+		return nil, nil
+	}
+	f, err := compileStreamFilter(info.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("the condition %s of stream task %s is not supported: %v", info.Condition, info.Name, err)
+	}
+	return f, nil
+}
+
+func compileStreamFilter(expr influxql.Expr) (streamFilter, error) {
+	switch e := expr.(type) {
+	case *influxql.ParenExpr:
+		return compileStreamFilter(e.Expr)
+	case *influxql.BinaryExpr:
+		switch e.Op {
+		case influxql.AND, influxql.OR:
+			lhs, err := compileStreamFilter(e.LHS)
+			if err != nil {
+				return nil, err
+			}
+			rhs, err := compileStreamFilter(e.RHS)
+			if err != nil {
+				return nil, err
+			}
+			if e.Op == influxql.AND {
+				return func(r *influx.Row) bool { return lhs(r) && rhs(r) }, nil
+			}
+			return func(r *influx.Row) bool { return lhs(r) || rhs(r) }, nil
+		}
+		return compileStreamComparison(e)
+	}
+	return nil, fmt.Errorf("unexpected expression %s", expr)
+}
+
+func compileStreamComparison(e *influxql.BinaryExpr) (streamFilter, error) {
+	op := e.Op
+	ref, ok := e.LHS.(*influxql.VarRef)
+	lit := e.RHS
+	if !ok {
+		if ref, ok = e.RHS.(*influxql.VarRef); !ok {
+			return nil, fmt.Errorf("no key is compared in %s", e)
+		}
+		lit = e.LHS
+		op = mirrorOp(op)
+	}
+	if ref.Val == "time" {
+		return nil, fmt.Errorf("time can not be compared in %s", e)
+	}
+	key := ref.Val
+	switch l := lit.(type) {
+	case *influxql.StringLiteral:
+		return compileStringComparison(key, op, l.Val, e)
+	case *influxql.RegexLiteral:
+		if op != influxql.EQREGEX && op != influxql.NEQREGEX {
+			return nil, fmt.Errorf("unexpected operator %s in %s", op, e)
+		}
+		return compileRegexComparison(key, op == influxql.EQREGEX, l.Val), nil
+	case *influxql.NumberLiteral:
+		return compileNumberComparison(key, op, l.Val, e)
+	case *influxql.IntegerLiteral:
+		return compileNumberComparison(key, op, float64(l.Val), e)
+	}
+	return nil, fmt.Errorf("unexpected literal %s in %s", lit, e)
+}
+
+// mirrorOp returns the operator of the comparison with the operands swapped.
+func mirrorOp(op influxql.Token) influxql.Token {
+	switch op {
+	case influxql.LT:
+		return influxql.GT
+	case influxql.LTE:
+		return influxql.GTE
+	case influxql.GT:
+		return influxql.LT
+	case influxql.GTE:
+		return influxql.LTE
+	}
+	return op
+}
+
+// stringValue returns the value of the tag or the string field of the key.
+func stringValue(r *influx.Row, key string) (string, bool) {
+	idx, ok := r.ColumnToIndex[key]
+	if !ok {
+		return "", false
+	}
+	if idx < r.Tags.Len() {
+		return r.Tags[idx].Value, true
+	}
+	f := &r.Fields[idx-r.Tags.Len()]
+	if f.Type != influx.Field_Type_String {
+		return "", false
+	}
+	return f.StrValue, true
+}
+
+// numberValue returns the value of the numeric field of the key.
+func numberValue(r *influx.Row, key string) (float64, bool) {
+	idx, ok := r.ColumnToIndex[key]
+	if !ok || idx < r.Tags.Len() {
+		return 0, false
+	}
+	f := &r.Fields[idx-r.Tags.Len()]
+	if f.Type == influx.Field_Type_String {
+		return 0, false
+	}
+	return f.NumValue, true
+}
+
+func compileStringComparison(key string, op influxql.Token, val string, e *influxql.BinaryExpr) (streamFilter, error) {
+	var cmp func(v string) bool
+	switch op {
+	case influxql.EQ:
+		cmp = func(v string) bool { return v == val }
+	case influxql.NEQ:
+		cmp = func(v string) bool { return v != val }
+	case influxql.LT:
+		cmp = func(v string) bool { return v < val }
+	case influxql.LTE:
+		cmp = func(v string) bool { return v <= val }
+	case influxql.GT:
+		cmp = func(v string) bool { return v > val }
+	case influxql.GTE:
+		cmp = func(v string) bool { return v >= val }
+	default:
+		return nil, fmt.Errorf("unexpected operator %s in %s", op, e)
+	}
+	return func(r *influx.Row) bool {
+		v, ok := stringValue(r, key)
+		if !ok {
+			if _, exist := r.ColumnToIndex[key]; exist {
+				// a numeric field is never equal to a string
+				return false
+			}
+		}
+		return cmp(v)
+	}, nil
+}
+
+func compileRegexComparison(key string, match bool, re *regexp.Regexp) streamFilter {
+	return func(r *influx.Row) bool {
+		v, _ := stringValue(r, key)
+		return re.MatchString(v) == match
+	}
+}
+
+func compileNumberComparison(key string, op influxql.Token, val float64, e *influxql.BinaryExpr) (streamFilter, error) {
+	var cmp func(v float64) bool
+	switch op {
+	case influxql.EQ:
+		cmp = func(v float64) bool { return v == val }
+	case influxql.NEQ:
+		cmp = func(v float64) bool { return v != val }
+	case influxql.LT:
+		cmp = func(v float64) bool { return v < val }
+	case influxql.LTE:
+		cmp = func(v float64) bool { return v <= val }
+	case influxql.GT:
+		cmp = func(v float64) bool { return v > val }
+	case influxql.GTE:
+		cmp = func(v float64) bool { return v >= val }
+	default:
+		return nil, fmt.Errorf("unexpected operator %s in %s", op, e)
+	}
+	return func(r *influx.Row) bool {
+		v, ok := numberValue(r, key)
+		return ok && cmp(v)
+	}, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFilter(t *testing.T) {
+	row := newStreamTestRow(0, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "fail"}},
+		floatField("fk1", 2), influx.Field{Key: "fk3", StrValue: "x", Type: influx.Field_Type_String})
+	for cond, match := range map[string]bool{
+		"tk2 != 'ok'":                true,
+		"tk2 = 'ok'":                 false,
+		"tk3 = ''":                   true,
+		"tk1 =~ /^a/ AND fk1 > 1":    true,
+		"tk1 !~ /^a/ OR fk1 <= 1":    false,
+		"(tk2 = 'ok' OR fk1 = 2)":    true,
+		"1 < fk1":                    true,
+		"fk1 >= 2.5":                 false,
+		"fk4 < 10":                   false,
+		"fk1 = 'x'":                  false,
+		"fk3 = 'x' AND tk1 > 'a'":    false,
+		"fk3 = 'x' AND tk1 >= 'a'":   true,
+		"tk1 = 'a' AND tk2 != 'foo'": true,
+	} {
+		expr, err := influxql.ParseExpr(cond)
+		require.NoError(t, err)
+		f, err := buildStreamFilter(&meta2.StreamInfo{Name: "t", Condition: expr})
+		require.NoError(t, err, cond)
+		require.Equal(t, match, f(row), cond)
+	}
+
+	for cond, msg := range map[string]string{
+		"time > 0":    "the condition time > 0 of stream task t is not supported: time can not be compared in time > 0",
+		"fk1 + 1 > 2": "the condition fk1 + 1 > 2 of stream task t is not supported: no key is compared in fk1 + 1 > 2",
+		"tk1 = /a/":   "the condition tk1 = /a/ of stream task t is not supported: unexpected operator = in tk1 = /a/",
+	} {
+		expr, err := influxql.ParseExpr(cond)
+		require.NoError(t, err)
+		_, err = buildStreamFilter(&meta2.StreamInfo{Name: "t", Condition: expr})
+		require.EqualError(t, err, msg)
+	}
+}
+
+func TestStreamCondition(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	expr, err := influxql.ParseExpr("tk2 != 'ok' AND fk1 > 1")
+	require.NoError(t, err)
+	si.Condition = expr
+	// the condition is kept by the meta data
+	other := &meta2.StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	require.Equal(t, si.Condition.String(), other.Condition.String())
+	require.True(t, si.Equal(other))
+
+	row := func(status string, v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: status}}, floatField("fk1", v))
+	}
+	out := rowsOfMst(env.calculate(t, si, row("ok", 5), row("fail", 2), row("fail", 1), row("fail", 3)), "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(5), v)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"math"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// FlushStreams writes the open windows of the stream tasks kept at the sql layer, it is called before the shutdown.
+// The batches calculating the streams are written first, and the following ones are blocked until it returns.
+// The open windows of the accumulators are written directly to the destinations at their start times with the
+// current results, and marked by the PartialField of the task as their rows may still arrive. The other windows
+// are written with every batch and kept by the store. ctx bounds the wait for the batches in flight.
+func (w *PointsWriter) FlushStreams(ctx context.Context) error {
+	if err := w.lockStreamFlush(ctx); err != nil {
+		return err
+	}
+	defer w.streamFlushMu.Unlock()
+
+	var flushErr error
+	for name, si := range w.MetaClient.GetStreamInfos() {
+		st, ok := w.streamTaskStates.load(name)
+		if !ok || (st.accumulators.open() == 0 && !st.jitter.holding() && !st.reorder.holding()) || w.streamBroken(si, 0) {
+			continue
+		}
+		n, err := w.flushStreamTask(si)
+		if err != nil {
+			w.logger.Error("flush stream task failed", zap.String("stream", name), zap.Error(err))
+			flushErr = err
+			continue
+		}
+		w.logger.Info("flushed the partial windows of stream task", zap.String("stream", name), zap.Int("windows", n))
+	}
+	return flushErr
+}
+
+// lockStreamFlush waits for the batches calculating the streams to be written and locks out the following ones.
+func (w *PointsWriter) lockStreamFlush(ctx context.Context) error {
+	locked := make(chan struct{})
+	go func() {
+		w.streamFlushMu.Lock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		return nil
+	case <-ctx.Done():
+		// the lock is released as soon as it is taken
+		go func() {
+			<-locked
+			w.streamFlushMu.Unlock()
+		}()
+		return ctx.Err()
+	}
+}
+
+// flushStreamTask writes the open windows of the task and returns the number of them.
+func (w *PointsWriter) flushStreamTask(si *meta2.StreamInfo) (int, error) {
+	return w.writeStreamTaskState(si, func(task *streamTask, ctx *injestionCtx) (int, error) {
+		return ctx.stream.flush(si, task, w, ctx)
+	})
+}
+
+// writeStreamTaskState writes the windows of the state of the task mapped to the shards by write, which returns the
+// number of them.
+func (w *PointsWriter) writeStreamTaskState(si *meta2.StreamInfo, write func(task *streamTask, ctx *injestionCtx) (int, error)) (int, error) {
+	ctx := getInjestionCtx()
+	defer putInjestionCtx(ctx)
+	ctx.writeHelper = newWriteHelper(w)
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	if err := ctx.initStreamVar(w); err != nil {
+		return 0, err
+	}
+
+	srcMst, err := ctx.writeHelper.createMeasurement(si.SrcMst.Database, si.SrcMst.RetentionPolicy, si.SrcMst.Name)
+	if err != nil {
+		return 0, err
+	}
+	task, err := w.buildTask(si, srcMst.Schema, (*ctx.getStreamMSTs())[0].Schema)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := write(task, ctx)
+	if err != nil || n == 0 {
+		return n, err
+	}
+
+	retentionPolicy := si.DesMst.RetentionPolicy
+	if retentionPolicy == "" {
+		retentionPolicy = (*ctx.getStreamDBs())[0].DefaultRetentionPolicy
+	}
+	if err = w.writeShardMap(si.DesMst.Database, retentionPolicy, ctx); err != nil {
+		return n, err
+	}
+	ctx.commitStreamWritten()
+	return n, nil
+}
+
+// flush maps the open windows of the accumulators of the task and the rows held by its flush jitter to the shards,
+// and returns the number of them.
+func (s *Stream) flush(si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, iCtx *injestionCtx) (int, error) {
+	ctx := GetStreamCtx()
+	defer PutStreamCtx(ctx)
+	if err := s.initStateCtx(si, task, pw, ctx, iCtx); err != nil {
+		return 0, err
+	}
+	ctx.partial = true
+	// the rows held by the reorder buffer and the flush jitter are written with the open windows
+	released, _ := ctx.state.reorder.flush(iCtx, math.MaxInt64)
+	if iCtx.streamWriter.flushInterval > 0 {
+		// the rows released by the reorder buffer are held by the flush jitter, which releases them below
+		released = 0
+	}
+	released += iCtx.releaseJitteredRows(true)
+	n := ctx.addPartialWindows(task)
+	if n == 0 {
+		return released, nil
+	}
+	if task.deltas != nil {
+		ctx.applyDeltas(si, task)
+	}
+	return n + released, s.mapRowsToShard(si, task, ctx, iCtx)
+}
+
+// initStateCtx prepares the context writing the windows kept by the state of the task instead of the rows of a batch.
+func (s *Stream) initStateCtx(si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, ctx *streamCtx, iCtx *injestionCtx) error {
+	ctx.ms = iCtx.streamMSTs[0]
+	ctx.taskOpt = task.opt
+	ctx.opt = task.windowOpt
+	ctx.state = pw.getStreamTaskState(si.Name)
+	ctx.state.reconcile(task)
+	ctx.accumulators = &ctx.state.accumulators
+	ctx.deltas = &ctx.state.delta
+	iCtx.setStreamWriter(si, ctx.state, task.opt)
+
+	if err := ctx.checkDBRP(si.DesMst.Database, si.DesMst.RetentionPolicy, s); err != nil {
+		return err
+	}
+	return ctx.initVar(pw, si)
+}
+
+// addPartialWindows adds the current results of the open windows of the accumulators to the windows to emit,
+// which are keyed by their start times as they are written directly. It returns the number of the windows.
+func (s *streamCtx) addPartialWindows(task *streamTask) int {
+	s.accumulators.mu.Lock()
+	defer s.accumulators.mu.Unlock()
+	n := 0
+	for k, w := range s.accumulators.windows {
+		v := w.acc.Value()
+		if math.IsNaN(v) {
+			continue
+		}
+		windows, ok := s.dataCache[k.group]
+		if !ok {
+			windows = make(map[int64][]*float64)
+			s.dataCache[k.group] = windows
+		}
+		values, ok := windows[k.start]
+		if !ok {
+			values = make([]*float64, len(task.calls))
+			windows[k.start] = values
+			n++
+		}
+		values[k.call] = &v
+		s.keepAccResult(&v, w.acc, task.calls[k.call].OutFieldType == influx.Field_Type_String)
+	}
+	return n
+}
+
+// writePartialWindow writes the window to the destination directly, with the partial field of the task set if the
+// window is open.
+func (s *Stream) writePartialWindow(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx, r *influx.Row) error {
+	if ctx.partial && task.opt.Output.PartialField != "" {
+		markPartial(r, task.opt.Output.PartialField)
+	}
+	err, pErr := s.mapWriteRow(ctx, iCtx, si.DesMst.Name, r, task.shardDims)
+	if err != nil {
+		return err
+	}
+	if pErr != nil {
+		ctx.addPartialError(pErr)
+		return nil
+	}
+	ctx.addWindowEmitted()
+	iCtx.addStreamWritten(ctx.state.stats, r)
+	return nil
+}
+
+// markPartial adds the true boolean field of the key to the row, a call of the same alias is kept.
+func markPartial(r *influx.Row, key string) {
+	for i := range r.Fields {
+		if r.Fields[i].Key == key {
+			return
+		}
+	}
+	r.Fields = append(r.Fields, influx.Field{Key: key, NumValue: 1, Type: influx.Field_Type_Boolean})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamJitterRows is the max number of the rows held by the flush jitter of a task, the held rows are written
+// before their flush time once it is reached.
+const streamJitterRows = 4096
+
+// streamFlushJitter holds the rows emitted by a task until its next flush time.
+type streamFlushJitter struct {
+	mu sync.Mutex
+	// due is the next flush time of the task, 0 means no row is held yet
+	due  int64
+	held []streamSpilledRow
+}
+
+// holding returns whether any row is held.
+func (j *streamFlushJitter) holding() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return len(j.held) > 0
+}
+
+// streamFlushOffset returns the offset of the flush times of the task into its interval, which is the hash of
+// its name, so the tasks of the same interval are written at different times. 0 means the rows of the task are
+// written with every batch.
+func streamFlushOffset(info *meta2.StreamInfo, opt *StreamTaskOptions) time.Duration {
+	if !opt.Output.FlushJitter || info.Interval <= 0 {
+		return 0
+	}
+	return time.Duration(xxhash.Sum64String(info.Name) % uint64(info.Interval))
+}
+
+// jitterStreamFlush holds the rows emitted by the current task until its flush times, and writes the rows held
+// by the former batches if the flush time is reached. The backfill rows are never held.
+func (s *injestionCtx) jitterStreamFlush(si *meta2.StreamInfo, opt *StreamTaskOptions) {
+	if !opt.Output.FlushJitter || si.Interval <= 0 {
+		return
+	}
+	sw := &s.streamWriter
+	sw.flushInterval, sw.flushOffset = int64(si.Interval), int64(streamFlushOffset(si, opt))
+	s.releaseJitteredRows(false)
+}
+
+// nextFlush returns the first flush time of the current task after the time.
+func (sw *streamWriter) nextFlush(now int64) int64 {
+	return (now-sw.flushOffset)/sw.flushInterval*sw.flushInterval + sw.flushInterval + sw.flushOffset
+}
+
+// holdJitteredRow holds the row of the current task until its flush time, the rows held before are written first
+// if the flush time is reached or the held rows are full.
+func (s *injestionCtx) holdJitteredRow(sh *meta2.ShardInfo, r *influx.Row) {
+	s.releaseJitteredRows(false)
+	sw := &s.streamWriter
+	j := &sw.state.jitter
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.due == 0 {
+		j.due = sw.nextFlush(sw.now())
+	}
+	j.held = append(j.held, streamSpilledRow{sh: sh, row: copyStreamRow(r)})
+}
+
+// releaseJitteredRows maps the rows held by the current task to their shards once its flush time is reached or
+// the held rows are full, or whatever the time if all is true. It returns the number of the rows released.
+// The released rows are limited by the rates of the task as the other rows.
+func (s *injestionCtx) releaseJitteredRows(all bool) int {
+	sw := &s.streamWriter
+	j := &sw.state.jitter
+	j.mu.Lock()
+	now := sw.now()
+	if len(j.held) == 0 || (!all && now < j.due && len(j.held) < streamJitterRows) {
+		j.mu.Unlock()
+		return 0
+	}
+	rows := j.held
+	j.held = nil
+	if sw.flushInterval > 0 {
+		j.due = sw.nextFlush(now)
+	}
+	j.mu.Unlock()
+
+	for _, sr := range rows {
+		s.addStreamShardIds(sr.sh, sr.row)
+		s.admitStreamShardRow(sr.sh, sr.row)
+	}
+	return len(rows)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFlushJitter(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "jitter"
+	si.Interval = time.Minute
+	opt := &StreamTaskOptions{Output: StreamOutputOptions{FlushJitter: true}}
+	setStreamTestOptions(si, opt)
+	offset := streamFlushOffset(si, opt)
+	require.True(t, offset >= 0 && offset < si.Interval)
+	require.Equal(t, offset, streamFlushOffset(si, opt))
+	other := newStreamTestInfo(si.Calls...)
+	other.Name = "jitter2"
+	other.Interval = si.Interval
+	require.NotEqual(t, offset, streamFlushOffset(other, opt))
+	require.Equal(t, time.Duration(0), streamFlushOffset(si, &StreamTaskOptions{}))
+
+	// the clock starts right after a flush time of the task
+	now := time.Unix(0, 0).Add(time.Hour + offset + time.Second)
+	env.pw.streamClock = func() int64 { return now.UnixNano() }
+	state := env.pw.getStreamTaskState(si.Name)
+	batch := func(groups ...string) []*influx.Row {
+		var rows []*influx.Row
+		for _, g := range groups {
+			rows = append(rows, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: g}}, floatField("fk1", 1)))
+		}
+		return rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	}
+
+	// the rows are held until the next flush time
+	require.Empty(t, batch("a", "b"))
+	require.Len(t, state.jitter.held, 2)
+	require.Equal(t, now.Add(si.Interval-time.Second).UnixNano(), state.jitter.due)
+	now = now.Add(si.Interval - 2*time.Second)
+	require.Empty(t, batch("c"))
+	require.Len(t, state.jitter.held, 3)
+
+	// the held rows are written once the flush time is reached, the windows of them are not changed
+	now = now.Add(time.Second)
+	out := batch("d")
+	require.Len(t, out, 3)
+	plain := newStreamTestInfo(si.Calls...)
+	plain.Interval = si.Interval
+	ref := rowsOfMst(env.calculate(t, plain, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1))), "mst2")
+	require.Len(t, ref, 1)
+	for _, r := range out {
+		require.Equal(t, ref[0].Timestamp, r.Timestamp)
+	}
+	require.Len(t, state.jitter.held, 1)
+	require.Equal(t, now.Add(si.Interval).UnixNano(), state.jitter.due)
+
+	// the rows held are written with the open windows at the shutdown
+	require.True(t, state.jitter.holding())
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	ctx.setStreamWriter(si, state, opt)
+	require.Equal(t, 1, ctx.releaseJitteredRows(true))
+	require.False(t, state.jitter.holding())
+}
+
+func TestStreamFlushJitterTasks(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	opt := &StreamTaskOptions{Output: StreamOutputOptions{FlushJitter: true}}
+	setStreamTestOptions(si, opt)
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	tasks := ctx.stream.Tasks()
+	require.Len(t, tasks, 1)
+	require.Equal(t, streamFlushOffset(si, opt), tasks[0].FlushOffset)
+
+	sw := &streamWriter{flushInterval: int64(time.Minute), flushOffset: int64(10 * time.Second)}
+	require.Equal(t, int64(70*time.Second), sw.nextFlush(int64(10*time.Second)))
+	require.Equal(t, int64(70*time.Second), sw.nextFlush(int64(69*time.Second)))
+	require.Equal(t, int64(130*time.Second), sw.nextFlush(int64(70*time.Second)))
+}
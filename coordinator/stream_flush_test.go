@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// streamInfosMetaClient replaces the streams of the mock meta client.
+type streamInfosMetaClient struct {
+	*MockMetaClient
+	infos map[string]*meta2.StreamInfo
+}
+
+func (m *streamInfosMetaClient) GetStreamInfos() map[string]*meta2.StreamInfo {
+	return m.infos
+}
+
+func TestStreamFlush(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}})
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{PartialField: "partial"}})
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+
+	// nothing is written without the open windows
+	require.NoError(t, env.pw.FlushStreams(context.Background()))
+	require.Empty(t, written)
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	env.calculate(t, si,
+		newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(start+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 3)),
+		newStreamTestRow(start+2, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 5)),
+	)
+
+	// the batches in flight are waited for within the deadline
+	env.pw.streamFlushMu.RLock()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	require.ErrorIs(t, env.pw.FlushStreams(ctx), context.DeadlineExceeded)
+	cancel()
+	env.pw.streamFlushMu.RUnlock()
+	require.Empty(t, written)
+
+	require.NoError(t, env.pw.FlushStreams(context.Background()))
+	out := rowsOfMst(written, "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []float64{2, 5} {
+		require.False(t, out[i].StreamOnly)
+		require.Equal(t, start, out[i].Timestamp)
+		v, ok := fieldValue(out[i], "p50_fk1")
+		require.True(t, ok)
+		require.Equal(t, exp, v)
+		v, ok = fieldValue(out[i], "partial")
+		require.True(t, ok)
+		require.Equal(t, float64(1), v)
+	}
+	require.Equal(t, "a", tagValue(out[0], "tk1"))
+	require.Equal(t, "b", tagValue(out[1], "tk1"))
+
+	// the windows are kept open for the following rows
+	require.Len(t, env.pw.getStreamTaskState(si.Name).accumulators.windows, 2)
+}
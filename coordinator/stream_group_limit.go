@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+const deadLetterGroupLimit = "group_limit"
+
+const (
+	// streamGroupOverhead is the estimated bytes of the maps holding a group and its window
+	streamGroupOverhead = 96
+	// streamWindowValueBytes is the estimated bytes of the value of a call in a window
+	streamWindowValueBytes = 16
+)
+
+// limitsGroups returns whether the groups of a batch are limited.
+func (w *streamTask) limitsGroups() bool {
+	return w.opt.Limits.MaxGroups > 0 || w.opt.Limits.MaxGroupBytes > 0
+}
+
+// groupBytes returns the estimated bytes of the group of the key.
+func (w *streamTask) groupBytes(groupKey string) int64 {
+	return int64(len(groupKey) + streamGroupOverhead + streamWindowValueBytes*len(w.calls))
+}
+
+// admitGroup returns whether the group is held by the batch within the limits, the group is counted if it is new.
+func (s *streamCtx) admitGroup(task *streamTask, groupKey string) bool {
+	if _, ok := s.limitedGroups[groupKey]; ok {
+		return true
+	}
+	size := task.groupBytes(groupKey)
+	if len(s.limitedGroups) > 0 {
+		if task.opt.Limits.MaxGroups > 0 && len(s.limitedGroups) >= task.opt.Limits.MaxGroups {
+			return false
+		}
+		if task.opt.Limits.MaxGroupBytes > 0 && s.groupBytes+size > task.opt.Limits.MaxGroupBytes {
+			return false
+		}
+	}
+	if s.limitedGroups == nil {
+		s.limitedGroups = make(map[string]struct{})
+	}
+	s.limitedGroups[groupKey] = struct{}{}
+	s.groupBytes += size
+	return true
+}
+
+// rejectGroupRow drops the row of a new group exceeding the limits.
+func (s *Stream) rejectGroupRow(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, r *influx.Row) {
+	ctx.state.addGroupLimitRow()
+	s.logGroupLimit(si, task, ctx)
+	if task.opt.Errors.DeadLetterMst != "" {
+		ctx.deadLetters = append(ctx.deadLetters, streamDeadLetter{row: r, reason: deadLetterGroupLimit})
+	}
+}
+
+// flushGroups emits the groups held by the batch early to make room for the new groups.
+func (s *Stream) flushGroups(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx, workers streamWorkerRows) error {
+	ctx.state.addGroupLimitFlush()
+	s.logGroupLimit(si, task, ctx)
+	if workers != nil {
+		s.aggregateParallel(workers, si, task, ctx)
+		workers.reset()
+	}
+	ctx.finishWindows()
+	if err := s.emitWindows(si, task, ctx, iCtx); err != nil {
+		return err
+	}
+	ctx.dataCache = make(map[string]map[int64][]*float64)
+	ctx.closedCache = nil
+	ctx.filled = nil
+	ctx.accResults = ctx.accResults[:0]
+	ctx.limitedGroups = nil
+	ctx.groupBytes = 0
+	return nil
+}
+
+func (s *Stream) logGroupLimit(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) {
+	if ctx.groupLimitLogged {
+		return
+	}
+	ctx.groupLimitLogged = true
+	s.logger.Warn("stream task exceeds the group limits", zap.String("stream", si.Name),
+		zap.Int("groups", len(ctx.limitedGroups)), zap.Int64("bytes", ctx.groupBytes),
+		zap.Int("maxGroups", task.opt.Limits.MaxGroups), zap.Int64("maxGroupBytes", task.opt.Limits.MaxGroupBytes),
+		zap.Bool("flush", task.opt.Limits.FlushOnGroupLimit))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamGroupLimit(t *testing.T) {
+	env := newStreamTestEnv()
+	row := func(group string, v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+	rows := []*influx.Row{row("a", 1), row("b", 2), row("c", 4), row("a", 8), row("d", 16)}
+	sums := func(name string, opt *StreamTaskOptions) (map[string][]float64, *streamTaskState) {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.Name = name
+		setStreamTestOptions(si, opt)
+		m := map[string][]float64{}
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			v, _ := fieldValue(r, "sum_fk1")
+			m[tagValue(r, "tk1")] = append(m[tagValue(r, "tk1")], v)
+		}
+		for _, v := range m {
+			sort.Float64s(v)
+		}
+		return m, env.pw.getStreamTaskState(si.Name)
+	}
+
+	// the rows of the new groups are rejected
+	out, state := sums("reject_groups", &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroups: 2}})
+	require.Equal(t, map[string][]float64{"a": {9}, "b": {2}}, out)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.groupLimitRows))
+	require.Equal(t, int64(2), state.stats.GroupLimitRows)
+
+	// a group of a single char key and a sum is estimated as 113 bytes
+	out, state = sums("reject_bytes", &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroupBytes: 300}, Errors: StreamErrorOptions{DeadLetterMst: "dead"}})
+	require.Equal(t, map[string][]float64{"a": {9}, "b": {2}}, out)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.groupLimitRows))
+
+	// the held groups are emitted early, the partial windows are merged by the store
+	exp := map[string][]float64{"a": {1, 8}, "b": {2}, "c": {4}, "d": {16}}
+	out, state = sums("flush_groups", &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroups: 2, FlushOnGroupLimit: true}})
+	require.Equal(t, exp, out)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.groupLimitFlushes))
+	require.Equal(t, int64(0), atomic.LoadInt64(&state.groupLimitRows))
+
+	out, _ = sums("flush_groups_parallel", &StreamTaskOptions{Limits: StreamLimitOptions{Workers: 4, MaxGroups: 2, FlushOnGroupLimit: true}})
+	require.Equal(t, exp, out)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// buildGroupOnlyDims checks the group-only dims of the task and removes them from the shard dims. The windows of
+// the groups differing only by them are written to the same series, so they must be merged by the store: the task
+// must not write the windows directly, where the last one wins, and its calls must be merged across the groups.
+func (w *streamTask) buildGroupOnlyDims() error {
+	if len(w.opt.Group.GroupOnlyDims) == 0 {
+		return nil
+	}
+	if w.passthrough {
+		return fmt.Errorf("stream task %s without calls has no groups to omit dims from", w.info.Name)
+	}
+	for _, c := range w.calls {
+		if c.MergeFunc == nil {
+			return fmt.Errorf("the %s call %s of stream task %s can not be merged across the groups without the group-only dims", c.Call, c.Alias, w.info.Name)
+		}
+	}
+	if w.direct || streamKeepsState(w.info) || streamFills(w.info) {
+		return fmt.Errorf("the windows of stream task %s are written directly, which can not be merged without the group-only dims", w.info.Name)
+	}
+
+	w.groupOnlyDims = make(map[string]struct{}, len(w.opt.Group.GroupOnlyDims))
+	for _, d := range w.opt.Group.GroupOnlyDims {
+		if !w.isTagDim(d) {
+			return fmt.Errorf("the group-only dim %s is not a tag dim of stream task %s", d, w.info.Name)
+		}
+		w.groupOnlyDims[d] = struct{}{}
+	}
+	shardDims := make([]string, 0, len(w.shardDims))
+	for _, d := range w.shardDims {
+		if _, ok := w.groupOnlyDims[d]; !ok {
+			shardDims = append(shardDims, d)
+		}
+	}
+	w.shardDims = shardDims
+	return nil
+}
+
+func (w *streamTask) isTagDim(key string) bool {
+	for _, d := range w.tagDimKeys {
+		if d == key {
+			return true
+		}
+	}
+	return false
+}
+
+// dropGroupOnlyTags removes the group-only dims from the tags of the agg row.
+func (w *streamTask) dropGroupOnlyTags(r *influx.Row) {
+	n := 0
+	for i := range r.Tags {
+		if _, ok := w.groupOnlyDims[r.Tags[i].Key]; ok {
+			delete(r.ColumnToIndex, r.Tags[i].Key)
+			continue
+		}
+		r.Tags[n] = r.Tags[i]
+		r.ColumnToIndex[r.Tags[n].Key] = n
+		n++
+	}
+	r.Tags = r.Tags[:n]
+}
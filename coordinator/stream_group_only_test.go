@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamGroupOnlyDims(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{GroupOnlyDims: []string{"tk2"}}})
+
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "x"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "y"}}, floatField("fk1", 2)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "y"}}, floatField("fk1", 4)),
+	), "mst2")
+	// the groups are still aggregated apart, their windows share the series merged by the store
+	require.Len(t, out, 2)
+	var sum float64
+	for _, r := range out {
+		require.True(t, r.StreamOnly)
+		require.Len(t, r.Tags, 1)
+		require.Equal(t, "a", tagValue(r, "tk1"))
+		_, ok := r.ColumnToIndex["tk2"]
+		require.False(t, ok)
+		require.Equal(t, out[0].ShardKey, r.ShardKey)
+		v, _ := fieldValue(r, "sum_fk1")
+		sum += v
+	}
+	require.Equal(t, float64(7), sum)
+}
+
+func TestStreamGroupOnlyDimsInvalid(t *testing.T) {
+	for msg, si := range map[string]*meta2.StreamInfo{
+		"the group-only dim tk2 is not a tag dim of stream task t": newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"}),
+		"the percentile call p50_fk1 of stream task t can not be merged across the groups": newStreamTestInfo(
+			&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}}),
+		"the windows of stream task t are written directly": func() *meta2.StreamInfo {
+			si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+			si.Slide = si.Interval / 2
+			return si
+		}(),
+		"stream task t without calls has no groups": newStreamTestInfo(),
+	} {
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{GroupOnlyDims: []string{"tk2"}}})
+		require.ErrorContains(t, err, msg)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/config"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// checkGroupSeparator checks the group separator of the task is a single control byte but the escape of the values,
+// the printable bytes are common in the tag values and would be escaped in most of the keys.
+func checkGroupSeparator(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	sep := opt.Group.Separator
+	if sep == "" {
+		return nil
+	}
+	if len(sep) != 1 || (sep[0] >= ' ' && sep[0] != 0x7f) || sep[0] == streamGroupValueEscape {
+		return fmt.Errorf("the group separator %q of stream task %s must be a single control byte other than %q",
+			sep, info.Name, streamGroupValueEscape)
+	}
+	return nil
+}
+
+// streamGroupSeparator returns the byte separating the values in the group keys of the task.
+func streamGroupSeparator(opt *StreamTaskOptions) byte {
+	if opt.Group.Separator == "" {
+		return config.StreamGroupValueSeparator
+	}
+	return opt.Group.Separator[0]
+}
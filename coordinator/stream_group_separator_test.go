@@ -0,0 +1,62 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamGroupSeparator(t *testing.T) {
+	values := []string{"", "a", "a\x1fb", "a\x00b", "\x01\x1f"}
+	for _, v := range values {
+		row := newStreamTestRow(0, []influx.Tag{{Key: "tk1", Value: v}, {Key: "tk2", Value: "x"}})
+		key := appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, false, 0x1f)
+		require.Equal(t, []string{v, "x"}, splitGroupKey(string(key), 0x1f), "%q", v)
+	}
+	// the null byte is kept as it is in the keys separated by another byte
+	row := newStreamTestRow(0, []influx.Tag{{Key: "tk1", Value: "a\x00b"}, {Key: "tk2", Value: "c"}})
+	require.Equal(t, "a\x00b\x1fc", string(appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, false, 0x1f)))
+
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{Separator: "\x1f", UnionMsts: []string{"mst1"}, SourceTag: "source"}})
+	var rows []*influx.Row
+	for i, v := range values {
+		rows = append(rows, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: v}, {Key: "tk2", Value: "x\x1fy"}}, floatField("fk1", float64(i))))
+	}
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, len(values))
+	for _, r := range out {
+		v, _ := fieldValue(r, "sum_fk1")
+		require.Equal(t, values[int(v)], tagValue(r, "tk1"))
+		require.Equal(t, "x\x1fy", tagValue(r, "tk2"))
+		require.Equal(t, "mst0", tagValue(r, "source"))
+	}
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	for _, sep := range []string{"\x01", "|", ",", "\x1f\x1f", "\x80"} {
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{Separator: sep}})
+		require.Error(t, err, "%q", sep)
+	}
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{Separator: "|"}})
+	require.EqualError(t, err, `the group separator "|" of stream task t must be a single control byte other than '\x01'`)
+}
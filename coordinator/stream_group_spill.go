@@ -0,0 +1,277 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// defaultStreamMaxSpillBytes is the max bytes of the groups spilled by a batch of the tasks without MaxSpillBytes.
+const defaultStreamMaxSpillBytes = 64 * 1024 * 1024
+
+// StreamSpillCodec serializes the windows of the groups spilled to disk. The values of a window are indexed by the
+// calls of the task, nil if the call has no value in the window.
+type StreamSpillCodec interface {
+	// Encode appends the windows of a group to dst.
+	Encode(dst []byte, windows map[int64][]*float64) []byte
+	// Decode returns the windows encoded by Encode, whose values are of the calls.
+	Decode(src []byte, calls int) (map[int64][]*float64, error)
+}
+
+var streamSpillCodecs = struct {
+	mu     sync.RWMutex
+	codecs map[string]StreamSpillCodec
+}{codecs: map[string]StreamSpillCodec{}}
+
+// RegisterStreamSpillCodec registers the codec named by the SpillCodec option of the stream tasks.
+func RegisterStreamSpillCodec(name string, codec StreamSpillCodec) {
+	streamSpillCodecs.mu.Lock()
+	defer streamSpillCodecs.mu.Unlock()
+	streamSpillCodecs.codecs[name] = codec
+}
+
+func getStreamSpillCodec(name string) (StreamSpillCodec, bool) {
+	if name == "" {
+		return binarySpillCodec{}, true
+	}
+	streamSpillCodecs.mu.RLock()
+	defer streamSpillCodecs.mu.RUnlock()
+	codec, ok := streamSpillCodecs.codecs[name]
+	return codec, ok
+}
+
+// binarySpillCodec encodes the windows as their count followed by the time of each window and the values of its
+// calls, each value is a byte telling whether it is present followed by the bits of the float if it is.
+type binarySpillCodec struct{}
+
+var errCorruptSpill = errors.New("corrupt spilled stream windows")
+
+func (binarySpillCodec) Encode(dst []byte, windows map[int64][]*float64) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(windows)))
+	for t, v := range windows {
+		dst = binary.AppendVarint(dst, t)
+		for i := range v {
+			if v[i] == nil {
+				dst = append(dst, 0)
+				continue
+			}
+			dst = append(dst, 1)
+			dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(*v[i]))
+		}
+	}
+	return dst
+}
+
+func (binarySpillCodec) Decode(src []byte, calls int) (map[int64][]*float64, error) {
+	n, size := binary.Uvarint(src)
+	if size <= 0 {
+		return nil, errCorruptSpill
+	}
+	src = src[size:]
+	windows := make(map[int64][]*float64, n)
+	for ; n > 0; n-- {
+		t, size := binary.Varint(src)
+		if size <= 0 {
+			return nil, errCorruptSpill
+		}
+		src = src[size:]
+		v := make([]*float64, calls)
+		for i := range v {
+			if len(src) == 0 {
+				return nil, errCorruptSpill
+			}
+			present := src[0]
+			src = src[1:]
+			if present == 0 {
+				continue
+			}
+			if len(src) < 8 {
+				return nil, errCorruptSpill
+			}
+			f := math.Float64frombits(binary.LittleEndian.Uint64(src))
+			v[i] = &f
+			src = src[8:]
+		}
+		windows[t] = v
+	}
+	return windows, nil
+}
+
+// spillsGroups returns whether the groups of a batch exceeding the group limits are spilled to disk.
+func (w *streamTask) spillsGroups() bool {
+	return w.opt.Limits.SpillDir != ""
+}
+
+func (w *streamTask) spillCodec() StreamSpillCodec {
+	codec, _ := getStreamSpillCodec(w.opt.Limits.SpillCodec)
+	return codec
+}
+
+func (w *streamTask) maxSpillBytes() int64 {
+	if w.opt.Limits.MaxSpillBytes > 0 {
+		return w.opt.Limits.MaxSpillBytes
+	}
+	return defaultStreamMaxSpillBytes
+}
+
+// checkGroupSpill rejects the spill of the groups whose windows are not all held in the data cache of the batch,
+// the spilled windows are merged with the ones of the same groups aggregated after them by the calls.
+func (w *streamTask) checkGroupSpill() error {
+	if !w.spillsGroups() {
+		return nil
+	}
+	name := w.info.Name
+	switch {
+	case w.opt.Limits.MaxSpillBytes < 0:
+		return fmt.Errorf("the max spill bytes %d of stream task %s is negative", w.opt.Limits.MaxSpillBytes, name)
+	case w.spillCodec() == nil:
+		return fmt.Errorf("the spill codec %s of stream task %s is not registered", w.opt.Limits.SpillCodec, name)
+	case !w.limitsGroups():
+		return fmt.Errorf("stream task %s spills the groups without the group limits", name)
+	case w.opt.Limits.FlushOnGroupLimit:
+		return fmt.Errorf("stream task %s can not both spill and flush the groups exceeding the group limits", name)
+	case w.accCalls != nil:
+		return fmt.Errorf("the accumulator calls of stream task %s hold the windows across the batches, which can not be spilled", name)
+	case w.parallel():
+		return fmt.Errorf("the groups of stream task %s aggregated by the workers can not be spilled", name)
+	case w.opt.Limits.MaxGroupWindows > 0 || w.opt.Limits.FlushGroupPoints > 0:
+		return fmt.Errorf("the groups of stream task %s emitted early can not be spilled", name)
+	}
+	return nil
+}
+
+// streamSpillSpan is the range of the spill file holding the windows of a group spilled once.
+type streamSpillSpan struct {
+	off  int64
+	size int
+}
+
+// streamGroupSpill holds the groups of a batch spilled to a file, which is removed once they are read back.
+type streamGroupSpill struct {
+	file  *os.File
+	size  int64
+	spans map[string][]streamSpillSpan
+	buf   []byte
+
+	// touched is the sequence of the latest row of the groups held in memory
+	touched map[string]uint64
+	seq     uint64
+}
+
+// touchGroup marks the group as the most recently updated one.
+func (s *streamCtx) touchGroup(groupKey string) {
+	if s.spill == nil {
+		s.spill = &streamGroupSpill{touched: make(map[string]uint64)}
+	}
+	s.spill.seq++
+	s.spill.touched[groupKey] = s.spill.seq
+}
+
+// spillGroups writes the windows of the least recently updated quarter of the groups held by the batch to the spill
+// file and removes them from the data cache. It returns whether any group is spilled, none is once the spilled
+// bytes of the batch reach the max spill bytes of the task.
+func (s *Stream) spillGroups(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) (bool, error) {
+	sp := ctx.spill
+	groups := make([]string, 0, len(ctx.limitedGroups))
+	for k := range ctx.limitedGroups {
+		groups = append(groups, k)
+	}
+	sort.Slice(groups, func(i, j int) bool { return sp.touched[groups[i]] < sp.touched[groups[j]] })
+	groups = groups[:len(groups)/4+1]
+
+	codec := task.spillCodec()
+	spilled := false
+	for _, k := range groups {
+		sp.buf = codec.Encode(sp.buf[:0], ctx.dataCache[k])
+		if sp.size+int64(len(sp.buf)) > task.maxSpillBytes() {
+			break
+		}
+		if sp.file == nil {
+			f, err := os.CreateTemp(task.opt.Limits.SpillDir, "stream-groups-*.spill")
+			if err != nil {
+				return false, fmt.Errorf("stream task %s failed to spill the groups: %v", si.Name, err)
+			}
+			sp.file = f
+		}
+		if _, err := sp.file.Write(sp.buf); err != nil {
+			return false, fmt.Errorf("stream task %s failed to spill the groups: %v", si.Name, err)
+		}
+		if sp.spans == nil {
+			sp.spans = make(map[string][]streamSpillSpan)
+		}
+		sp.spans[k] = append(sp.spans[k], streamSpillSpan{off: sp.size, size: len(sp.buf)})
+		sp.size += int64(len(sp.buf))
+		ctx.state.addGroupSpill(int64(len(sp.buf)))
+
+		delete(ctx.dataCache, k)
+		delete(ctx.limitedGroups, k)
+		delete(sp.touched, k)
+		ctx.groupBytes -= task.groupBytes(k)
+		spilled = true
+	}
+	return spilled, nil
+}
+
+// reloadSpilledGroups reads the spilled groups back and merges them into the data cache, the spill file is removed.
+func (s *streamCtx) reloadSpilledGroups(task *streamTask) error {
+	sp := s.spill
+	if sp == nil || sp.file == nil {
+		return nil
+	}
+	defer sp.close()
+	codec := task.spillCodec()
+	group := make(map[string]map[int64][]*float64, 1)
+	for k, spans := range sp.spans {
+		for _, span := range spans {
+			if cap(sp.buf) < span.size {
+				sp.buf = make([]byte, span.size)
+			}
+			sp.buf = sp.buf[:span.size]
+			if _, err := sp.file.ReadAt(sp.buf, span.off); err != nil {
+				return fmt.Errorf("stream task %s failed to read the spilled groups: %v", task.info.Name, err)
+			}
+			windows, err := codec.Decode(sp.buf, len(task.calls))
+			if err != nil {
+				return fmt.Errorf("stream task %s failed to read the spilled groups: %v", task.info.Name, err)
+			}
+			group[k] = windows
+			s.mergeWindows(task, s.dataCache, group)
+			delete(group, k)
+		}
+	}
+	return nil
+}
+
+// close removes the spill file, the spilled groups are dropped.
+func (sp *streamGroupSpill) close() {
+	if sp.file != nil {
+		name := sp.file.Name()
+		_ = sp.file.Close()
+		_ = os.Remove(name)
+		sp.file = nil
+	}
+	sp.size = 0
+	sp.spans = nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// countingSpillCodec counts the groups encoded by the binary codec.
+type countingSpillCodec struct {
+	binarySpillCodec
+	encoded int
+}
+
+func (c *countingSpillCodec) Encode(dst []byte, windows map[int64][]*float64) []byte {
+	c.encoded++
+	return c.binarySpillCodec.Encode(dst, windows)
+}
+
+func TestStreamGroupSpill(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"})
+	dir := t.TempDir()
+	codec := &countingSpillCodec{}
+	RegisterStreamSpillCodec("counting", codec)
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroups: 2, SpillDir: dir, SpillCodec: "counting"}})
+	row := func(tk1 string, ts int64, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: tk1}}, floatField("fk1", v))
+	}
+	sec := int64(time.Second)
+
+	// the groups spilled are merged with the rows of them aggregated after the spill
+	out := rowsOfMst(env.calculate(t, si, row("a", env.base, 1), row("b", env.base, 2), row("c", env.base, 3),
+		row("a", env.base+sec, 4), row("d", env.base, 5), row("a", env.base+1, 6), row("b", env.base+2, 7)), "mst2")
+	sums := map[string]map[int64]float64{}
+	for _, r := range out {
+		if sums[tagValue(r, "tk1")] == nil {
+			sums[tagValue(r, "tk1")] = map[int64]float64{}
+		}
+		sums[tagValue(r, "tk1")][r.Timestamp], _ = fieldValue(r, "sum_fk1")
+	}
+	end := env.base + sec - 1
+	require.Equal(t, map[string]map[int64]float64{
+		"a": {end: 7, end + sec: 4},
+		"b": {end: 9},
+		"c": {end: 3},
+		"d": {end: 5},
+	}, sums)
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Positive(t, codec.encoded)
+	require.Equal(t, int64(codec.encoded), atomic.LoadInt64(&state.groupSpills))
+	require.Positive(t, atomic.LoadInt64(&state.groupSpillBytes))
+	require.Zero(t, atomic.LoadInt64(&state.groupLimitRows))
+	// the spill file is removed once the groups are read back
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// the rows of the new groups are rejected once the spilled bytes reach the limit
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroups: 2, SpillDir: dir, MaxSpillBytes: 1}})
+	out = rowsOfMst(env.calculate(t, si, row("a", env.base, 1), row("b", env.base, 2), row("c", env.base, 3)), "mst2")
+	require.Len(t, out, 2)
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.groupLimitRows))
+}
+
+func TestStreamSpillCodec(t *testing.T) {
+	one, two := 1.5, -2.0
+	windows := map[int64][]*float64{-1: {&one, nil}, 1 << 40: {nil, &two}}
+	codec := binarySpillCodec{}
+	buf := codec.Encode(nil, windows)
+	decoded, err := codec.Decode(buf, 2)
+	require.NoError(t, err)
+	require.Equal(t, windows, decoded)
+	_, err = codec.Decode(buf[:len(buf)-1], 2)
+	require.EqualError(t, err, "corrupt spilled stream windows")
+}
+
+func TestStreamGroupSpillInvalid(t *testing.T) {
+	dir := t.TempDir()
+	for msg, opt := range map[string]*StreamTaskOptions{
+		"stream task t spills the groups without the group limits":                         {Limits: StreamLimitOptions{SpillDir: dir}},
+		"the max spill bytes -1 of stream task t is negative":                              {Limits: StreamLimitOptions{MaxGroups: 1, SpillDir: dir, MaxSpillBytes: -1}},
+		"stream task t can not both spill and flush the groups exceeding the group limits": {Limits: StreamLimitOptions{MaxGroups: 1, FlushOnGroupLimit: true, SpillDir: dir}},
+		"the groups of stream task t aggregated by the workers can not be spilled":         {Limits: StreamLimitOptions{Workers: 2, MaxGroups: 1, SpillDir: dir}},
+		"the groups of stream task t emitted early can not be spilled":                     {Limits: StreamLimitOptions{MaxGroupWindows: 1, MaxGroups: 1, SpillDir: dir}},
+		"the spill codec none of stream task t is not registered":                          {Limits: StreamLimitOptions{SpillDir: dir, MaxGroups: 1, SpillCodec: "none"}},
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		require.EqualError(t, err, msg)
+	}
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroups: 1, SpillDir: dir}})
+	require.EqualError(t, err, "the accumulator calls of stream task t hold the windows across the batches, which can not be spilled")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"strconv"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// histogramCall counts the values of the window at or below each of the upper bounds given as the args,
+// like the cumulative buckets of the Prometheus histograms.
+const histogramCall = "histogram"
+
+// histogramBucketAlias returns the alias of the bucket of the histogram call, like "latency_le_0.5".
+func histogramBucketAlias(alias, bound string) string {
+	return alias + "_le_" + bound
+}
+
+// expandHistograms returns the stream with every histogram call replaced by a call per bucket, each of which
+// writes a field of its own. The bounds must be sorted in the ascending order.
+func expandHistograms(info *meta2.StreamInfo) (*meta2.StreamInfo, error) {
+	var calls []*meta2.StreamCall
+	for i, c := range info.Calls {
+		if c.Call != histogramCall {
+			if calls != nil {
+				calls = append(calls, c)
+			}
+			continue
+		}
+		if calls == nil {
+			calls = append(make([]*meta2.StreamCall, 0, len(info.Calls)), info.Calls[:i]...)
+		}
+		if len(c.Args) == 0 {
+			return nil, fmt.Errorf("the histogram call %s of stream task %s has no buckets", c.Alias, info.Name)
+		}
+		var prev float64
+		for j, arg := range c.Args {
+			bound, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return nil, fmt.Errorf("the bucket %s of the histogram call %s of stream task %s is not a number", arg, c.Alias, info.Name)
+			}
+			if j > 0 && bound <= prev {
+				return nil, fmt.Errorf("the buckets of the histogram call %s of stream task %s are not sorted", c.Alias, info.Name)
+			}
+			prev = bound
+			calls = append(calls, &meta2.StreamCall{Call: histogramCall, Field: c.Field, Alias: histogramBucketAlias(c.Alias, arg), Args: []string{arg}, Condition: c.Condition})
+		}
+	}
+	if calls == nil {
+		return info, nil
+	}
+	ei := *info
+	ei.Calls = calls
+	return &ei, nil
+}
+
+// newHistogramBucket returns the accumulator of the bucket call expanded from a histogram call.
+func newHistogramBucket(c *meta2.StreamCall) newAccumulatorFunc {
+	bound, _ := strconv.ParseFloat(c.Args[0], 64)
+	return func(int64, int64) streamLib.Accumulator {
+		return &histogramBucket{bound: bound}
+	}
+}
+
+// histogramBucket counts the values at or below the bound.
+type histogramBucket struct {
+	bound float64
+	count int64
+}
+
+func (b *histogramBucket) Add(value float64, _ int64) {
+	if value <= b.bound {
+		b.count++
+	}
+}
+
+func (b *histogramBucket) Value() float64 {
+	return float64(b.count)
+}
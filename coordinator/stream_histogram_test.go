@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamHistogram(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: histogramCall, Field: "fk1", Alias: "fk1", Args: []string{"0.1", "0.5", "1"}},
+	)
+	require.True(t, streamKeepsState(si))
+
+	tags := []influx.Tag{{Key: "tk1", Value: "a"}}
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, tags, floatField("fk1", 0.05)),
+		newStreamTestRow(env.base+1, tags, floatField("fk1", 0.1)),
+		newStreamTestRow(env.base+2, tags, floatField("fk1", 0.3)),
+		newStreamTestRow(env.base+3, tags, floatField("fk1", 2)),
+	), "mst2")
+	require.Len(t, out, 1)
+	for key, exp := range map[string]float64{"sum_fk1": 2.45, "fk1_le_0.1": 2, "fk1_le_0.5": 3, "fk1_le_1": 3} {
+		v, ok := fieldValue(out[0], key)
+		require.True(t, ok, key)
+		require.InDelta(t, exp, v, 1e-9, key)
+	}
+	for i := range out[0].Fields {
+		if out[0].Fields[i].Key != "sum_fk1" {
+			require.Equal(t, int32(influx.Field_Type_Int), out[0].Fields[i].Type)
+		}
+	}
+	// the info of the task is not changed
+	require.Len(t, si.Calls, 2)
+
+	for args, msg := range map[string][]string{
+		"has no buckets":  nil,
+		"is not a number": {"0.1", "x"},
+		"are not sorted":  {"0.5", "0.1"},
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: histogramCall, Field: "fk1", Alias: "fk1", Args: msg})
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+		require.ErrorContains(t, err, "the histogram call fk1 of stream task t "+args)
+	}
+	si = newStreamTestInfo(&meta2.StreamCall{Call: histogramCall, Field: "fk1", Alias: "fk1", Args: []string{"1", "1"}})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.ErrorContains(t, err, "are not sorted")
+}
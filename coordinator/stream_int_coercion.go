@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+)
+
+// StreamIntCoercion is how the float results of a call are written to an integer field of the destination.
+type StreamIntCoercion uint8
+
+const (
+	// StreamCoerceNone rejects the task writing the float results to an integer field, the results of the calls
+	// following the type of the integer field are left to the store, which truncates them
+	StreamCoerceNone StreamIntCoercion = iota
+	// StreamCoerceTruncate drops the fraction, the values are rounded toward zero
+	StreamCoerceTruncate
+	// StreamCoerceRound rounds the values to the nearest integers, the halves are rounded away from zero
+	StreamCoerceRound
+	// StreamCoerceFloor rounds the values toward negative infinity
+	StreamCoerceFloor
+)
+
+// addIntCoercion records how the float results of the call are written to the integer field of the destination,
+// and returns whether the call coerces them. The results merged by the store are the partial ones of the windows,
+// which can not be coerced before they are merged.
+func (w *streamTask) addIntCoercion(i int, c *streamLib.FieldCall) (bool, error) {
+	call := w.info.Calls[i]
+	opt, ok := w.opt.CallOptions[call.Alias]
+	if !ok || opt.IntCoercion == StreamCoerceNone {
+		return false, nil
+	}
+	if !w.direct && c.MergeFunc != nil {
+		return false, fmt.Errorf("the results of the %s call %s of stream task %s are merged by the store, which can not be coerced to integers",
+			call.Call, call.Alias, w.info.Name)
+	}
+	if w.coercions == nil {
+		w.coercions = make([]StreamIntCoercion, len(w.calls))
+	}
+	w.coercions[i] = opt.IntCoercion
+	return true, nil
+}
+
+// checkIntCoercions rejects the unknown coercions of the calls of the task.
+func (w *streamTask) checkIntCoercions() error {
+	for _, c := range w.info.Calls {
+		if opt, ok := w.opt.CallOptions[c.Alias]; ok && opt.IntCoercion > StreamCoerceFloor {
+			return fmt.Errorf("the integer coercion %d of the %s call %s of stream task %s is unknown", opt.IntCoercion, c.Call, c.Alias, w.info.Name)
+		}
+	}
+	return nil
+}
+
+// coerceToInt returns the integer of the float value by the coercion, the values out of the range of the integers
+// are clamped to it.
+func coerceToInt(v float64, coercion StreamIntCoercion) float64 {
+	switch coercion {
+	case StreamCoerceTruncate:
+		v = math.Trunc(v)
+	case StreamCoerceRound:
+		v = math.Round(v)
+	case StreamCoerceFloor:
+		v = math.Floor(v)
+	default:
+		return v
+	}
+	if v >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	if v <= math.MinInt64 {
+		return math.MinInt64
+	}
+	return v
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamIntCoercion(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_trunc", Args: []string{"50"}},
+		&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_round", Args: []string{"50"}},
+		&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_floor"},
+	)
+	srcSchema, dstSchema := streamTestSchema(si)
+	for _, c := range si.Calls {
+		dstSchema[c.Alias] = influx.Field_Type_Int
+	}
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the fields of stream task t conflict with the destination mst2: mean_floor is float but integer in the destination")
+
+	opt := &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{
+		"p50_trunc":  {IntCoercion: StreamCoerceTruncate},
+		"p50_round":  {IntCoercion: StreamCoerceRound},
+		"mean_floor": {IntCoercion: StreamCoerceFloor},
+	}}
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	task, err := newStreamTask(si, srcSchema, dstSchema, opt)
+	require.NoError(t, err)
+	ctx.stream.tasks[si.Name] = task
+
+	_, err = ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", -2.5)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 2.5)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "c"}}, floatField("fk1", -2.7)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "d"}}, floatField("fk1", -0.4)),
+	}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	out := rowsOfMst(ctx.shardRowMap[0].rows, "mst2")
+	require.Len(t, out, 4)
+	exp := map[string]map[string]float64{
+		"a": {"p50_trunc": -2, "p50_round": -3, "mean_floor": -3},
+		"b": {"p50_trunc": 2, "p50_round": 3, "mean_floor": 2},
+		"c": {"p50_trunc": -2, "p50_round": -3, "mean_floor": -3},
+		"d": {"p50_trunc": 0, "p50_round": 0, "mean_floor": -1},
+	}
+	for _, r := range out {
+		for _, f := range r.Fields {
+			require.Equal(t, influx.Field_Type_Int, int(f.Type), f.Key)
+			require.Equal(t, exp[tagValue(r, "tk1")][f.Key], f.NumValue, "%s %s", tagValue(r, "tk1"), f.Key)
+		}
+	}
+
+	opt.CallOptions["mean_floor"].IntCoercion = StreamCoerceFloor + 1
+	_, err = newStreamTask(si, srcSchema, dstSchema, opt)
+	require.EqualError(t, err, "the integer coercion 4 of the mean call mean_floor of stream task t is unknown")
+
+	// the partial results merged by the store are not coerced
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema = streamTestSchema(si)
+	dstSchema["sum_fk1"] = influx.Field_Type_Int
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{
+		"sum_fk1": {IntCoercion: StreamCoerceRound},
+	}})
+	require.EqualError(t, err, "the results of the sum call sum_fk1 of stream task t are merged by the store, which can not be coerced to integers")
+}
+
+func TestCoerceToInt(t *testing.T) {
+	for _, c := range []StreamIntCoercion{StreamCoerceTruncate, StreamCoerceRound, StreamCoerceFloor} {
+		require.Equal(t, float64(math.MaxInt64), coerceToInt(1e300, c))
+		require.Equal(t, float64(math.MinInt64), coerceToInt(-1e300, c))
+	}
+	require.Equal(t, 1.5, coerceToInt(1.5, StreamCoerceNone))
+	require.Equal(t, -1.0, coerceToInt(-1.5, StreamCoerceTruncate))
+	require.Equal(t, -2.0, coerceToInt(-1.5, StreamCoerceRound))
+	require.Equal(t, -2.0, coerceToInt(-1.1, StreamCoerceFloor))
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamIntOverflow is how the integer sum of a call handles the values overflowing the integers.
+type StreamIntOverflow uint8
+
+const (
+	// StreamIntOverflowError fails the batch, the value overflowing the sum is not added
+	StreamIntOverflowError StreamIntOverflow = iota
+	// StreamIntOverflowWrap wraps the sum around as the int64 arithmetic does
+	StreamIntOverflowWrap
+	// StreamIntOverflowSaturate clamps the sum to the max or the min integer, the following values are added to it
+	StreamIntOverflowSaturate
+)
+
+// streamSumsInts returns whether a call of the task aggregates the integers as integers. The store merges the
+// results as floats, so the rows of such a task are aggregated at the sql layer and the results written directly.
+func streamSumsInts(opt *StreamTaskOptions) bool {
+	if opt == nil {
+		return false
+	}
+	for _, c := range opt.CallOptions {
+		if c != nil && (c.IntSum || c.IntMinMax) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildIntSumCall returns the accumulator constructor of the sum call of the integer field, the sum is written to
+// an integer field unless the destination keeps it as a float.
+func buildIntSumCall(info *meta2.StreamInfo, c *meta2.StreamCall, call *streamLib.FieldCall, opt *StreamCallOptions) (newAccumulatorFunc, error) {
+	if c.Call != "sum" {
+		return nil, fmt.Errorf("the %s call %s of stream task %s can not sum the integers, only the sum calls can", c.Call, c.Alias, info.Name)
+	}
+	if call.InFieldType != influx.Field_Type_Int {
+		return nil, fmt.Errorf("the field %s of the sum call %s of stream task %s is not an integer", c.Field, c.Alias, info.Name)
+	}
+	if opt.IntOverflow > StreamIntOverflowSaturate {
+		return nil, fmt.Errorf("the integer overflow %d of the sum call %s of stream task %s is unknown", opt.IntOverflow, c.Alias, info.Name)
+	}
+	if call.OutFieldType == influx.Field_Type_Unknown {
+		call.OutFieldType = influx.Field_Type_Int
+	}
+	overflow := opt.IntOverflow
+	return func(int64, int64) streamLib.Accumulator {
+		return &intSumAccumulator{overflow: overflow}
+	}, nil
+}
+
+// intSumAccumulator sums the integer values of the window as int64.
+type intSumAccumulator struct {
+	sum      int64
+	n        int64
+	overflow StreamIntOverflow
+	// overflows is the number of the values overflowing the sum which are not counted by the task yet
+	overflows int64
+}
+
+func (a *intSumAccumulator) Add(value float64, timestamp int64) {
+	a.AddInt(floatToInt(value), timestamp)
+}
+
+func (a *intSumAccumulator) AddInt(v int64, _ int64) {
+	sum := a.sum + v
+	a.n++
+	if (v > 0 && sum < a.sum) || (v < 0 && sum > a.sum) {
+		a.overflows++
+		switch a.overflow {
+		case StreamIntOverflowError:
+			return
+		case StreamIntOverflowSaturate:
+			sum = math.MaxInt64
+			if v < 0 {
+				sum = math.MinInt64
+			}
+		}
+	}
+	a.sum = sum
+}
+
+// Value returns NaN if the window has no value.
+func (a *intSumAccumulator) Value() float64 {
+	if a.n == 0 {
+		return math.NaN()
+	}
+	return float64(a.sum)
+}
+
+func (a *intSumAccumulator) IntValue() int64 {
+	return a.sum
+}
+
+// buildIntMinMaxCall returns the accumulator constructor of the min or the max call of the integer field.
+func buildIntMinMaxCall(info *meta2.StreamInfo, c *meta2.StreamCall, call *streamLib.FieldCall) (newAccumulatorFunc, error) {
+	if c.Call != "min" && c.Call != "max" {
+		return nil, fmt.Errorf("the %s call %s of stream task %s can not select the integers, only the min and the max calls can", c.Call, c.Alias, info.Name)
+	}
+	if call.InFieldType != influx.Field_Type_Int {
+		return nil, fmt.Errorf("the field %s of the %s call %s of stream task %s is not an integer", c.Field, c.Call, c.Alias, info.Name)
+	}
+	if call.OutFieldType == influx.Field_Type_Unknown {
+		call.OutFieldType = influx.Field_Type_Int
+	}
+	max := c.Call == "max"
+	return func(int64, int64) streamLib.Accumulator {
+		return &intMinMaxAccumulator{max: max}
+	}, nil
+}
+
+// intMinMaxAccumulator selects the min or the max integer value of the window as int64.
+type intMinMaxAccumulator struct {
+	value int64
+	n     int64
+	max   bool
+}
+
+func (a *intMinMaxAccumulator) Add(value float64, timestamp int64) {
+	a.AddInt(floatToInt(value), timestamp)
+}
+
+func (a *intMinMaxAccumulator) AddInt(v int64, _ int64) {
+	if a.n == 0 || (a.max && v > a.value) || (!a.max && v < a.value) {
+		a.value = v
+	}
+	a.n++
+}
+
+// Value returns NaN if the window has no value.
+func (a *intMinMaxAccumulator) Value() float64 {
+	if a.n == 0 {
+		return math.NaN()
+	}
+	return float64(a.value)
+}
+
+func (a *intMinMaxAccumulator) IntValue() int64 {
+	return a.value
+}
+
+// floatToInt returns the integer of the value of an integer field, which is clamped to the range of the integers
+// as the max integer is rounded up by the float.
+func floatToInt(v float64) int64 {
+	if v >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	if v <= math.MinInt64 {
+		return math.MinInt64
+	}
+	return int64(v)
+}
+
+// countIntOverflows counts the values overflowing the integer sum of the call, the first call failing on them
+// fails the batch.
+func (s *streamCtx) countIntOverflows(task *streamTask, call int, a *intSumAccumulator) {
+	s.state.addIntOverflows(a.overflows)
+	a.overflows = 0
+	if a.overflow == StreamIntOverflowError && s.intOverflowErr == nil {
+		c := task.info.Calls[call]
+		s.intOverflowErr = fmt.Errorf("the sum call %s of stream task %s overflows the integers", c.Alias, task.info.Name)
+	}
+}
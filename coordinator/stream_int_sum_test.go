@@ -0,0 +1,161 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamIntSum(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	row := func(ts int64, tk1 string, v int64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: tk1}},
+			influx.Field{Key: "fk1", NumValue: float64(v), Type: influx.Field_Type_Int})
+	}
+	calculate := func(env *streamTestEnv, overflow StreamIntOverflow, rows ...*influx.Row) (map[string]float64, error) {
+		// the task of the float field is replaced by the one of the integer field
+		setStreamTestOptions(si, nil)
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		opt := &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk1": {IntSum: true, IntOverflow: overflow}}}
+		setStreamTestOptions(si, opt)
+		src, dst := streamTestSchema(si)
+		// the sums are written to a new integer field
+		src["fk1"] = influx.Field_Type_Int
+		delete(dst, "sum_fk1")
+		task, err := newStreamTask(si, src, dst, opt)
+		require.NoError(t, err)
+		ctx.stream.tasks[si.Name] = task
+		if _, err = ctx.stream.calculate(rows, si, env.pw, ctx, 0); err != nil {
+			return nil, err
+		}
+		sums := map[string]float64{}
+		for i := range ctx.shardRowMap {
+			for _, r := range rowsOfMst(ctx.shardRowMap[i].rows, "mst2") {
+				require.Equal(t, int32(influx.Field_Type_Int), r.Fields[0].Type)
+				sums[tagValue(r, "tk1")], _ = fieldValue(r, "sum_fk1")
+			}
+		}
+		return sums, nil
+	}
+
+	for overflow, exp := range map[StreamIntOverflow]float64{
+		StreamIntOverflowWrap:     math.MinInt64,
+		StreamIntOverflowSaturate: math.MaxInt64,
+	} {
+		env := newStreamTestEnv()
+		sums, err := calculate(env, overflow, row(env.base, "a", 1), row(env.base+1, "a", 2),
+			row(env.base, "b", 1<<62), row(env.base+1, "b", 1<<62))
+		require.NoError(t, err)
+		require.Equal(t, map[string]float64{"a": 3, "b": exp}, sums)
+		require.Equal(t, int64(1), atomic.LoadInt64(&env.pw.getStreamTaskState(si.Name).intOverflows))
+	}
+
+	// the batch fails on the overflow by default
+	env := newStreamTestEnv()
+	_, err := calculate(env, StreamIntOverflowError, row(env.base, "b", 1<<62), row(env.base+1, "b", 1<<62))
+	require.EqualError(t, err, "the sum call sum_fk1 of stream task t overflows the integers")
+	require.Equal(t, int64(1), atomic.LoadInt64(&env.pw.getStreamTaskState(si.Name).intOverflows))
+}
+
+func TestStreamIntSumAccumulator(t *testing.T) {
+	a := &intSumAccumulator{overflow: StreamIntOverflowSaturate}
+	require.True(t, math.IsNaN(a.Value()))
+	a.Add(math.MinInt64, 0)
+	a.Add(-1, 0)
+	require.Equal(t, float64(math.MinInt64), a.Value())
+	// the saturated sum goes on with the following values
+	a.Add(1, 0)
+	require.Equal(t, int64(math.MinInt64+1), a.sum)
+	require.Equal(t, int64(1), a.overflows)
+	require.Equal(t, int64(math.MaxInt64), floatToInt(math.MaxInt64))
+}
+
+func TestStreamIntSumInvalid(t *testing.T) {
+	for msg, c := range map[string]*meta2.StreamCall{
+		"the max call max_fk1 of stream task t can not sum the integers, only the sum calls can": {Call: "max", Field: "fk1", Alias: "max_fk1"},
+		"the field fk2 of the sum call sum_fk2 of stream task t is not an integer":               {Call: "sum", Field: "fk2", Alias: "sum_fk2"},
+	} {
+		si := newStreamTestInfo(c)
+		src, dst := streamTestSchema(si)
+		src["fk1"] = influx.Field_Type_Int
+		_, err := newStreamTask(si, src, dst, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{c.Alias: {IntSum: true}}})
+		require.EqualError(t, err, msg)
+	}
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	src, dst := streamTestSchema(si)
+	src["fk1"] = influx.Field_Type_Int
+	_, err := newStreamTask(si, src, dst, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk1": {IntSum: true, IntOverflow: 3}}})
+	require.EqualError(t, err, "the integer overflow 3 of the sum call sum_fk1 of stream task t is unknown")
+	_, err = newStreamTask(si, src, dst, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk1": {IntMinMax: true}}})
+	require.EqualError(t, err, "the sum call sum_fk1 of stream task t can not select the integers, only the min and the max calls can")
+}
+
+func TestStreamIntExact(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "min", Field: "fk1", Alias: "min_fk1"}, &meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"})
+	env := newStreamTestEnv()
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	opt := &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{
+		"sum_fk1": {IntSum: true}, "min_fk1": {IntMinMax: true}, "max_fk1": {IntMinMax: true},
+	}}
+	setStreamTestOptions(si, opt)
+	src, dst := streamTestSchema(si)
+	src["fk1"] = influx.Field_Type_Int
+	for _, c := range si.Calls {
+		delete(dst, c.Alias)
+	}
+	task, err := newStreamTask(si, src, dst, opt)
+	require.NoError(t, err)
+	ctx.stream.tasks[si.Name] = task
+
+	// the values beyond 2^53 are rounded by the floats
+	var rows []*influx.Row
+	for i, v := range []int64{1<<60 + 1, 1<<60 + 3, 1 << 54} {
+		f := influx.Field{Key: "fk1", Type: influx.Field_Type_Int}
+		f.SetInt(v)
+		rows = append(rows, newStreamTestRow(env.base+int64(i), []influx.Tag{{Key: "tk1", Value: "a"}}, f))
+	}
+	_, err = ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	var out []influx.Row
+	for i := range ctx.shardRowMap {
+		for _, r := range rowsOfMst(ctx.shardRowMap[i].rows, "mst2") {
+			out = append(out, *r)
+		}
+	}
+	require.Len(t, out, 1)
+
+	// the integers are written to the store exactly
+	buf, err := influx.FastMarshalMultiRows(nil, out)
+	require.NoError(t, err)
+	rs, _, _, _, _, err := influx.FastUnmarshalMultiRows(buf, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	values := map[string]int64{}
+	for _, f := range rs[0].Fields {
+		require.Equal(t, int32(influx.Field_Type_Int), f.Type)
+		values[f.Key] = f.Int()
+	}
+	require.Equal(t, map[string]int64{"sum_fk1": 1<<61 + 1<<54 + 4, "min_fk1": 1 << 54, "max_fk1": 1<<60 + 3}, values)
+}
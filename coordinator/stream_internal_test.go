@@ -0,0 +1,410 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"encoding/json"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+type streamTestEnv struct {
+	pw *PointsWriter
+	// base is the start time of a window that is covered by the shard group of the mock meta client
+	base int64
+}
+
+func newStreamTestEnv() *streamTestEnv {
+	streamDistribution = diffDis
+	// the record writer tests leave the mock measurements in the column store
+	engineType = config.TSSTORE
+	pw := NewPointsWriter(time.Second * 10)
+	pw.MetaClient = NewMockMetaClient()
+	pw.TSDBStore = NewMockNetStore()
+	return &streamTestEnv{
+		pw:   pw,
+		base: time.Now().Truncate(time.Second).Add(time.Second).UnixNano(),
+	}
+}
+
+func newStreamTestInfo(calls ...*meta2.StreamCall) *meta2.StreamInfo {
+	return &meta2.StreamInfo{
+		Name:     "t",
+		ID:       1,
+		SrcMst:   &meta2.StreamMeasurementInfo{Name: "mst0", Database: "db0", RetentionPolicy: "rp0"},
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst2", Database: "db0", RetentionPolicy: "rp0"},
+		Interval: time.Second,
+		Dims:     []string{"tk1"},
+		Calls:    calls,
+	}
+}
+
+// setStreamTestOptions sets the options of the stream as stored in meta, nil restores the default options.
+func setStreamTestOptions(si *meta2.StreamInfo, opt *StreamTaskOptions) {
+	si.Options = ""
+	if opt != nil {
+		data, _ := json.Marshal(opt)
+		si.Options = string(data)
+	}
+}
+
+func newStreamTestRow(ts int64, tags []influx.Tag, fields ...influx.Field) *influx.Row {
+	r := &influx.Row{Name: "mst0", Timestamp: ts, Tags: tags, Fields: fields}
+	sort.Sort(&r.Tags)
+	sort.Stable(&r.Fields)
+	buildColumnToIndex(r)
+	return r
+}
+
+func floatField(key string, v float64) influx.Field {
+	return influx.Field{Key: key, NumValue: v, Type: influx.Field_Type_Float}
+}
+
+func streamTestSchema(si *meta2.StreamInfo) (map[string]int32, map[string]int32) {
+	src := map[string]int32{"tk1": influx.Field_Type_Tag, "tk2": influx.Field_Type_Tag}
+	dst := map[string]int32{}
+	for _, c := range si.Calls {
+		if _, ok := src[c.Field]; !ok {
+			src[c.Field] = influx.Field_Type_Float
+			if streamLib.IsBooleanCall(c.Call) {
+				src[c.Field] = influx.Field_Type_Boolean
+			}
+		}
+		if c.Call == weightedMeanCall && len(c.Args) == 1 {
+			src[c.Args[0]] = influx.Field_Type_Float
+		}
+		dst[c.Alias] = streamTestOutType(c.Call, src[c.Field])
+	}
+	return src, dst
+}
+
+// streamTestOutType returns the type of the destination field written by the call, the fields of the calls
+// whose types follow the destination are floats.
+func streamTestOutType(call string, in int32) int32 {
+	switch call {
+	case "any", "all":
+		return influx.Field_Type_Boolean
+	case "count_true", "count_distinct", "min_ts", "max_ts":
+		return influx.Field_Type_Int
+	case "first", "last":
+		return in
+	}
+	return influx.Field_Type_Float
+}
+
+// prepare returns the injestion context with the task of the stream registered,
+// the context should be put back to the pool by the caller.
+func (e *streamTestEnv) prepare(t *testing.T, si *meta2.StreamInfo) *injestionCtx {
+	ctx := getInjestionCtx()
+	ctx.writeHelper = newWriteHelper(e.pw)
+	// the pooled context may keep the stream and write helpers of a previous PointsWriter
+	ctx.stream = nil
+	ctx.streamWriteHelpers = ctx.streamWriteHelpers[:0]
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	require.NoError(t, ctx.initStreamVar(e.pw))
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	task, err := e.pw.buildTask(si, srcSchema, dstSchema)
+	require.NoError(t, err)
+	ctx.stream.tasks[si.Name] = task
+	return ctx
+}
+
+// calculate runs the sql layer calculation of the stream and returns all the rows mapped to the shards.
+func (e *streamTestEnv) calculate(t *testing.T, si *meta2.StreamInfo, rows ...*influx.Row) []*influx.Row {
+	ctx := e.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err := ctx.stream.calculate(rows, si, e.pw, ctx, 0)
+	require.NoError(t, err)
+
+	var out []*influx.Row
+	for i := range ctx.shardRowMap {
+		out = append(out, ctx.shardRowMap[i].rows...)
+	}
+	return out
+}
+
+func rowsOfMst(rows []*influx.Row, name string) []*influx.Row {
+	var out []*influx.Row
+	for _, r := range rows {
+		if r.Name == name {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Timestamp != out[j].Timestamp {
+			return out[i].Timestamp < out[j].Timestamp
+		}
+		return tagValue(out[i], "tk1") < tagValue(out[j], "tk1")
+	})
+	return out
+}
+
+func tagValue(r *influx.Row, key string) string {
+	for i := range r.Tags {
+		if r.Tags[i].Key == key {
+			return r.Tags[i].Value
+		}
+	}
+	return ""
+}
+
+func fieldValue(r *influx.Row, key string) (float64, bool) {
+	for i := range r.Fields {
+		if r.Fields[i].Key == key {
+			return r.Fields[i].NumValue, true
+		}
+	}
+	return 0, false
+}
+
+func TestStreamCalculate(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	rows := env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 5)),
+	)
+	out := rowsOfMst(rows, "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []float64{3, 5} {
+		v, _ := fieldValue(out[i], "sum_fk1")
+		require.Equal(t, exp, v)
+		require.True(t, out[i].StreamOnly)
+		require.Equal(t, env.base+int64(time.Second)-1, out[i].Timestamp)
+	}
+	v, _ := fieldValue(out[0], "count_fk1")
+	require.Equal(t, float64(2), v)
+}
+
+func TestStreamCountType(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	src := map[string]int32{"fk1": influx.Field_Type_Float}
+	calls, err := BuildFieldCall(si, nil, src, map[string]int32{})
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Int), calls[0].OutFieldType)
+	require.Equal(t, int32(influx.Field_Type_Unknown), calls[1].OutFieldType)
+
+	// the type of an existing destination field is kept
+	calls, err = BuildFieldCall(si, nil, src, map[string]int32{"count_fk1": influx.Field_Type_Float})
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Float), calls[0].OutFieldType)
+}
+
+func TestStreamCountExact(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	// the count is written as an integer to the destination without the field
+	srcSchema, dstSchema := streamTestSchema(si)
+	delete(dstSchema, "count_fk1")
+	task, err := env.pw.buildTask(si, srcSchema, dstSchema)
+	require.NoError(t, err)
+	ctx.stream.tasks[si.Name] = task
+	_, err = ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 3)),
+	}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, ctx.shardRowMap, 1)
+	out := ctx.shardRowMap[0].rows
+	require.Len(t, out, 1)
+	require.Equal(t, influx.Field{Key: "count_fk1", NumValue: 3, IntValue: 3, Type: influx.Field_Type_Int}, out[0].Fields[0])
+
+	// the counts merged and counted beyond 2^53 are exact
+	const boundary = int64(1) << 53
+	sCtx := &streamCtx{}
+	dst, src := float64(boundary), float64(4)
+	sCtx.mergeCount(&dst, &src)
+	sCtx.addCount(&dst)
+	require.Equal(t, boundary+5, sCtx.intResults[&dst])
+}
+
+func TestStreamLazyTask(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "lazy"
+	rows := []*influx.Row{newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1))}
+
+	// the stream synced from meta but not registered yet is built by the batch
+	ctx := env.prepare(t, si)
+	delete(ctx.stream.tasks, si.Name)
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.Contains(t, ctx.stream.tasks, si.Name)
+	out := rowsOfMst(ctx.shardRowMap[0].rows, "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(1), v)
+	putInjestionCtx(ctx)
+	require.Equal(t, int64(1), atomic.LoadInt64(&env.pw.getStreamTaskState(si.Name).lazyTaskBuilds))
+
+	// the batch fails if the task can not be built
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "count_true", Field: "fk1", Alias: "count_true_fk1"})
+	ctx = env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	delete(ctx.stream.tasks, si.Name)
+	_, err = ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.EqualError(t, err, "t have no task: the count_true call count_true_fk1 of stream task t only supports boolean fields")
+}
+
+func TestStreamGroupKeyEscape(t *testing.T) {
+	values := []string{"", "a", "a\x00b", "/a\x00/b\x00", "\x01", "\x01\x00\x010", "a\x011"}
+	for _, v := range values {
+		for _, w := range values {
+			row := newStreamTestRow(0, []influx.Tag{{Key: "tk1", Value: v}, {Key: "tk2", Value: w}})
+			key := appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, false, config.StreamGroupValueSeparator)
+			require.Equal(t, []string{v, w}, splitGroupKey(string(key), config.StreamGroupValueSeparator), "%q %q", v, w)
+		}
+	}
+	// the values without the separator and the escape are kept as they are
+	row := newStreamTestRow(0, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "b"}})
+	require.Equal(t, "a\x00b", string(appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, false, config.StreamGroupValueSeparator)))
+
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	var rows []*influx.Row
+	for i, v := range values {
+		rows = append(rows, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: v}, {Key: "tk2", Value: "x\x00y"}}, floatField("fk1", float64(i))))
+	}
+	out := env.calculate(t, si, rows...)
+	require.Len(t, out, len(values))
+	for _, r := range out {
+		v, _ := fieldValue(r, "sum_fk1")
+		require.Equal(t, values[int(v)], tagValue(r, "tk1"))
+		require.Equal(t, "x\x00y", tagValue(r, "tk2"))
+	}
+}
+
+func TestStreamGroupByFields(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"fhost", "fport", "tk1"}
+	row := func(tk1, host string, port int64, v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: tk1}}, floatField("fk1", v),
+			influx.Field{Key: "fhost", StrValue: host, Type: influx.Field_Type_String},
+			influx.Field{Key: "fport", NumValue: float64(port), Type: influx.Field_Type_Int})
+	}
+	calculate := func(opt *StreamTaskOptions, rows ...*influx.Row) []*influx.Row {
+		setStreamTestOptions(si, opt)
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		src, dst := streamTestSchema(si)
+		src["fhost"], src["fport"] = influx.Field_Type_String, influx.Field_Type_Int
+		task, err := newStreamTask(si, src, dst, opt)
+		require.NoError(t, err)
+		ctx.stream.tasks[si.Name] = task
+		_, err = ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		var out []*influx.Row
+		for i := range ctx.shardRowMap {
+			out = append(out, ctx.shardRowMap[i].rows...)
+		}
+		return rowsOfMst(out, "mst2")
+	}
+	rows := []*influx.Row{row("a", "h\x001", 80, 1), row("a", "h\x001", 80, 2), row("a", "h2", 80, 4), row("a", "h2", 443, 8), row("b", "h2", 443, 16)}
+
+	// the dims of the fields are ignored by default
+	out := calculate(&StreamTaskOptions{}, rows...)
+	require.Len(t, out, 2)
+	for _, r := range out {
+		require.Equal(t, 1, len(r.Tags))
+	}
+
+	out = calculate(&StreamTaskOptions{Group: StreamGroupOptions{GroupByFields: true}}, rows...)
+	got := map[string]float64{}
+	for _, r := range out {
+		require.True(t, sort.IsSorted(&r.Tags))
+		for i := range r.Tags {
+			require.Equal(t, i, r.ColumnToIndex[r.Tags[i].Key])
+		}
+		v, _ := fieldValue(r, "sum_fk1")
+		got[tagValue(r, "tk1")+"/"+tagValue(r, "fhost")+"/"+tagValue(r, "fport")] = v
+	}
+	require.Equal(t, map[string]float64{"a/h\x001/80": 3, "a/h2/80": 4, "a/h2/443": 8, "b/h2/443": 16}, got)
+}
+
+func TestStreamExpiredWindows(t *testing.T) {
+	env := newStreamTestEnv()
+	db, err := env.pw.MetaClient.Database("db0")
+	require.NoError(t, err)
+	rp := db.RetentionPolicies["rp0"]
+	rp.ShardGroups[0].StartTime = rp.ShardGroups[0].StartTime.Add(-3 * time.Hour)
+	old := env.base - int64(2*time.Hour)
+	rows := []*influx.Row{
+		newStreamTestRow(old, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+	}
+
+	// the windows out of the retention policy are not written
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "expired_windows"
+	out := env.calculate(t, si, rows...)
+	require.Len(t, out, 1)
+	require.Equal(t, env.base+int64(time.Second)-1, out[0].Timestamp)
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.expiredWindows))
+
+	// the infinite retention keeps all the windows
+	rp.Duration = 0
+	si.Name = "infinite_retention"
+	require.Len(t, env.calculate(t, si, rows...), 2)
+	require.Equal(t, int64(0), atomic.LoadInt64(&env.pw.getStreamTaskState(si.Name).expiredWindows))
+}
+
+func TestStreamAliasAffixes(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum"},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max"})
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{AliasPrefix: "t1_", AliasSuffix: "_fk1"}})
+
+	out := env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+	)
+	require.Len(t, out, 1)
+	v, ok := fieldValue(out[0], "t1_sum_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(3), v)
+	v, ok = fieldValue(out[0], "t1_max_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(2), v)
+	_, ok = fieldValue(out[0], "sum")
+	require.False(t, ok)
+
+	// the calls written to the same field are rejected
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "v"},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "v"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{AliasPrefix: "t1_"}})
+	require.EqualError(t, err, "the calls v and v of stream task t are both written to the field t1_v")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// checkStreamInterval rejects the intervals of the windows which are not positive or longer than the max
+// interval of the task.
+func checkStreamInterval(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if info.Interval <= 0 {
+		return fmt.Errorf("the interval %s of stream task %s is not positive", info.Interval, info.Name)
+	}
+	if opt.Limits.MaxInterval > 0 && info.Interval > opt.Limits.MaxInterval {
+		return fmt.Errorf("the interval %s of stream task %s exceeds the max %s", info.Interval, info.Name, opt.Limits.MaxInterval)
+	}
+	return nil
+}
+
+// streamDatabases returns the databases of the destinations of the stream tasks.
+type streamDatabases interface {
+	Database(name string) (*meta2.DatabaseInfo, error)
+}
+
+// CheckStreamDestinations checks the windows of the stream task and of its destinations against the retention
+// policies of the destinations, nil options are the defaults. The missing databases and retention policies are
+// left to the writes.
+func CheckStreamDestinations(dbs streamDatabases, info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt == nil {
+		opt = defaultStreamTaskOptions
+	}
+	infos := []*meta2.StreamInfo{info}
+	for _, d := range info.Destinations {
+		infos = append(infos, destinationInfo(info, d))
+	}
+	for _, di := range infos {
+		rp := streamDestinationRP(dbs, di.DesMst)
+		if rp == nil {
+			continue
+		}
+		if err := checkStreamDestination(di, opt, rp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildTask builds the task of the stream with its options, and checks the windows of the task and of its destinations
+// against the retention policies of them once. The task failing the check skips the rows instead of failing the writes.
+func (w *PointsWriter) buildTask(si *meta2.StreamInfo, srcSchema, dstSchema map[string]int32) (*streamTask, error) {
+	task, err := newStreamTask(si, srcSchema, dstSchema, w.getStreamTaskOptions(si))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range append([]*streamTask{task}, task.destinations...) {
+		rp := streamDestinationRP(w.MetaClient, t.info.DesMst)
+		if rp == nil {
+			continue
+		}
+		if t.skipErr = checkStreamDestination(t.info, t.opt, rp); t.skipErr != nil {
+			w.logger.Warn("stream task skips the rows", zap.String("stream", t.info.Name), zap.Error(t.skipErr))
+		}
+	}
+	return task, nil
+}
+
+// streamDestinationRP returns the retention policy of the destination, the default one of the database if not set,
+// nil if missing.
+func streamDestinationRP(dbs streamDatabases, mst *meta2.StreamMeasurementInfo) *meta2.RetentionPolicyInfo {
+	db, err := dbs.Database(mst.Database)
+	if err != nil || db == nil {
+		return nil
+	}
+	name := mst.RetentionPolicy
+	if name == "" {
+		name = db.DefaultRetentionPolicy
+	}
+	rp, err := db.GetRetentionPolicy(name)
+	if err != nil {
+		return nil
+	}
+	return rp
+}
+
+// checkStreamDestination checks the windows of the task against the retention policy of the destination.
+func checkStreamDestination(info *meta2.StreamInfo, opt *StreamTaskOptions, rp *meta2.RetentionPolicyInfo) error {
+	if err := checkStreamShardWindows(info, opt, rp); err != nil {
+		return err
+	}
+	return checkStreamRetention(info, opt, rp)
+}
+
+// checkStreamShardWindows rejects the windows much shorter than the shard groups of the retention policy of the
+// destination, whose rows are written to a shard group by more than MaxShardWindows windows of each group.
+func checkStreamShardWindows(info *meta2.StreamInfo, opt *StreamTaskOptions, rp *meta2.RetentionPolicyInfo) error {
+	if opt.Limits.MaxShardWindows <= 0 || rp.ShardGroupDuration <= 0 || info.Interval <= 0 {
+		return nil
+	}
+	if n := int64(rp.ShardGroupDuration / info.Interval); n > int64(opt.Limits.MaxShardWindows) {
+		return fmt.Errorf("the interval %s of stream task %s makes %d windows in a shard group of %s of the retention policy %s, the max is %d",
+			info.Interval, info.Name, n, rp.ShardGroupDuration, rp.Name, opt.Limits.MaxShardWindows)
+	}
+	return nil
+}
+
+// checkStreamRetention rejects the windows which expire from the retention policy of the destination before they are
+// useful. A window is complete after the interval and the delay of the task, the window longer than the duration
+// of the retention policy is out of it once complete, and so is any window kept by less than MinRetentionWindows.
+func checkStreamRetention(info *meta2.StreamInfo, opt *StreamTaskOptions, rp *meta2.RetentionPolicyInfo) error {
+	if rp.Duration <= 0 || info.Interval <= 0 {
+		return nil
+	}
+	if info.Interval+info.Delay >= rp.Duration {
+		return fmt.Errorf("the interval %s and the delay %s of stream task %s are not shorter than the duration %s of the retention policy %s, "+
+			"the windows expire once they are complete: shorten the interval or lengthen the duration",
+			info.Interval, info.Delay, info.Name, rp.Duration, rp.Name)
+	}
+	if opt.Limits.MinRetentionWindows > 0 && int64(rp.Duration/info.Interval) < int64(opt.Limits.MinRetentionWindows) {
+		return fmt.Errorf("the duration %s of the retention policy %s keeps %d windows of the interval %s of stream task %s, the min is %d: "+
+			"shorten the interval or lengthen the duration",
+			rp.Duration, rp.Name, rp.Duration/info.Interval, info.Interval, info.Name, opt.Limits.MinRetentionWindows)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamInterval(t *testing.T) {
+	for interval, msg := range map[time.Duration]string{
+		0:                "the interval 0s of stream task t is not positive",
+		-time.Second:     "the interval -1s of stream task t is not positive",
+		48 * time.Hour:   "the interval 48h0m0s of stream task t exceeds the max 24h0m0s",
+		24 * time.Hour:   "",
+		time.Millisecond: "",
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.Interval = interval
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Limits: StreamLimitOptions{MaxInterval: 24 * time.Hour}})
+		if msg == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.EqualError(t, err, msg)
+	}
+}
+
+func TestStreamShardWindows(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	rp := &meta2.RetentionPolicyInfo{Name: "rp0", ShardGroupDuration: time.Hour}
+	require.NoError(t, checkStreamShardWindows(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxShardWindows: 3600}}, rp))
+	require.NoError(t, checkStreamShardWindows(si, defaultStreamTaskOptions, rp))
+	require.EqualError(t, checkStreamShardWindows(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxShardWindows: 60}}, rp),
+		"the interval 1s of stream task t makes 3600 windows in a shard group of 1h0m0s of the retention policy rp0, the max is 60")
+
+	// the options are rejected before they are stored
+	env := newStreamTestEnv()
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	db, err := env.pw.MetaClient.Database("db0")
+	require.NoError(t, err)
+	db.RetentionPolicies["rp0"].ShardGroupDuration = time.Hour
+	err = env.pw.SetStreamTaskOptions(si.Name, &StreamTaskOptions{Limits: StreamLimitOptions{MaxShardWindows: 60}})
+	require.ErrorContains(t, err, "makes 3600 windows in a shard group")
+
+	// the task is checked once it is built, and skips the rows without failing the write
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxShardWindows: 60}})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	require.ErrorContains(t, ctx.stream.tasks[si.Name].skipErr, "makes 3600 windows in a shard group")
+	_, err = ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+	}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, ctx.shardRowMap)
+}
+
+func TestStreamRetention(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Interval = time.Hour
+	rp := &meta2.RetentionPolicyInfo{Name: "rp0", Duration: 30 * time.Minute}
+	require.EqualError(t, checkStreamRetention(si, defaultStreamTaskOptions, rp),
+		"the interval 1h0m0s and the delay 0s of stream task t are not shorter than the duration 30m0s of the retention policy rp0, "+
+			"the windows expire once they are complete: shorten the interval or lengthen the duration")
+
+	// the windows are complete after the delay as well
+	rp.Duration = 2 * time.Hour
+	require.NoError(t, checkStreamRetention(si, defaultStreamTaskOptions, rp))
+	si.Delay = time.Hour
+	require.ErrorContains(t, checkStreamRetention(si, defaultStreamTaskOptions, rp), "the delay 1h0m0s")
+	si.Delay = 0
+
+	require.EqualError(t, checkStreamRetention(si, &StreamTaskOptions{Limits: StreamLimitOptions{MinRetentionWindows: 3}}, rp),
+		"the duration 2h0m0s of the retention policy rp0 keeps 2 windows of the interval 1h0m0s of stream task t, the min is 3: "+
+			"shorten the interval or lengthen the duration")
+	require.NoError(t, checkStreamRetention(si, &StreamTaskOptions{Limits: StreamLimitOptions{MinRetentionWindows: 2}}, rp))
+
+	// the infinite retention keeps any window
+	rp.Duration = 0
+	require.NoError(t, checkStreamRetention(si, &StreamTaskOptions{Limits: StreamLimitOptions{MinRetentionWindows: 3}}, rp))
+
+	// the retention policy altered after the stream is created skips the rows of the task without failing the write
+	env := newStreamTestEnv()
+	db, err := env.pw.MetaClient.Database("db0")
+	require.NoError(t, err)
+	db.RetentionPolicies["rp0"].Duration = 30 * time.Minute
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err = ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+	}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, ctx.shardRowMap)
+}
+
+type streamTestDatabases map[string]*meta2.DatabaseInfo
+
+func (dbs streamTestDatabases) Database(name string) (*meta2.DatabaseInfo, error) {
+	db, ok := dbs[name]
+	if !ok {
+		return nil, errno.NewError(errno.DatabaseNotFound, name)
+	}
+	return db, nil
+}
+
+func TestCheckStreamDestinations(t *testing.T) {
+	dbs := streamTestDatabases{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0", RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+		"rp0": {Name: "rp0", Duration: 2 * time.Hour},
+		"rp1": {Name: "rp1", Duration: 30 * time.Minute},
+	}}}
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Interval = time.Hour
+	require.NoError(t, CheckStreamDestinations(dbs, si, nil))
+	require.ErrorContains(t, CheckStreamDestinations(dbs, si, &StreamTaskOptions{Limits: StreamLimitOptions{MinRetentionWindows: 3}}),
+		"keeps 2 windows of the interval 1h0m0s of stream task t, the min is 3")
+
+	// the empty retention policy is the default one of the database
+	si.DesMst.RetentionPolicy = ""
+	require.NoError(t, CheckStreamDestinations(dbs, si, nil))
+	dbs["db0"].DefaultRetentionPolicy = "rp1"
+	require.ErrorContains(t, CheckStreamDestinations(dbs, si, nil), "the duration 30m0s of the retention policy rp1")
+
+	// the destinations besides DesMst are checked at their intervals
+	si.DesMst.RetentionPolicy = "rp0"
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp1"},
+		Interval: time.Hour,
+	}}
+	require.ErrorContains(t, CheckStreamDestinations(dbs, si, nil), "stream task t"+StreamDestinationSeparator+"mst3")
+	si.Destinations[0].Interval = time.Minute
+	require.NoError(t, CheckStreamDestinations(dbs, si, nil))
+
+	// the missing destinations are left to the writes
+	si.DesMst.Database = "db1"
+	si.Destinations[0].DesMst.RetentionPolicy = "rp2"
+	require.NoError(t, CheckStreamDestinations(dbs, si, nil))
+}
@@ -0,0 +1,24 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+const deadLetterLate = "late"
+
+// isLate returns whether the window ending at et is behind the watermark by more than the interval and the lateness.
+func (w *streamTask) isLate(et, watermark int64) bool {
+	return w.opt.Window.AllowedLateness > 0 && et < watermark-int64(w.info.Interval)-int64(w.opt.Window.AllowedLateness)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamAllowedLateness(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	row := func(sec int) *influx.Row {
+		return newStreamTestRow(env.base+int64(sec)*int64(time.Second), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1))
+	}
+	state := env.pw.getStreamTaskState(si.Name)
+
+	// without the lateness the rows of any window are accepted
+	require.Len(t, rowsOfMst(env.calculate(t, si, row(10), row(0)), "mst2"), 2)
+	require.Equal(t, int64(0), atomic.LoadInt64(&state.lateRows))
+
+	env = newStreamTestEnv()
+	state = env.pw.getStreamTaskState(si.Name)
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{AllowedLateness: 2 * time.Second}})
+	require.Len(t, rowsOfMst(env.calculate(t, si, row(10)), "mst2"), 1)
+	// the watermark is kept across the batches, the window of 0s is too late but the one of 7s is not
+	out := rowsOfMst(env.calculate(t, si, row(0), row(7), row(6)), "mst2")
+	require.Len(t, out, 1)
+	require.Equal(t, env.base+int64(8*time.Second)-1, out[0].Timestamp)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.lateRows))
+
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		if mstName == "dead_letter" {
+			mi.Schema = nil
+		}
+		return mi, nil
+	}
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{AllowedLateness: 2 * time.Second}, Errors: StreamErrorOptions{DeadLetterMst: "dead_letter"}})
+	all := env.calculate(t, si, row(1))
+	require.Empty(t, rowsOfMst(all, "mst2"))
+	dl := rowsOfMst(all, "dead_letter")
+	require.Len(t, dl, 1)
+	require.Equal(t, deadLetterLate, tagValue(dl[0], DeadLetterReasonTag))
+	require.Equal(t, int64(3), atomic.LoadInt64(&state.lateRows))
+}
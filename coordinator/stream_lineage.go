@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// buildSourceShardTag checks the tag carrying the source shard of the rows, which groups the rows of different
+// source shards apart.
+func (w *streamTask) buildSourceShardTag() error {
+	tag := w.opt.Group.SourceShardTag
+	if tag == "" {
+		return nil
+	}
+	if w.passthrough {
+		return fmt.Errorf("stream task %s without calls has no groups to tag with the source shard", w.info.Name)
+	}
+	for _, d := range append(w.tagDimKeys, w.fieldIndexKeys...) {
+		if d == tag {
+			return fmt.Errorf("the source shard tag %s conflicts with the group by tags of stream task %s", d, w.info.Name)
+		}
+	}
+	switch {
+	case w.bucket != nil && tag == w.bucket.tag:
+		return fmt.Errorf("the source shard tag %s of stream task %s is the bucket tag", tag, w.info.Name)
+	case tag == w.sourceRPTag:
+		return fmt.Errorf("the source shard tag %s of stream task %s is the source retention policy tag", tag, w.info.Name)
+	case tag == w.opt.Group.SourceTag && len(w.opt.Group.UnionMsts) > 0:
+		return fmt.Errorf("the source shard tag %s of stream task %s is the source tag", tag, w.info.Name)
+	}
+	w.sourceShardTag = tag
+	return nil
+}
+
+// appendSourceShard appends the source shard of the rows to the group key, nothing if the shard is unknown, such
+// as the rows of the chained streams and the backfills.
+func appendSourceShard(dst []byte, shard uint64) []byte {
+	if shard == 0 {
+		return dst
+	}
+	return strconv.AppendUint(dst, shard, 10)
+}
+
+// addSourceShardTag adds the source shard tag to the tags of the agg row and keeps the tags sorted, the windows
+// of the unknown shards are not tagged.
+func (w *streamTask) addSourceShardTag(r *influx.Row, shard string) {
+	if shard == "" {
+		return
+	}
+	addAggTag(r, w.sourceShardTag, shard)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strconv"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSourceShardTag(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{SourceShardTag: "_src_shard"}})
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	calculate := func(shard uint64, rows ...*influx.Row) []*influx.Row {
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		ctx.streamSourceShard = shard
+		_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		var out []*influx.Row
+		for i := range ctx.shardRowMap {
+			out = append(out, ctx.shardRowMap[i].rows...)
+		}
+		return rowsOfMst(out, "mst2")
+	}
+
+	// the windows of each source shard are tagged with it
+	for shard, sum := range map[uint64]float64{1: 3, 12: 4} {
+		out := calculate(shard, row(1), row(sum-1))
+		require.Len(t, out, 1)
+		require.Equal(t, []influx.Tag{{Key: "_src_shard", Value: strconv.FormatUint(shard, 10)}, {Key: "tk1", Value: "a"}},
+			[]influx.Tag(out[0].Tags))
+		v, _ := fieldValue(out[0], "sum_fk1")
+		require.Equal(t, sum, v)
+	}
+
+	// the windows of the unknown shards are not tagged
+	out := calculate(0, row(1))
+	require.Len(t, out, 1)
+	require.Equal(t, []influx.Tag{{Key: "tk1", Value: "a"}}, []influx.Tag(out[0].Tags))
+}
+
+func TestStreamSourceShardTagCheck(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{SourceShardTag: "tk1"}})
+	require.EqualError(t, err, "the source shard tag tk1 conflicts with the group by tags of stream task t")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"mem"}, SourceTag: "src", SourceShardTag: "src"}})
+	require.EqualError(t, err, "the source shard tag src of stream task t is the source tag")
+	si.SrcRPs = []string{"rp1"}
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{SourceRPTag: "tier", SourceShardTag: "tier"}})
+	require.EqualError(t, err, "the source shard tag tier of stream task t is the source retention policy tag")
+
+	copied := newStreamTestInfo()
+	srcSchema, dstSchema = streamTestSchema(copied)
+	_, err = newStreamTask(copied, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{SourceShardTag: "_src_shard"}})
+	require.EqualError(t, err, "stream task t without calls has no groups to tag with the source shard")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+// streamMalformedLogInterval is the min interval between the logs of the malformed rows dropped by a task.
+const streamMalformedLogInterval = 10 * time.Second
+
+const (
+	malformedNilIndex     = "nil column index"
+	malformedUnsortedTags = "unsorted tags"
+)
+
+// malformedRow returns why the row can not be grouped by the task, empty if it can. The group keys and the calls
+// look the keys up by ColumnToIndex and the tags by binary search, which silently miss the keys of such a row.
+func malformedRow(r *influx.Row) string {
+	if r.ColumnToIndex == nil && len(r.Tags)+len(r.Fields) > 0 {
+		return malformedNilIndex
+	}
+	for i := 1; i < len(r.Tags); i++ {
+		if r.Tags[i-1].Key > r.Tags[i].Key {
+			return malformedUnsortedTags
+		}
+	}
+	return ""
+}
+
+// dropMalformedRows returns the rows without the malformed ones, which are counted and logged once per
+// streamMalformedLogInterval. The rows are returned as they are if none of them is malformed.
+func (s *Stream) dropMalformedRows(rows []*influx.Row, si *meta2.StreamInfo, state *streamTaskState) []*influx.Row {
+	first := -1
+	for i, r := range rows {
+		if malformedRow(r) != "" {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return rows
+	}
+	valid := make([]*influx.Row, first, len(rows))
+	copy(valid, rows[:first])
+	var dropped int64
+	var reason string
+	for _, r := range rows[first:] {
+		if why := malformedRow(r); why != "" {
+			dropped++
+			reason = why
+			continue
+		}
+		valid = append(valid, r)
+	}
+	state.addMalformedRows(dropped)
+	if state.logMalformed(time.Now().UnixNano()) {
+		s.logger.Warn("stream task dropped the malformed rows", zap.String("stream", si.Name), zap.Int64("dropped", dropped),
+			zap.Int64("total", atomic.LoadInt64(&state.malformedRows)), zap.String("reason", reason))
+	}
+	return valid
+}
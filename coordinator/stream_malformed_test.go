@@ -0,0 +1,61 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamMalformedRows(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = []string{"tk1", "tk2"}
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "b"}}, floatField("fk1", v))
+	}
+	noIndex := row(2)
+	noIndex.ColumnToIndex = nil
+	unsorted := row(4)
+	unsorted.Tags[0], unsorted.Tags[1] = unsorted.Tags[1], unsorted.Tags[0]
+	require.Equal(t, malformedNilIndex, malformedRow(noIndex))
+	require.Equal(t, malformedUnsortedTags, malformedRow(unsorted))
+
+	out := rowsOfMst(env.calculate(t, si, row(1), noIndex, unsorted, row(8)), "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(9), v)
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.malformedRows))
+
+	// the rows are returned as they are without the malformed ones
+	rows := []*influx.Row{row(1)}
+	require.Equal(t, rows, NewStream(nil, nil, nil, 0).dropMalformedRows(rows, si, state))
+}
+
+func TestStreamMalformedLog(t *testing.T) {
+	state := &streamTaskState{}
+	now := int64(streamMalformedLogInterval) * 5
+	require.True(t, state.logMalformed(now))
+	require.False(t, state.logMalformed(now+1))
+	require.False(t, state.logMalformed(now+int64(streamMalformedLogInterval)-1))
+	require.True(t, state.logMalformed(now+int64(streamMalformedLogInterval)))
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// deadLetterMissingDim is the reason of the rows skipped as they miss a dim of the task
+const deadLetterMissingDim = "missing_dim"
+
+// defaultStreamMissingDimValue is the tag value of the missing dims of the windows without MissingDimValue.
+const defaultStreamMissingDimValue = "null"
+
+// streamGroupNull is the value of a missing dim in the group key, appendGroupValue never appends the escape
+// followed by escapedNull, so the missing dims are grouped apart from any value.
+const (
+	escapedNull     byte   = 'n'
+	streamGroupNull string = string(streamGroupValueEscape) + string(escapedNull)
+)
+
+// StreamMissingDim is how a task groups the rows without a dim.
+type StreamMissingDim uint8
+
+const (
+	// StreamMissingDimEmpty groups the rows missing a dim with the rows whose dim is the empty string,
+	// the windows of them are written without the tag of the dim.
+	StreamMissingDimEmpty StreamMissingDim = iota
+	// StreamMissingDimDistinct groups the rows missing a dim apart, the windows of them are written with
+	// the tag of the dim set to MissingDimValue.
+	StreamMissingDimDistinct
+	// StreamMissingDimSkip skips the rows missing a dim, which are written to the dead-letter measurement if the
+	// task has one.
+	StreamMissingDimSkip
+)
+
+func checkMissingDims(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt.Group.MissingDims > StreamMissingDimSkip {
+		return fmt.Errorf("the missing dim policy %d of stream task %s is unknown", opt.Group.MissingDims, info.Name)
+	}
+	return nil
+}
+
+// missingDim returns whether the row misses a tag dim or a field dim grouped by the task.
+func (w *streamTask) missingDim(r *influx.Row) bool {
+	tagIndex := 0
+	for _, k := range w.tagDimKeys {
+		idx := util.Search(tagIndex, len(r.Tags), func(j int) bool { return r.Tags[j].Key >= k })
+		if idx == len(r.Tags) || r.Tags[idx].Key != k {
+			return true
+		}
+		tagIndex = idx + 1
+	}
+	for _, k := range w.fieldIndexKeys {
+		if idx, ok := r.ColumnToIndex[k]; !ok || idx < r.Tags.Len() {
+			return true
+		}
+	}
+	return false
+}
+
+// dimValue returns the tag value of the value of a dim in the group key.
+func (w *streamTask) dimValue(v string) string {
+	if v != streamGroupNull {
+		return v
+	}
+	if w.opt.Group.MissingDimValue != "" {
+		return w.opt.Group.MissingDimValue
+	}
+	return defaultStreamMissingDimValue
+}
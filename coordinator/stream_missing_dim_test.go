@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamMissingDims(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	rows := func() []*influx.Row {
+		return []*influx.Row{
+			newStreamTestRow(env.base, nil, floatField("fk1", 1)),
+			newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: ""}}, floatField("fk1", 2)),
+			newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+		}
+	}
+	sums := func(out []*influx.Row) map[string]float64 {
+		m := make(map[string]float64)
+		for _, r := range out {
+			v, _ := fieldValue(r, "sum_fk1")
+			m[tagValue(r, "tk1")] = v
+		}
+		return m
+	}
+
+	// the rows missing the dim are merged with the empty ones by default
+	require.Equal(t, map[string]float64{"": 3, "a": 4}, sums(rowsOfMst(env.calculate(t, si, rows()...), "mst2")))
+
+	opt := &StreamTaskOptions{Group: StreamGroupOptions{MissingDims: StreamMissingDimDistinct}}
+	require.True(t, streamNeedsSQLLayer(si, opt))
+	setStreamTestOptions(si, opt)
+	require.Equal(t, map[string]float64{"null": 1, "": 2, "a": 4}, sums(rowsOfMst(env.calculate(t, si, rows()...), "mst2")))
+	opt.Group.MissingDimValue = "none"
+	setStreamTestOptions(si, opt)
+	require.Equal(t, map[string]float64{"none": 1, "": 2, "a": 4}, sums(rowsOfMst(env.calculate(t, si, rows()...), "mst2")))
+
+	opt.Group.MissingDims = StreamMissingDimSkip
+	setStreamTestOptions(si, opt)
+	require.Equal(t, map[string]float64{"": 2, "a": 4}, sums(rowsOfMst(env.calculate(t, si, rows()...), "mst2")))
+
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		if mstName == "dead_letter" {
+			mi.Schema = nil
+		}
+		return mi, nil
+	}
+	opt.Errors.DeadLetterMst = "dead_letter"
+	setStreamTestOptions(si, opt)
+	dl := rowsOfMst(env.calculate(t, si, rows()...), "dead_letter")
+	require.Len(t, dl, 1)
+	require.Equal(t, deadLetterMissingDim, tagValue(dl[0], DeadLetterReasonTag))
+
+	opt.Group.MissingDims = StreamMissingDimSkip + 1
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+	require.EqualError(t, err, "the missing dim policy 3 of stream task t is unknown")
+}
+
+func TestStreamGroupKeyNull(t *testing.T) {
+	for _, v := range []string{"", "n", "\x01", "\x01n", "\x01\x01n"} {
+		row := newStreamTestRow(0, []influx.Tag{{Key: "tk2", Value: v}})
+		key := appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, true, config.StreamGroupValueSeparator)
+		require.Equal(t, []string{streamGroupNull, v}, splitGroupKey(string(key), config.StreamGroupValueSeparator), "%q", v)
+		// the missing dims are the empty strings otherwise
+		key = appendGroupKey(nil, []string{"tk1", "tk2"}, nil, row, false, config.StreamGroupValueSeparator)
+		require.Equal(t, []string{"", v}, splitGroupKey(string(key), config.StreamGroupValueSeparator), "%q", v)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// deadLetterMissingField is the reason of the rows rejected as they miss the field of a call
+const deadLetterMissingField = "missing_field"
+
+// StreamMissingField is how a call handles the rows without its field.
+type StreamMissingField uint8
+
+const (
+	// StreamMissingSkip leaves the call of the window to the other rows. The call of a window whose rows all miss
+	// the field has no value, and the window is not written if none of its calls has a value.
+	StreamMissingSkip StreamMissingField = iota
+	// StreamMissingZero aggregates the row with the zero value instead, so the call of a window whose rows all
+	// miss the field has a value and the window is always written
+	StreamMissingZero
+	// StreamMissingError rejects the row, which is written to the dead-letter measurement if the task has one,
+	// or fails the batch otherwise
+	StreamMissingError
+)
+
+// buildMissingFieldCalls returns how the calls handle the rows missing their fields, nil if all the calls skip them.
+func buildMissingFieldCalls(info *meta2.StreamInfo, calls []*streamLib.FieldCall, callOptions map[string]*StreamCallOptions) ([]StreamMissingField, error) {
+	var missing []StreamMissingField
+	for i, c := range info.Calls {
+		opt, ok := callOptions[c.Alias]
+		if !ok || opt.MissingField == StreamMissingSkip {
+			continue
+		}
+		if opt.MissingField > StreamMissingError {
+			return nil, fmt.Errorf("the missing field policy %d of the %s call %s of stream task %s is unknown", opt.MissingField, c.Call, c.Alias, info.Name)
+		}
+		if opt.MissingField == StreamMissingZero && calls[i].InFieldType == influx.Field_Type_String {
+			return nil, fmt.Errorf("the %s call %s of stream task %s can not take the missing string field as zero", c.Call, c.Alias, info.Name)
+		}
+		if missing == nil {
+			missing = make([]StreamMissingField, len(info.Calls))
+		}
+		missing[i] = opt.MissingField
+	}
+	return missing, nil
+}
+
+// zeroesMissing returns whether the call aggregates the rows missing its field with the zero value.
+func (w *streamTask) zeroesMissing(call int) bool {
+	return w.missingFields != nil && w.missingFields[call] == StreamMissingZero
+}
+
+// missingFieldCall returns the index of the first call rejecting the row as it misses the field of the call,
+// -1 if no call rejects it. The rows not matching the condition of a call are not aggregated by it anyway.
+func (w *streamTask) missingFieldCall(r *influx.Row) int {
+	if w.missingFields == nil {
+		return -1
+	}
+	for i := range w.calls {
+		if w.missingFields[i] != StreamMissingError {
+			continue
+		}
+		if _, ok := r.ColumnToIndex[w.calls[i].Name]; ok {
+			continue
+		}
+		if w.callFilters != nil && w.callFilters[i] != nil && !w.callFilters[i](r) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
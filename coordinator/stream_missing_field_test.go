@@ -0,0 +1,94 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamMissingField(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_skip"},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_zero"},
+		&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_zero"},
+		&meta2.StreamCall{Call: "sum", Field: "fk2", Alias: "sum_fk2"},
+	)
+	opt := &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{
+		"count_zero": {MissingField: StreamMissingZero},
+		"mean_zero":  {MissingField: StreamMissingZero},
+	}}
+	require.True(t, streamNeedsSQLLayer(si, opt))
+	setStreamTestOptions(si, opt)
+
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk2", 1)),
+		// the window of the rows missing all the fields is written by the calls taking them as zero
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk3", 1)),
+	), "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []map[string]float64{
+		{"sum_skip": 4, "count_zero": 2, "mean_zero": 2, "sum_fk2": 1},
+		{"count_zero": 1, "mean_zero": 0},
+	} {
+		require.Len(t, out[i].Fields, len(exp))
+		for k, v := range exp {
+			got, ok := fieldValue(out[i], k)
+			require.True(t, ok, k)
+			require.Equal(t, v, got, k)
+		}
+	}
+
+	// the rows missing the field of the call are rejected
+	opt.CallOptions["sum_fk2"] = &StreamCallOptions{MissingField: StreamMissingError}
+	setStreamTestOptions(si, opt)
+	rows := []*influx.Row{newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4))}
+	ctx := env.prepare(t, si)
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.EqualError(t, err, "the field fk2 of the sum call sum_fk2 is missing from the row of stream task t")
+	putInjestionCtx(ctx)
+
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		if mstName == "dead_letter" {
+			mi.Schema = nil
+		}
+		return mi, nil
+	}
+	opt.Errors.DeadLetterMst = "dead_letter"
+	setStreamTestOptions(si, opt)
+	all := env.calculate(t, si, rows...)
+	require.Empty(t, rowsOfMst(all, "mst2"))
+	dl := rowsOfMst(all, "dead_letter")
+	require.Len(t, dl, 1)
+	require.Equal(t, deadLetterMissingField, tagValue(dl[0], DeadLetterReasonTag))
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk2": {MissingField: 3}}})
+	require.EqualError(t, err, "the missing field policy 3 of the sum call sum_fk2 of stream task t is unknown")
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"})
+	srcSchema, dstSchema = streamTestSchema(si)
+	srcSchema["fk1"] = influx.Field_Type_String
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"last_fk1": {MissingField: StreamMissingZero}}})
+	require.EqualError(t, err, "the last call last_fk1 of stream task t can not take the missing string field as zero")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// StreamNonFinite is how a task writes the NaN and the infinite results of its calls.
+type StreamNonFinite uint8
+
+const (
+	// StreamNonFiniteDrop drops the fields of the non-finite results, the window is skipped if it has no other field.
+	// The dense layout writes the zeros for them as for the calls without values.
+	StreamNonFiniteDrop StreamNonFinite = iota
+	// StreamNonFiniteZero writes the non-finite results as zeros
+	StreamNonFiniteZero
+	// StreamNonFiniteError fails the calculation of the batch emitting a non-finite result
+	StreamNonFiniteError
+)
+
+func checkNonFinite(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt.Output.NonFinite > StreamNonFiniteError {
+		return fmt.Errorf("the non-finite value policy %d of stream task %s is unknown", opt.Output.NonFinite, info.Name)
+	}
+	return nil
+}
+
+func isNonFinite(v float64) bool {
+	return math.IsNaN(v) || math.IsInf(v, 0)
+}
+
+// nonFiniteError is the error of the non-finite result of the call i emitted by the task.
+func (w *streamTask) nonFiniteError(i int, v float64) error {
+	call := w.calls[i]
+	return fmt.Errorf("the %s call %s of stream task %s emits the non-finite value %v", call.Call, call.Alias, w.info.Name, v)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamNonFinite(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", math.Inf(1))),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", math.Inf(-1))),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 1)),
+	}
+	state := env.pw.getStreamTaskState(si.Name)
+
+	// the NaN sum is dropped by default, the count of the window is written
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 2)
+	for _, r := range out {
+		_, ok := fieldValue(r, "sum_fk1")
+		require.Equal(t, tagValue(r, "tk1") == "b", ok)
+		v, _ := fieldValue(r, "count_fk1")
+		require.Equal(t, map[string]float64{"a": 2, "b": 1}[tagValue(r, "tk1")], v)
+	}
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.nonFiniteValues))
+
+	// the window without any other field is skipped
+	sum := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	require.Len(t, rowsOfMst(env.calculate(t, sum, rows...), "mst2"), 1)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.nonFiniteValues))
+
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{NonFinite: StreamNonFiniteZero}})
+	out = rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 2)
+	for _, r := range out {
+		v, ok := fieldValue(r, "sum_fk1")
+		require.True(t, ok)
+		require.False(t, math.IsNaN(v))
+	}
+	require.Equal(t, int64(3), atomic.LoadInt64(&state.nonFiniteValues))
+
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{NonFinite: StreamNonFiniteError}})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.EqualError(t, err, "the sum call sum_fk1 of stream task t emits the non-finite value NaN")
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{NonFinite: StreamNonFiniteError + 1}})
+	require.EqualError(t, err, "the non-finite value policy 3 of stream task t is unknown")
+}
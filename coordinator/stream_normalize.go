@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+)
+
+// StreamTagNormalization describes how the values of a group by tag are normalized before grouping,
+// the steps are applied in the order of trim, case-fold and alias mapping.
+type StreamTagNormalization struct {
+	Trim      bool
+	LowerCase bool
+	// Aliases maps the variants of a value to its canonical value
+	Aliases map[string]string
+}
+
+type tagNormalizer struct {
+	trim      bool
+	lowerCase bool
+	aliases   map[string]string
+}
+
+func newTagNormalizer(n *StreamTagNormalization) *tagNormalizer {
+	tn := &tagNormalizer{trim: n.Trim, lowerCase: n.LowerCase}
+	if len(n.Aliases) == 0 {
+		return tn
+	}
+	// the variants are matched after trim and case-fold, so normalize them in advance
+	tn.aliases = make(map[string]string, len(n.Aliases))
+	for k, v := range n.Aliases {
+		tn.aliases[tn.fold(k)] = v
+	}
+	return tn
+}
+
+func (tn *tagNormalizer) fold(v string) string {
+	if tn.trim {
+		v = strings.TrimSpace(v)
+	}
+	if tn.lowerCase {
+		v = strings.ToLower(v)
+	}
+	return v
+}
+
+func (tn *tagNormalizer) normalize(v string) string {
+	v = tn.fold(v)
+	if alias, ok := tn.aliases[v]; ok {
+		return alias
+	}
+	return v
+}
+
+// buildTagNormalizers returns the normalizers of the dims, nil if none of the dims is normalized.
+func buildTagNormalizers(dims []string, normalizations map[string]*StreamTagNormalization) []*tagNormalizer {
+	if len(normalizations) == 0 {
+		return nil
+	}
+	normalizers := make([]*tagNormalizer, len(dims))
+	for i := range dims {
+		if n, ok := normalizations[dims[i]]; ok && n != nil {
+			normalizers[i] = newTagNormalizer(n)
+		}
+	}
+	return normalizers
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagNormalizer(t *testing.T) {
+	tn := newTagNormalizer(&StreamTagNormalization{
+		Trim:      true,
+		LowerCase: true,
+		Aliases:   map[string]string{" USE1": "us-east-1"},
+	})
+	require.Equal(t, "us-east-1", tn.normalize("use1 "))
+	require.Equal(t, "us-east-1", tn.normalize("US-East-1"))
+	require.Equal(t, "us-west-1", tn.normalize(" us-west-1"))
+
+	require.Nil(t, buildTagNormalizers([]string{"tk1"}, nil))
+	normalizers := buildTagNormalizers([]string{"tk1", "tk2"}, map[string]*StreamTagNormalization{"tk2": {Trim: true}})
+	require.Nil(t, normalizers[0])
+	require.Equal(t, "a", normalizers[1].normalize(" a "))
+}
+
+func TestStreamTagNormalization(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{
+		Group: StreamGroupOptions{TagNormalizations: map[string]*StreamTagNormalization{
+			"tk1": {Trim: true, LowerCase: true, Aliases: map[string]string{"use1": "us-east-1"}},
+		}},
+	})
+
+	var rows []*influx.Row
+	for i, v := range []string{"us-east-1", "US-EAST-1", "us-east-1 ", "use1", "us-west-1"} {
+		rows = append(rows, newStreamTestRow(env.base+int64(i), []influx.Tag{{Key: "tk1", Value: v}}, floatField("fk1", 1)))
+	}
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 2)
+	require.Equal(t, "us-east-1", tagValue(out[0], "tk1"))
+	v, _ := fieldValue(out[0], "count_fk1")
+	require.Equal(t, float64(4), v)
+	require.Equal(t, "us-west-1", tagValue(out[1], "tk1"))
+}
+
+func TestStreamTagNormalizationSameDistribution(t *testing.T) {
+	env, si, write := newStreamSameShardEnv(t)
+	require.NoError(t, env.pw.SetStreamTaskOptions(si.Name, &StreamTaskOptions{
+		Group: StreamGroupOptions{TagNormalizations: map[string]*StreamTagNormalization{"tk1": {Trim: true, LowerCase: true}}},
+	}))
+
+	// the rows differ by the case and the spaces of tk1 only, so they are in the same group once normalized
+	rows := generateRows(2, make([]influx.Row, 2))
+	rows[1].Tags = append(influx.PointTags{}, rows[0].Tags...)
+	rows[1].Tags[0].Value = " VALUE1 "
+	rows[1].Timestamp = rows[0].Timestamp
+	rows[1].IndexKey = rows[1].UnmarshalIndexKeys(nil)
+	rows[1].ShardKey = rows[1].IndexKey
+	out := write(rows)
+	for _, r := range rowsOfMst(out, "mst0") {
+		require.Empty(t, r.StreamId)
+	}
+	out = rowsOfMst(out, "mst2")
+	require.Len(t, out, 1)
+	require.Equal(t, "value1", tagValue(out[0], "tk1"))
+	sum, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(2), sum)
+}
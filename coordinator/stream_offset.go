@@ -0,0 +1,63 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// streamShifted returns whether the windows of the stream are shifted from the epoch boundaries by an offset
+// or a time zone. The store only aggregates the windows aligned to the epoch, so all the calls of a shifted
+// stream are aggregated by the accumulators of the sql layer.
+func streamShifted(info *meta2.StreamInfo) bool {
+	return info.Offset != 0 || info.TimeZone != ""
+}
+
+// buildStreamWindowOptions returns the options computing the windows of the stream. The windows in a time zone
+// are aligned to the local time, they are longer or shorter than the interval across the daylight saving time changes.
+func buildStreamWindowOptions(info *meta2.StreamInfo) (*query.ProcessorOptions, error) {
+	opt := &query.ProcessorOptions{Interval: hybridqp.Interval{Duration: info.Interval, Offset: info.Offset}}
+	if info.TimeZone == "" {
+		return opt, nil
+	}
+	if streamSliding(info) {
+		return nil, fmt.Errorf("the sliding windows of stream task %s can not be aligned in the time zone %s", info.Name, info.TimeZone)
+	}
+	if streamFills(info) {
+		return nil, fmt.Errorf("the empty windows of stream task %s can not be filled in the time zone %s", info.Name, info.TimeZone)
+	}
+	loc, err := time.LoadLocation(info.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("the time zone %s of stream task %s is unknown: %v", info.TimeZone, info.Name, err)
+	}
+	opt.Location = loc
+	return opt, nil
+}
+
+// windowEnd returns the end time of the window starting at st.
+func (s *streamCtx) windowEnd(si *meta2.StreamInfo, st int64) int64 {
+	if s.opt.Location == nil {
+		return st + int64(si.Interval)
+	}
+	_, end := s.opt.Window(st)
+	return end
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWindowOffset(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Offset = 300 * time.Millisecond
+	require.True(t, streamKeepsState(si))
+
+	ms := int64(time.Millisecond)
+	start := env.base + int64(time.Second) + 300*ms
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(start-1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		// the row on the boundary starts the next window
+		newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(start+999*ms, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+	), "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []struct {
+		ts  int64
+		sum float64
+	}{{start - int64(time.Second), 1}, {start, 6}} {
+		require.Equal(t, exp.ts, out[i].Timestamp)
+		require.False(t, out[i].StreamOnly)
+		v, _ := fieldValue(out[i], "sum_fk1")
+		require.Equal(t, exp.sum, v)
+	}
+}
+
+func TestStreamWindowTimeZone(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Interval = 24 * time.Hour
+	si.TimeZone = "America/New_York"
+	opt, err := buildStreamWindowOptions(si)
+	require.NoError(t, err)
+	ctx := &streamCtx{opt: opt}
+	loc := opt.Location
+
+	// the day of the daylight saving time change is 23 hours long
+	day := time.Date(2024, 3, 10, 0, 0, 0, 0, loc).UnixNano()
+	next := time.Date(2024, 3, 11, 0, 0, 0, 0, loc).UnixNano()
+	require.Equal(t, []int64{day}, ctx.windowStarts(si, time.Date(2024, 3, 10, 12, 0, 0, 0, loc).UnixNano()))
+	require.Equal(t, next, ctx.windowEnd(si, day))
+	require.Equal(t, 23*time.Hour, time.Duration(next-day))
+	require.Equal(t, []int64{day}, ctx.windowStarts(si, next-1))
+	require.Equal(t, []int64{next}, ctx.windowStarts(si, next))
+
+	// the business days start at 08:00 of the time zone
+	si.Offset = 8 * time.Hour
+	opt, err = buildStreamWindowOptions(si)
+	require.NoError(t, err)
+	ctx.opt = opt
+	open := time.Date(2024, 3, 12, 8, 0, 0, 0, loc).UnixNano()
+	require.Equal(t, []int64{open}, ctx.windowStarts(si, open))
+	require.Equal(t, []int64{open - int64(24*time.Hour)}, ctx.windowStarts(si, open-1))
+
+	for tz, msg := range map[string]string{
+		"Mars/Olympus": "the time zone Mars/Olympus of stream task t is unknown",
+		"slide":        "the sliding windows of stream task t can not be aligned in the time zone UTC",
+		"fill":         "the empty windows of stream task t can not be filled in the time zone UTC",
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.TimeZone = tz
+		switch tz {
+		case "slide":
+			si.TimeZone, si.Slide = "UTC", si.Interval/2
+		case "fill":
+			si.TimeZone, si.Fill = "UTC", influxql.PreviousFill
+		}
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+		require.ErrorContains(t, err, msg)
+	}
+}
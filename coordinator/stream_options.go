@@ -0,0 +1,312 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+var defaultStreamTaskOptions = &StreamTaskOptions{}
+
+// defaultStreamBackfillChunk bounds the rows of the source read at once by BackfillStream
+const defaultStreamBackfillChunk = time.Hour
+
+// StreamTaskOptions holds the settings of a stream task that belong to the sql layer calculation,
+// which are stored in meta as the JSON options of the stream.
+type StreamTaskOptions struct {
+	Window StreamWindowOptions
+	Group  StreamGroupOptions
+	Output StreamOutputOptions
+	Errors StreamErrorOptions
+	Limits StreamLimitOptions
+
+	// CallOptions holds the parameters of the calls, keyed by the alias of the call
+	CallOptions map[string]*StreamCallOptions
+}
+
+// StreamWindowOptions are how the rows are assigned to the windows.
+type StreamWindowOptions struct {
+	// AllowedLateness drops the rows whose windows end before the watermark by more than it, 0 accepts any row
+	AllowedLateness time.Duration
+	// WindowTime is the time the rows are windowed by, the timestamps of the rows by default
+	WindowTime StreamWindowTime
+	// InputPrecision is the line protocol precision of the source timestamps, empty means nanoseconds
+	InputPrecision string
+	// WarmUp suppresses the windows ending within WarmUpPeriod of the first batch, 0 means the interval
+	WarmUp       bool
+	WarmUpPeriod time.Duration
+	// Dedup aggregates the latest row of the rows of the same point only
+	Dedup bool
+	// StartField is the integer field holding the start time of the window on the rows of the destinations,
+	// empty means the field is not written
+	StartField string
+}
+
+// StreamGroupOptions are how the rows are grouped and which rows feed the task.
+type StreamGroupOptions struct {
+	// UnionMsts are the other source measurements of the task, SourceTag carries the measurement of the rows
+	UnionMsts []string
+	SourceTag string
+	// SourceRPTag carries the source retention policy of the rows of the streams with SrcRPs
+	SourceRPTag string
+	// SourceShards are the only source shards aggregated, SourceShardTag carries the source shard of the windows
+	SourceShards   []uint64
+	SourceShardTag string
+	// TagNormalizations normalizes the values of the group by tags, keyed by tag key
+	TagNormalizations map[string]*StreamTagNormalization
+	// GroupByFields groups the rows by the dims which are the fields of the source as well
+	GroupByFields bool
+	// GroupOnlyDims are the tag dims grouping the rows but omitted from the windows written
+	GroupOnlyDims []string
+	// MissingDims is how the rows missing a dim are grouped, MissingDimValue is the value of the dims grouped apart
+	MissingDims     StreamMissingDim
+	MissingDimValue string
+	// BucketDims are replaced by BucketTag holding the hash bucket of their values in [0, BucketCount)
+	BucketDims  []string
+	BucketCount int
+	BucketTag   string
+	// DimFields are the dims written as the fields of the windows instead of the tags, keyed by dim
+	DimFields map[string]StreamDimField
+	// Separator is the byte separating the values in the group keys, which is the null byte by default.
+	// It must be a control byte never in the tag values of the source, as they are escaped otherwise.
+	Separator string
+}
+
+// StreamOutputOptions are how the windows are written.
+type StreamOutputOptions struct {
+	// AliasPrefix and AliasSuffix are added to the aliases of the calls to get the fields of the destinations
+	AliasPrefix string
+	AliasSuffix string
+	// CreateDestination creates the databases and the retention policies of the destinations if they are missing
+	CreateDestination bool
+	// FanOutMst is the template of the measurement each call is written to, "{mst}" and "{alias}" are replaced
+	FanOutMst string
+	// SafeMode drops the rows violating the schema of the destination
+	SafeMode bool
+	// ReorderBufferSize holds the rows of the task across the batches to deliver them in time order, the rows are
+	// held until the watermark of the task passes them by ReorderLateness
+	ReorderBufferSize int
+	ReorderLateness   time.Duration
+	// PartialField marks the windows written by FlushStreams before they are complete
+	PartialField string
+	// CompleteField marks whether the windows are complete, empty means no mark
+	CompleteField string
+	// SampleCountField is the integer field counting the rows of the windows
+	SampleCountField string
+	// DenseFields writes every call of the windows, the calls without values as zeros
+	DenseFields bool
+	// WidenFieldTypes writes the integer results to the float fields of the destination
+	WidenFieldTypes bool
+	// NonFinite is how the NaN and the infinite results are written, EmptyWindows how the windows without values are
+	NonFinite    StreamNonFinite
+	EmptyWindows StreamEmptyWindow
+	// FlushJitter holds the rows until the flush times of the task offset by the hash of its name
+	FlushJitter bool
+	// EmitRowsPerSecond and EmitBytesPerSecond limit the rows emitted, holding up to ThrottleRows over the rates
+	EmitRowsPerSecond  int
+	EmitBytesPerSecond int64
+	ThrottleRows       int
+}
+
+// outputAlias returns the field of the destinations the call of the alias is written to.
+func outputAlias(opt *StreamTaskOptions, alias string) string {
+	if opt == nil {
+		return alias
+	}
+	return opt.Output.AliasPrefix + alias + opt.Output.AliasSuffix
+}
+
+// StreamErrorOptions are how the failures of the task are handled.
+type StreamErrorOptions struct {
+	// DeadLetterMst is the measurement of the destination receiving the rows rejected by the task
+	DeadLetterMst string
+	// ErrorActions overrides how the errors are handled, keyed by errno
+	ErrorActions map[errno.Errno]StreamErrorAction
+	// WriteRetries bounds the retries of the transient write errors, SpillRows the rows kept after them
+	WriteRetries int
+	SpillRows    int
+	// BreakerFailures opens the breaker after as many consecutive failed writes for BreakerCooldown
+	BreakerFailures int
+	BreakerCooldown time.Duration
+}
+
+// StreamLimitOptions bound the resources of the task, 0 means no limit.
+type StreamLimitOptions struct {
+	// MaxGroupWindows bounds the open windows of a group in a batch
+	MaxGroupWindows int
+	// MaxGroups and MaxGroupBytes bound the groups of a batch, FlushOnGroupLimit emits them early once reached
+	MaxGroups         int
+	MaxGroupBytes     int64
+	FlushOnGroupLimit bool
+	// FlushGroupPoints emits the windows of a group early once it has the points in a batch
+	FlushGroupPoints int
+	// SpillDir is where the groups over the limits are spilled, up to MaxSpillBytes encoded by the registered SpillCodec
+	SpillDir      string
+	MaxSpillBytes int64
+	SpillCodec    string
+	// Workers is the number of the goroutines aggregating a batch
+	Workers int
+	// MaxDimValueLength is the length of the dim values assumed by the check of the shard keys
+	MaxDimValueLength int
+	// MaxInterval bounds the interval of the task
+	MaxInterval time.Duration
+	// MinRetentionWindows and MaxShardWindows bound the windows kept by the destination and its shard groups
+	MinRetentionWindows int
+	MaxShardWindows     int
+	// BackfillChunk is the length of the time ranges read at once by BackfillStream, defaultStreamBackfillChunk if not set
+	BackfillChunk time.Duration
+	// WriteTimeout overrides the timeout of writing the rows of the task to the store, zero means the default
+	WriteTimeout time.Duration
+}
+
+// StreamCallOptions holds the parameters of a call of the stream task.
+type StreamCallOptions struct {
+	// SubInterval is the length of the sub-intervals of the window checked by the coverage call
+	SubInterval time.Duration
+	// TWABoundary is how the twa call weights the values at the edges of the window
+	TWABoundary StreamTWABoundary
+	// CarryForward carries the value of a last call into the empty windows within it after the value
+	CarryForward time.Duration
+	// MissingField is how the call handles the rows without its field, they are skipped by default
+	MissingField StreamMissingField
+	// IntCoercion is how the float results are written to an integer field of the destination
+	IntCoercion StreamIntCoercion
+	// Delta writes the results as the deltas from the previous windows, ClampNegativeDelta writes the negative ones as 0
+	Delta              StreamDelta
+	ClampNegativeDelta bool
+	// IntSum sums the integer field as integers, IntOverflow is how the overflowing sums are handled
+	IntSum      bool
+	IntOverflow StreamIntOverflow
+	// IntMinMax selects the min or the max of the integer field as integers
+	IntMinMax bool
+}
+
+// ParseStreamTaskOptions decodes the options of a stream task from JSON, rejecting the unknown options.
+func ParseStreamTaskOptions(data []byte) (*StreamTaskOptions, error) {
+	opt := &StreamTaskOptions{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(opt); err != nil {
+		return nil, fmt.Errorf("invalid stream task options: %v", err)
+	}
+	return opt, nil
+}
+
+// streamTaskOption is the options decoded from the options of a stream stored in meta.
+type streamTaskOption struct {
+	raw string
+	opt *StreamTaskOptions
+}
+
+// streamTaskOptionsMap caches the options of the stream tasks decoded from meta, keyed by stream name.
+type streamTaskOptionsMap struct {
+	mu   sync.RWMutex
+	opts map[string]streamTaskOption
+}
+
+// get returns the options of the stream, which are decoded again only once they are changed in meta.
+func (m *streamTaskOptionsMap) get(si *meta2.StreamInfo, logger *logger.Logger) *StreamTaskOptions {
+	if si.Options == "" {
+		return defaultStreamTaskOptions
+	}
+	m.mu.RLock()
+	o, ok := m.opts[si.Name]
+	m.mu.RUnlock()
+	if ok && o.raw == si.Options {
+		return o.opt
+	}
+
+	opt, err := ParseStreamTaskOptions([]byte(si.Options))
+	if err != nil {
+		logger.Error("stream task runs with the default options", zap.String("stream", si.Name), zap.Error(err))
+		opt = defaultStreamTaskOptions
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.opts == nil {
+		m.opts = make(map[string]streamTaskOption)
+	}
+	m.opts[si.Name] = streamTaskOption{raw: si.Options, opt: opt}
+	return opt
+}
+
+// SetStreamTaskOptions checks the options of the stream task and stores them in meta, the task is rebuilt with them
+// by the next write. nil restores the default options.
+func (w *PointsWriter) SetStreamTaskOptions(name string, opt *StreamTaskOptions) error {
+	si, ok := w.MetaClient.GetStreamInfos()[name]
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	var options string
+	if opt != nil {
+		if err := w.checkStreamTaskOptions(si, opt); err != nil {
+			return err
+		}
+		data, err := json.Marshal(opt)
+		if err != nil {
+			return err
+		}
+		options = string(data)
+	}
+	if opt != nil && len(opt.Group.SourceShards) > 0 {
+		w.logger.Info("stream task only aggregates the rows of the source shards", zap.String("stream", name), zap.Uint64s("shards", opt.Group.SourceShards))
+	}
+	return w.MetaClient.SetStreamOptions(name, options)
+}
+
+// SetStreamOptions sets the options of the stream task decoded from JSON, empty restores the default options.
+func (w *PointsWriter) SetStreamOptions(name string, data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return w.SetStreamTaskOptions(name, nil)
+	}
+	opt, err := ParseStreamTaskOptions(data)
+	if err != nil {
+		return err
+	}
+	return w.SetStreamTaskOptions(name, opt)
+}
+
+// checkStreamTaskOptions builds the task of the stream with the options against the current schemas of the source
+// and the destination, the destination missing yet has no fields, and checks the windows against the retention
+// policies of the destinations.
+func (w *PointsWriter) checkStreamTaskOptions(si *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	srcMst, err := w.MetaClient.Measurement(si.SrcMst.Database, si.SrcMst.RetentionPolicy, si.SrcMst.Name)
+	if err != nil {
+		return err
+	}
+	var dstSchema map[string]int32
+	if dstMst, err := w.MetaClient.Measurement(si.DesMst.Database, si.DesMst.RetentionPolicy, si.DesMst.Name); err == nil {
+		dstSchema = dstMst.Schema
+	}
+	if _, err = newStreamTask(si, srcMst.Schema, dstSchema, opt); err != nil {
+		return err
+	}
+	return CheckStreamDestinations(w.MetaClient, si, opt)
+}
+
+func (w *PointsWriter) getStreamTaskOptions(si *meta2.StreamInfo) *StreamTaskOptions {
+	return w.streamTaskOptions.get(si, w.logger)
+}
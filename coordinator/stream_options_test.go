@@ -0,0 +1,234 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// streamDataMetaClient keeps the streams in the meta data, which reach the writer through their protobuf.
+type streamDataMetaClient struct {
+	*MockMetaClient
+	data *meta2.Data
+}
+
+func (m *streamDataMetaClient) GetStreamInfos() map[string]*meta2.StreamInfo {
+	infos := make(map[string]*meta2.StreamInfo, len(m.data.Streams))
+	for name, si := range m.data.Streams {
+		synced := &meta2.StreamInfo{}
+		synced.Unmarshal(si.Marshal())
+		infos[name] = synced
+	}
+	return infos
+}
+
+func (m *streamDataMetaClient) GetDstStreamInfos(db, rp string, dstSis *[]*meta2.StreamInfo) bool {
+	*dstSis = (*dstSis)[:0]
+	for _, si := range m.GetStreamInfos() {
+		if si.SrcMst.Database == db && si.HasSourceRP(rp) {
+			*dstSis = append(*dstSis, si)
+		}
+	}
+	return len(*dstSis) > 0
+}
+
+func (m *streamDataMetaClient) SetStreamOptions(name, options string) error {
+	si, ok := m.data.Streams[name]
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	pb := si.Marshal()
+	pb.Options = proto.String(options)
+	info := &meta2.StreamInfo{}
+	info.Unmarshal(pb)
+	return m.data.SetStream(info)
+}
+
+func (m *streamDataMetaClient) PauseStream(name string, paused bool) error {
+	si, ok := m.data.Streams[name]
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	pb := si.Marshal()
+	pb.Paused = proto.Bool(paused)
+	info := &meta2.StreamInfo{}
+	info.Unmarshal(pb)
+	return m.data.SetStream(info)
+}
+
+func TestStreamTaskOptionsFromMeta(t *testing.T) {
+	env := newStreamTestEnv()
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	si := mc.GetStreamInfos()["t"]
+	data := &meta2.Data{Streams: map[string]*meta2.StreamInfo{si.Name: si}, MaxStreamID: si.ID + 1}
+	env.pw.MetaClient = &streamDataMetaClient{MockMetaClient: mc, data: data}
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	write := func() []*influx.Row {
+		written = written[:0]
+		require.NoError(t, env.pw.RetryWritePointRows("db0", "rp0", generateRows(1, make([]influx.Row, 1))))
+		return rowsOfMst(written, "mst2")
+	}
+
+	out := write()
+	require.Len(t, out, 1)
+	_, ok := fieldValue(out[0], "samples")
+	require.False(t, ok)
+
+	// the options stored in meta are taken by the next write, and the stream keeps its ID
+	require.NoError(t, env.pw.SetStreamTaskOptions(si.Name, &StreamTaskOptions{Output: StreamOutputOptions{SampleCountField: "samples"}}))
+	require.Equal(t, si.ID, data.Streams[si.Name].ID)
+	out = write()
+	require.Len(t, out, 1)
+	samples, ok := fieldValue(out[0], "samples")
+	require.True(t, ok)
+	require.Equal(t, float64(1), samples)
+
+	// the invalid options are rejected before they are stored
+	err := env.pw.SetStreamOptions(si.Name, []byte(`{"Output":{"NonFinite":9}}`))
+	require.EqualError(t, err, "the non-finite value policy 9 of stream task t is unknown")
+	require.EqualError(t, env.pw.SetStreamOptions(si.Name, []byte(`{"Unknown":1}`)),
+		`invalid stream task options: json: unknown field "Unknown"`)
+	require.True(t, errno.Equal(env.pw.SetStreamOptions("none", nil), errno.StreamNotFound))
+
+	// the empty options restore the defaults
+	require.NoError(t, env.pw.SetStreamOptions(si.Name, nil))
+	require.Empty(t, data.Streams[si.Name].Options)
+	out = write()
+	require.Len(t, out, 1)
+	_, ok = fieldValue(out[0], "samples")
+	require.False(t, ok)
+}
+
+func TestStreamTaskOptionsInvalidInMeta(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo()
+	si.Options = "{"
+	require.Equal(t, defaultStreamTaskOptions, env.pw.getStreamTaskOptions(si))
+}
+
+func TestStreamNeedsSQLLayer(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	require.False(t, streamNeedsSQLLayer(si, defaultStreamTaskOptions))
+	require.False(t, streamNeedsSQLLayer(si, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{}}, CallOptions: map[string]*StreamCallOptions{}}))
+
+	// every option set but the checks of the creation of the task is calculated at the sql layer
+	creation := map[string]bool{"Workers": true, "MaxDimValueLength": true, "MaxInterval": true, "MinRetentionWindows": true,
+		"MaxShardWindows": true, "BackfillChunk": true}
+	opt := reflect.ValueOf(&StreamTaskOptions{}).Elem()
+	for i := 0; i < opt.NumField(); i++ {
+		group := opt.Field(i)
+		if group.Kind() != reflect.Struct {
+			continue
+		}
+		for j := 0; j < group.NumField(); j++ {
+			set := &StreamTaskOptions{}
+			f := reflect.ValueOf(set).Elem().Field(i).Field(j)
+			switch f.Kind() {
+			case reflect.Bool:
+				f.SetBool(true)
+			case reflect.String:
+				f.SetString("x")
+			case reflect.Int, reflect.Int64:
+				f.SetInt(1)
+			case reflect.Uint8:
+				f.SetUint(1)
+			case reflect.Slice:
+				f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+			case reflect.Map:
+				f.Set(reflect.MakeMap(f.Type()))
+				f.SetMapIndex(reflect.New(f.Type().Key()).Elem(), reflect.New(f.Type().Elem()).Elem())
+			default:
+				t.Fatalf("the option %s is not set by the test", group.Type().Field(j).Name)
+			}
+			name := group.Type().Field(j).Name
+			require.Equal(t, !creation[name], streamNeedsSQLLayer(si, set), name)
+		}
+	}
+	require.True(t, streamNeedsSQLLayer(si, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"sum_fk1": {IntSum: true}}}))
+}
+
+// newStreamSameShardEnv returns the env whose stream t shares the shards of its source and is kept in the meta data,
+// and the writer of the rows to db0.rp0 returning every row written to the store.
+func newStreamSameShardEnv(t *testing.T) (*streamTestEnv, *meta2.StreamInfo, func([]influx.Row) []*influx.Row) {
+	env := newStreamTestEnv()
+	// the source and the destination share the shards by the shard key of the database
+	streamDistribution = sameShard
+	mc := NewMockMetaClient()
+	si := mc.GetStreamInfos()["t"]
+	si.Dims = []string{"tk1", "tk2"}
+	data := &meta2.Data{Streams: map[string]*meta2.StreamInfo{si.Name: si}, MaxStreamID: si.ID + 1}
+	env.pw.MetaClient = &streamDataMetaClient{MockMetaClient: mc, data: data}
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	return env, si, func(rows []influx.Row) []*influx.Row {
+		written = written[:0]
+		require.NoError(t, env.pw.RetryWritePointRows("db0", "rp0", rows))
+		return written
+	}
+}
+
+func TestStreamOptionsSameDistribution(t *testing.T) {
+	env, si, write := newStreamSameShardEnv(t)
+
+	// the raw rows are marked for the store to aggregate
+	out := write(generateRows(1, make([]influx.Row, 1)))
+	require.Empty(t, rowsOfMst(out, "mst2"))
+	require.Equal(t, []uint64{si.ID}, rowsOfMst(out, "mst0")[0].StreamId)
+
+	// the rows of the task with any option are calculated at the sql layer instead
+	require.NoError(t, env.pw.SetStreamTaskOptions(si.Name, &StreamTaskOptions{Limits: StreamLimitOptions{FlushGroupPoints: 4}}))
+	out = write(generateRows(1, make([]influx.Row, 1)))
+	require.Empty(t, rowsOfMst(out, "mst0")[0].StreamId)
+	out = rowsOfMst(out, "mst2")
+	require.Len(t, out, 1)
+	sum, ok := fieldValue(out[0], "sum_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(1), sum)
+}
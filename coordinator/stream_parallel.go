@@ -0,0 +1,141 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamWorkerRow is a row aggregated by a worker with its group key.
+type streamWorkerRow struct {
+	row      *influx.Row
+	groupKey string
+}
+
+// streamWorkerRows are the rows of the workers, the rows of a group always go to the same worker.
+type streamWorkerRows [][]streamWorkerRow
+
+func (w streamWorkerRows) add(r *influx.Row, groupKey string) {
+	i := xxhash.Sum64String(groupKey) % uint64(len(w))
+	w[i] = append(w[i], streamWorkerRow{row: r, groupKey: groupKey})
+}
+
+func (w streamWorkerRows) reset() {
+	for i := range w {
+		w[i] = w[i][:0]
+	}
+}
+
+// parallel returns whether the rows of a batch are aggregated by the workers.
+// The accumulators are shared by the batches of the task, so the task with accumulator calls is never parallel.
+func (w *streamTask) parallel() bool {
+	return w.opt.Limits.Workers > 1 && w.accCalls == nil
+}
+
+// aggregateParallel aggregates the rows of each worker in its own goroutine, the windows of the workers
+// are merged into the context then. Each worker owns the windows of a disjoint set of groups.
+func (s *Stream) aggregateParallel(workers streamWorkerRows, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) {
+	ctxs := make([]*streamCtx, len(workers))
+	var wg sync.WaitGroup
+	for i := range workers {
+		if len(workers[i]) == 0 {
+			continue
+		}
+		wCtx := GetStreamCtx()
+		if wCtx.dataCache == nil {
+			wCtx.dataCache = make(map[string]map[int64][]*float64)
+		}
+		wCtx.opt = ctx.opt
+		wCtx.taskOpt = ctx.taskOpt
+		wCtx.state = ctx.state
+		wCtx.backfill = ctx.backfill
+		wCtx.windowsFrom, wCtx.windowsTo = ctx.windowsFrom, ctx.windowsTo
+		ctxs[i] = wCtx
+
+		wg.Add(1)
+		go func(rows []streamWorkerRow) {
+			defer wg.Done()
+			for _, wr := range rows {
+				s.addToWindows(wr.row, si, task, wCtx, wr.groupKey, wCtx.windowStarts(si, wr.row.Timestamp))
+				wCtx.countGroupPoint(task, wr.groupKey)
+			}
+		}(workers[i])
+	}
+	wg.Wait()
+
+	for _, wCtx := range ctxs {
+		if wCtx == nil {
+			continue
+		}
+		// the exact counts are kept with the slots, which are moved or merged into the context
+		for slot, n := range wCtx.intResults {
+			if ctx.intResults == nil {
+				ctx.intResults = make(map[*float64]int64)
+			}
+			ctx.intResults[slot] = n
+		}
+		// the points of the groups are counted by their workers, the groups of the workers are disjoint
+		for groupKey, n := range wCtx.groupPoints {
+			if ctx.groupPoints == nil {
+				ctx.groupPoints = make(map[string]int)
+			}
+			ctx.groupPoints[groupKey] = n
+		}
+		ctx.mergeWindows(task, ctx.dataCache, wCtx.dataCache)
+		if wCtx.closedCache != nil {
+			if ctx.closedCache == nil {
+				ctx.closedCache = make(map[string]map[int64][]*float64)
+			}
+			ctx.mergeWindows(task, ctx.closedCache, wCtx.closedCache)
+		}
+		// the windows are owned by the context now, the reset allocates new ones for the worker context
+		PutStreamCtx(wCtx)
+	}
+}
+
+// mergeWindows merges the windows of src into dst, the values of the same window are merged by the calls.
+func (s *streamCtx) mergeWindows(task *streamTask, dst, src map[string]map[int64][]*float64) {
+	for k, tv := range src {
+		dv, ok := dst[k]
+		if !ok {
+			dst[k] = tv
+			continue
+		}
+		for t, v := range tv {
+			d, ok := dv[t]
+			if !ok {
+				dv[t] = v
+				continue
+			}
+			for i := range v {
+				switch {
+				case v[i] == nil:
+				case d[i] == nil:
+					d[i] = v[i]
+				case task.calls[i].Call == "count":
+					s.mergeCount(d[i], v[i])
+				default:
+					*d[i] = task.calls[i].MergeFunc(*d[i], *v[i])
+				}
+			}
+		}
+	}
+}
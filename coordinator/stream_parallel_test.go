@@ -0,0 +1,83 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamParallel(t *testing.T) {
+	calls := []*meta2.StreamCall{
+		{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		{Call: "count", Field: "fk1", Alias: "count_fk1"},
+		{Call: "min", Field: "fk1", Alias: "min_fk1"},
+		{Call: "max", Field: "fk1", Alias: "max_fk1"},
+	}
+	env := newStreamTestEnv()
+	var rows []*influx.Row
+	for i := 0; i < 600; i++ {
+		ts := env.base + int64(i%3)*int64(time.Second) + int64(i)
+		rows = append(rows, newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: fmt.Sprintf("g%d", i%50)}}, floatField("fk1", float64(i))))
+	}
+	windows := func(workers, maxGroupWindows int) map[string]float64 {
+		si := newStreamTestInfo(calls...)
+		si.Name = fmt.Sprintf("parallel_%d_%d", workers, maxGroupWindows)
+		setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroupWindows: maxGroupWindows, Workers: workers}})
+		m := map[string]float64{}
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			for _, c := range calls {
+				v, ok := fieldValue(r, c.Alias)
+				require.True(t, ok)
+				m[fmt.Sprintf("%s/%d/%s", tagValue(r, "tk1"), r.Timestamp, c.Alias)] = v
+			}
+		}
+		return m
+	}
+
+	exp := windows(0, 0)
+	require.Len(t, exp, 50*3*len(calls))
+	require.Equal(t, exp, windows(4, 0))
+	require.Equal(t, exp, windows(64, 0))
+	// the windows closed by the limit are merged as well
+	exp = windows(0, 2)
+	require.Equal(t, exp, windows(4, 2))
+}
+
+func TestStreamMergeWindows(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "min", Field: "fk1", Alias: "min_fk1"})
+	src, dst := streamTestSchema(si)
+	task, err := newStreamTask(si, src, dst, nil)
+	require.NoError(t, err)
+	f := func(v float64) *float64 { return &v }
+
+	windows := map[string]map[int64][]*float64{"a": {1: {f(1), nil}}}
+	(&streamCtx{}).mergeWindows(task, windows, map[string]map[int64][]*float64{
+		"a": {1: {f(2), f(3)}, 2: {f(4), f(4)}},
+		"b": {1: {nil, f(5)}},
+	})
+	require.Equal(t, map[string]map[int64][]*float64{
+		"a": {1: {f(3), f(3)}, 2: {f(4), f(4)}},
+		"b": {1: {nil, f(5)}},
+	}, windows)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamPassthrough returns whether the stream copies the rows instead of aggregating them, which is true for the
+// streams without calls. The rows matching the condition of the stream are copied to the destination with all
+// their tags and fields at their own timestamps, they are never windowed.
+func streamPassthrough(info *meta2.StreamInfo) bool {
+	return len(info.Calls) == 0
+}
+
+// checkStreamPassthrough rejects the settings of the windows on the stream copying the rows.
+func checkStreamPassthrough(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if !streamPassthrough(info) {
+		return nil
+	}
+	if streamSliding(info) || streamFills(info) || streamStampsWindows(opt) {
+		return fmt.Errorf("stream task %s without calls copies the rows, which can not be slid, filled or stamped by windows", info.Name)
+	}
+	return nil
+}
+
+// buildCopyNormalizers returns the normalizers of the tags of the copied rows keyed by tag key,
+// nil if no tag is normalized.
+func buildCopyNormalizers(normalizations map[string]*StreamTagNormalization) map[string]*tagNormalizer {
+	var normalizers map[string]*tagNormalizer
+	for k, n := range normalizations {
+		if n == nil {
+			continue
+		}
+		if normalizers == nil {
+			normalizers = make(map[string]*tagNormalizer, len(normalizations))
+		}
+		normalizers[k] = newTagNormalizer(n)
+	}
+	return normalizers
+}
+
+// copyRows writes the copies of the rows matching the condition of the task to the destination as normal writes,
+// each copy is counted as a window emitted. The values of the tags are rewritten by the normalizations of the task.
+func (s *Stream) copyRows(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, ctx *streamCtx, iCtx *injestionCtx) error {
+	wCtx, err := s.newStreamWriteCtx(pw, si.DesMst.Database, si.DesMst.RetentionPolicy)
+	if err != nil {
+		return err
+	}
+	defer PutStreamCtx(wCtx)
+	wCtx.taskOpt = task.opt
+
+	var copied int64
+	for _, r := range rows {
+		if ctx.backfill && (r.Timestamp < ctx.startTime || r.Timestamp >= ctx.endTime) {
+			continue
+		}
+		if task.filter != nil && !task.filter(r) {
+			continue
+		}
+		c := task.copyRow(r)
+		err, pErr := s.mapWriteRow(wCtx, iCtx, si.DesMst.Name, c, nil)
+		if err != nil {
+			return err
+		}
+		if pErr != nil {
+			ctx.addPartialError(pErr)
+			continue
+		}
+		copied++
+		ctx.addWindowEmitted()
+		iCtx.addStreamWritten(ctx.state.stats, c)
+	}
+	ctx.state.stats.AddRowsAggregated(copied)
+	return nil
+}
+
+// copyRow returns the copy of the row with the normalized tags, the row itself is written to its source as well.
+func (w *streamTask) copyRow(r *influx.Row) *influx.Row {
+	c := &influx.Row{Timestamp: r.Timestamp}
+	c.Tags = append(c.Tags, r.Tags...)
+	for i := range c.Tags {
+		if n, ok := w.copyNormalizers[c.Tags[i].Key]; ok {
+			c.Tags[i].Value = n.normalize(c.Tags[i].Value)
+		}
+	}
+	c.Fields = append(c.Fields, r.Fields...)
+	buildColumnToIndex(c)
+	return c
+}
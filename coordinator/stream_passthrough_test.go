@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamPassthrough(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo()
+	si.Name = "passthrough_task"
+	si.Condition = influxql.MustParseExpr("tk2 != 'skip'")
+	require.True(t, streamPassthrough(si))
+	setStreamTestOptions(si, &StreamTaskOptions{
+		Group: StreamGroupOptions{TagNormalizations: map[string]*StreamTagNormalization{"tk1": {LowerCase: true}}},
+	})
+
+	// the rows are copied at their own timestamps, which are not truncated to the windows
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "A"}, {Key: "tk2", Value: "x"}}, floatField("fk1", 1), influx.Field{Key: "fk2", NumValue: 2, Type: influx.Field_Type_Int}),
+		newStreamTestRow(env.base+2, []influx.Tag{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "skip"}}, floatField("fk1", 3)),
+		newStreamTestRow(env.base+3, []influx.Tag{{Key: "tk1", Value: "b"}, {Key: "tk2", Value: "y"}}, floatField("fk1", 5)),
+	), "mst2")
+	require.Len(t, out, 2)
+	for i, exp := range []struct {
+		ts       int64
+		tk1, tk2 string
+		fk1      float64
+	}{{env.base + 1, "a", "x", 1}, {env.base + 3, "b", "y", 5}} {
+		require.Equal(t, exp.ts, out[i].Timestamp)
+		require.False(t, out[i].StreamOnly)
+		require.Equal(t, exp.tk1, tagValue(out[i], "tk1"))
+		require.Equal(t, exp.tk2, tagValue(out[i], "tk2"))
+		v, ok := fieldValue(out[i], "fk1")
+		require.True(t, ok)
+		require.Equal(t, exp.fk1, v)
+	}
+	v, ok := fieldValue(out[0], "fk2")
+	require.True(t, ok)
+	require.Equal(t, float64(2), v)
+	require.Equal(t, int64(2), statistics.StreamTaskStat.Task(si.Name).WindowsEmitted)
+}
+
+func TestStreamPassthroughWindows(t *testing.T) {
+	for name, set := range map[string]func(si *meta2.StreamInfo) *StreamTaskOptions{
+		"slide": func(si *meta2.StreamInfo) *StreamTaskOptions { si.Slide = si.Interval / 2; return nil },
+		"fill":  func(si *meta2.StreamInfo) *StreamTaskOptions { si.Fill = influxql.PreviousFill; return nil },
+		"window": func(si *meta2.StreamInfo) *StreamTaskOptions {
+			return &StreamTaskOptions{Window: StreamWindowOptions{StartField: "window_start"}}
+		},
+	} {
+		si := newStreamTestInfo()
+		opt := set(si)
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		require.ErrorContains(t, err, "stream task t without calls copies the rows", name)
+	}
+}
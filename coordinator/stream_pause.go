@@ -0,0 +1,51 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// PauseStream pauses or resumes the stream task in meta, which is taken by the next write. Pausing the paused
+// task or resuming the running one changes nothing.
+func (w *PointsWriter) PauseStream(name string, paused bool) error {
+	return w.MetaClient.PauseStream(name, paused)
+}
+
+// streamPaused returns whether the stream task is paused and records the state in the statistics of the task.
+// The rows of a paused task are dropped, and so are its windows, so the task resumes with fresh windows.
+func (w *PointsWriter) streamPaused(si *meta2.StreamInfo, rows int) bool {
+	stats := statistics.StreamTaskStat.Task(si.Name)
+	stats.SetPaused(si.Paused)
+	if !si.Paused {
+		return false
+	}
+	w.streamTaskStates.drop(si.Name)
+	stats.AddPausedRows(int64(rows))
+	return true
+}
+
+// shardRowsLen returns the number of the rows of the shards.
+func shardRowsLen(shardIdRowMap map[uint64]*[]*influx.Row) int {
+	n := 0
+	for _, rs := range shardIdRowMap {
+		n += len(*rs)
+	}
+	return n
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamPause(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_fk1"})
+	si.Name = "pause_task"
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	mean := func(rows []*influx.Row) float64 {
+		out := rowsOfMst(rows, "mst2")
+		require.Len(t, out, 1)
+		v, _ := fieldValue(out[0], "mean_fk1")
+		return v
+	}
+	require.Equal(t, float64(1), mean(env.calculate(t, si, row(1))))
+	stats := env.pw.getStreamTaskState(si.Name).stats
+
+	// the rows of the paused task are dropped with its windows
+	si.Paused = true
+	require.Empty(t, env.calculate(t, si, row(3), row(5)))
+	require.Equal(t, int64(1), atomic.LoadInt64(&stats.Paused))
+	require.Equal(t, int64(2), atomic.LoadInt64(&stats.PausedRows))
+	_, ok := env.pw.streamTaskStates.load(si.Name)
+	require.False(t, ok)
+
+	// the resumed task starts with fresh windows
+	si.Paused = false
+	require.Equal(t, float64(7), mean(env.calculate(t, si, row(7))))
+	require.Equal(t, int64(0), atomic.LoadInt64(&stats.Paused))
+	require.True(t, stats == env.pw.getStreamTaskState(si.Name).stats)
+}
+
+func TestPauseStreamFromMeta(t *testing.T) {
+	env := newStreamTestEnv()
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	si := mc.GetStreamInfos()["t"]
+	data := &meta2.Data{Streams: map[string]*meta2.StreamInfo{si.Name: si}, MaxStreamID: si.ID + 1}
+	env.pw.MetaClient = &streamDataMetaClient{MockMetaClient: mc, data: data}
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	write := func() []*influx.Row {
+		written = written[:0]
+		require.NoError(t, env.pw.RetryWritePointRows("db0", "rp0", generateRows(1, make([]influx.Row, 1))))
+		return rowsOfMst(written, "mst2")
+	}
+	require.Len(t, write(), 1)
+
+	// the paused stream keeps its ID, and pausing it again changes nothing
+	require.NoError(t, env.pw.PauseStream(si.Name, true))
+	require.NoError(t, env.pw.PauseStream(si.Name, true))
+	require.True(t, data.Streams[si.Name].Paused)
+	require.Equal(t, si.ID, data.Streams[si.Name].ID)
+	require.Equal(t, si.ID+1, data.MaxStreamID)
+	require.Empty(t, write())
+
+	require.NoError(t, env.pw.PauseStream(si.Name, false))
+	require.Equal(t, si.ID, data.Streams[si.Name].ID)
+	require.Len(t, write(), 1)
+	require.True(t, errno.Equal(env.pw.PauseStream("none", true), errno.StreamNotFound))
+}
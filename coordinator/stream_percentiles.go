@@ -0,0 +1,176 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"strconv"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// percentilesCall estimates each of the quantiles given as the args by a single sketch of the values of the window.
+const percentilesCall = "percentiles"
+
+// percentileAlias returns the alias of the quantile of the percentiles call, like "latency_p99.9".
+func percentileAlias(alias, quantile string) string {
+	return alias + "_p" + quantile
+}
+
+// expandPercentiles returns the stream with every percentiles call replaced by a percentile call per quantile,
+// each of which writes a field of its own. The quantiles are normalized in the aliases, so "90.0" writes p90.
+func expandPercentiles(info *meta2.StreamInfo) (*meta2.StreamInfo, error) {
+	var calls []*meta2.StreamCall
+	for i, c := range info.Calls {
+		if c.Call != percentilesCall {
+			if calls != nil {
+				calls = append(calls, c)
+			}
+			continue
+		}
+		if calls == nil {
+			calls = append(make([]*meta2.StreamCall, 0, len(info.Calls)), info.Calls[:i]...)
+		}
+		if len(c.Args) == 0 {
+			return nil, fmt.Errorf("the percentiles call %s of stream task %s has no quantiles", c.Alias, info.Name)
+		}
+		seen := make(map[string]struct{}, len(c.Args))
+		for _, arg := range c.Args {
+			q, err := strconv.ParseFloat(arg, 64)
+			if err != nil || q < 0 || q > 100 {
+				return nil, fmt.Errorf("the quantile %s of the percentiles call %s of stream task %s is not in [0, 100]", arg, c.Alias, info.Name)
+			}
+			quantile := strconv.FormatFloat(q, 'f', -1, 64)
+			if _, ok := seen[quantile]; ok {
+				return nil, fmt.Errorf("the quantile %s of the percentiles call %s of stream task %s is repeated", arg, c.Alias, info.Name)
+			}
+			seen[quantile] = struct{}{}
+			calls = append(calls, &meta2.StreamCall{Call: "percentile", Field: c.Field, Alias: percentileAlias(c.Alias, quantile),
+				Args: []string{quantile}, Condition: c.Condition, Precision: c.Precision})
+		}
+	}
+	if calls == nil {
+		return info, nil
+	}
+	ei := *info
+	ei.Calls = calls
+	return &ei, nil
+}
+
+// expandStreamCalls returns the stream with the calls writing several fields replaced by a call per field.
+func expandStreamCalls(info *meta2.StreamInfo) (*meta2.StreamInfo, error) {
+	info, err := expandHistograms(info)
+	if err != nil {
+		return nil, err
+	}
+	return expandPercentiles(info)
+}
+
+// sketchShare is the quantile the call reads from the sketch of the leading call, leader is -1 if the call keeps
+// a sketch of its own.
+type sketchShare struct {
+	leader int
+	q      float64
+}
+
+// sketchQuantile returns the quantile of the call estimated by a sketch, false if the call keeps no sketch.
+func sketchQuantile(c *meta2.StreamCall) (float64, bool) {
+	switch c.Call {
+	case "median":
+		return 0.5, true
+	case "percentile":
+		q, err := strconv.ParseFloat(c.Args[0], 64)
+		return q / 100, err == nil
+	}
+	return 0, false
+}
+
+// buildSketchShares shares the sketch of the first percentile or median call of a field with the following calls
+// of the field aggregating the same rows, which are the calls of the same condition without the options of their
+// own. The calls of a percentiles call are always shared then.
+func (w *streamTask) buildSketchShares() {
+	if w.accCalls == nil {
+		return
+	}
+	leaders := make(map[string]int)
+	for i, c := range w.info.Calls {
+		q, ok := sketchQuantile(c)
+		if !ok || w.accCalls[i] == nil || w.opt.CallOptions[c.Alias] != nil {
+			continue
+		}
+		key := c.Field
+		if c.Condition != nil {
+			key += " WHERE " + c.Condition.String()
+		}
+		leader, ok := leaders[key]
+		if !ok {
+			leaders[key] = i
+			continue
+		}
+		if w.sketchShares == nil {
+			w.sketchShares = make([]sketchShare, len(w.info.Calls))
+			for j := range w.sketchShares {
+				w.sketchShares[j].leader = -1
+			}
+		}
+		w.sketchShares[i] = sketchShare{leader: leader, q: q}
+	}
+}
+
+// sharesSketch returns whether the call reads the sketch of another call.
+func (w *streamTask) sharesSketch(i int) bool {
+	return w.sketchShares != nil && w.sketchShares[i].leader >= 0
+}
+
+// sharedQuantile reads a quantile of the sketch of the leading call of the window, the values are only added to
+// the sketch by the leading call.
+type sharedQuantile struct {
+	digest *streamLib.TDigest
+	q      float64
+}
+
+func (s *sharedQuantile) Add(float64, int64) {}
+
+func (s *sharedQuantile) Value() float64 {
+	return s.digest.Quantile(s.q)
+}
+
+// share returns the accumulator of the window reading the sketch of the leading call, which has added the value of
+// the row already. The accumulator follows the sketch of the leader if the calls are remapped by an update, and
+// the value is added to a sketch of the call's own if the window of the leader has no sketch.
+func (a *streamAccumulators) share(key accumulatorKey, share sketchShare, end int64, newAcc newAccumulatorFunc, f *influx.Field, timestamp int64) streamLib.Accumulator {
+	var digest *streamLib.TDigest
+	if lw, ok := a.windows[accumulatorKey{group: key.group, call: share.leader, start: key.start}]; ok {
+		digest, _ = lw.acc.(*streamLib.TDigest)
+	}
+	if digest == nil {
+		return a.add(key, end, newAcc, f, timestamp)
+	}
+	if w, ok := a.windows[key]; ok {
+		if sq, ok := w.acc.(*sharedQuantile); ok && sq.digest == digest {
+			return sq
+		}
+	}
+	sq := &sharedQuantile{digest: digest, q: share.q}
+	if a.windows == nil {
+		a.windows = make(map[accumulatorKey]*accumulatorWindow)
+	}
+	a.windows[key] = &accumulatorWindow{acc: sq, end: end}
+	return sq
+}
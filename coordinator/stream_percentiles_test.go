@@ -0,0 +1,87 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamPercentiles(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: percentilesCall, Field: "fk1", Alias: "lat", Args: []string{"50", "90.0", "99.9"}},
+		&meta2.StreamCall{Call: "median", Field: "fk1", Alias: "median_fk1"},
+	)
+	require.True(t, streamKeepsState(si))
+
+	tags := []influx.Tag{{Key: "tk1", Value: "a"}}
+	var rows []*influx.Row
+	digests := map[float64]*streamLib.TDigest{0.5: streamLib.NewTDigest(0.5), 0.9: streamLib.NewTDigest(0.9), 0.999: streamLib.NewTDigest(0.999)}
+	for i := 0; i < 1000; i++ {
+		v := float64((i * 7919) % 1000)
+		rows = append(rows, newStreamTestRow(env.base+int64(i), tags, floatField("fk1", v)))
+		for _, d := range digests {
+			d.Add(v, 0)
+		}
+	}
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 1)
+	// the quantiles read from the shared sketch are the ones of the sketches of their own
+	for key, q := range map[string]float64{"lat_p50": 0.5, "lat_p90": 0.9, "lat_p99.9": 0.999, "median_fk1": 0.5} {
+		v, ok := fieldValue(out[0], key)
+		require.True(t, ok, key)
+		require.InDelta(t, digests[q].Value(), v, 1e-9, key)
+	}
+	// the info of the task is not changed
+	require.Len(t, si.Calls, 2)
+
+	// one sketch is kept by the window for all the calls
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err := ctx.stream.calculate(rows[:1], si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	task, _ := ctx.stream.getTask(si.Name)
+	var leaders []int
+	for _, share := range task.sketchShares {
+		leaders = append(leaders, share.leader)
+	}
+	require.Equal(t, []int{-1, 0, 0, 0}, leaders)
+	var digestsKept int
+	for _, w := range env.pw.getStreamTaskState(si.Name).accumulators.windows {
+		if _, ok := w.acc.(*streamLib.TDigest); ok {
+			digestsKept++
+		}
+	}
+	require.Equal(t, 1, digestsKept)
+
+	for msg, args := range map[string][]string{
+		"has no quantiles":                 nil,
+		"the quantile x of":                {"50", "x"},
+		"the quantile 101 of":              {"101"},
+		"the quantile 90.0 of the percent": {"90", "90.0"},
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: percentilesCall, Field: "fk1", Alias: "lat", Args: args})
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+		require.ErrorContains(t, err, msg)
+	}
+}
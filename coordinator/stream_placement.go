@@ -0,0 +1,70 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// WriteWindows writes the agg rows of the windows to the shard sh of the pt on the node as they are, without
+// mapping them to the shards of the destination. It is used to replay the windows to a given placement, such as
+// for the tests and the recovery of a node. The rows marked StreamOnly are merged by the store with the windows
+// of their streams, and the others overwrite the windows.
+func (s *Stream) WriteWindows(database, retentionPolicy string, sh *meta2.ShardInfo, nodeID uint64, pt uint32, rows []influx.Row) error {
+	if sh == nil {
+		return fmt.Errorf("the shard of the windows of database %s is missing", database)
+	}
+	if !shardOwnedBy(sh, pt) {
+		return fmt.Errorf("the shard %d of database %s is not owned by the pt %d", sh.ID, database, pt)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	ctx := &netstorage.WriteContext{Rows: rows, Shard: sh}
+	for i := range rows {
+		if !rows[i].StreamOnly {
+			continue
+		}
+		for _, id := range rows[i].StreamId {
+			ctx.StreamShards = appendStreamShard(ctx.StreamShards, id, sh.ID)
+		}
+	}
+	return s.TSDBStore.WriteRows(ctx, nodeID, pt, database, retentionPolicy, s.timeout)
+}
+
+func shardOwnedBy(sh *meta2.ShardInfo, pt uint32) bool {
+	for _, owner := range sh.Owners {
+		if owner == pt {
+			return true
+		}
+	}
+	return false
+}
+
+// appendStreamShard appends the pair of the stream and its destination shard once.
+func appendStreamShard(streamShards []uint64, streamID, shardID uint64) []uint64 {
+	for i := 0; i+1 < len(streamShards); i += 2 {
+		if streamShards[i] == streamID {
+			return streamShards
+		}
+	}
+	return append(streamShards, streamID, shardID)
+}
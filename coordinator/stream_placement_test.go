@@ -0,0 +1,71 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWriteWindows(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 2)),
+	), "mst2")
+	require.Len(t, out, 2)
+	rows := make([]influx.Row, len(out))
+	for i := range out {
+		rows[i].Clone(out[i])
+	}
+
+	var written *netstorage.WriteContext
+	var node uint64
+	var ptID uint32
+	store := &MockNetStore{WriteRowsFn: func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		written, node, ptID = ctx, nodeID, pt
+		require.Equal(t, "db0", database)
+		require.Equal(t, "rp0", rp)
+		require.Equal(t, 5*time.Second, timeout)
+		return nil
+	}}
+	s := NewStream(store, env.pw.MetaClient, logger.NewLogger(errno.ModuleCoordinator), 5*time.Second)
+
+	sh := &meta2.ShardInfo{ID: 7, Owners: []uint32{3}}
+	require.NoError(t, s.WriteWindows("db0", "rp0", sh, 2, 3, rows))
+	require.Equal(t, uint64(2), node)
+	require.Equal(t, uint32(3), ptID)
+	require.Same(t, sh, written.Shard)
+	require.Len(t, written.Rows, 2)
+	// the windows merged by the store are bound to the destination shard of their stream once
+	require.True(t, rows[0].StreamOnly)
+	require.Equal(t, []uint64{si.ID, sh.ID}, written.StreamShards)
+
+	written = nil
+	require.EqualError(t, s.WriteWindows("db0", "rp0", sh, 2, 4, rows), "the shard 7 of database db0 is not owned by the pt 4")
+	require.EqualError(t, s.WriteWindows("db0", "rp0", nil, 2, 3, rows), "the shard of the windows of database db0 is missing")
+	require.NoError(t, s.WriteWindows("db0", "rp0", sh, 2, 3, nil))
+	require.Nil(t, written)
+}
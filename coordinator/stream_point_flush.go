@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// checkFlushGroupPoints checks the flush points of the groups are not negative.
+func (w *streamTask) checkFlushGroupPoints() error {
+	if w.opt.Limits.FlushGroupPoints < 0 {
+		return fmt.Errorf("the flush points %d of stream task %s are negative", w.opt.Limits.FlushGroupPoints, w.info.Name)
+	}
+	return nil
+}
+
+// countGroupPoint counts the row of the group in the batch and returns whether the group reaches the flush points.
+// The backfill windows are written as the whole windows, which are never flushed early.
+func (s *streamCtx) countGroupPoint(task *streamTask, groupKey string) bool {
+	if task.opt.Limits.FlushGroupPoints <= 0 || s.backfill {
+		return false
+	}
+	if s.groupPoints == nil {
+		s.groupPoints = make(map[string]int)
+	}
+	s.groupPoints[groupKey]++
+	return s.groupPoints[groupKey] >= task.opt.Limits.FlushGroupPoints
+}
+
+// flushWorkerGroups flushes the groups aggregated by the workers which reach the flush points. Their windows are only
+// merged at the end of the batch, so each of them is flushed once after the merge whatever its points.
+func (s *Stream) flushWorkerGroups(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx) error {
+	for groupKey, n := range ctx.groupPoints {
+		if n < task.opt.Limits.FlushGroupPoints {
+			continue
+		}
+		if err := s.flushGroup(si, task, ctx, iCtx, groupKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushGroup emits the windows of the group early and drops them from the batch, the following rows of the group
+// open the windows again. The windows are written at the same times as at the end of the batch: the partial results
+// are merged by the store, and the accumulators keep their windows so the later results replace the early ones.
+func (s *Stream) flushGroup(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx, iCtx *injestionCtx, groupKey string) error {
+	ctx.state.addPointFlush()
+	windows := ctx.dataCache
+	group, ok := windows[groupKey]
+	if !ok {
+		return nil
+	}
+	for t, v := range ctx.closedCache[groupKey] {
+		group[t] = v
+	}
+	n := 0
+	for i := range ctx.accResults {
+		if ctx.accResults[i].group != groupKey {
+			ctx.accResults[n] = ctx.accResults[i]
+			n++
+			continue
+		}
+		ctx.accResults[i].fill(ctx)
+	}
+	ctx.accResults = ctx.accResults[:n]
+
+	ctx.dataCache = map[string]map[int64][]*float64{groupKey: group}
+	err := s.emitWindows(si, task, ctx, iCtx)
+	ctx.dataCache = windows
+	delete(windows, groupKey)
+	delete(ctx.closedCache, groupKey)
+	delete(ctx.filled, groupKey)
+	delete(ctx.groupPoints, groupKey)
+	return err
+}
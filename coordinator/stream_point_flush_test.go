@@ -0,0 +1,80 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFlushGroupPoints(t *testing.T) {
+	env := newStreamTestEnv()
+	row := func(group string, v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+	rows := []*influx.Row{row("a", 1), row("b", 2), row("a", 4), row("a", 8), row("a", 16)}
+	sums := func(name string, call *meta2.StreamCall, opt *StreamTaskOptions) (map[string][]float64, []int64, *streamTaskState) {
+		si := newStreamTestInfo(call)
+		si.Name = name
+		setStreamTestOptions(si, opt)
+		m := map[string][]float64{}
+		var times []int64
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			v, _ := fieldValue(r, call.Alias)
+			m[tagValue(r, "tk1")] = append(m[tagValue(r, "tk1")], v)
+			times = append(times, r.Timestamp)
+		}
+		for _, v := range m {
+			sort.Float64s(v)
+		}
+		return m, times, env.pw.getStreamTaskState(si.Name)
+	}
+
+	// the partial windows of the group are emitted every two points and merged by the store
+	sum := &meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"}
+	out, times, state := sums("flush_points", sum, &StreamTaskOptions{Limits: StreamLimitOptions{FlushGroupPoints: 2}})
+	require.Equal(t, map[string][]float64{"a": {5, 24}, "b": {2}}, out)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.pointFlushes))
+	require.Equal(t, int64(2), state.stats.PointFlushes)
+	for _, ts := range times {
+		require.Equal(t, times[0], ts)
+	}
+
+	// the accumulators keep their windows, so the later results replace the early ones
+	p := &meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}}
+	out, _, state = sums("flush_points_acc", p, &StreamTaskOptions{Limits: StreamLimitOptions{FlushGroupPoints: 2}})
+	require.Equal(t, map[string][]float64{"a": {2.5, 6}, "b": {2}}, out)
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.pointFlushes))
+
+	si := newStreamTestInfo(sum)
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Limits: StreamLimitOptions{FlushGroupPoints: -1}})
+	require.ErrorContains(t, err, "the flush points -1 of stream task t are negative")
+
+	// the groups of the workers are flushed once after their windows are merged
+	out, times, state = sums("flush_points_workers", sum, &StreamTaskOptions{Limits: StreamLimitOptions{Workers: 4, FlushGroupPoints: 2}})
+	require.Equal(t, map[string][]float64{"a": {29}, "b": {2}}, out)
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.pointFlushes))
+	for _, ts := range times {
+		require.Equal(t, times[0], ts)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// maxStreamPrecision is the max number of the decimal places of the rounded results, the float64 has no more
+const maxStreamPrecision = influxql.MaxStreamPrecision
+
+// buildCallRoundings returns the scales rounding the results of the calls by their precisions, which are 0 for
+// the calls not rounded. The results of the calls other than floats are never rounded. nil means no call is rounded.
+// The partial results merged by the store are not rounded again once merged, so only the results of the accumulators
+// and of the tasks writing the windows directly can be rounded.
+func buildCallRoundings(info *meta2.StreamInfo, calls []*streamLib.FieldCall, direct bool) ([]float64, error) {
+	var roundings []float64
+	for i, c := range info.Calls {
+		if c.Precision < 0 || c.Precision > maxStreamPrecision {
+			return nil, fmt.Errorf("the precision %d of the call %s of stream task %s is not in [0, %d]",
+				c.Precision, c.Alias, info.Name, maxStreamPrecision)
+		}
+		if c.Precision == 0 || calls[i].OutFieldType != influx.Field_Type_Float {
+			continue
+		}
+		if !direct && calls[i].MergeFunc != nil {
+			return nil, fmt.Errorf("the results of the %s call %s of stream task %s are merged by the store, which can not be rounded",
+				c.Call, c.Alias, info.Name)
+		}
+		if roundings == nil {
+			roundings = make([]float64, len(calls))
+		}
+		roundings[i] = math.Pow10(c.Precision)
+	}
+	return roundings, nil
+}
+
+// roundHalfEven rounds the value to the decimal places of the scale, the halves are rounded to the even digits.
+// The values too large to have the decimal places are kept as they are.
+func roundHalfEven(v, scale float64) float64 {
+	scaled := v * scale
+	if math.IsInf(scaled, 0) || math.IsNaN(scaled) || math.Abs(scaled) >= 1<<53 {
+		return v
+	}
+	return math.RoundToEven(scaled) / scale
+}
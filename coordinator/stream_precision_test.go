@@ -0,0 +1,88 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundHalfEven(t *testing.T) {
+	for _, c := range []struct {
+		v, scale, exp float64
+	}{
+		{0.125, 100, 0.12},
+		{0.375, 100, 0.38},
+		{-0.125, 100, -0.12},
+		{2.5, 1, 2},
+		{3.5, 1, 4},
+		{1.23456, 1000, 1.235},
+		{1e300, 1000, 1e300},
+		{math.MaxFloat64, 10, math.MaxFloat64},
+	} {
+		require.Equal(t, c.exp, roundHalfEven(c.v, c.scale), "%v", c.v)
+	}
+	require.True(t, math.IsNaN(roundHalfEven(math.NaN(), 10)))
+}
+
+func TestStreamCallPrecision(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "mean", Field: "fk1", Alias: "mean_fk1", Precision: 2},
+		&meta2.StreamCall{Call: "count_distinct", Field: "fk1", Alias: "distinct_fk1", Precision: 2},
+		&meta2.StreamCall{Call: "stddev", Field: "fk1", Alias: "stddev_fk1"},
+	)
+	other := &meta2.StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	require.Equal(t, 2, other.Calls[0].Precision)
+	require.Equal(t, 0, other.Calls[2].Precision)
+
+	out := rowsOfMst(env.calculate(t, si,
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 0.25)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 0)),
+	), "mst2")
+	require.Len(t, out, 1)
+	// the half is rounded to the even digit
+	v, _ := fieldValue(out[0], "mean_fk1")
+	require.Equal(t, 0.12, v)
+	v, _ = fieldValue(out[0], "distinct_fk1")
+	require.Equal(t, float64(2), v)
+	v, _ = fieldValue(out[0], "stddev_fk1")
+	require.Equal(t, math.Sqrt(0.03125), v)
+
+	for msg, c := range map[string]*meta2.StreamCall{
+		"the precision 16 of the call mean_fk1 of stream task t is not in [0, 15]": {Call: "mean", Field: "fk1", Alias: "mean_fk1", Precision: 16},
+		"the results of the sum call sum_fk1 of stream task t are merged by the store, which can not be rounded": {
+			Call: "sum", Field: "fk1", Alias: "sum_fk1", Precision: 1},
+	} {
+		si := newStreamTestInfo(c)
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+		require.EqualError(t, err, msg)
+	}
+
+	// the windows written directly are rounded for all the calls
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1", Precision: 1})
+	si.Slide = si.Interval / 2
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+}
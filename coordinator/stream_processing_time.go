@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// StreamWindowTime is the time a task windows its rows by.
+type StreamWindowTime uint8
+
+const (
+	// StreamEventTime windows the rows by their timestamps
+	StreamEventTime StreamWindowTime = iota
+	// StreamProcessingTime windows the rows by the time they are aggregated at the sql layer
+	StreamProcessingTime
+)
+
+// streamUsesProcessingTime returns whether the task windows the rows by the time they arrive, which is only known
+// to the sql layer.
+func streamUsesProcessingTime(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Window.WindowTime == StreamProcessingTime
+}
+
+// checkWindowTime rejects the unknown window times and the calls weighing the rows by their times, the rows of a
+// batch arrive at the same time.
+func checkWindowTime(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	switch opt.Window.WindowTime {
+	case StreamEventTime:
+		return nil
+	case StreamProcessingTime:
+		for _, c := range info.Calls {
+			if c.Call == twaCall || c.Call == coverageCall {
+				return fmt.Errorf("the %s call %s of stream task %s weighs the rows by their times, which can not be windowed by the processing time",
+					c.Call, c.Alias, info.Name)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("the window time %d of stream task %s is unknown", opt.Window.WindowTime, info.Name)
+}
+
+// stampArrivals returns the rows with their timestamps replaced by the time now they arrive. The rows are shared
+// with the write of the source, so the copies of them are stamped unless they are the copies scaled already.
+func (s *streamCtx) stampArrivals(rows []*influx.Row, task *streamTask, now int64) []*influx.Row {
+	if task.timeScale > 1 {
+		for _, r := range rows {
+			r.Timestamp = now
+		}
+		return rows
+	}
+	if cap(s.scaledRows) < len(rows) {
+		s.scaledRows = make([]influx.Row, 0, len(rows))
+	}
+	s.scaledRows = s.scaledRows[:0]
+	stamped := make([]*influx.Row, 0, len(rows))
+	for _, r := range rows {
+		s.scaledRows = append(s.scaledRows, *r)
+		c := &s.scaledRows[len(s.scaledRows)-1]
+		c.Timestamp = now
+		stamped = append(stamped, c)
+	}
+	return stamped
+}
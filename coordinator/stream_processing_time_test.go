@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamProcessingTime(t *testing.T) {
+	env := newStreamTestEnv()
+	sec := int64(time.Second)
+	now := env.base + sec/2
+	env.pw.streamClock = func() int64 { return now }
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{WindowTime: StreamProcessingTime}})
+	row := func(ts int64, v float64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+
+	// the rows of any time are windowed by their arrival, the source rows keep their times
+	early, late := row(env.base-time.Hour.Nanoseconds(), 1), row(env.base+5*sec, 2)
+	out := rowsOfMst(env.calculate(t, si, early, late), "mst2")
+	require.Len(t, out, 1)
+	require.Equal(t, env.base+sec-1, out[0].Timestamp)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(3), v)
+	require.Equal(t, env.base-time.Hour.Nanoseconds(), early.Timestamp)
+
+	// the rows arriving later are never late however old they are
+	now += sec
+	out = rowsOfMst(env.calculate(t, si, row(env.base-time.Hour.Nanoseconds(), 4)), "mst2")
+	require.Len(t, out, 1)
+	require.Equal(t, env.base+2*sec-1, out[0].Timestamp)
+
+	// the windows by the processing time are not backfilled
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	env.pw.StreamSource = &mockStreamSource{}
+	require.EqualError(t, env.pw.BackfillStream(si.Name, env.base, env.base+sec),
+		"the windows of stream task t by the processing time can not be backfilled from the historical rows")
+}
+
+func TestStreamProcessingTimeCheck(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "twa", Field: "fk1", Alias: "twa_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Window: StreamWindowOptions{WindowTime: StreamProcessingTime}})
+	require.EqualError(t, err, "the twa call twa_fk1 of stream task t weighs the rows by their times, which can not be windowed by the processing time")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Window: StreamWindowOptions{WindowTime: StreamProcessingTime + 1}})
+	require.EqualError(t, err, "the window time 2 of stream task t is unknown")
+}
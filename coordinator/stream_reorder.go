@@ -0,0 +1,146 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"container/heap"
+	"sync"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+type streamReorderItem struct {
+	sh  *meta2.ShardInfo
+	row *influx.Row
+	seq int
+}
+
+type streamReorderItems []streamReorderItem
+
+func (h streamReorderItems) Len() int { return len(h) }
+
+func (h streamReorderItems) Less(i, j int) bool {
+	if h[i].row.Timestamp != h[j].row.Timestamp {
+		return h[i].row.Timestamp < h[j].row.Timestamp
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h streamReorderItems) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *streamReorderItems) Push(x interface{}) { *h = append(*h, x.(streamReorderItem)) }
+
+func (h *streamReorderItems) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = streamReorderItem{}
+	*h = old[:n-1]
+	return item
+}
+
+// streamReorderBuffer holds the rows emitted by a stream task to deliver them to the shards in time order.
+// The oldest row is released once more than size rows are buffered, or once it is older than the newest
+// buffered row by more than lateness. A row older than the last released one can not be delivered in order,
+// it is late and delivered at once. The buffer of the task is kept across the batches, the rows are released
+// once the watermark of the task passes them by lateness.
+type streamReorderBuffer struct {
+	mu       sync.Mutex
+	size     int
+	lateness int64
+
+	items    streamReorderItems
+	seq      int
+	maxTime  int64
+	released int64
+	// hasReleased indicates that released holds the timestamp of the last released row
+	hasReleased bool
+	late        int
+}
+
+// bindReorder binds the reorder buffer of the task kept across the batches to the context, the rows of a backfill
+// are ordered within the backfill only.
+func (s *streamCtx) bindReorder() {
+	if s.reorder != nil {
+		return
+	}
+	if s.backfill {
+		s.reorder = &streamReorderBuffer{}
+		return
+	}
+	s.reorder = &s.state.reorder
+}
+
+// init sets the bounds of the buffer, the rows held are kept.
+func (b *streamReorderBuffer) init(size int, lateness int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.size = size
+	b.lateness = lateness
+}
+
+// holding returns whether any row is held.
+func (b *streamReorderBuffer) holding() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.items) > 0
+}
+
+// push holds a copy of the row, as the rows of a batch are reused by the following batches.
+func (b *streamReorderBuffer) push(iCtx *injestionCtx, sh *meta2.ShardInfo, r *influx.Row) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hasReleased && r.Timestamp < b.released {
+		b.late++
+		iCtx.addStreamShardIds(sh, r)
+		iCtx.setStreamShardRow(sh, r)
+		return
+	}
+	if len(b.items) == 0 || r.Timestamp > b.maxTime {
+		b.maxTime = r.Timestamp
+	}
+	heap.Push(&b.items, streamReorderItem{sh: sh, row: copyStreamRow(r), seq: b.seq})
+	b.seq++
+	for len(b.items) > b.size || (b.lateness > 0 && len(b.items) > 0 && b.maxTime-b.items[0].row.Timestamp > b.lateness) {
+		b.release(iCtx)
+	}
+}
+
+func (b *streamReorderBuffer) release(iCtx *injestionCtx) {
+	item := heap.Pop(&b.items).(streamReorderItem)
+	b.released = item.row.Timestamp
+	b.hasReleased = true
+	// the row may be held by a former batch, whose context records the shards of the streams no more
+	iCtx.addStreamShardIds(item.sh, item.row)
+	iCtx.setStreamShardRow(item.sh, item.row)
+}
+
+// flush delivers the buffered rows up to the time in time order, it returns the number of them and the number
+// of the late rows delivered since the last flush.
+func (b *streamReorderBuffer) flush(iCtx *injestionCtx, before int64) (int, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := 0
+	for len(b.items) > 0 && b.items[0].row.Timestamp <= before {
+		b.release(iCtx)
+		n++
+	}
+	late := b.late
+	b.late = 0
+	return n, late
+}
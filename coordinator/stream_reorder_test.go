@@ -0,0 +1,95 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamReorderBuffer(t *testing.T) {
+	sh := &meta2.ShardInfo{ID: 1}
+	deliver := func(size int, lateness int64, times ...int64) ([]int64, int) {
+		iCtx := getInjestionCtx()
+		defer putInjestionCtx(iCtx)
+		b := &streamReorderBuffer{}
+		b.init(size, lateness)
+		for _, ts := range times {
+			b.push(iCtx, sh, &influx.Row{Timestamp: ts})
+		}
+		_, late := b.flush(iCtx, math.MaxInt64)
+		var out []int64
+		for _, r := range iCtx.shardRowMap[0].rows {
+			out = append(out, r.Timestamp)
+		}
+		return out, late
+	}
+
+	// bounded by size, 1 and 2 are older than the released 3 and 4
+	out, late := deliver(2, 0, 5, 3, 4, 1, 9, 2, 8, 7)
+	require.Equal(t, []int64{3, 1, 4, 2, 5, 7, 8, 9}, out)
+	require.Equal(t, 2, late)
+
+	// bounded by lateness, 9 releases 3 and 5, then 4 is late
+	out, late = deliver(10, 3, 5, 3, 9, 7, 8, 4)
+	require.Equal(t, []int64{3, 5, 4, 7, 8, 9}, out)
+	require.Equal(t, 1, late)
+
+	// within the bound, the rows are in order
+	out, late = deliver(10, 0, 5, 3, 9, 7, 8, 4)
+	require.Equal(t, []int64{3, 4, 5, 7, 8, 9}, out)
+	require.Equal(t, 0, late)
+}
+
+func TestStreamReorderOutput(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{ReorderBufferSize: 64}})
+
+	var rows []*influx.Row
+	for i := 20; i > 0; i-- {
+		rows = append(rows, newStreamTestRow(env.base+int64(i)*int64(time.Second),
+			[]influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", float64(i))))
+	}
+	// the window of the watermark is held until the watermark passes it
+	out := env.calculate(t, si, rows...)
+	require.Len(t, out, 19)
+	for i := 1; i < len(out); i++ {
+		require.Less(t, out[i-1].Timestamp, out[i].Timestamp)
+	}
+	last := out[len(out)-1].Timestamp
+	require.True(t, env.pw.getStreamTaskState(si.Name).reorder.holding())
+
+	// the held window is delivered by the following batch in order
+	out = env.calculate(t, si, newStreamTestRow(env.base+22*int64(time.Second), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 22)))
+	require.Len(t, out, 1)
+	require.Equal(t, last+int64(time.Second), out[0].Timestamp)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(20), v)
+
+	// the held windows are delivered by the flush of the task
+	iCtx := getInjestionCtx()
+	defer putInjestionCtx(iCtx)
+	n, _ := env.pw.getStreamTaskState(si.Name).reorder.flush(iCtx, math.MaxInt64)
+	require.Equal(t, 1, n)
+	require.Equal(t, env.base+23*int64(time.Second)-1, iCtx.shardRowMap[0].rows[0].Timestamp)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// newStreamReplayEnv returns the env whose clock is fixed at now, and whose meta client serves the shard group
+// of any time, so the fixture rows of a replay do not depend on the time the test runs.
+func newStreamReplayEnv(now time.Time) *streamTestEnv {
+	env := newStreamTestEnv()
+	env.base = now.UnixNano()
+	env.pw.streamClock = func() int64 { return now.UnixNano() }
+
+	var mu sync.Mutex
+	groups := map[time.Time]*meta2.ShardGroupInfo{}
+	env.pw.MetaClient.(*MockMetaClient).CreateShardGroupFn = func(database, policy string, timestamp time.Time, version uint32, engineType config.EngineType) (*meta2.ShardGroupInfo, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		start := timestamp.Truncate(time.Hour)
+		sg, ok := groups[start]
+		if !ok {
+			sg = &meta2.ShardGroupInfo{
+				ID:         nextShardID(),
+				StartTime:  start,
+				EndTime:    start.Add(time.Hour - 1),
+				Shards:     []meta2.ShardInfo{{ID: nextShardID(), Owners: []uint32{0}}},
+				EngineType: engineType,
+			}
+			groups[start] = sg
+		}
+		return sg, nil
+	}
+	return env
+}
+
+// replay runs the sql layer calculation of the stream batch by batch, and writes the results to the mock store.
+// It returns the rows received by the store, ordered by measurement, time and tags.
+func (e *streamTestEnv) replay(t *testing.T, si *meta2.StreamInfo, batches ...[]*influx.Row) []*influx.Row {
+	var mu sync.Mutex
+	var written []*influx.Row
+	e.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+
+	for _, rows := range batches {
+		ctx := e.prepare(t, si)
+		_, err := ctx.stream.calculate(rows, si, e.pw, ctx, 0)
+		require.NoError(t, err)
+		require.NoError(t, e.pw.writeShardMap(si.DesMst.Database, si.DesMst.RetentionPolicy, ctx))
+		putInjestionCtx(ctx)
+	}
+
+	sort.SliceStable(written, func(i, j int) bool {
+		if written[i].Name != written[j].Name {
+			return written[i].Name < written[j].Name
+		}
+		if written[i].Timestamp != written[j].Timestamp {
+			return written[i].Timestamp < written[j].Timestamp
+		}
+		return streamTagsString(written[i]) < streamTagsString(written[j])
+	})
+	return written
+}
+
+func streamTagsString(r *influx.Row) string {
+	var b strings.Builder
+	for i := range r.Tags {
+		b.WriteString(r.Tags[i].Key)
+		b.WriteByte('=')
+		b.WriteString(r.Tags[i].Value)
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+func TestStreamReplay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 10, 0, 0, time.UTC)
+	env := newStreamReplayEnv(now)
+	require.Equal(t, now.UnixNano(), env.pw.getStreamClock()())
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	row := func(offset time.Duration, group string, v float64) *influx.Row {
+		return newStreamTestRow(now.Add(offset).UnixNano(), []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+
+	out := env.replay(t, si,
+		[]*influx.Row{row(0, "a", 1), row(100*time.Millisecond, "b", 2), row(1500*time.Millisecond, "a", 3)},
+		[]*influx.Row{row(200*time.Millisecond, "a", 4)},
+	)
+
+	type emitted struct {
+		ts    time.Time
+		group string
+		sum   float64
+	}
+	var got []emitted
+	for _, r := range out {
+		require.Equal(t, "mst2", r.Name)
+		require.True(t, r.StreamOnly)
+		require.Equal(t, []uint64{si.ID}, r.StreamId)
+		v, _ := fieldValue(r, "sum_fk1")
+		got = append(got, emitted{ts: time.Unix(0, r.Timestamp).UTC(), group: tagValue(r, "tk1"), sum: v})
+	}
+	// each batch emits its own partial results at the end of the window, which the store merges
+	windowEnd := func(n int) time.Time { return now.Add(time.Duration(n)*time.Second - 1) }
+	require.Equal(t, []emitted{
+		{ts: windowEnd(1), group: "a", sum: 1},
+		{ts: windowEnd(1), group: "a", sum: 4},
+		{ts: windowEnd(1), group: "b", sum: 2},
+		{ts: windowEnd(2), group: "a", sum: 3},
+	}, got)
+}
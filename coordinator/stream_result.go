@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// streamResult is the outcome of calculating a batch of the stream task. The windows mapped to the shards and
+// the rows dropped by the partial errors are counted, while the fatal error still fails the batch.
+type streamResult struct {
+	windowsEmitted int64
+	partialErrors  int64
+	// errnos counts the partial errors by their codes, the errors without a code are only counted in partialErrors
+	errnos map[errno.Errno]int64
+}
+
+func (r *streamResult) addPartialError(err error) {
+	r.partialErrors++
+	var e *errno.Error
+	if !errors.As(err, &e) {
+		return
+	}
+	if r.errnos == nil {
+		r.errnos = make(map[errno.Errno]int64)
+	}
+	r.errnos[e.Errno()]++
+}
+
+func (r *streamResult) merge(other *streamResult) {
+	r.windowsEmitted += other.windowsEmitted
+	r.partialErrors += other.partialErrors
+	for code, n := range other.errnos {
+		if r.errnos == nil {
+			r.errnos = make(map[errno.Errno]int64)
+		}
+		r.errnos[code] += n
+	}
+}
+
+// MarshalLogObject logs the result with the zap.Object field.
+func (r *streamResult) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt64("windows", r.windowsEmitted)
+	enc.AddInt64("partial_errors", r.partialErrors)
+	return enc.AddArray("errnos", zapcore.ArrayMarshalerFunc(func(arr zapcore.ArrayEncoder) error {
+		for code, n := range r.errnos {
+			if err := arr.AppendObject(zapcore.ObjectMarshalerFunc(func(oe zapcore.ObjectEncoder) error {
+				oe.AddUint16("errno", uint16(code))
+				oe.AddInt64("rows", n)
+				return nil
+			})); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// addWindowEmitted counts the window mapped to the shard.
+func (s *streamCtx) addWindowEmitted() {
+	s.state.stats.AddWindowsEmitted(1)
+	s.result.windowsEmitted++
+}
+
+// addPartialError counts the row dropped by the partial error.
+func (s *streamCtx) addPartialError(err error) {
+	s.state.stats.AddPartialErrors(1)
+	s.result.addPartialError(err)
+}
+
+// takeResult returns the result of the batch, which is owned by the caller afterwards.
+func (s *streamCtx) takeResult() streamResult {
+	r := s.result
+	s.result = streamResult{}
+	return r
+}
+
+// logStreamResult surfaces the rows of the batch dropped by the stream task.
+func (w *PointsWriter) logStreamResult(name string, r *streamResult) {
+	if r.partialErrors == 0 {
+		return
+	}
+	w.logger.Warn("stream task dropped the windows by the partial errors", zap.String("stream", name), zap.Object("result", r))
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamResult(t *testing.T) {
+	var r streamResult
+	r.addPartialError(errors.New("unknown"))
+	r.addPartialError(errno.NewError(errno.WritePointShardKeyTooLarge))
+	require.Equal(t, streamResult{partialErrors: 2, errnos: map[errno.Errno]int64{errno.WritePointShardKeyTooLarge: 1}}, r)
+
+	other := streamResult{windowsEmitted: 3, partialErrors: 1, errnos: map[errno.Errno]int64{errno.WritePointShardKeyTooLarge: 1}}
+	r.merge(&other)
+	require.Equal(t, streamResult{windowsEmitted: 3, partialErrors: 3, errnos: map[errno.Errno]int64{errno.WritePointShardKeyTooLarge: 2}}, r)
+
+	// the windows of the destinations are counted in the result of the batch
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp0"},
+		Interval: 2 * time.Second,
+	}}
+	start := time.Unix(0, env.base).Truncate(2 * time.Second).Add(2 * time.Second).UnixNano()
+	rows := []*influx.Row{
+		newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(start+int64(time.Second), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+	}
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	res, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, streamResult{windowsEmitted: 3}, res)
+}
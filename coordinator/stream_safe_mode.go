@@ -0,0 +1,50 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const deadLetterSchemaViolation = "schema_violation"
+
+// checkRowSchema checks the tags and fields of the row against the schema of the destination measurement,
+// a key missing in the schema or of a different type is a violation.
+func checkRowSchema(r *influx.Row, schema map[string]int32) error {
+	for i := range r.Tags {
+		typ, ok := schema[r.Tags[i].Key]
+		if !ok {
+			return fmt.Errorf("tag %s is not in the schema", r.Tags[i].Key)
+		}
+		if typ != influx.Field_Type_Tag {
+			return fmt.Errorf("tag %s is %s in the schema", r.Tags[i].Key, influx.FieldTypeString(typ))
+		}
+	}
+	for i := range r.Fields {
+		typ, ok := schema[r.Fields[i].Key]
+		if !ok {
+			return fmt.Errorf("field %s is not in the schema", r.Fields[i].Key)
+		}
+		if typ != r.Fields[i].Type {
+			return fmt.Errorf("field %s is %s but %s in the schema", r.Fields[i].Key,
+				influx.FieldTypeString(r.Fields[i].Type), influx.FieldTypeString(typ))
+		}
+	}
+	return nil
+}
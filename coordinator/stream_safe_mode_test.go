@@ -0,0 +1,78 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRowSchema(t *testing.T) {
+	schema := map[string]int32{"tk1": influx.Field_Type_Tag, "fk1": influx.Field_Type_Float, "fk2": influx.Field_Type_Int}
+	r := &influx.Row{Tags: []influx.Tag{{Key: "tk1", Value: "a"}}, Fields: []influx.Field{floatField("fk1", 1)}}
+	require.NoError(t, checkRowSchema(r, schema))
+
+	r.Fields = append(r.Fields, floatField("fk2", 1))
+	require.EqualError(t, checkRowSchema(r, schema), "field fk2 is float but integer in the schema")
+	r.Fields = []influx.Field{floatField("fk3", 1)}
+	require.EqualError(t, checkRowSchema(r, schema), "field fk3 is not in the schema")
+	r.Tags = []influx.Tag{{Key: "fk1", Value: "a"}}
+	require.EqualError(t, checkRowSchema(r, schema), "tag fk1 is float in the schema")
+	r.Tags = []influx.Tag{{Key: "tk3", Value: "a"}}
+	require.EqualError(t, checkRowSchema(r, schema), "tag tk3 is not in the schema")
+}
+
+func TestStreamSafeMode(t *testing.T) {
+	env := newStreamTestEnv()
+	// the destination schema has fk2 as integer, but the sum is float
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "fk2"})
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 2)),
+	}
+	require.Len(t, rowsOfMst(env.calculate(t, si, rows...), "mst2"), 2)
+
+	state := env.pw.getStreamTaskState(si.Name)
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{SafeMode: true}})
+	require.Empty(t, env.calculate(t, si, rows...))
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.schemaViolations))
+
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, engineType)
+		if mstName == "dead_letter" {
+			mi.Schema = nil
+		}
+		return mi, nil
+	}
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{SafeMode: true}, Errors: StreamErrorOptions{DeadLetterMst: "dead_letter"}})
+	out := env.calculate(t, si, rows...)
+	require.Empty(t, rowsOfMst(out, "mst2"))
+	dl := rowsOfMst(out, "dead_letter")
+	require.Len(t, dl, 2)
+	for i, exp := range []string{"a", "b"} {
+		require.Equal(t, exp, tagValue(dl[i], "tk1"))
+		require.Equal(t, deadLetterSchemaViolation, tagValue(dl[i], DeadLetterReasonTag))
+		v, _ := fieldValue(dl[i], "fk2")
+		require.Equal(t, float64(i+1), v)
+	}
+	require.Equal(t, int64(4), atomic.LoadInt64(&state.schemaViolations))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// sampleCountField is the value added to the sample count of a window for each row, the count ignores it.
+var sampleCountField = influx.Field{Type: influx.Field_Type_Int}
+
+// streamCountsSamples returns whether the windows of the task carry the number of the rows aggregated by them.
+// The store merges the windows without the count, so the windows of such a task are aggregated at the sql layer
+// and written at their start times.
+func streamCountsSamples(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Output.SampleCountField != ""
+}
+
+// withSampleCount returns the stream with the count of the rows of the windows as the last call, which has no field.
+func withSampleCount(info *meta2.StreamInfo, opt *StreamTaskOptions) (*meta2.StreamInfo, error) {
+	if !streamCountsSamples(opt) {
+		return info, nil
+	}
+	if streamPassthrough(info) {
+		return nil, fmt.Errorf("stream task %s without calls copies the rows, which have no sample counts", info.Name)
+	}
+	if streamFills(info) {
+		return nil, fmt.Errorf("the empty windows of stream task %s can not be filled with the sample counts", info.Name)
+	}
+	for _, c := range info.Calls {
+		if outputAlias(opt, c.Alias) == opt.Output.SampleCountField {
+			return nil, fmt.Errorf("the sample count field %s of stream task %s is written by the call %s", opt.Output.SampleCountField, info.Name, c.Alias)
+		}
+	}
+	for _, d := range info.Dims {
+		if d == opt.Output.SampleCountField {
+			return nil, fmt.Errorf("the sample count field %s of stream task %s is a dim", opt.Output.SampleCountField, info.Name)
+		}
+	}
+	si := *info
+	si.Calls = append(make([]*meta2.StreamCall, 0, len(info.Calls)+1), info.Calls...)
+	si.Calls = append(si.Calls, &meta2.StreamCall{Call: "count", Alias: opt.Output.SampleCountField})
+	return &si, nil
+}
+
+// isSampleCount returns whether the call of the index counts the rows of the windows.
+func (w *streamTask) isSampleCount(i int) bool {
+	return streamCountsSamples(w.opt) && i == len(w.calls)-1
+}
+
+// buildSampleCount writes the count of the rows of the windows to the field itself as an integer.
+func (w *streamTask) buildSampleCount() {
+	if !streamCountsSamples(w.opt) {
+		return
+	}
+	c := w.calls[len(w.calls)-1]
+	c.Alias = w.opt.Output.SampleCountField
+	c.OutFieldType = influx.Field_Type_Int
+}
+
+// ensureSampleCountField adds the sample count field to the schema of the destination measurement if it is missing,
+// the rows of the windows are routed to the shards without updating the schema.
+func (s *Stream) ensureSampleCountField(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
+	key := task.opt.Output.SampleCountField
+	typ, ok := ctx.ms.Schema[key]
+	if ok {
+		if typ != influx.Field_Type_Int {
+			return fmt.Errorf("the sample count field %s of stream task %s is a %s field of the destination",
+				key, si.Name, influx.FieldTypeString(typ))
+		}
+		return nil
+	}
+	fields := appendField(nil, key, influx.Field_Type_Int)
+	return s.MetaClient.UpdateSchema(ctx.db.Name, ctx.rp.Name, ctx.ms.OriginName(), fields)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSampleCount(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "max", Field: "fk2", Alias: "max_fk2"},
+	)
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{SampleCountField: "_sample_count"}})
+	row := func(ts int64, fields ...influx.Field) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, fields...)
+	}
+	intField := func(v int64) influx.Field {
+		return influx.Field{Key: "fk2", NumValue: float64(v), Type: influx.Field_Type_Int}
+	}
+
+	out := rowsOfMst(env.calculate(t, si,
+		row(env.base, floatField("fk1", 1), intField(1)),
+		row(env.base+1, floatField("fk1", 2)),
+		// the row aggregated by no call is not a sample
+		row(env.base+2, floatField("fk3", 3)),
+	), "mst2")
+	require.Len(t, out, 1)
+	require.False(t, out[0].StreamOnly)
+	require.Equal(t, env.base, out[0].Timestamp)
+	v, ok := fieldValue(out[0], "_sample_count")
+	require.True(t, ok)
+	require.Equal(t, float64(2), v)
+	v, _ = fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(3), v)
+	for _, f := range out[0].Fields {
+		if f.Key == "_sample_count" {
+			require.Equal(t, int32(influx.Field_Type_Int), f.Type)
+		}
+	}
+
+	// the samples of the window are counted across the batches
+	out = rowsOfMst(env.calculate(t, si, row(env.base+3, intField(5))), "mst2")
+	require.Len(t, out, 1)
+	v, _ = fieldValue(out[0], "_sample_count")
+	require.Equal(t, float64(3), v)
+	v, _ = fieldValue(out[0], "max_fk2")
+	require.Equal(t, float64(5), v)
+}
+
+func TestStreamSampleCountInvalid(t *testing.T) {
+	for msg, set := range map[string]func(si *meta2.StreamInfo){
+		"the sample count field sum_fk1 of stream task t is written by the call sum_fk1": func(si *meta2.StreamInfo) {},
+		"the sample count field tk1 of stream task t is a dim":                           func(si *meta2.StreamInfo) {},
+		"the empty windows of stream task t can not be filled with the sample counts": func(si *meta2.StreamInfo) {
+			si.Fill = influxql.PreviousFill
+		},
+	} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		set(si)
+		field := "_sample_count"
+		switch msg {
+		case "the sample count field sum_fk1 of stream task t is written by the call sum_fk1":
+			field = "sum_fk1"
+		case "the sample count field tk1 of stream task t is a dim":
+			field = "tk1"
+		}
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Output: StreamOutputOptions{SampleCountField: field}})
+		require.EqualError(t, err, msg)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// missingSourceKeys returns the dims and the fields of the calls of the stream missing from the source schema.
+// The types of them are unknown to the task, which is rebuilt once any of them is added to the source.
+func missingSourceKeys(info *meta2.StreamInfo, srcSchema map[string]int32) []string {
+	var keys []string
+	add := func(key string) {
+		if _, ok := srcSchema[key]; ok {
+			return
+		}
+		for _, k := range keys {
+			if k == key {
+				return
+			}
+		}
+		keys = append(keys, key)
+	}
+	for _, d := range info.Dims {
+		add(d)
+	}
+	for _, c := range info.Calls {
+		add(c.Field)
+	}
+	return keys
+}
+
+// sourceKeysAdded returns whether a key missing from the source schema at the build of the task is
+// in the schema now, the field types resolved by the task are stale then.
+func (w *streamTask) sourceKeysAdded(srcSchema map[string]int32) bool {
+	for _, k := range w.missingKeys {
+		if _, ok := srcSchema[k]; ok {
+			return true
+		}
+	}
+	return false
+}
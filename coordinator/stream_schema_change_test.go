@@ -0,0 +1,86 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSourceFieldAdded(t *testing.T) {
+	env := newStreamTestEnv()
+	var added bool
+	env.pw.MetaClient.(*MockMetaClient).MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		mi := NewMeasurement(mstName, config.TSSTORE)
+		if added {
+			mi.Schema["fk3"] = influx.Field_Type_Int
+			mi.Schema["sum_fk3"] = influx.Field_Type_Int
+		}
+		return mi, nil
+	}
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk3", Alias: "sum_fk3"},
+	)
+
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	// the task is built before the field is added to the source
+	srcMst, err := ctx.writeHelper.createMeasurement("db0", "rp0", "mst0")
+	require.NoError(t, err)
+	task, err := newStreamTask(si, srcMst.Schema, ctx.streamMSTs[0].Schema, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"fk3"}, task.missingKeys)
+	ctx.stream.tasks[si.Name] = task
+
+	tags := []influx.Tag{{Key: "tk1", Value: "a"}}
+	_, err = ctx.stream.calculate([]*influx.Row{newStreamTestRow(env.base, tags, floatField("fk1", 1))}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	require.True(t, task == ctx.stream.tasks[si.Name])
+
+	// the rows of the following batch carry the new field
+	added = true
+	ctx.writeHelper.sameSchema = false
+	ctx.shardRowMap = ctx.shardRowMap[:0]
+	fk3 := influx.Field{Key: "fk3", NumValue: 5, Type: influx.Field_Type_Int}
+	_, err = ctx.stream.calculate([]*influx.Row{newStreamTestRow(env.base+1, tags, floatField("fk1", 2), fk3)}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	rebuilt := ctx.stream.tasks[si.Name]
+	require.False(t, task == rebuilt)
+	require.Empty(t, rebuilt.missingKeys)
+	require.Equal(t, int64(1), atomic.LoadInt64(&env.pw.getStreamTaskState(si.Name).schemaRebuilds))
+
+	var out []*influx.Row
+	for i := range ctx.shardRowMap {
+		out = append(out, ctx.shardRowMap[i].rows...)
+	}
+	out = rowsOfMst(out, "mst2")
+	require.Len(t, out, 1)
+	v, ok := fieldValue(out[0], "sum_fk3")
+	require.True(t, ok)
+	require.Equal(t, float64(5), v)
+	for i := range out[0].Fields {
+		if out[0].Fields[i].Key == "sum_fk3" {
+			require.Equal(t, int32(influx.Field_Type_Int), out[0].Fields[i].Type)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+// streamHasShard returns whether the rows written to the source shard feed the task.
+func streamHasShard(opt *StreamTaskOptions, shardID uint64) bool {
+	if len(opt.Group.SourceShards) == 0 {
+		return true
+	}
+	for _, id := range opt.Group.SourceShards {
+		if id == shardID {
+			return true
+		}
+	}
+	return false
+}
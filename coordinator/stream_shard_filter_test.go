@@ -0,0 +1,35 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamHasShard(t *testing.T) {
+	require.True(t, streamHasShard(defaultStreamTaskOptions, 3))
+	opt := &StreamTaskOptions{Group: StreamGroupOptions{SourceShards: []uint64{1, 3}}}
+	require.True(t, streamHasShard(opt, 3))
+	require.False(t, streamHasShard(opt, 2))
+
+	env := newStreamTestEnv()
+	si := newStreamTestInfo()
+	setStreamTestOptions(si, opt)
+	require.Equal(t, opt, env.pw.getStreamTaskOptions(si))
+}
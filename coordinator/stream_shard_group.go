@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// streamShardGroup is a shard group of the destination measurement with the routing information of its rows.
+type streamShardGroup struct {
+	mst             string
+	sg              *meta2.ShardGroupInfo
+	shardKeyInfo    *meta2.ShardKeyInfo
+	aliveShardIdxes []int
+}
+
+// streamShardGroups caches the shard groups looked up by a stream context. The windows of a flush are emitted
+// out of time order, so the shard group of the previous row alone is replaced back and forth when they span
+// several shard groups. The groups are matched by their time boundaries and the measurement, and the cache is
+// dropped with the context, so every flush looks up the shard groups again.
+type streamShardGroups []streamShardGroup
+
+func (c streamShardGroups) get(mst string, ts time.Time) *streamShardGroup {
+	for i := range c {
+		if c[i].mst == mst && c[i].sg.Contains(ts) {
+			return &c[i]
+		}
+	}
+	return nil
+}
+
+// shardGroupOf returns the shard group of the destination measurement containing the time, which is created if needed.
+func (s *Stream) shardGroupOf(database, retentionPolicy string, ts int64, ctx *streamCtx) (*streamShardGroup, error) {
+	t := time.Unix(0, ts)
+	if g := ctx.shardGroups.get(ctx.ms.Name, t); g != nil {
+		return g, nil
+	}
+
+	mi := ctx.ms
+	sg, _, err := ctx.writeHelper.createShardGroup(database, retentionPolicy, t, mi.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	g := streamShardGroup{mst: mi.Name, sg: sg}
+	if len(ctx.db.ShardKey.ShardKey) > 0 {
+		g.shardKeyInfo = &ctx.db.ShardKey
+	} else {
+		g.shardKeyInfo = mi.GetShardKey(sg.ID)
+	}
+	g.aliveShardIdxes = s.MetaClient.GetAliveShards(database, sg)
+	ctx.shardGroups = append(ctx.shardGroups, g)
+	return &ctx.shardGroups[len(ctx.shardGroups)-1], nil
+}
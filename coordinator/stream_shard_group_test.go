@@ -0,0 +1,57 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamShardGroupCache(t *testing.T) {
+	hour := time.Now().Truncate(time.Hour)
+	env := newStreamReplayEnv(hour.Add(10 * time.Minute))
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	create := mc.CreateShardGroupFn
+	var lookups int
+	mc.CreateShardGroupFn = func(database, policy string, timestamp time.Time, version uint32, engineType config.EngineType) (*meta2.ShardGroupInfo, error) {
+		lookups++
+		return create(database, policy, timestamp, version, engineType)
+	}
+
+	// the windows of the groups span the shard groups of two hours
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	var rows []*influx.Row
+	for i := 0; i < 20; i++ {
+		tags := []influx.Tag{{Key: "tk1", Value: fmt.Sprintf("g%d", i)}}
+		rows = append(rows,
+			newStreamTestRow(hour.Add(-2*time.Second).UnixNano(), tags, floatField("fk1", 1)),
+			newStreamTestRow(hour.UnixNano(), tags, floatField("fk1", 2)))
+	}
+	out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	require.Len(t, out, 40)
+	require.Equal(t, 2, lookups)
+
+	// the shard groups are looked up again by the following flush
+	env.calculate(t, si, rows[0])
+	require.Equal(t, 3, lookups)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+)
+
+// streamMstVersionLen is the length of the version suffix of the stored names of the measurements, like "_0000"
+const streamMstVersionLen = 5
+
+// checkShardKeySize estimates the size of the shard keys of the windows built from the measurement and the dims,
+// which are ",key=value" pairs following the stored name of the measurement. The rows whose shard keys exceed
+// MaxShardKey are dropped at the write time, so the task is rejected if the keys and the names take more than
+// the limit, or if the values as long as MaxDimValueLength may exceed it.
+func (w *streamTask) checkShardKeySize() error {
+	size := len(w.info.DesMst.Name)
+	for _, mst := range w.fanOutMsts {
+		if len(mst) > size {
+			size = len(mst)
+		}
+	}
+	size += streamMstVersionLen
+
+	var bounded int
+	for _, d := range w.shardDims {
+		size += len(",=") + len(d)
+		if d == w.sourceTag {
+			// the values of the source tag are the names of the source measurements
+			size += w.maxSourceLen()
+			continue
+		}
+		if d == w.sourceRPTag {
+			size += w.maxSourceRPLen()
+			continue
+		}
+		bounded++
+	}
+	if size > MaxShardKey {
+		return fmt.Errorf("the shard keys of stream task %s take at least %d bytes, which exceed the limit %d",
+			w.info.Name, size, MaxShardKey)
+	}
+	if w.opt.Limits.MaxDimValueLength <= 0 {
+		return nil
+	}
+	if size += bounded * w.opt.Limits.MaxDimValueLength; size > MaxShardKey {
+		return fmt.Errorf("the shard keys of stream task %s may take %d bytes with the dim values of %d bytes, which exceed the limit %d",
+			w.info.Name, size, w.opt.Limits.MaxDimValueLength, MaxShardKey)
+	}
+	return nil
+}
+
+func (w *streamTask) maxSourceLen() int {
+	n := len(w.info.SrcMst.Name)
+	for _, mst := range w.opt.Group.UnionMsts {
+		if len(mst) > n {
+			n = len(mst)
+		}
+	}
+	return n
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamShardKeySize(t *testing.T) {
+	newTask := func(dims []string, opt *StreamTaskOptions) error {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+		si.Dims = dims
+		srcSchema, dstSchema := streamTestSchema(si)
+		for _, d := range dims {
+			srcSchema[d] = influx.Field_Type_Tag
+		}
+		_, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		return err
+	}
+
+	// the values are unbounded by default
+	require.NoError(t, newTask([]string{"tk1", "tk2"}, nil))
+	long := strings.Repeat("k", MaxShardKey)
+	require.EqualError(t, newTask([]string{long}, nil),
+		"the shard keys of stream task t take at least 65547 bytes, which exceed the limit 65536")
+
+	// the worst case of the bounded values
+	require.NoError(t, newTask([]string{"tk1", "tk2"}, &StreamTaskOptions{Limits: StreamLimitOptions{MaxDimValueLength: 1024}}))
+	require.EqualError(t, newTask([]string{"tk1", "tk2"}, &StreamTaskOptions{Limits: StreamLimitOptions{MaxDimValueLength: MaxShardKey / 2}}),
+		"the shard keys of stream task t may take 65555 bytes with the dim values of 32768 bytes, which exceed the limit 65536")
+
+	// the values of the source tag are bounded by the names of the source measurements
+	opt := &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{strings.Repeat("m", MaxShardKey)}, SourceTag: "src"}}
+	require.ErrorContains(t, newTask([]string{"tk1"}, opt), "the shard keys of stream task t take at least")
+}
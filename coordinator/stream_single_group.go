@@ -0,0 +1,140 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// singleGroupKey is the key of the only group of the tasks aggregated by calculateSingleGroup.
+const singleGroupKey = ""
+
+// streamSingleWindow is a window of the single group ending at end, v holds the result of the call.
+type streamSingleWindow struct {
+	end int64
+	v   []*float64
+}
+
+// isSingleGroup returns whether the task has one call and no dims, and none of the options which need the general
+// calculation of the rows, such as the conditions, the accumulators, the group limits and the dead letters, or the
+// fill, which adds the empty windows to the data cache.
+func (w *streamTask) isSingleGroup() bool {
+	if len(w.calls) != 1 || len(w.tagDimKeys) != 0 || len(w.fieldIndexKeys) != 0 {
+		return false
+	}
+	if w.direct || w.passthrough || w.bucket != nil || w.sourceTag != "" || w.sourceRPTag != "" || w.sourceShardTag != "" || streamHasSourceRPs(w.info) {
+		return false
+	}
+	if w.accCalls != nil || w.filter != nil || w.callFilters != nil || w.weights != nil || w.missingFields != nil {
+		return false
+	}
+	if streamFills(w.info) {
+		return false
+	}
+	opt := w.opt
+	return !w.limitsGroups() && !w.parallel() && opt.Limits.FlushGroupPoints <= 0 && opt.Limits.MaxGroupWindows <= 0 && opt.Errors.DeadLetterMst == ""
+}
+
+// calculateSingleGroup aggregates the rows of the single group task as calculateWindow does, without the group keys
+// of the rows and the data cache. The rows of a batch mostly fall into the same window, which is looked up once it
+// changes.
+func (s *Stream) calculateSingleGroup(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
+	call := task.calls[0]
+	isCount := call.Call == "count"
+	var maxTime int64 = math.MinInt64
+	var aggregated int64
+	watermark := ctx.state.loadWatermark()
+	curEnd := int64(math.MinInt64)
+	var cur []*float64
+	for _, r := range rows {
+		if fv := task.unsupportedField(r); fv != nil {
+			return fmt.Errorf("the %s %s type is not supported for stream task %s", fv.Key, influx.FieldTypeString(fv.Type), si.Name)
+		}
+		st, _ := ctx.opt.Window(r.Timestamp)
+		if task.isLate(ctx.windowEnd(si, st)-1, watermark) {
+			ctx.state.addLateRow()
+			continue
+		}
+		aggregated++
+		if r.Timestamp > maxTime {
+			maxTime = r.Timestamp
+		}
+		if r.Timestamp > watermark {
+			watermark = r.Timestamp
+		}
+		// the window is opened by the rows missing the field as well
+		if et := st + int64(si.Interval) - 1; et != curEnd {
+			curEnd, cur = et, task.singleGroupWindow(et)
+		}
+		id, ok := r.ColumnToIndex[call.Name]
+		if !ok {
+			continue
+		}
+		if isCount {
+			if cur[0] == nil {
+				cur[0] = new(float64)
+			}
+			ctx.addCount(cur[0])
+			continue
+		}
+		curVal := r.Fields[id-r.Tags.Len()].NumValue
+		if cur[0] == nil {
+			var t float64
+			if call.Call == "min" {
+				t = math.MaxFloat64
+			} else if call.Call == "max" {
+				t = -math.MaxFloat64
+			}
+			cur[0] = &t
+		}
+		*cur[0] = call.SingleThreadFunc(*cur[0], curVal)
+	}
+	ctx.state.stats.AddRowsAggregated(aggregated)
+	if maxTime != math.MinInt64 {
+		ctx.state.advanceWatermark(maxTime)
+	}
+	return nil
+}
+
+// singleGroupWindow returns the values of the window of the single group ending at et, the window is opened if
+// it is not open yet. The windows of a batch are few, which are looked up in order.
+func (w *streamTask) singleGroupWindow(et int64) []*float64 {
+	for i := range w.singleWindows {
+		if w.singleWindows[i].end == et {
+			return w.singleWindows[i].v
+		}
+	}
+	n := len(w.singleWindows)
+	if n < cap(w.singleWindows) {
+		// the window of a previous batch is reused
+		w.singleWindows = w.singleWindows[:n+1]
+		sw := &w.singleWindows[n]
+		sw.end, sw.v[0] = et, nil
+		return sw.v
+	}
+	w.singleWindows = append(w.singleWindows, streamSingleWindow{end: et, v: make([]*float64, 1)})
+	return w.singleWindows[n].v
+}
+
+// resetSingleWindows drops the windows of the single group once the batch aggregating them is done.
+func (w *streamTask) resetSingleWindows() {
+	w.singleWindows = w.singleWindows[:0]
+}
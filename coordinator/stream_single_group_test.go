@@ -0,0 +1,152 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// singleGroupTask builds the task of the single group stream aggregated by the fast path or the general one.
+func (e *streamTestEnv) singleGroupTask(t testing.TB, si *meta2.StreamInfo, fast bool) *streamTask {
+	srcSchema, dstSchema := streamTestSchema(si)
+	task, err := newStreamTask(si, srcSchema, dstSchema, e.pw.getStreamTaskOptions(si))
+	require.NoError(t, err)
+	require.True(t, task.singleGroup)
+	task.singleGroup = fast
+	return task
+}
+
+// calculatePath runs the sql layer calculation of the stream by the task of singleGroupTask.
+func (e *streamTestEnv) calculatePath(t testing.TB, si *meta2.StreamInfo, task *streamTask, rows ...*influx.Row) []*influx.Row {
+	ctx := getInjestionCtx()
+	defer putInjestionCtx(ctx)
+	ctx.writeHelper = newWriteHelper(e.pw)
+	ctx.stream = nil
+	ctx.streamWriteHelpers = ctx.streamWriteHelpers[:0]
+	ctx.streamInfos = append(ctx.streamInfos[:0], si)
+	require.NoError(t, ctx.initStreamVar(e.pw))
+	ctx.stream.tasks[si.Name] = task
+
+	_, err := ctx.stream.calculate(rows, si, e.pw, ctx, 0)
+	require.NoError(t, err)
+	var out []*influx.Row
+	for i := range ctx.shardRowMap {
+		out = append(out, ctx.shardRowMap[i].rows...)
+	}
+	return rowsOfMst(out, "mst2")
+}
+
+func TestStreamSingleGroup(t *testing.T) {
+	sec := int64(time.Second)
+	for _, call := range []string{"sum", "count", "min", "max"} {
+		si := newStreamTestInfo(&meta2.StreamCall{Call: call, Field: "fk1", Alias: call + "_fk1"})
+		si.Dims = nil
+		fast, general := newStreamTestEnv(), newStreamTestEnv()
+		general.base = fast.base
+		opt := &StreamTaskOptions{Window: StreamWindowOptions{AllowedLateness: time.Second}}
+		setStreamTestOptions(si, opt)
+		setStreamTestOptions(si, opt)
+
+		row := func(ts int64, fields ...influx.Field) *influx.Row {
+			return newStreamTestRow(fast.base+ts, []influx.Tag{{Key: "tk1", Value: "a"}}, fields...)
+		}
+		batches := [][]*influx.Row{
+			{row(0, floatField("fk1", 3)), row(1, floatField("fk1", -2)), row(sec, floatField("fk1", 5)), row(2), row(3, floatField("fk1", 7))},
+			// the rows out of order, the rows missing the field and the late rows
+			{row(4*sec, floatField("fk1", 1)), row(sec + 1), row(3*sec, floatField("fk1", 4)), row(0, floatField("fk1", 9))},
+		}
+		fastTask, generalTask := fast.singleGroupTask(t, si, true), general.singleGroupTask(t, si, false)
+		for _, rows := range batches {
+			want := general.calculatePath(t, si, generalTask, rows...)
+			got := fast.calculatePath(t, si, fastTask, rows...)
+			// the windows are dropped once emitted, and reused by the next batch
+			require.Empty(t, fastTask.singleWindows, call)
+			require.NotZero(t, cap(fastTask.singleWindows), call)
+			require.Equal(t, len(want), len(got), call)
+			for i := range want {
+				require.Equal(t, want[i].Timestamp, got[i].Timestamp, call)
+				require.Equal(t, want[i].Tags, got[i].Tags, call)
+				require.Equal(t, want[i].Fields, got[i].Fields, call)
+				require.Equal(t, want[i].ShardKey, got[i].ShardKey, call)
+			}
+		}
+		fastState, generalState := fast.pw.getStreamTaskState(si.Name), general.pw.getStreamTaskState(si.Name)
+		require.Equal(t, generalState.loadWatermark(), fastState.loadWatermark(), call)
+	}
+}
+
+func TestStreamSingleGroupCheck(t *testing.T) {
+	sum := &meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"}
+	build := func(si *meta2.StreamInfo, opt *StreamTaskOptions) bool {
+		srcSchema, dstSchema := streamTestSchema(si)
+		task, err := newStreamTask(si, srcSchema, dstSchema, opt)
+		require.NoError(t, err)
+		return task.singleGroup
+	}
+	si := newStreamTestInfo(sum)
+	require.False(t, build(si, nil))
+	si.Dims = nil
+	require.True(t, build(si, nil))
+	require.False(t, build(si, &StreamTaskOptions{Limits: StreamLimitOptions{Workers: 2}}))
+	require.False(t, build(si, &StreamTaskOptions{Errors: StreamErrorOptions{DeadLetterMst: "dl"}}))
+
+	si = newStreamTestInfo(sum, &meta2.StreamCall{Call: "count", Field: "fk1", Alias: "count_fk1"})
+	si.Dims = nil
+	require.False(t, build(si, nil))
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"})
+	si.Dims = nil
+	require.False(t, build(si, nil))
+	si = newStreamTestInfo(sum)
+	si.Dims = nil
+	si.Slide = si.Interval / 2
+	require.False(t, build(si, nil))
+
+	// the empty windows filled are added to the data cache
+	si = newStreamTestInfo(sum)
+	si.Dims = nil
+	si.Fill = influxql.NumberFill
+	require.False(t, build(si, nil))
+}
+
+func BenchmarkStreamSingleGroup(b *testing.B) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Dims = nil
+	for _, path := range []struct {
+		name string
+		fast bool
+	}{{"fast", true}, {"general", false}} {
+		b.Run(path.name, func(b *testing.B) {
+			env := newStreamTestEnv()
+			rows := make([]*influx.Row, 1000)
+			for i := range rows {
+				rows[i] = newStreamTestRow(env.base+int64(i)*int64(time.Millisecond), nil, floatField("fk1", float64(i)))
+			}
+			task := env.singleGroupTask(b, si, path.fast)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				env.calculatePath(b, si, task, rows...)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// maxStreamSlideWindows bounds the overlapping windows a row is aggregated into
+const maxStreamSlideWindows = 64
+
+func checkStreamSlide(info *meta2.StreamInfo) error {
+	if info.Slide < 0 || info.Slide > info.Interval {
+		return fmt.Errorf("the slide %s of stream task %s is not in [0, %s]", info.Slide, info.Name, info.Interval)
+	}
+	if info.Slide == 0 {
+		return nil
+	}
+	if n := (info.Interval + info.Slide - 1) / info.Slide; n > maxStreamSlideWindows {
+		return fmt.Errorf("a row of stream task %s is in %d windows, the max is %d", info.Name, n, maxStreamSlideWindows)
+	}
+	return nil
+}
+
+// streamSliding returns whether the windows of the stream overlap. The store only aggregates the tumbling windows,
+// so all the calls of a sliding stream are aggregated by the accumulators of the sql layer.
+func streamSliding(info *meta2.StreamInfo) bool {
+	return info.Slide > 0 && info.Slide < info.Interval
+}
+
+// windowStarts returns the start times of the windows of the time, from the latest to the earliest.
+// The sliding windows start at the multiples of the slide and all the windows containing the time are returned.
+func (s *streamCtx) windowStarts(si *meta2.StreamInfo, t int64) []int64 {
+	s.starts = s.starts[:0]
+	if !streamSliding(si) {
+		st, _ := s.opt.Window(t)
+		return append(s.starts, st)
+	}
+	slide, interval := int64(si.Slide), int64(si.Interval)
+	// the windows start at the multiples of the slide shifted by the offset
+	dt := (t - int64(si.Offset)) % slide
+	if dt < 0 {
+		dt += slide
+	}
+	st := t - dt
+	for ; st+interval > t; st -= slide {
+		s.starts = append(s.starts, st)
+	}
+	return s.starts
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSlideCheck(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	si.Slide = 2 * time.Second
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the slide 2s of stream task t is not in [0, 1s]")
+	si.Slide = time.Millisecond
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "a row of stream task t is in 1000 windows, the max is 64")
+	si.Slide = time.Second
+	task, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	require.False(t, task.sliding)
+}
+
+func TestStreamSlide(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"},
+		&meta2.StreamCall{Call: "max", Field: "fk1", Alias: "max_fk1"})
+	si.Interval = 3 * time.Second
+	si.Slide = time.Second
+	require.True(t, streamKeepsState(si))
+	// the rows are in the windows which are all covered by the shard group
+	start := env.base + int64(2*time.Second)
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	windowsOf := func(rows []*influx.Row) map[int64][2]float64 {
+		m := map[int64][2]float64{}
+		for _, r := range rowsOfMst(rows, "mst2") {
+			// the results of the sliding windows are written to the destination directly
+			require.False(t, r.StreamOnly)
+			sum, _ := fieldValue(r, "sum_fk1")
+			max, _ := fieldValue(r, "max_fk1")
+			m[(r.Timestamp-start)/int64(time.Second)] = [2]float64{sum, max}
+		}
+		return m
+	}
+
+	out := env.calculate(t, si, row(500, 1), row(1500, 2), row(2500, 4))
+	require.Equal(t, map[int64][2]float64{-2: {1, 1}, -1: {3, 2}, 0: {7, 4}, 1: {6, 4}, 2: {4, 4}}, windowsOf(out))
+
+	// the windows are kept across the batches
+	out = env.calculate(t, si, row(2600, 3))
+	require.Equal(t, map[int64][2]float64{0: {10, 4}, 1: {9, 4}, 2: {7, 4}}, windowsOf(out))
+}
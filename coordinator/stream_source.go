@@ -0,0 +1,132 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	query2 "github.com/influxdata/influxdb/query"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamSourceChunkSize is the number of the points of a series read by a chunk of the backfill query.
+const streamSourceChunkSize = 10000
+
+// StreamQueryExecutor executes the queries reading the historical rows of the source measurements of the streams.
+type StreamQueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query2.Result
+}
+
+// QueryStreamSource reads the historical rows of the source measurements by the query path, every field and tag
+// of the points in the time range is read.
+type QueryStreamSource struct {
+	QueryExecutor StreamQueryExecutor
+}
+
+// ReadRows returns the rows of the measurement whose time is in [start, end).
+func (s *QueryStreamSource) ReadRows(database, retentionPolicy, mst string, start, end int64) ([]*influx.Row, error) {
+	q, err := influxql.ParseQuery(fmt.Sprintf("SELECT * FROM %s WHERE time >= %d AND time < %d GROUP BY *",
+		influxql.QuoteIdent(database, retentionPolicy, mst), start, end))
+	if err != nil {
+		return nil, err
+	}
+	closing := make(chan struct{})
+	defer close(closing)
+	opt := query.ExecutionOptions{
+		Database:  database,
+		ChunkSize: streamSourceChunkSize,
+		AbortCh:   closing,
+	}
+
+	var rows []*influx.Row
+	for res := range s.QueryExecutor.ExecuteQuery(q, opt, closing, nil) {
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		for _, series := range res.Series {
+			seriesRows, err := streamSourceRows(mst, series)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, seriesRows...)
+		}
+	}
+	return rows, nil
+}
+
+// streamSourceRows converts the points of a series to the rows, the null fields of the points are omitted.
+func streamSourceRows(mst string, series *models.Row) ([]*influx.Row, error) {
+	var tags influx.PointTags
+	for k, v := range series.Tags {
+		if v != "" {
+			tags = append(tags, influx.Tag{Key: k, Value: v})
+		}
+	}
+	sort.Sort(&tags)
+
+	rows := make([]*influx.Row, 0, len(series.Values))
+	for _, values := range series.Values {
+		if len(values) != len(series.Columns) || len(values) == 0 {
+			return nil, fmt.Errorf("the point of the measurement %s has %d values for %d columns", mst, len(values), len(series.Columns))
+		}
+		r := &influx.Row{Name: mst, Tags: tags}
+		switch t := values[0].(type) {
+		case time.Time:
+			r.Timestamp = t.UnixNano()
+		case int64:
+			r.Timestamp = t
+		default:
+			return nil, fmt.Errorf("the time %v of the measurement %s is not supported", values[0], mst)
+		}
+		for i := 1; i < len(values); i++ {
+			f := influx.Field{Key: series.Columns[i]}
+			switch v := values[i].(type) {
+			case nil:
+				continue
+			case float64:
+				f.Type, f.NumValue = influx.Field_Type_Float, v
+			case int64:
+				f.Type, f.NumValue = influx.Field_Type_Int, float64(v)
+			case uint64:
+				f.Type, f.NumValue = influx.Field_Type_UInt, float64(v)
+			case string:
+				f.Type, f.StrValue = influx.Field_Type_String, v
+			case bool:
+				f.Type = influx.Field_Type_Boolean
+				if v {
+					f.NumValue = 1
+				}
+			default:
+				return nil, fmt.Errorf("the field %s of the measurement %s has the unsupported value %v", f.Key, mst, v)
+			}
+			r.Fields = append(r.Fields, f)
+		}
+		if len(r.Fields) == 0 {
+			continue
+		}
+		sort.Stable(&r.Fields)
+		buildColumnToIndex(r)
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// The rows of all the source retention policies of a stream are aggregated into the same windows by their own
+// timestamps, nothing is shifted or deduplicated across them. The task is built by the schema of the source
+// measurement in SrcMst.RetentionPolicy, the int and the float fields of the calls are aggregated alike as the
+// numbers, and the fields of the other retention policies of other types are rejected as the type errors.
+
+// streamHasSourceRPs returns true if the rows of more than one retention policy feed the stream, which are
+// always calculated at the sql layer to aggregate the rows of all of them into the same windows.
+func streamHasSourceRPs(si *meta2.StreamInfo) bool {
+	return len(si.SrcRPs) > 0
+}
+
+// sameStreamFieldKind returns whether the fields of the types are aggregated alike by the calls.
+func sameStreamFieldKind(a, b int32) bool {
+	number := func(t int32) bool { return t == influx.Field_Type_Int || t == influx.Field_Type_Float }
+	return a == b || number(a) && number(b)
+}
+
+// checkSourceRPSchema checks the fields of the calls in the schema of the source measurement in the retention
+// policy against the schema of it in SrcMst.RetentionPolicy, the fields missing from either are not checked.
+func checkSourceRPSchema(si *meta2.StreamInfo, srcSchema, rpSchema map[string]int32, rp string) error {
+	for _, c := range si.Calls {
+		t, ok := srcSchema[c.Field]
+		if !ok {
+			continue
+		}
+		if rt, ok := rpSchema[c.Field]; ok && !sameStreamFieldKind(t, rt) {
+			return fmt.Errorf("the field %s of the %s call %s is %s in the source retention policy %s but %s in %s",
+				c.Field, c.Call, c.Alias, influx.FieldTypeString(rt), rp, influx.FieldTypeString(t), si.SrcMst.RetentionPolicy)
+		}
+	}
+	return nil
+}
+
+// mismatchedSourceField returns the field of a call of the row whose type differs from the one of the source
+// schema the task is built by, nil if the row matches it. The fields missing from the schema are not checked.
+func (w *streamTask) mismatchedSourceField(r *influx.Row) *influx.Field {
+	for i := range w.calls {
+		id, ok := r.ColumnToIndex[w.calls[i].Name]
+		if !ok || w.calls[i].InFieldType == influx.Field_Type_Unknown {
+			continue
+		}
+		if fv := &r.Fields[id-r.Tags.Len()]; !sameStreamFieldKind(fv.Type, w.calls[i].InFieldType) {
+			return fv
+		}
+	}
+	return nil
+}
+
+// buildSourceRPTag checks the tag carrying the source retention policy of the rows, which groups the rows of
+// different retention policies apart.
+func (w *streamTask) buildSourceRPTag() error {
+	tag := w.opt.Group.SourceRPTag
+	if tag == "" {
+		return nil
+	}
+	if !streamHasSourceRPs(w.info) {
+		return fmt.Errorf("stream task %s with the source retention policy tag %s has only one source retention policy", w.info.Name, tag)
+	}
+	if w.passthrough {
+		return fmt.Errorf("stream task %s without calls has no groups to tag with the source retention policy", w.info.Name)
+	}
+	for _, d := range append(w.tagDimKeys, w.fieldIndexKeys...) {
+		if d == tag {
+			return fmt.Errorf("the source retention policy tag %s conflicts with the group by tags of stream task %s", d, w.info.Name)
+		}
+	}
+	if w.bucket != nil && tag == w.bucket.tag {
+		return fmt.Errorf("the source retention policy tag %s of stream task %s is the bucket tag", tag, w.info.Name)
+	}
+	w.sourceRPTag = tag
+	return nil
+}
+
+// sourceRPDims returns the sorted dims with the source retention policy tag if the task groups the rows by it.
+func (w *streamTask) sourceRPDims(dims []string) []string {
+	if w.sourceRPTag == "" {
+		return dims
+	}
+	withRP := make([]string, 0, len(dims)+1)
+	withRP = append(withRP, dims...)
+	withRP = append(withRP, w.sourceRPTag)
+	sort.Strings(withRP)
+	return withRP
+}
+
+// addSourceRPTag adds the source retention policy tag to the tags of the agg row and keeps the tags sorted.
+func (w *streamTask) addSourceRPTag(r *influx.Row, rp string) {
+	addAggTag(r, w.sourceRPTag, rp)
+}
+
+func (w *streamTask) maxSourceRPLen() int {
+	n := len(w.info.SrcMst.RetentionPolicy)
+	for _, rp := range w.info.SrcRPs {
+		if len(rp) > n {
+			n = len(rp)
+		}
+	}
+	return n
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// calculateRP runs the sql layer calculation of the rows written to the source retention policy.
+func (e *streamTestEnv) calculateRP(t *testing.T, si *meta2.StreamInfo, rp string, rows ...*influx.Row) ([]*influx.Row, error) {
+	ctx := e.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	ctx.streamSourceRP = rp
+	_, err := ctx.stream.calculate(rows, si, e.pw, ctx, 0)
+
+	var out []*influx.Row
+	for i := range ctx.shardRowMap {
+		out = append(out, ctx.shardRowMap[i].rows...)
+	}
+	return out, err
+}
+
+func TestStreamSourceRPs(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.SrcRPs = []string{"rp1"}
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{SourceRPTag: "tier"}})
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+
+	for rp, sum := range map[string]float64{"rp0": 3, "rp1": 4} {
+		out, err := env.calculateRP(t, si, rp, row(1), row(sum-1))
+		require.NoError(t, err)
+		out = rowsOfMst(out, "mst2")
+		require.Len(t, out, 1)
+		require.Equal(t, []influx.Tag{{Key: "tier", Value: rp}, {Key: "tk1", Value: "a"}}, []influx.Tag(out[0].Tags))
+		// the source retention policy is a part of the shard key
+		require.True(t, strings.Contains(string(out[0].ShardKey), "tier="+rp), string(out[0].ShardKey))
+		v, _ := fieldValue(out[0], "sum_fk1")
+		require.Equal(t, sum, v)
+	}
+
+	// the windows of the retention policies are merged without the tag
+	setStreamTestOptions(si, defaultStreamTaskOptions)
+	out, err := env.calculateRP(t, si, "rp1", row(1))
+	require.NoError(t, err)
+	out = rowsOfMst(out, "mst2")
+	require.Len(t, out, 1)
+	require.Equal(t, []influx.Tag{{Key: "tk1", Value: "a"}}, []influx.Tag(out[0].Tags))
+
+	// the fields of the other retention policies follow the source schema, the ints are numbers as well
+	last := newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"})
+	last.SrcRPs = []string{"rp1"}
+	_, err = env.calculateRP(t, last, "rp1", newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}},
+		influx.Field{Key: "fk1", NumValue: 1, Type: influx.Field_Type_Int}))
+	require.NoError(t, err)
+	_, err = env.calculateRP(t, last, "rp1", newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}},
+		influx.Field{Key: "fk1", StrValue: "x", Type: influx.Field_Type_String}))
+	require.EqualError(t, err, "the fk1 string type of the source retention policy rp1 differs from the source schema of stream task t")
+}
+
+func TestStreamSourceRPCheck(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{SourceRPTag: "tier"}})
+	require.EqualError(t, err, "stream task t with the source retention policy tag tier has only one source retention policy")
+
+	si.SrcRPs = []string{"rp1"}
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{SourceRPTag: "tk1"}})
+	require.EqualError(t, err, "the source retention policy tag tk1 conflicts with the group by tags of stream task t")
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"mem"}, SourceTag: "src", SourceRPTag: "src"}})
+	require.EqualError(t, err, "the source tag src conflicts with the group by tags of stream task t")
+
+	require.NoError(t, checkSourceRPSchema(si, srcSchema, map[string]int32{"fk1": influx.Field_Type_Int}, "rp1"))
+	require.NoError(t, checkSourceRPSchema(si, srcSchema, map[string]int32{}, "rp1"))
+	require.EqualError(t, checkSourceRPSchema(si, srcSchema, map[string]int32{"fk1": influx.Field_Type_String}, "rp1"),
+		"the field fk1 of the sum call sum_fk1 is string in the source retention policy rp1 but float in rp0")
+}
+
+func TestStreamSourceRPChain(t *testing.T) {
+	env := newStreamTestEnv()
+	minute := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	minute.DesMst.RetentionPolicy = "rp1"
+	hour := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "sum_fk1", Alias: "sum_sum_fk1"})
+	hour.Name = "t_rollup"
+	hour.SrcMst = &meta2.StreamMeasurementInfo{Name: "mst2", Database: "db0", RetentionPolicy: "rp0"}
+	hour.DesMst = &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp0"}
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient),
+		infos: map[string]*meta2.StreamInfo{minute.Name: minute, hour.Name: hour}}
+	require.Empty(t, env.pw.streamChildren(minute))
+	// the rows emitted to a source retention policy of the child feed it
+	hour.SrcRPs = []string{"rp1"}
+	require.Equal(t, []*meta2.StreamInfo{hour}, env.pw.streamChildren(minute))
+}
+
+// rpStreamSource returns the rows of the retention policy read.
+type rpStreamSource map[string][]*influx.Row
+
+func (m rpStreamSource) ReadRows(database, retentionPolicy, mst string, start, end int64) ([]*influx.Row, error) {
+	return m[retentionPolicy], nil
+}
+
+func TestStreamSourceRPBackfill(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.SrcRPs = []string{"rp1"}
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient),
+		infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	env.pw.StreamSource = rpStreamSource{"rp0": {row(1), row(2)}, "rp1": {row(4)}}
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	sums := func() map[string]float64 {
+		written = written[:0]
+		require.NoError(t, env.pw.BackfillStream(si.Name, env.base, env.base+1))
+		m := map[string]float64{}
+		for _, r := range rowsOfMst(written, "mst2") {
+			m[tagValue(r, "tier")], _ = fieldValue(r, "sum_fk1")
+		}
+		return m
+	}
+
+	// the whole windows are recomputed from the rows of all the source retention policies
+	require.Equal(t, map[string]float64{"": 7}, sums())
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{SourceRPTag: "tier"}})
+	require.Equal(t, map[string]float64{"rp0": 3, "rp1": 4}, sums())
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	query2 "github.com/influxdata/influxdb/query"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStreamQueryExecutor returns the results to any query, and records the queries.
+type mockStreamQueryExecutor struct {
+	results []*query2.Result
+	queries []string
+}
+
+func (e *mockStreamQueryExecutor) ExecuteQuery(q *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query2.Result {
+	e.queries = append(e.queries, opt.Database+": "+q.String())
+	ch := make(chan *query2.Result, len(e.results))
+	for _, res := range e.results {
+		ch <- res
+	}
+	close(ch)
+	return ch
+}
+
+func TestQueryStreamSource(t *testing.T) {
+	columns := []string{"time", "fb", "ff", "fi", "fs"}
+	executor := &mockStreamQueryExecutor{results: []*query2.Result{
+		{Partial: true, Series: models.Rows{{
+			Name: "mst0", Tags: map[string]string{"tk2": "b", "tk1": "a", "tk3": ""}, Columns: columns,
+			Values: [][]interface{}{{time.Unix(0, 1), true, 1.5, int64(2), "x"}},
+		}}},
+		{Series: models.Rows{{
+			Name: "mst0", Tags: map[string]string{"tk1": "a"}, Columns: columns,
+			Values: [][]interface{}{{int64(2), nil, 2.5, nil, nil}, {int64(3), nil, nil, nil, nil}},
+		}}},
+	}}
+	src := &QueryStreamSource{QueryExecutor: executor}
+	rows, err := src.ReadRows("db0", "rp0", "mst0", 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{`db0: SELECT * FROM db0.rp0.mst0 WHERE time >= 1 AND time < 10 GROUP BY *`}, executor.queries)
+
+	// the points with no field are omitted, and so are the null fields and the empty tags
+	require.Len(t, rows, 2)
+	require.Equal(t, int64(1), rows[0].Timestamp)
+	require.Equal(t, influx.PointTags{{Key: "tk1", Value: "a"}, {Key: "tk2", Value: "b"}}, rows[0].Tags)
+	require.Equal(t, influx.Fields{
+		{Key: "fb", NumValue: 1, Type: influx.Field_Type_Boolean},
+		{Key: "ff", NumValue: 1.5, Type: influx.Field_Type_Float},
+		{Key: "fi", NumValue: 2, Type: influx.Field_Type_Int},
+		{Key: "fs", StrValue: "x", Type: influx.Field_Type_String},
+	}, rows[0].Fields)
+	require.Equal(t, 3, rows[0].ColumnToIndex["ff"])
+	require.Equal(t, int64(2), rows[1].Timestamp)
+	require.Equal(t, influx.Fields{{Key: "ff", NumValue: 2.5, Type: influx.Field_Type_Float}}, rows[1].Fields)
+
+	executor.results = []*query2.Result{{Err: errors.New("query failed")}}
+	_, err = src.ReadRows("db0", "rp0", "mst0", 1, 10)
+	require.EqualError(t, err, "query failed")
+	executor.results = []*query2.Result{{Series: models.Rows{{Columns: []string{"time", "f"}, Values: [][]interface{}{{"now", 1.0}}}}}}
+	_, err = src.ReadRows("db0", "rp0", "mst0", 1, 10)
+	require.EqualError(t, err, "the time now of the measurement mst0 is not supported")
+}
+
+func TestStreamBackfillFromQuery(t *testing.T) {
+	env := newStreamTestEnv()
+	// the window of the mock stream is 5ns
+	si := env.pw.MetaClient.GetStreamInfos()["t"]
+	env.pw.StreamSource = &QueryStreamSource{QueryExecutor: &mockStreamQueryExecutor{results: []*query2.Result{{Series: models.Rows{{
+		Name: "mst0", Tags: map[string]string{"tk1": "a"}, Columns: []string{"time", "fk1"},
+		Values: [][]interface{}{{time.Unix(0, env.base), 1.0}, {time.Unix(0, env.base+1), 2.0}},
+	}}}}}}
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+	require.NoError(t, env.pw.BackfillStream(si.Name, env.base, env.base+5))
+	out := rowsOfMst(written, "mst2")
+	require.Len(t, out, 1)
+	sum, ok := fieldValue(out[0], "sum_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(3), sum)
+}
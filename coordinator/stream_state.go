@@ -0,0 +1,243 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"sync/atomic"
+
+	atomic2 "github.com/openGemini/openGemini/lib/atomic"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+)
+
+// streamTaskState is the runtime state of a stream task which is kept across the batches.
+type streamTaskState struct {
+	// accumulators are the states of the windows of the calls aggregated by accumulators
+	accumulators streamAccumulators
+	// fill holds the latest windows of the groups to fill the empty windows after them
+	fill streamFillState
+	// carry holds the latest values of the groups carried forward into the empty windows after them
+	carry streamCarryState
+	// delta holds the latest results of the groups written as the deltas from the previous windows
+	delta streamDeltaState
+
+	// schemaViolations is the number of emitted rows rejected by the safe-mode
+	schemaViolations int64
+	// forcedCloses is the number of windows closed by the limit of the open windows per group
+	forcedCloses int64
+	// closedWindowRows is the number of rows dropped because their windows are force-closed
+	closedWindowRows int64
+	// lateRows is the number of rows dropped because they are later than the allowed lateness
+	lateRows int64
+	// groupLimitRows is the number of rows dropped because their groups exceed the group limits
+	groupLimitRows int64
+	// groupLimitFlushes is the number of the early emits of the groups because of the group limits
+	groupLimitFlushes int64
+	// pointFlushes is the number of the early emits of the groups reaching the flush points
+	pointFlushes int64
+	// expiredWindows is the number of windows skipped because they are out of the retention policy of the destination
+	expiredWindows int64
+	// lazyTaskBuilds is the number of the batches building the task which is not registered yet
+	lazyTaskBuilds int64
+	// schemaRebuilds is the number of the rebuilds of the task because a key it references is added to the source
+	schemaRebuilds int64
+	// failedWindows is the number of the windows whose rows failed to be written to the store
+	failedWindows int64
+	// nonFiniteValues is the number of the NaN and the infinite results of the calls emitted by the task
+	nonFiniteValues int64
+	// malformedRows is the number of rows dropped because they have no column index or unsorted tags
+	malformedRows int64
+	// malformedLoggedAt is the time the malformed rows are last logged at
+	malformedLoggedAt int64
+	// warmUpEnd is the end of the warm-up of the task, 0 means the warm-up is not started
+	warmUpEnd int64
+	// groupSpills is the number of the groups spilled to disk by the group limits, groupSpillBytes is their size
+	groupSpills     int64
+	groupSpillBytes int64
+	// intOverflows is the number of the values overflowing the integer sums of the calls
+	intOverflows int64
+	// duplicateRows is the number of the rows dropped as the duplicates of the points, dedup holds the points
+	duplicateRows int64
+	dedup         streamDedup
+	// breakerRows is the number of rows dropped while the breaker is open, breaker counts the failed writes
+	breakerRows int64
+	breaker     streamBreaker
+	// layout is the layout of the windows and the groups above, which is reconciled with the task of every batch
+	layoutMu sync.Mutex
+	layout   streamStateLayout
+	// spill holds the rows failed by the transient errors, which are written again with the next batch
+	spill streamSpill
+	// throttle holds the rows over the emission rates of the task, which are written with the following batches
+	throttle streamThrottle
+	// jitter holds the rows of the task until its next flush time
+	jitter streamFlushJitter
+	// reorder holds the rows of the task to deliver them in time order
+	reorder streamReorderBuffer
+	// watermark is the max time of the rows seen by the task
+	watermark int64
+
+	// stats are the counters of the task exposed through the statistics
+	stats *statistics.StreamTaskStats
+}
+
+func (s *streamTaskState) addSchemaViolation() {
+	atomic.AddInt64(&s.schemaViolations, 1)
+	s.stats.AddSchemaViolations(1)
+}
+
+func (s *streamTaskState) addForcedClose() {
+	atomic.AddInt64(&s.forcedCloses, 1)
+	s.stats.AddForcedCloses(1)
+}
+
+func (s *streamTaskState) addClosedWindowRow() {
+	atomic.AddInt64(&s.closedWindowRows, 1)
+	s.stats.AddClosedWindowRows(1)
+}
+
+func (s *streamTaskState) addLateRow() {
+	atomic.AddInt64(&s.lateRows, 1)
+	s.stats.AddLateRows(1)
+}
+
+func (s *streamTaskState) addGroupLimitRow() {
+	atomic.AddInt64(&s.groupLimitRows, 1)
+	s.stats.AddGroupLimitRows(1)
+}
+
+func (s *streamTaskState) addGroupLimitFlush() {
+	atomic.AddInt64(&s.groupLimitFlushes, 1)
+	s.stats.AddGroupLimitFlushes(1)
+}
+
+func (s *streamTaskState) addPointFlush() {
+	atomic.AddInt64(&s.pointFlushes, 1)
+	s.stats.AddPointFlushes(1)
+}
+
+func (s *streamTaskState) addExpiredWindow() {
+	atomic.AddInt64(&s.expiredWindows, 1)
+	s.stats.AddExpiredWindows(1)
+}
+
+func (s *streamTaskState) addLazyTaskBuild() {
+	atomic.AddInt64(&s.lazyTaskBuilds, 1)
+	s.stats.AddLazyTaskBuilds(1)
+}
+
+func (s *streamTaskState) addSchemaRebuild() {
+	atomic.AddInt64(&s.schemaRebuilds, 1)
+	s.stats.AddSchemaRebuilds(1)
+}
+
+func (s *streamTaskState) addFailedWindows(n int64) {
+	atomic.AddInt64(&s.failedWindows, n)
+	s.stats.AddFailedWindows(n)
+}
+
+func (s *streamTaskState) addNonFiniteValue() {
+	atomic.AddInt64(&s.nonFiniteValues, 1)
+	s.stats.AddNonFiniteValues(1)
+}
+
+func (s *streamTaskState) addMalformedRows(n int64) {
+	atomic.AddInt64(&s.malformedRows, n)
+	s.stats.AddMalformedRows(n)
+}
+
+// logMalformed returns whether the malformed rows dropped at now are logged, they are logged once per
+// streamMalformedLogInterval across the batches of the task.
+func (s *streamTaskState) logMalformed(now int64) bool {
+	last := atomic.LoadInt64(&s.malformedLoggedAt)
+	return now-last >= int64(streamMalformedLogInterval) && atomic.CompareAndSwapInt64(&s.malformedLoggedAt, last, now)
+}
+
+func (s *streamTaskState) addIntOverflows(n int64) {
+	atomic.AddInt64(&s.intOverflows, n)
+	s.stats.AddIntOverflows(n)
+}
+
+func (s *streamTaskState) addDuplicateRows(n int64) {
+	atomic.AddInt64(&s.duplicateRows, n)
+	s.stats.AddDuplicateRows(n)
+}
+
+func (s *streamTaskState) addBreakerRows(n int64) {
+	atomic.AddInt64(&s.breakerRows, n)
+	s.stats.AddBreakerRows(n)
+}
+
+func (s *streamTaskState) addGroupSpill(bytes int64) {
+	atomic.AddInt64(&s.groupSpills, 1)
+	atomic.AddInt64(&s.groupSpillBytes, bytes)
+	s.stats.AddGroupSpills(1, bytes)
+}
+
+func (s *streamTaskState) loadWatermark() int64 {
+	return atomic.LoadInt64(&s.watermark)
+}
+
+func (s *streamTaskState) advanceWatermark(t int64) {
+	atomic2.CompareAndSwapMaxInt64(&s.watermark, t)
+}
+
+// streamTaskStateMap holds the states of the stream tasks, keyed by stream name.
+type streamTaskStateMap struct {
+	mu     sync.Mutex
+	states map[string]*streamTaskState
+}
+
+// get returns the state of the task, the state is created if absent.
+func (m *streamTaskStateMap) get(name string) *streamTaskState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if st, ok := m.states[name]; ok {
+		return st
+	}
+	if m.states == nil {
+		m.states = make(map[string]*streamTaskState)
+	}
+	st := &streamTaskState{stats: statistics.StreamTaskStat.Task(name)}
+	m.states[name] = st
+	return st
+}
+
+// drop drops the state of the task, the state is created again by the next get.
+func (m *streamTaskStateMap) drop(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.states, name)
+}
+
+// load returns the state of the task if it exists.
+func (m *streamTaskStateMap) load(name string) (*streamTaskState, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.states[name]
+	return st, ok
+}
+
+func (w *PointsWriter) getStreamTaskState(name string) *streamTaskState {
+	return w.streamTaskStates.get(name)
+}
+
+func (w *PointsWriter) getStreamClock() func() int64 {
+	if w.streamClock != nil {
+		return w.streamClock
+	}
+	return streamNow
+}
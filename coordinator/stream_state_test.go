@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTaskStats(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "stats_task"
+	si.Condition = influxql.MustParseExpr("tk1 != 'skip'")
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+
+	rows := []*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 3)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "skip"}}, floatField("fk1", 4)),
+	}
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+
+	stats := statistics.StreamTaskStat.Task(si.Name)
+	require.Equal(t, int64(4), stats.RowsIn)
+	require.Equal(t, int64(3), stats.RowsAggregated)
+	require.Equal(t, int64(2), stats.WindowsEmitted)
+	require.Equal(t, int64(0), stats.PartialErrors)
+	require.Equal(t, int64(1), stats.Calculations)
+	require.True(t, stats.CalculateDuration > 0)
+
+	// the bytes are credited once the rows are written
+	require.Equal(t, int64(0), stats.BytesWritten)
+	var size int
+	for i := range ctx.shardRowMap {
+		for _, r := range ctx.shardRowMap[i].rows {
+			buf, err := r.FastMarshalBinary(nil)
+			require.NoError(t, err)
+			size += len(buf)
+		}
+	}
+	ctx.commitStreamWritten()
+	require.Equal(t, int64(size), stats.BytesWritten)
+	ctx.commitStreamWritten()
+	require.Equal(t, int64(size), stats.BytesWritten)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// StreamTaskInfo is the snapshot of a task registered to the stream, which shares nothing with the task.
+type StreamTaskInfo struct {
+	Name   string
+	SrcMst meta2.StreamMeasurementInfo
+	DesMst meta2.StreamMeasurementInfo
+	// Calls are the calls of the task as the stream shows them
+	Calls []string
+	// Dims are the tags and the fields the rows are grouped by
+	Dims []string
+	// Groups is the number of the groups whose windows are kept by the task across the batches
+	Groups int
+	// FlushOffset is the offset of the flush times of the task into its interval, 0 means the task has no flush jitter
+	FlushOffset time.Duration
+	// WarmingUp tells whether the task suppresses the windows of its warm-up, which ends at WarmUpEnd.
+	// WarmUpEnd is zero if the task has not started a warm-up.
+	WarmingUp bool
+	WarmUpEnd time.Time
+	// BreakerOpen tells whether the rows of the task are dropped by its breaker after WriteFailures consecutive
+	// failed writes
+	BreakerOpen   bool
+	WriteFailures int64
+}
+
+// Tasks returns the snapshots of the tasks registered to the stream, sorted by name.
+func (s *Stream) Tasks() []StreamTaskInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	infos := make([]StreamTaskInfo, 0, len(s.tasks))
+	for name, task := range s.tasks {
+		info := StreamTaskInfo{
+			Name:   name,
+			SrcMst: *task.info.SrcMst,
+			DesMst: *task.info.DesMst,
+			Calls:  make([]string, 0, len(task.info.Calls)),
+			Dims:   make([]string, 0, len(task.tagDimKeys)+len(task.fieldIndexKeys)),
+			// the offset is the hash of the name, which is the same whenever the task is built
+			FlushOffset: streamFlushOffset(task.info, task.opt),
+		}
+		for _, c := range task.info.Calls {
+			info.Calls = append(info.Calls, c.String())
+		}
+		info.Dims = append(append(info.Dims, task.tagDimKeys...), task.fieldIndexKeys...)
+		if task.bucket != nil {
+			info.Dims = append(info.Dims, task.bucket.tag)
+		}
+		if s.states != nil {
+			if st, ok := s.states.load(name); ok {
+				info.Groups = st.bufferedGroups()
+				if end := atomic.LoadInt64(&st.warmUpEnd); end != 0 && streamWarmsUp(task.opt) {
+					info.WarmingUp = st.warmingUp(s.now())
+					info.WarmUpEnd = time.Unix(0, end)
+				}
+				info.WriteFailures = atomic.LoadInt64(&st.breaker.failures)
+				info.BreakerOpen = st.breaker.open(task.opt, s.now())
+			}
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// bufferedGroups returns the number of the groups with the windows of the accumulators, the latest windows filled
+// after or the values carried forward.
+func (s *streamTaskState) bufferedGroups() int {
+	groups := make(map[string]struct{})
+	s.accumulators.mu.Lock()
+	for k := range s.accumulators.windows {
+		groups[k.group] = struct{}{}
+	}
+	s.accumulators.mu.Unlock()
+	s.fill.mu.Lock()
+	for k := range s.fill.groups {
+		groups[k] = struct{}{}
+	}
+	s.fill.mu.Unlock()
+	s.carry.mu.Lock()
+	for k := range s.carry.groups {
+		groups[k] = struct{}{}
+	}
+	s.carry.mu.Unlock()
+	return len(groups)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTasks(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+
+	tasks := ctx.stream.Tasks()
+	require.Len(t, tasks, 1)
+	require.Equal(t, "t", tasks[0].Name)
+	require.Equal(t, "mst0", tasks[0].SrcMst.Name)
+	require.Equal(t, "mst2", tasks[0].DesMst.Name)
+	require.Equal(t, []string{"tk1"}, tasks[0].Dims)
+	require.Equal(t, []string{"percentile_fk1ASp50_fk1"}, tasks[0].Calls)
+	require.Equal(t, 0, tasks[0].Groups)
+
+	_, err := ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "b"}}, floatField("fk1", 2)),
+	}, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	tasks = ctx.stream.Tasks()
+	require.Equal(t, 2, tasks[0].Groups)
+
+	// the snapshot shares nothing with the task
+	tasks[0].Dims[0] = "tk2"
+	tasks[0].SrcMst.Name = "mst1"
+	task := ctx.stream.tasks[si.Name]
+	require.Equal(t, "tk1", task.tagDimKeys[0])
+	require.Equal(t, "mst0", si.SrcMst.Name)
+}
+
+// TestStreamTasksRace is run with -race, the tasks are registered, removed and listed while the batches are calculated.
+func TestStreamTasksRace(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	srcSchema, dstSchema := streamTestSchema(si)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			other := newStreamTestInfo(si.Calls...)
+			other.Name = fmt.Sprintf("t%d", i%4)
+			task, err := newStreamTask(other, srcSchema, dstSchema, nil)
+			if err != nil {
+				panic(err)
+			}
+			ctx.stream.registerTask(other.Name, task)
+			ctx.stream.Tasks()
+			if i%2 == 0 {
+				ctx.stream.removeTask(other.Name)
+				// the task of the batches is built again by the following one
+				ctx.stream.removeTask(si.Name)
+			}
+		}
+	}()
+
+	rows := []*influx.Row{newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1))}
+	for i := 0; i < 200; i++ {
+		_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+	}
+	close(stop)
+	wg.Wait()
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	_, ok := ctx.stream.getTask(si.Name)
+	require.True(t, ok)
+}
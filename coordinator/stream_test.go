@@ -70,6 +70,42 @@ func TestStreamGenerateGroupKey(t *testing.T) {
 	assert2.Equal(t, value, "\x00value3")
 }
 
+func TestStreamGenerateGroupKeyAllocs(t *testing.T) {
+	s := coordinator.NewStream(nil, nil, logger.NewLogger(errno.ModuleCoordinator), time.Second)
+	rows := generateRows(5, make([]influx.Row, 5))
+	ctx := coordinator.GetStreamCtx()
+	defer coordinator.PutStreamCtx(ctx)
+
+	keys := []string{"tk1", "tk2", "tk3"}
+	assert2.Equal(t, "value12\x00value23\x00", s.GenerateGroupKey(ctx, keys, &rows[2]))
+	// the keys of the groups seen in the batch are not allocated again
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := range rows {
+			s.GenerateGroupKey(ctx, keys, &rows[i])
+		}
+	})
+	assert2.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkStreamGenerateGroupKey(b *testing.B) {
+	s := coordinator.NewStream(nil, nil, logger.NewLogger(errno.ModuleCoordinator), time.Second)
+	rows := generateRows(1000, make([]influx.Row, 1000))
+	for i := range rows {
+		rows[i].Tags[0].Value = fmt.Sprintf("value%d", i%100)
+	}
+	ctx := coordinator.GetStreamCtx()
+	defer coordinator.PutStreamCtx(ctx)
+	keys := []string{"tk1", "tk2", "tk3"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range rows {
+			s.GenerateGroupKey(ctx, keys, &rows[j])
+		}
+	}
+}
+
 func TestStreamBuildFieldCall(t *testing.T) {
 	infos := map[string]*meta2.StreamInfo{}
 	info := &meta2.StreamInfo{}
@@ -128,7 +164,7 @@ func TestStreamBuildFieldCall(t *testing.T) {
 		"min_fk3":   3,
 		"count_fk2": 1,
 	}
-	_, err := coordinator.BuildFieldCall(info, srcSchema, dstSchema)
+	_, err := coordinator.BuildFieldCall(info, nil, srcSchema, dstSchema)
 	if err != nil {
 		t.Fatal("StreamBuildFieldCall failed")
 	}
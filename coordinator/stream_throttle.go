@@ -0,0 +1,129 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"sync"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// defaultStreamThrottleRows is the max number of the rows held by the throttle of a task without ThrottleRows.
+const defaultStreamThrottleRows = 4096
+
+// streamThrottle limits the rows emitted by a task to the rates of its options. The tokens are refilled at the
+// rates up to the rows and bytes of a second, the rows over them are held and written with the following batches.
+type streamThrottle struct {
+	mu    sync.Mutex
+	rows  float64
+	bytes float64
+	// last is the time the tokens are refilled, 0 means the buckets are full
+	last int64
+	held []streamSpilledRow
+}
+
+// streamThrottled returns whether the rows of the task are limited by the emission rates.
+func streamThrottled(opt *StreamTaskOptions) bool {
+	return opt.Output.EmitRowsPerSecond > 0 || opt.Output.EmitBytesPerSecond > 0
+}
+
+// take consumes the tokens of a row of the size, and returns whether the row is within the rates. A row larger
+// than the bytes left is taken as long as any byte is left, so the rows larger than the rate are not held forever.
+func (t *streamThrottle) take(sw *streamWriter, size int, now int64) bool {
+	rowsRate, bytesRate := float64(sw.rowsRate), float64(sw.bytesRate)
+	if t.last == 0 {
+		t.rows, t.bytes = rowsRate, bytesRate
+	} else if now > t.last {
+		elapsed := float64(now-t.last) / 1e9
+		t.rows = math.Min(t.rows+elapsed*rowsRate, rowsRate)
+		t.bytes = math.Min(t.bytes+elapsed*bytesRate, bytesRate)
+	}
+	if now > t.last {
+		t.last = now
+	}
+	if (rowsRate > 0 && t.rows < 1) || (bytesRate > 0 && t.bytes <= 0) {
+		return false
+	}
+	t.rows--
+	t.bytes -= float64(size)
+	return true
+}
+
+// throttledSize returns the marshaled size of the row if the bytes of the task are limited.
+func (s *injestionCtx) throttledSize(r *influx.Row) int {
+	if s.streamWriter.bytesRate <= 0 {
+		return 0
+	}
+	buf, err := r.FastMarshalBinary(s.streamRowBuf[:0])
+	if err != nil {
+		return 0
+	}
+	s.streamRowBuf = buf
+	return len(buf)
+}
+
+// admitStreamRow returns whether the row of the current task is within its rates. The rows over them are held
+// after the rows held already, which keeps the rows in order, and dropped once the throttle is full.
+// The windows and bytes of the held rows are counted as they are emitted.
+func (s *injestionCtx) admitStreamRow(sh *meta2.ShardInfo, r *influx.Row) bool {
+	sw := &s.streamWriter
+	size := s.throttledSize(r)
+	t := &sw.state.throttle
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.held) == 0 && t.take(sw, size, sw.now()) {
+		return true
+	}
+	if len(t.held) >= sw.throttleRows {
+		sw.state.stats.AddThrottleDrops(1)
+		return false
+	}
+	t.held = append(t.held, streamSpilledRow{sh: sh, row: copyStreamRow(r)})
+	sw.state.stats.AddThrottledRows(1)
+	sw.state.stats.SetThrottled(true)
+	return false
+}
+
+// releaseThrottledRows maps the rows held by the current task to their shards within its rates, the rows are
+// all released once the task is no longer throttled.
+func (s *injestionCtx) releaseThrottledRows() {
+	sw := &s.streamWriter
+	t := &sw.state.throttle
+	t.mu.Lock()
+	now := sw.now()
+	n := 0
+	for ; n < len(t.held); n++ {
+		if sw.throttled && !t.take(sw, s.throttledSize(t.held[n].row), now) {
+			break
+		}
+	}
+	rows := t.held[:n]
+	if n == len(t.held) {
+		t.held = nil
+	} else {
+		t.held = append([]streamSpilledRow(nil), t.held[n:]...)
+	}
+	sw.state.stats.SetThrottled(len(t.held) > 0)
+	t.mu.Unlock()
+
+	for _, sr := range rows {
+		s.addStreamShardIds(sr.sh, sr.row)
+		s.mapStreamShardRow(sr.sh, sr.row)
+	}
+}
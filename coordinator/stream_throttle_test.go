@@ -0,0 +1,83 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamThrottle(t *testing.T) {
+	env := newStreamTestEnv()
+	now := time.Unix(0, env.base)
+	env.pw.streamClock = func() int64 { return now.UnixNano() }
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "throttle"
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{EmitRowsPerSecond: 2, ThrottleRows: 2}})
+	state := env.pw.getStreamTaskState(si.Name)
+	batch := func(groups ...string) []*influx.Row {
+		var rows []*influx.Row
+		for _, g := range groups {
+			rows = append(rows, newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: g}}, floatField("fk1", 1)))
+		}
+		return rowsOfMst(env.calculate(t, si, rows...), "mst2")
+	}
+
+	// a second of the rate is written at once, the following rows are held up to the limit and dropped then
+	require.Len(t, batch("a", "b", "c", "d", "e"), 2)
+	require.Len(t, state.throttle.held, 2)
+	require.Equal(t, int64(2), state.stats.ThrottledRows)
+	require.Equal(t, int64(1), state.stats.ThrottleDrops)
+	require.Equal(t, int64(1), state.stats.Throttled)
+
+	// the held rows are not released before the tokens are refilled
+	require.Empty(t, batch("f"))
+	require.Equal(t, int64(2), state.stats.ThrottleDrops)
+
+	// the held rows are released first, and the new rows are held after them
+	now = now.Add(time.Second)
+	out := batch("g")
+	require.Len(t, out, 2)
+	for _, r := range out {
+		require.NotEqual(t, "g", tagValue(r, "tk1"))
+	}
+	require.Len(t, state.throttle.held, 1)
+	require.Equal(t, "g", tagValue(state.throttle.held[0].row, "tk1"))
+
+	// the held rows are all released once the task is no longer throttled
+	setStreamTestOptions(si, &StreamTaskOptions{})
+	require.Len(t, batch("h", "i", "j"), 4)
+	require.Empty(t, state.throttle.held)
+	require.Equal(t, int64(0), state.stats.Throttled)
+}
+
+func TestStreamThrottleBytes(t *testing.T) {
+	sw := &streamWriter{bytesRate: 100}
+	th := &streamThrottle{}
+	// a row larger than the bytes left is taken as long as any byte is left
+	require.True(t, th.take(sw, 60, 1))
+	require.True(t, th.take(sw, 60, 1))
+	require.False(t, th.take(sw, 1, 1))
+	// the bytes are refilled at the rate up to a second of it
+	require.True(t, th.take(sw, 1, 1+int64(300*time.Millisecond)))
+	require.True(t, th.take(sw, 100, 1+int64(10*time.Second)))
+	require.False(t, th.take(sw, 1, 1+int64(10*time.Second)))
+}
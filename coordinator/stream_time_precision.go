@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const deadLetterTimeOverflow = "time_overflow"
+
+// buildTimeScale returns the nanoseconds of the unit of the timestamps of the source rows, the precisions are the
+// ones of the line protocol.
+func buildTimeScale(info *meta2.StreamInfo, precision string) (int64, error) {
+	switch precision {
+	case "", "ns":
+		return 1, nil
+	case "u", "us", "µ":
+		return 1e3, nil
+	case "ms":
+		return 1e6, nil
+	case "s":
+		return 1e9, nil
+	case "m":
+		return 1e9 * 60, nil
+	case "h":
+		return 1e9 * 3600, nil
+	}
+	return 0, fmt.Errorf("the input precision %s of stream task %s is unknown", precision, info.Name)
+}
+
+// scaleTimes returns the rows with their timestamps in nanoseconds. The rows are shared with the write of the source,
+// so the copies of them are scaled, which still share the tags and the fields. The rows whose timestamps overflow
+// the nanoseconds are kept in DeadLetterMst if it is set, and fail the batch otherwise.
+func (s *streamCtx) scaleTimes(rows []*influx.Row, si *meta2.StreamInfo, task *streamTask) ([]*influx.Row, error) {
+	if cap(s.scaledRows) < len(rows) {
+		s.scaledRows = make([]influx.Row, 0, len(rows))
+	}
+	s.scaledRows = s.scaledRows[:0]
+	scaled := make([]*influx.Row, 0, len(rows))
+	for _, r := range rows {
+		if r.Timestamp > math.MaxInt64/task.timeScale || r.Timestamp < math.MinInt64/task.timeScale {
+			if task.opt.Errors.DeadLetterMst == "" {
+				return nil, fmt.Errorf("the time %d of stream task %s overflows the nanoseconds in the input precision %s",
+					r.Timestamp, si.Name, task.opt.Window.InputPrecision)
+			}
+			s.deadLetters = append(s.deadLetters, streamDeadLetter{row: r, reason: deadLetterTimeOverflow})
+			continue
+		}
+		s.scaledRows = append(s.scaledRows, *r)
+		c := &s.scaledRows[len(s.scaledRows)-1]
+		c.Timestamp *= task.timeScale
+		scaled = append(scaled, c)
+	}
+	return scaled, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamInputPrecision(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	si.Name = "input_ms"
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{InputPrecision: "ms"}})
+	ms := env.base / int64(time.Millisecond)
+	src := []*influx.Row{
+		newStreamTestRow(ms, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(ms+999, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 2)),
+		newStreamTestRow(ms+1000, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 4)),
+	}
+	out := rowsOfMst(env.calculate(t, si, src...), "mst2")
+	require.Len(t, out, 2)
+	sums := map[int64]float64{}
+	for _, r := range out {
+		sums[r.Timestamp], _ = fieldValue(r, "sum_fk1")
+	}
+	sec := int64(time.Second)
+	require.Equal(t, map[int64]float64{env.base + sec - 1: 3, env.base + 2*sec - 1: 4}, sums)
+	// the source rows keep their timestamps
+	require.Equal(t, ms, src[0].Timestamp)
+
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+	_, err := ctx.stream.calculate([]*influx.Row{
+		newStreamTestRow(math.MaxInt64/int64(time.Millisecond)+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+	}, si, env.pw, ctx, 0)
+	require.ErrorContains(t, err, "of stream task input_ms overflows the nanoseconds in the input precision ms")
+
+	require.True(t, streamNeedsSQLLayer(si, env.pw.getStreamTaskOptions(si)))
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Window: StreamWindowOptions{InputPrecision: "d"}})
+	require.EqualError(t, err, "the input precision d of stream task input_ms is unknown")
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const twaCall = "twa"
+
+// StreamTWABoundary is how the time-weighted average weights the values at the edges of the window.
+type StreamTWABoundary uint8
+
+const (
+	// StreamTWATruncate averages over the time from the first to the last sample of the window
+	StreamTWATruncate StreamTWABoundary = iota
+	// StreamTWAExtrapolate holds the first value back to the start of the window and the last value until the end,
+	// the average is over the whole window
+	StreamTWAExtrapolate
+)
+
+// streamTWACall is the parameters of a twa call.
+type streamTWACall struct {
+	boundary StreamTWABoundary
+}
+
+// buildTWACall returns the parameters of the twa call of the stream.
+func buildTWACall(info *meta2.StreamInfo, c *meta2.StreamCall, callOptions map[string]*StreamCallOptions) (*streamTWACall, error) {
+	if len(c.Args) != 0 {
+		return nil, fmt.Errorf("the twa call %s of stream task %s does not take arguments", c.Alias, info.Name)
+	}
+	twa := &streamTWACall{}
+	if opt, ok := callOptions[c.Alias]; ok {
+		if opt.TWABoundary > StreamTWAExtrapolate {
+			return nil, fmt.Errorf("the boundary %d of the twa call %s of stream task %s is unknown", opt.TWABoundary, c.Alias, info.Name)
+		}
+		twa.boundary = opt.TWABoundary
+	}
+	return twa, nil
+}
+
+func (c *streamTWACall) newAccumulator(start, end int64) streamLib.Accumulator {
+	return &twaAccumulator{start: start, end: end, boundary: c.boundary, sorted: true}
+}
+
+type twaSample struct {
+	ts    int64
+	value float64
+}
+
+// twaAccumulator computes the time-weighted average of the window, every value is weighted by the time
+// until the next sample. The rows may arrive out of order across the batches, so all the samples of the window
+// are held until it is closed, which takes 16 bytes per row instead of a fixed size like the other calls.
+type twaAccumulator struct {
+	start    int64
+	end      int64
+	boundary StreamTWABoundary
+	samples  []twaSample
+	sorted   bool
+}
+
+func (a *twaAccumulator) Add(value float64, ts int64) {
+	if n := len(a.samples); n > 0 && ts < a.samples[n-1].ts {
+		a.sorted = false
+	}
+	a.samples = append(a.samples, twaSample{ts: ts, value: value})
+}
+
+// Value returns the average, the value of a single sample when the samples cover no time.
+func (a *twaAccumulator) Value() float64 {
+	if len(a.samples) == 0 {
+		return math.NaN()
+	}
+	if !a.sorted {
+		// the samples of the same time keep their order, so the last one of them is weighted
+		sort.SliceStable(a.samples, func(i, j int) bool { return a.samples[i].ts < a.samples[j].ts })
+		a.sorted = true
+	}
+
+	first, last := a.samples[0], a.samples[len(a.samples)-1]
+	from, to := first.ts, last.ts
+	var sum float64
+	if a.boundary == StreamTWAExtrapolate {
+		from, to = a.start, a.end
+		sum = first.value*float64(first.ts-a.start) + last.value*float64(a.end-last.ts)
+	}
+	if to == from {
+		return last.value
+	}
+	for i := 0; i < len(a.samples)-1; i++ {
+		sum += a.samples[i].value * float64(a.samples[i+1].ts-a.samples[i].ts)
+	}
+	return sum / float64(to-from)
+}
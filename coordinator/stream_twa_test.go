@@ -0,0 +1,69 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTWA(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "twa", Field: "fk1", Alias: "twa_fk1"})
+	require.True(t, streamKeepsState(si))
+
+	for boundary, exp := range map[StreamTWABoundary][]float64{
+		StreamTWATruncate:    {1, 3},
+		StreamTWAExtrapolate: {2, 3},
+	} {
+		env := newStreamTestEnv()
+		setStreamTestOptions(si, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"twa_fk1": {TWABoundary: boundary}}})
+		start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+		row := func(ms int, v float64) *influx.Row {
+			return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+		}
+		twaOf := func(rows []*influx.Row) float64 {
+			out := rowsOfMst(rows, "mst2")
+			require.Len(t, out, 1)
+			require.Equal(t, int32(influx.Field_Type_Float), out[0].Fields[0].Type)
+			v, _ := fieldValue(out[0], "twa_fk1")
+			return v
+		}
+
+		require.Equal(t, exp[0], twaOf(env.calculate(t, si, row(0, 1), row(500, 3))), boundary)
+		// the late row of the window is weighted in the time order
+		require.Equal(t, exp[1], twaOf(env.calculate(t, si, row(250, 5))), boundary)
+	}
+
+	// the single sample covers no time
+	acc := (&streamTWACall{}).newAccumulator(0, 10)
+	acc.Add(4, 5)
+	require.Equal(t, float64(4), acc.Value())
+	acc = (&streamTWACall{boundary: StreamTWAExtrapolate}).newAccumulator(0, 10)
+	acc.Add(4, 5)
+	require.Equal(t, float64(4), acc.Value())
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{CallOptions: map[string]*StreamCallOptions{"twa_fk1": {TWABoundary: 2}}})
+	require.EqualError(t, err, "the boundary 2 of the twa call twa_fk1 of stream task t is unknown")
+	si.Calls[0].Args = []string{"1s"}
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the twa call twa_fk1 of stream task t does not take arguments")
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamHasSource returns true if the rows of the measurement feed the stream,
+// which is the source measurement of the stream or one of the union measurements.
+func streamHasSource(si *meta2.StreamInfo, opt *StreamTaskOptions, mst string) bool {
+	if si.SrcMst.Name == mst {
+		return true
+	}
+	for _, m := range opt.Group.UnionMsts {
+		if m == mst {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSourceTag returns the tag carrying the source measurement and the keys to compute the shard key by,
+// the source tag is empty if it is not used. The dims are the sorted tags of the agg rows.
+func buildSourceTag(info *meta2.StreamInfo, dims []string, opt *StreamTaskOptions) (string, []string, error) {
+	if len(opt.Group.UnionMsts) == 0 || opt.Group.SourceTag == "" {
+		return "", dims, nil
+	}
+	for _, d := range dims {
+		if d == opt.Group.SourceTag {
+			return "", nil, fmt.Errorf("the source tag %s conflicts with the group by tags of stream task %s", d, info.Name)
+		}
+	}
+	shardDims := make([]string, 0, len(dims)+1)
+	shardDims = append(shardDims, dims...)
+	shardDims = append(shardDims, opt.Group.SourceTag)
+	sort.Strings(shardDims)
+	return opt.Group.SourceTag, shardDims, nil
+}
+
+// addSourceTag adds the source tag to the tags of the agg row and keeps the tags sorted.
+func (w *streamTask) addSourceTag(r *influx.Row, source string) {
+	addAggTag(r, w.sourceTag, source)
+}
+
+// addAggTag adds the tag to the tags of the agg row and keeps the tags sorted.
+func addAggTag(r *influx.Row, key, value string) {
+	r.Tags = append(r.Tags, influx.Tag{Key: key, Value: value})
+	sort.Sort(&r.Tags)
+	if r.ColumnToIndex == nil {
+		r.ColumnToIndex = make(map[string]int)
+	}
+	for i := range r.Tags {
+		r.ColumnToIndex[r.Tags[i].Key] = i
+	}
+}
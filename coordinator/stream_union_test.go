@@ -0,0 +1,78 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"strings"
+	"testing"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamHasSource(t *testing.T) {
+	si := newStreamTestInfo()
+	require.True(t, streamHasSource(si, defaultStreamTaskOptions, "mst0"))
+	require.False(t, streamHasSource(si, defaultStreamTaskOptions, "mem"))
+	require.True(t, streamHasSource(si, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"cpu", "mem"}}}, "mem"))
+}
+
+func TestStreamSourceTag(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"mem"}, SourceTag: "tk1"}})
+	require.EqualError(t, err, "the source tag tk1 conflicts with the group by tags of stream task t")
+
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"mem"}, SourceTag: "src"}})
+	row := func(mst, group string, v float64) *influx.Row {
+		r := newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+		r.Name = mst
+		return r
+	}
+	out := rowsOfMst(env.calculate(t, si,
+		row("mst0", "a", 1), row("mem", "a", 2), row("mst0", "a", 3), row("mem", "b", 4),
+	), "mst2")
+	require.Len(t, out, 3)
+
+	sums := map[string]float64{}
+	for _, r := range out {
+		require.Equal(t, []influx.Tag{{Key: "src", Value: tagValue(r, "src")}, {Key: "tk1", Value: tagValue(r, "tk1")}}, []influx.Tag(r.Tags))
+		require.Equal(t, 0, r.ColumnToIndex["src"])
+		require.Equal(t, 1, r.ColumnToIndex["tk1"])
+		// the source tag is a part of the shard key
+		require.True(t, strings.Contains(string(r.ShardKey), "src="+tagValue(r, "src")), string(r.ShardKey))
+		v, _ := fieldValue(r, "sum_fk1")
+		sums[tagValue(r, "src")+","+tagValue(r, "tk1")] = v
+	}
+	require.Equal(t, map[string]float64{"mst0,a": 4, "mem,a": 2, "mem,b": 4}, sums)
+
+	// the windows of the measurements are merged without the source tag, they would be written to the same series
+	setStreamTestOptions(si, &StreamTaskOptions{Group: StreamGroupOptions{UnionMsts: []string{"mem"}}})
+	out = rowsOfMst(env.calculate(t, si, row("mst0", "a", 1), row("mem", "a", 2)), "mst2")
+	require.Len(t, out, 1)
+	v, _ := fieldValue(out[0], "sum_fk1")
+	require.Equal(t, float64(3), v)
+
+	// the key of a single source stream is the key of its dims only
+	task, err := newStreamTask(si, srcSchema, dstSchema, defaultStreamTaskOptions)
+	require.NoError(t, err)
+	r := row("mst0", "a", 1)
+	require.Equal(t, "a", string(task.appendGroupKey(nil, r)))
+}
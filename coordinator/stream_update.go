@@ -0,0 +1,198 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// streamStateLayout is the layout of the state of a task kept across the batches: the windows and the groups of
+// the state, and the calls indexing the values of them.
+type streamStateLayout struct {
+	windows string
+	calls   []string
+}
+
+// stateLayout returns the layout of the state of the task. The types of the fields are not a part of it, the
+// destination fields are created by the first batch.
+func (w *streamTask) stateLayout() streamStateLayout {
+	info := w.info
+	l := streamStateLayout{
+		windows: fmt.Sprintf("%v/%v/%v/%s/%q/%q/%t/%s/%s/%s", info.Interval, info.Slide, info.Offset, info.TimeZone,
+			w.tagDimKeys, w.fieldIndexKeys, w.bucket != nil, w.sourceTag, w.sourceRPTag, w.sourceShardTag),
+		calls: make([]string, len(info.Calls)),
+	}
+	for i, c := range info.Calls {
+		l.calls[i] = fmt.Sprintf("%s(%s%q) as %s", c.Call, c.Field, c.Args, c.Alias)
+	}
+	return l
+}
+
+func (l *streamStateLayout) equal(o *streamStateLayout) bool {
+	if l.windows != o.windows || len(l.calls) != len(o.calls) {
+		return false
+	}
+	for i := range l.calls {
+		if l.calls[i] != o.calls[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// streamStateUpdate is how the state of a task is reconciled with a new layout.
+type streamStateUpdate struct {
+	added, removed int
+	// reset indicates that the windows or the groups changed, all the windows of the state are dropped
+	reset bool
+}
+
+// reconcile moves the state of the task to the layout of the task if it changes. The windows of the calls kept are
+// moved to the indexes of the calls in the task, the calls added start with empty windows, and the windows of the
+// calls removed or changed are dropped. The windows of the state are dropped if the windows or the groups change.
+func (s *streamTaskState) reconcile(task *streamTask) streamStateUpdate {
+	layout := task.stateLayout()
+	s.layoutMu.Lock()
+	defer s.layoutMu.Unlock()
+	var u streamStateUpdate
+	if s.layout.calls == nil {
+		// the state of a new task is in its layout
+		s.layout = layout
+		return u
+	}
+	if s.layout.equal(&layout) {
+		return u
+	}
+	defer func() { s.layout = layout }()
+	if s.layout.windows != layout.windows {
+		u.reset = true
+		s.resetWindows()
+		return u
+	}
+
+	index := make(map[string]int, len(layout.calls))
+	for i, c := range layout.calls {
+		index[c] = i
+	}
+	remap := make([]int, len(s.layout.calls))
+	for i, c := range s.layout.calls {
+		j, ok := index[c]
+		if !ok {
+			j = -1
+			u.removed++
+		}
+		remap[i] = j
+	}
+	u.added = len(layout.calls) - (len(remap) - u.removed)
+	s.remapCalls(remap, len(layout.calls))
+	return u
+}
+
+// resetWindows drops the windows and the groups kept by the state.
+func (s *streamTaskState) resetWindows() {
+	s.accumulators.mu.Lock()
+	s.accumulators.windows = nil
+	s.accumulators.mu.Unlock()
+	s.fill.mu.Lock()
+	s.fill.groups = nil
+	s.fill.mu.Unlock()
+	s.carry.mu.Lock()
+	s.carry.groups = nil
+	s.carry.mu.Unlock()
+	s.delta.mu.Lock()
+	s.delta.groups = nil
+	s.delta.mu.Unlock()
+	s.dedup.mu.Lock()
+	s.dedup.points = nil
+	s.dedup.mu.Unlock()
+}
+
+// remapCalls moves the values of the call i of the windows and the groups kept by the state to the call remap[i]
+// of the n calls, the values of the calls remapped to -1 are dropped.
+func (s *streamTaskState) remapCalls(remap []int, n int) {
+	s.accumulators.mu.Lock()
+	windows := make(map[accumulatorKey]*accumulatorWindow, len(s.accumulators.windows))
+	for k, w := range s.accumulators.windows {
+		if k.call = remap[k.call]; k.call >= 0 {
+			windows[k] = w
+		}
+	}
+	s.accumulators.windows = windows
+	s.accumulators.mu.Unlock()
+
+	s.fill.mu.Lock()
+	for _, g := range s.fill.groups {
+		values := make([]*float64, n)
+		for i, j := range remap {
+			if j >= 0 && i < len(g.values) {
+				values[j] = g.values[i]
+			}
+		}
+		g.values = values
+	}
+	s.fill.mu.Unlock()
+
+	s.carry.mu.Lock()
+	for k, g := range s.carry.groups {
+		c := newCarryGroup(n, g.emitted)
+		for i, j := range remap {
+			if j >= 0 {
+				c.starts[j], c.values[j], c.strs[j], c.has[j] = g.starts[i], g.values[i], g.strs[i], g.has[i]
+			}
+		}
+		s.carry.groups[k] = c
+	}
+	s.carry.mu.Unlock()
+
+	s.delta.mu.Lock()
+	for k, g := range s.delta.groups {
+		d := newDeltaGroup(n)
+		for i, j := range remap {
+			if j >= 0 {
+				d.starts[j], d.values[j], d.prev[j], d.has[j], d.hasPrev[j] = g.starts[i], g.values[i], g.prev[i], g.has[i], g.hasPrev[i]
+			}
+		}
+		s.delta.groups[k] = d
+	}
+	s.delta.mu.Unlock()
+}
+
+// UpdateStreamTask reconciles the state of the stream task kept across the batches with the new definition of
+// the task, so the windows buffered are not lost by the change. The windows of the calls kept go on, the calls
+// added start with empty windows, and the windows of the calls removed or changed are dropped. All the windows
+// are dropped if the windows or the groups of the task change, such as its interval or dims. The batches of the
+// new definition reconcile the state as well, the update validates the definition and reconciles the state ahead
+// of them, once the batches in flight are written.
+func (w *PointsWriter) UpdateStreamTask(si *meta2.StreamInfo) error {
+	w.streamFlushMu.Lock()
+	defer w.streamFlushMu.Unlock()
+	var u streamStateUpdate
+	// the task is built without writing any window
+	_, err := w.writeStreamTaskState(si, func(task *streamTask, _ *injestionCtx) (int, error) {
+		u = w.getStreamTaskState(si.Name).reconcile(task)
+		return 0, nil
+	})
+	if err != nil {
+		return err
+	}
+	w.logger.Info("updated the state of stream task", zap.String("stream", si.Name), zap.Int("added", u.added),
+		zap.Int("removed", u.removed), zap.Bool("reset", u.reset))
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamUpdateTask(t *testing.T) {
+	env := newStreamTestEnv()
+	p50 := &meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}}
+	stddev := &meta2.StreamCall{Call: "stddev", Field: "fk1", Alias: "stddev_fk1"}
+	si := newStreamTestInfo(p50)
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	row := func(v float64) *influx.Row {
+		return newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	results := func(rows ...*influx.Row) map[string]float64 {
+		out := rowsOfMst(env.calculate(t, si, rows...), "mst2")
+		require.Len(t, out, 1)
+		values := map[string]float64{}
+		for _, f := range out[0].Fields {
+			values[f.Key] = f.NumValue
+		}
+		return values
+	}
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Equal(t, map[string]float64{"p50_fk1": 1}, results(row(1)))
+
+	// the call added before the kept one starts with an empty window, the window of the kept one goes on
+	si.Calls = []*meta2.StreamCall{stddev, p50}
+	require.NoError(t, env.pw.UpdateStreamTask(si))
+	require.Len(t, state.accumulators.windows, 1)
+	for k := range state.accumulators.windows {
+		require.Equal(t, 1, k.call)
+	}
+	require.Equal(t, map[string]float64{"p50_fk1": 3, "stddev_fk1": math.Sqrt2}, results(row(3), row(5)))
+
+	// the windows of the removed call are dropped by the batches of the new definition as well
+	si.Calls = []*meta2.StreamCall{stddev}
+	require.Equal(t, map[string]float64{"stddev_fk1": 2}, results(row(7)))
+	require.Len(t, state.accumulators.windows, 1)
+
+	// the windows are reset once the interval changes
+	si.Interval = 2 * time.Second
+	require.NoError(t, env.pw.UpdateStreamTask(si))
+	require.Empty(t, state.accumulators.windows)
+
+	si.Calls = []*meta2.StreamCall{{Call: "percentile", Field: "fk1", Alias: "p_fk1"}}
+	require.Error(t, env.pw.UpdateStreamTask(si))
+}
+
+func TestStreamStateReconcile(t *testing.T) {
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "last", Field: "fk1", Alias: "last_fk1"},
+		&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	srcSchema, dstSchema := streamTestSchema(si)
+	task, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	state := &streamTaskState{}
+	require.Equal(t, streamStateUpdate{}, state.reconcile(task))
+
+	one, two := 1.0, 2.0
+	state.fill.groups = map[string]*fillGroup{"a": {values: []*float64{&one, &two}}}
+	state.carry.groups = map[string]*carryGroup{"a": {starts: []int64{1, 2}, values: []float64{1, 2}, strs: []string{"", ""}, has: []bool{true, true}}}
+	state.delta.groups = map[string]*deltaGroup{"a": {starts: []int64{1, 2}, values: []float64{1, 2}, prev: []float64{0, 1},
+		has: []bool{true, true}, hasPrev: []bool{false, true}}}
+
+	si.Calls = []*meta2.StreamCall{si.Calls[1], {Call: "max", Field: "fk1", Alias: "max_fk1"}}
+	task, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	require.Equal(t, streamStateUpdate{added: 1, removed: 1}, state.reconcile(task))
+	require.Equal(t, []*float64{&two, nil}, state.fill.groups["a"].values)
+	require.Equal(t, []int64{2, 0}, state.carry.groups["a"].starts)
+	require.Equal(t, []float64{2, 0}, state.delta.groups["a"].values)
+	require.Equal(t, []bool{true, false}, state.delta.groups["a"].hasPrev)
+	require.Equal(t, streamStateUpdate{}, state.reconcile(task))
+
+	si.Dims = []string{"tk2"}
+	task, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.NoError(t, err)
+	require.Equal(t, streamStateUpdate{reset: true}, state.reconcile(task))
+	require.Nil(t, state.fill.groups)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// StreamValidationKind is the kind of the failure found by the validation of a stream task.
+type StreamValidationKind int
+
+const (
+	// StreamInvalidInterval means the window interval of the stream is not positive
+	StreamInvalidInterval StreamValidationKind = iota
+	// StreamMissingDBRP means the database or the retention policy of the source or the destination does not exist
+	StreamMissingDBRP
+	// StreamMissingMeasurement means the source measurement does not exist
+	StreamMissingMeasurement
+	// StreamUnknownField means a call aggregates a field which is not in the source measurement
+	StreamUnknownField
+	// StreamUnsupportedField means the type of the field is not supported by the call
+	StreamUnsupportedField
+	// StreamInvalidTask means the other settings or the options of the stream can not build a task
+	StreamInvalidTask
+	// StreamInvalidDestination means the windows of the task do not suit the retention policy of a destination
+	StreamInvalidDestination
+)
+
+var streamValidationKindNames = [...]string{
+	StreamInvalidInterval:    "invalid interval",
+	StreamMissingDBRP:        "missing database or retention policy",
+	StreamMissingMeasurement: "missing measurement",
+	StreamUnknownField:       "unknown field",
+	StreamUnsupportedField:   "unsupported field",
+	StreamInvalidTask:        "invalid task",
+	StreamInvalidDestination: "invalid destination",
+}
+
+func (k StreamValidationKind) String() string {
+	if int(k) < len(streamValidationKindNames) {
+		return streamValidationKindNames[k]
+	}
+	return fmt.Sprintf("StreamValidationKind(%d)", int(k))
+}
+
+// StreamValidationError is returned by Validate when the stream task can not run.
+type StreamValidationError struct {
+	Kind   StreamValidationKind
+	Stream string
+	Err    error
+}
+
+func (e *StreamValidationError) Error() string {
+	return fmt.Sprintf("validate stream task %s: %s: %v", e.Stream, e.Kind, e.Err)
+}
+
+func (e *StreamValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks the stream task with its options against the current meta data without writing anything,
+// the checks are the ones done when the task is built.
+// The destination measurement is not required to exist, it is created by the first write.
+func (s *Stream) Validate(si *meta2.StreamInfo) error {
+	fail := func(kind StreamValidationKind, err error) error {
+		return &StreamValidationError{Kind: kind, Stream: si.Name, Err: err}
+	}
+	if si.Interval <= 0 {
+		return fail(StreamInvalidInterval, fmt.Errorf("the interval %s is not positive", si.Interval))
+	}
+
+	ctx := GetStreamCtx()
+	defer PutStreamCtx(ctx)
+	if err := ctx.checkDBRP(si.SrcMst.Database, si.SrcMst.RetentionPolicy, s); err != nil {
+		return fail(StreamMissingDBRP, err)
+	}
+	srcRP := ctx.rp.Name
+	dsts := []*meta2.StreamMeasurementInfo{si.DesMst}
+	for _, d := range si.Destinations {
+		dsts = append(dsts, d.DesMst)
+	}
+	for _, d := range dsts {
+		if err := ctx.checkDBRP(d.Database, d.RetentionPolicy, s); err != nil {
+			return fail(StreamMissingDBRP, err)
+		}
+	}
+
+	src, err := s.MetaClient.Measurement(si.SrcMst.Database, srcRP, si.SrcMst.Name)
+	if err != nil {
+		return fail(StreamMissingMeasurement, err)
+	}
+	var dstSchema map[string]int32
+	if dst, err := s.MetaClient.Measurement(si.DesMst.Database, si.DesMst.RetentionPolicy, si.DesMst.Name); err == nil {
+		dstSchema = dst.Schema
+	} else if err != meta2.ErrMeasurementNotFound {
+		return fail(StreamMissingMeasurement, err)
+	}
+
+	// the source measurement may be missing from the other source retention policies until the rows are written
+	for _, rp := range si.SrcRPs {
+		if err := ctx.checkDBRP(si.SrcMst.Database, rp, s); err != nil {
+			return fail(StreamMissingDBRP, err)
+		}
+		rpSrc, err := s.MetaClient.Measurement(si.SrcMst.Database, rp, si.SrcMst.Name)
+		if err == meta2.ErrMeasurementNotFound {
+			continue
+		}
+		if err != nil {
+			return fail(StreamMissingMeasurement, err)
+		}
+		if err = checkSourceRPSchema(si, src.Schema, rpSrc.Schema, rp); err != nil {
+			return fail(StreamUnsupportedField, err)
+		}
+	}
+
+	for _, c := range si.Calls {
+		if _, ok := src.Schema[c.Field]; !ok {
+			return fail(StreamUnknownField, fmt.Errorf("the field %s of the %s call %s is not in the measurement %s", c.Field, c.Call, c.Alias, si.SrcMst.Name))
+		}
+	}
+	var opt *StreamTaskOptions
+	if si.Options != "" {
+		if opt, err = ParseStreamTaskOptions([]byte(si.Options)); err != nil {
+			return fail(StreamInvalidTask, err)
+		}
+	}
+	expanded, err := expandStreamCalls(si)
+	if err != nil {
+		return fail(StreamUnsupportedField, err)
+	}
+	if _, err = BuildFieldCall(expanded, opt, src.Schema, dstSchema); err != nil {
+		return fail(StreamUnsupportedField, err)
+	}
+	if _, err = newStreamTask(si, src.Schema, dstSchema, opt); err != nil {
+		return fail(StreamInvalidTask, err)
+	}
+	if err = CheckStreamDestinations(s.MetaClient, si, opt); err != nil {
+		return fail(StreamInvalidDestination, err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamValidate(t *testing.T) {
+	env := newStreamTestEnv()
+	mc := env.pw.MetaClient.(*MockMetaClient)
+	s := NewStream(env.pw.TSDBStore, mc, env.pw.logger, time.Second)
+	sum := &meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"}
+	require.NoError(t, s.Validate(newStreamTestInfo(sum)))
+	// the validation never registers the task
+	require.Equal(t, 0, len(s.tasks))
+
+	kindOf := func(si *meta2.StreamInfo) StreamValidationKind {
+		err := s.Validate(si)
+		var vErr *StreamValidationError
+		require.True(t, errors.As(err, &vErr), "%v", err)
+		require.Equal(t, si.Name, vErr.Stream)
+		return vErr.Kind
+	}
+
+	si := newStreamTestInfo(sum)
+	si.Interval = 0
+	require.Equal(t, StreamInvalidInterval, kindOf(si))
+
+	si = newStreamTestInfo(sum)
+	si.DesMst.RetentionPolicy = "rp_missing"
+	require.Equal(t, StreamMissingDBRP, kindOf(si))
+
+	si = newStreamTestInfo(sum)
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp_missing"},
+		Interval: 2 * time.Second,
+	}}
+	require.Equal(t, StreamMissingDBRP, kindOf(si))
+
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk9", Alias: "sum_fk9"})
+	require.Equal(t, StreamUnknownField, kindOf(si))
+
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "any", Field: "fk1", Alias: "any_fk1"})
+	require.Equal(t, StreamUnsupportedField, kindOf(si))
+
+	si = newStreamTestInfo(sum)
+	si.Slide = 3 * time.Second
+	require.Equal(t, StreamInvalidTask, kindOf(si))
+
+	// the task is built with its options
+	si = newStreamTestInfo(sum)
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{NonFinite: 9}})
+	require.Equal(t, StreamInvalidTask, kindOf(si))
+	si.Options = `{"Unknown":1}`
+	require.Equal(t, StreamInvalidTask, kindOf(si))
+
+	// the windows are checked against the retention policies of the destinations
+	db, err := mc.Database("db0")
+	require.NoError(t, err)
+	db.RetentionPolicies["rp0"].Duration = 2 * time.Hour
+	db.RetentionPolicies["rp0"].ShardGroupDuration = time.Hour
+	si = newStreamTestInfo(sum)
+	si.Interval = 3 * time.Hour
+	require.Equal(t, StreamInvalidDestination, kindOf(si))
+	si = newStreamTestInfo(sum)
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxShardWindows: 60}})
+	require.EqualError(t, s.Validate(si), "validate stream task t: invalid destination: "+
+		"the interval 1s of stream task t makes 3600 windows in a shard group of 1h0m0s of the retention policy rp0, the max is 60")
+	si.Destinations = []*meta2.StreamDestination{{
+		DesMst:   &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db0", RetentionPolicy: "rp0"},
+		Interval: 2 * time.Hour,
+	}}
+	setStreamTestOptions(si, nil)
+	require.Equal(t, StreamInvalidDestination, kindOf(si))
+	db.RetentionPolicies["rp0"].Duration = 0
+	si.Destinations = nil
+
+	mc.MeasurementFn = func(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+		if mstName == "mst2" {
+			return nil, meta2.ErrMeasurementNotFound
+		}
+		ms := NewMeasurement(mstName, engineType)
+		ms.Schema["fk3"] = influx.Field_Type_String
+		return ms, nil
+	}
+	// the destination measurement is created by the first write
+	require.NoError(t, s.Validate(newStreamTestInfo(sum)))
+	si = newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk3", Alias: "sum_fk3"})
+	require.Equal(t, StreamUnsupportedField, kindOf(si))
+	require.EqualError(t, s.Validate(si), "validate stream task t: unsupported field: the fk3 string type is not supported for stream task t")
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// streamWarmsUp returns whether the task suppresses the windows of its warm-up. The store writes the windows it
+// merges without suppressing them, so the rows of such a task are aggregated at the sql layer.
+func streamWarmsUp(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Window.WarmUp
+}
+
+func checkWarmUp(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt.Window.WarmUpPeriod < 0 {
+		return fmt.Errorf("the warm-up period %v of stream task %s is negative", opt.Window.WarmUpPeriod, info.Name)
+	}
+	return nil
+}
+
+// warmUpPeriod returns the length of the warm-up of the task in nanoseconds.
+func warmUpPeriod(si *meta2.StreamInfo, opt *StreamTaskOptions) int64 {
+	if opt.Window.WarmUpPeriod > 0 {
+		return int64(opt.Window.WarmUpPeriod)
+	}
+	return int64(si.Interval)
+}
+
+// startWarmUp starts the warm-up of the task at now if it is not started yet. The warm-up starts once for the state
+// of the task, the rebuilds of the task do not start it again but the pause of the task drops the state, whose
+// windows miss the rows dropped while paused.
+func (s *streamTaskState) startWarmUp(si *meta2.StreamInfo, opt *StreamTaskOptions, now int64) {
+	if atomic.LoadInt64(&s.warmUpEnd) != 0 {
+		return
+	}
+	atomic.CompareAndSwapInt64(&s.warmUpEnd, 0, now+warmUpPeriod(si, opt))
+}
+
+// warmingUp returns whether the warm-up of the task is not over at now.
+func (s *streamTaskState) warmingUp(now int64) bool {
+	return now < atomic.LoadInt64(&s.warmUpEnd)
+}
+
+// warmsUpWindow returns whether the window of the key ends before the end of the warm-up of the task, the rows of
+// it may be ingested before the task starts, so the window is not written.
+func (s *streamCtx) warmsUpWindow(si *meta2.StreamInfo, task *streamTask, t int64) bool {
+	warmUpEnd := atomic.LoadInt64(&s.state.warmUpEnd)
+	if warmUpEnd == 0 || s.backfill || !streamWarmsUp(task.opt) {
+		return false
+	}
+	end := t + 1
+	if task.direct {
+		// the direct windows are keyed by their start times
+		end = s.windowEnd(si, t)
+	}
+	return end <= warmUpEnd
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWarmUp(t *testing.T) {
+	env := newStreamTestEnv()
+	sec := int64(time.Second)
+	now := env.base + sec/2
+	env.pw.streamClock = func() int64 { return now }
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{WarmUp: true}})
+	row := func(ts int64) *influx.Row {
+		return newStreamTestRow(ts, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1))
+	}
+	windows := func(rows ...*influx.Row) []int64 {
+		var ends []int64
+		for _, r := range rowsOfMst(env.calculate(t, si, rows...), "mst2") {
+			ends = append(ends, r.Timestamp)
+		}
+		return ends
+	}
+
+	// the window the task starts in is not written, the next one is
+	require.Equal(t, []int64{env.base + 2*sec - 1}, windows(row(env.base+1), row(env.base+sec+1)))
+	// the warm-up is not started again by the following batches
+	now += 5 * sec
+	require.Empty(t, windows(row(env.base+2)))
+	require.Len(t, windows(row(env.base+6*sec)), 1)
+
+	// the windows are written once the warm-up is disabled
+	setStreamTestOptions(si, nil)
+	require.Len(t, windows(row(env.base+2)), 1)
+}
+
+func TestStreamWarmUpPeriod(t *testing.T) {
+	env := newStreamTestEnv()
+	sec := int64(time.Second)
+	now := env.base
+	env.pw.streamClock = func() int64 { return now }
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Window: StreamWindowOptions{WarmUp: true, WarmUpPeriod: 3 * time.Second}})
+	ctx := env.prepare(t, si)
+	defer putInjestionCtx(ctx)
+
+	var rows []*influx.Row
+	for i := int64(0); i < 5; i++ {
+		rows = append(rows, newStreamTestRow(env.base+i*sec, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)))
+	}
+	_, err := ctx.stream.calculate(rows, si, env.pw, ctx, 0)
+	require.NoError(t, err)
+	var out []*influx.Row
+	for i := range ctx.shardRowMap {
+		out = append(out, ctx.shardRowMap[i].rows...)
+	}
+	require.Len(t, rowsOfMst(out, "mst2"), 2)
+
+	tasks := ctx.stream.Tasks()
+	require.True(t, tasks[0].WarmingUp)
+	require.Equal(t, time.Unix(0, env.base+3*sec), tasks[0].WarmUpEnd)
+	now += 3 * sec
+	require.False(t, ctx.stream.Tasks()[0].WarmingUp)
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err = newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Window: StreamWindowOptions{WarmUp: true, WarmUpPeriod: -time.Second}})
+	require.EqualError(t, err, "the warm-up period -1s of stream task t is negative")
+}
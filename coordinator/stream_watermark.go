@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// AdvanceStreamWatermark advances the watermark of the stream task to the time supplied by the caller, such as the
+// time of a marker of the source, and writes the windows of the accumulators ended before it, which are dropped then.
+// The windows are written at their start times as complete whatever the current time, so replaying the same rows
+// and watermarks writes the same windows. The windows ending at or after the time stay open, and the rows of a closed
+// window arriving later open it again unless they are late. The other windows are written with every batch.
+// It returns the number of the windows written.
+func (w *PointsWriter) AdvanceStreamWatermark(name string, ts int64) (int, error) {
+	si, ok := w.MetaClient.GetStreamInfos()[name]
+	if !ok {
+		return 0, errno.NewError(errno.StreamNotFound)
+	}
+	// the windows are closed once the batches in flight are written
+	w.streamFlushMu.Lock()
+	defer w.streamFlushMu.Unlock()
+
+	st := w.getStreamTaskState(name)
+	st.advanceWatermark(ts)
+	if !st.accumulators.endsBefore(ts) {
+		return 0, nil
+	}
+	n, err := w.writeStreamTaskState(si, func(task *streamTask, ctx *injestionCtx) (int, error) {
+		return ctx.stream.closeWindows(si, task, w, ctx, ts)
+	})
+	if err != nil {
+		// the windows are kept open to be written by the following watermarks
+		return n, err
+	}
+	st.accumulators.mu.Lock()
+	st.accumulators.expire(ts)
+	st.accumulators.mu.Unlock()
+	w.logger.Info("closed the windows of stream task by the watermark", zap.String("stream", name),
+		zap.Int64("watermark", ts), zap.Int("windows", n))
+	return n, nil
+}
+
+// closeWindows maps the windows of the accumulators of the task ended before the time to the shards, and returns
+// the number of them.
+func (s *Stream) closeWindows(si *meta2.StreamInfo, task *streamTask, pw *PointsWriter, iCtx *injestionCtx, before int64) (int, error) {
+	ctx := GetStreamCtx()
+	defer PutStreamCtx(ctx)
+	if err := s.initStateCtx(si, task, pw, ctx, iCtx); err != nil {
+		return 0, err
+	}
+	ctx.closing = true
+	ctx.accumulators.mu.Lock()
+	n := ctx.addCompletedWindows(task, before)
+	ctx.accumulators.mu.Unlock()
+	if n == 0 {
+		return 0, nil
+	}
+	if task.deltas != nil {
+		ctx.applyDeltas(si, task)
+	}
+	return n, s.mapRowsToShard(si, task, ctx, iCtx)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamAdvanceWatermark(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}})
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{PartialField: "partial"}})
+
+	var mu sync.Mutex
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+
+	sec := int64(time.Second)
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	env.calculate(t, si,
+		newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		newStreamTestRow(start+1, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 3)),
+		newStreamTestRow(start+sec, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 5)),
+	)
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Len(t, state.accumulators.windows, 2)
+
+	// nothing is written until the watermark passes the end of the first window
+	n, err := env.pw.AdvanceStreamWatermark(si.Name, start+sec)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Empty(t, written)
+
+	// the window ended before the watermark is written and dropped, the newer one stays open
+	n, err = env.pw.AdvanceStreamWatermark(si.Name, start+sec+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	out := rowsOfMst(written, "mst2")
+	require.Len(t, out, 1)
+	require.False(t, out[0].StreamOnly)
+	require.Equal(t, start, out[0].Timestamp)
+	require.Equal(t, "a", tagValue(out[0], "tk1"))
+	v, ok := fieldValue(out[0], "p50_fk1")
+	require.True(t, ok)
+	require.Equal(t, float64(2), v)
+	_, ok = fieldValue(out[0], "partial")
+	require.False(t, ok)
+	require.Len(t, state.accumulators.windows, 1)
+	require.Equal(t, start+sec+1, state.loadWatermark())
+
+	// the watermark does not go back
+	n, err = env.pw.AdvanceStreamWatermark(si.Name, start)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Equal(t, start+sec+1, state.loadWatermark())
+
+	_, err = env.pw.AdvanceStreamWatermark("unknown", start)
+	require.EqualError(t, err, errno.NewError(errno.StreamNotFound).Error())
+}
+
+func TestStreamAdvanceWatermarkComplete(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "percentile", Field: "fk1", Alias: "p50_fk1", Args: []string{"50"}})
+	si.Delay = 10 * time.Minute
+	env.pw.MetaClient = &streamInfosMetaClient{MockMetaClient: env.pw.MetaClient.(*MockMetaClient), infos: map[string]*meta2.StreamInfo{si.Name: si}}
+	setStreamTestOptions(si, &StreamTaskOptions{Output: StreamOutputOptions{CompleteField: "complete"}})
+
+	var written []*influx.Row
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		for i := range ctx.Rows {
+			r := &influx.Row{}
+			r.Clone(&ctx.Rows[i])
+			written = append(written, r)
+		}
+		return nil
+	}
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	env.calculate(t, si, newStreamTestRow(start, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)))
+	written = nil
+
+	// the windows closed by the watermark are complete whatever the delay of the task
+	n, err := env.pw.AdvanceStreamWatermark(si.Name, start+int64(time.Second)+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	out := rowsOfMst(written, "mst2")
+	require.Len(t, out, 1)
+	v, ok := fieldValue(out[0], "complete")
+	require.True(t, ok)
+	require.Equal(t, float64(1), v)
+	require.Empty(t, env.pw.getStreamTaskState(si.Name).accumulators.windows)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"math"
+
+	streamLib "github.com/openGemini/openGemini/lib/stream"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// weightedMeanCall averages the values of the field weighted by the values of the field given as the arg,
+// like the average price weighted by the volume.
+const weightedMeanCall = "weighted_mean"
+
+// buildWeightedMeanCalls returns the weight fields indexed by the calls of the stream, nil if the stream has no
+// weighted_mean call. Both the fields of a weighted_mean call must be numeric fields of the source.
+func buildWeightedMeanCalls(info *meta2.StreamInfo, srcSchema map[string]int32) ([]string, error) {
+	var weights []string
+	for i, c := range info.Calls {
+		if c.Call != weightedMeanCall {
+			continue
+		}
+		if len(c.Args) != 1 {
+			return nil, fmt.Errorf("the weighted_mean call %s of stream task %s needs exactly one weight field argument", c.Alias, info.Name)
+		}
+		for _, f := range []string{c.Field, c.Args[0]} {
+			if t, ok := srcSchema[f]; !ok || (t != influx.Field_Type_Float && t != influx.Field_Type_Int) {
+				return nil, fmt.Errorf("the field %s of the weighted_mean call %s of stream task %s is not a numeric field of the source", f, c.Alias, info.Name)
+			}
+		}
+		if weights == nil {
+			weights = make([]string, len(info.Calls))
+		}
+		weights[i] = c.Args[0]
+	}
+	return weights, nil
+}
+
+// weightOf returns the weight of the row for the weighted_mean call, false if the row has no numeric weight.
+func (w *streamTask) weightOf(r *influx.Row, call int) (float64, bool) {
+	id, ok := r.ColumnToIndex[w.weights[call]]
+	if !ok {
+		return 0, false
+	}
+	f := &r.Fields[id-r.Tags.Len()]
+	if f.Type != influx.Field_Type_Float && f.Type != influx.Field_Type_Int {
+		return 0, false
+	}
+	return f.NumValue, true
+}
+
+// addWeighted adds the value weighted by the weight to the weighted_mean accumulator of the window and returns it.
+func (a *streamAccumulators) addWeighted(key accumulatorKey, end int64, newAcc newAccumulatorFunc, value, weight float64) streamLib.Accumulator {
+	acc := a.window(key, end, newAcc)
+	acc.(*weightedMeanAccumulator).addWeighted(value, weight)
+	return acc
+}
+
+func newWeightedMean(int64, int64) streamLib.Accumulator {
+	return &weightedMeanAccumulator{}
+}
+
+// weightedMeanAccumulator keeps the sum of the weighted values and the sum of the weights of the window.
+type weightedMeanAccumulator struct {
+	weighted float64
+	weights  float64
+}
+
+// Add adds the value of the weight 1, the values of the rows are added with their weights by addWeighted.
+func (a *weightedMeanAccumulator) Add(value float64, _ int64) {
+	a.addWeighted(value, 1)
+}
+
+func (a *weightedMeanAccumulator) addWeighted(value, weight float64) {
+	if math.IsNaN(value) || math.IsNaN(weight) {
+		return
+	}
+	a.weighted += value * weight
+	a.weights += weight
+}
+
+// Value returns NaN if the weights sum to zero.
+func (a *weightedMeanAccumulator) Value() float64 {
+	if a.weights == 0 {
+		return math.NaN()
+	}
+	return a.weighted / a.weights
+}
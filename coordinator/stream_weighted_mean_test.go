@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWeightedMean(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(
+		&meta2.StreamCall{Call: "weighted_mean", Field: "price", Alias: "vwap", Args: []string{"volume"}},
+		&meta2.StreamCall{Call: "sum", Field: "price", Alias: "sum_price"},
+	)
+	require.True(t, streamKeepsState(si))
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	tags := []influx.Tag{{Key: "tk1", Value: "a"}}
+	resultOf := func(rows []*influx.Row) (float64, float64) {
+		out := rowsOfMst(rows, "mst2")
+		require.Len(t, out, 1)
+		vwap, ok := fieldValue(out[0], "vwap")
+		require.True(t, ok)
+		sum, _ := fieldValue(out[0], "sum_price")
+		return vwap, sum
+	}
+
+	vwap, sum := resultOf(env.calculate(t, si,
+		newStreamTestRow(start, tags, floatField("price", 10), floatField("volume", 1)),
+		newStreamTestRow(start+1, tags, floatField("price", 20), floatField("volume", 3)),
+		// the row without the weight is only skipped by the weighted mean
+		newStreamTestRow(start+2, tags, floatField("price", 100)),
+		newStreamTestRow(start+3, tags, floatField("price", 50), influx.Field{Key: "volume", StrValue: "many", Type: influx.Field_Type_String}),
+	))
+	require.Equal(t, 17.5, vwap)
+	require.Equal(t, float64(180), sum)
+
+	// the window is averaged across the batches
+	vwap, _ = resultOf(env.calculate(t, si, newStreamTestRow(start+4, tags, floatField("price", 30), floatField("volume", 4))))
+	require.Equal(t, 23.75, vwap)
+
+	// the weights summing to zero have no mean
+	acc := newWeightedMean(0, 0).(*weightedMeanAccumulator)
+	require.True(t, math.IsNaN(acc.Value()))
+	acc.addWeighted(5, 2)
+	acc.addWeighted(7, -2)
+	require.True(t, math.IsNaN(acc.Value()))
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	si.Calls[0].Args = nil
+	_, err := newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the weighted_mean call vwap of stream task t needs exactly one weight field argument")
+	si.Calls[0].Args = []string{"qty"}
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the field qty of the weighted_mean call vwap of stream task t is not a numeric field of the source")
+	si.Calls[0].Args = []string{"tk1"}
+	_, err = newStreamTask(si, srcSchema, dstSchema, nil)
+	require.EqualError(t, err, "the field tk1 of the weighted_mean call vwap of stream task t is not a numeric field of the source")
+}
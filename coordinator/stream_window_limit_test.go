@@ -0,0 +1,64 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamMaxGroupWindows(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{MaxGroupWindows: 2}})
+	at := func(window int, group string, v float64) *influx.Row {
+		return newStreamTestRow(env.base+int64(window)*int64(time.Second), []influx.Tag{{Key: "tk1", Value: group}}, floatField("fk1", v))
+	}
+
+	out := rowsOfMst(env.calculate(t, si,
+		at(2, "a", 1), at(3, "a", 2), at(2, "a", 3),
+		// closes the window 2
+		at(4, "a", 4),
+		// the window 2 is closed and the window 1 is older than the open windows
+		at(2, "a", 5), at(1, "a", 6), at(3, "a", 7),
+		// the other group is not limited by the windows of a
+		at(1, "b", 8), at(2, "b", 9),
+	), "mst2")
+
+	var sums []float64
+	var windows []int64
+	for _, r := range out {
+		if tagValue(r, "tk1") != "a" {
+			continue
+		}
+		v, _ := fieldValue(r, "sum_fk1")
+		sums = append(sums, v)
+		windows = append(windows, (r.Timestamp+1-env.base)/int64(time.Second))
+	}
+	require.Equal(t, []int64{3, 4, 5}, windows)
+	require.Equal(t, []float64{4, 9, 4}, sums)
+	require.Len(t, out, 5)
+
+	state := env.pw.getStreamTaskState(si.Name)
+	require.Equal(t, int64(1), atomic.LoadInt64(&state.forcedCloses))
+	require.Equal(t, int64(2), atomic.LoadInt64(&state.closedWindowRows))
+}
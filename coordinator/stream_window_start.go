@@ -0,0 +1,72 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamStampsWindows returns whether the rows of the stream carry the start times of their windows. The store
+// writes the windows it merges without the field, so the windows of such a stream are aggregated at the sql layer
+// and written at their start times.
+func streamStampsWindows(opt *StreamTaskOptions) bool {
+	return opt != nil && opt.Window.StartField != ""
+}
+
+// checkWindowStartField rejects the window start field which is also a call or a dim of the stream.
+func checkWindowStartField(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if !streamStampsWindows(opt) {
+		return nil
+	}
+	key := opt.Window.StartField
+	for _, c := range info.Calls {
+		if outputAlias(opt, c.Alias) == key {
+			return fmt.Errorf("the window start field %s of stream task %s is written by the call %s", key, info.Name, c.Alias)
+		}
+	}
+	for _, d := range info.Dims {
+		if d == key {
+			return fmt.Errorf("the window start field %s of stream task %s is a dim", key, info.Name)
+		}
+	}
+	return nil
+}
+
+// ensureWindowStartField adds the window start field to the schema of the destination measurement if it is missing,
+// the rows of the windows are routed to the shards without updating the schema.
+func (s *Stream) ensureWindowStartField(si *meta2.StreamInfo, task *streamTask, ctx *streamCtx) error {
+	key := task.opt.Window.StartField
+	typ, ok := ctx.ms.Schema[key]
+	if ok {
+		if typ != influx.Field_Type_Int {
+			return fmt.Errorf("the window start field %s of stream task %s is a %s field of the destination",
+				key, si.Name, influx.FieldTypeString(typ))
+		}
+		return nil
+	}
+	fields := appendField(nil, key, influx.Field_Type_Int)
+	return s.MetaClient.UpdateSchema(ctx.db.Name, ctx.rp.Name, ctx.ms.OriginName(), fields)
+}
+
+// addWindowStart adds the start time of the window to the row as an integer field. The fields hold the integers
+// as float64, so the start times are exact for the windows aligned to the seconds.
+func addWindowStart(r *influx.Row, key string, start int64) {
+	r.Fields = append(r.Fields, influx.Field{Key: key, NumValue: float64(start), Type: influx.Field_Type_Int})
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWindowStartField(t *testing.T) {
+	env := newStreamTestEnv()
+	var created []string
+	env.pw.MetaClient.(*MockMetaClient).UpdateSchemaFn = func(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error {
+		for _, f := range fieldToCreate {
+			require.Equal(t, int32(influx.Field_Type_Int), f.GetFieldType())
+			created = append(created, mst+"."+f.GetFieldName())
+		}
+		return nil
+	}
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	opt := &StreamTaskOptions{Window: StreamWindowOptions{StartField: "_window_start"}}
+	setStreamTestOptions(si, opt)
+	require.True(t, streamNeedsSQLLayer(si, opt))
+
+	start := time.Unix(0, env.base).Truncate(time.Second).Add(time.Second).UnixNano()
+	row := func(ms int, v float64) *influx.Row {
+		return newStreamTestRow(start+int64(ms)*int64(time.Millisecond), []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v))
+	}
+	check := func(rows []*influx.Row, sum float64) {
+		out := rowsOfMst(rows, "mst2")
+		require.Len(t, out, 1)
+		require.False(t, out[0].StreamOnly)
+		require.Equal(t, start, out[0].Timestamp)
+		v, _ := fieldValue(out[0], "sum_fk1")
+		require.Equal(t, sum, v)
+		v, ok := fieldValue(out[0], "_window_start")
+		require.True(t, ok)
+		require.Equal(t, start, int64(v))
+		require.Equal(t, int32(influx.Field_Type_Int), out[0].Fields[len(out[0].Fields)-1].Type)
+	}
+
+	check(env.calculate(t, si, row(0, 1), row(500, 2)), 3)
+	require.Equal(t, []string{"mst2._window_start"}, created)
+	// the windows are aggregated at the sql layer across the batches
+	check(env.calculate(t, si, row(900, 4)), 7)
+
+	for field, msg := range map[string]string{
+		"sum_fk1": "the window start field sum_fk1 of stream task t is written by the call sum_fk1",
+		"tk1":     "the window start field tk1 of stream task t is a dim",
+	} {
+		srcSchema, dstSchema := streamTestSchema(si)
+		_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Window: StreamWindowOptions{StartField: field}})
+		require.EqualError(t, err, msg)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	streamWriteBackoff    = 100 * time.Millisecond
+	maxStreamWriteBackoff = 2 * time.Second
+)
+
+// streamWriteRetry bounds the retries writing the rows of a shard after the transient errors,
+// nil retries them at the fixed interval until the timeout.
+type streamWriteRetry struct {
+	retries int
+	backoff time.Duration
+}
+
+// wait sleeps before the next retry, it returns false without sleeping if the retries are exhausted.
+func (r *streamWriteRetry) wait() bool {
+	if r == nil {
+		time.Sleep(streamWriteBackoff)
+		return true
+	}
+	if r.retries <= 0 {
+		return false
+	}
+	r.retries--
+	time.Sleep(r.backoff)
+	if r.backoff *= 2; r.backoff > maxStreamWriteBackoff {
+		r.backoff = maxStreamWriteBackoff
+	}
+	return true
+}
+
+// writeRetry returns the retries writing the rows of the shard, nil if no task of the shard bounds them.
+// The shard holding the other rows as well is retried until the timeout.
+func (s *streamShard) writeRetry(sr *ShardRow) *streamWriteRetry {
+	if s == nil || s.retries == 0 || s.rows != len(sr.rows) {
+		return nil
+	}
+	return &streamWriteRetry{retries: s.retries, backoff: streamWriteBackoff}
+}
+
+// streamSpilledRow is a row of a stream task kept to be written to its shard again.
+type streamSpilledRow struct {
+	sh  *meta2.ShardInfo
+	row *influx.Row
+}
+
+// streamShardSpill are the rows of a task mapped to a shard which are kept if their write fails.
+type streamShardSpill struct {
+	limit int
+	rows  []*influx.Row
+}
+
+func (s *streamShard) addSpillRow(state *streamTaskState, limit int, r *influx.Row) {
+	if s.spill == nil {
+		s.spill = make(map[*streamTaskState]*streamShardSpill)
+	}
+	sp, ok := s.spill[state]
+	if !ok {
+		sp = &streamShardSpill{limit: limit}
+		s.spill[state] = sp
+	}
+	sp.rows = append(sp.rows, r)
+}
+
+// streamSpill holds the rows of a task failed by the transient errors of the store.
+type streamSpill struct {
+	mu   sync.Mutex
+	rows []streamSpilledRow
+}
+
+// add keeps the copies of the rows up to the limit of the spill, and returns the number of the rows kept.
+func (s *streamSpill) add(sh *meta2.ShardInfo, rows []*influx.Row, limit int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, r := range rows {
+		if len(s.rows) >= limit {
+			break
+		}
+		s.rows = append(s.rows, streamSpilledRow{sh: sh, row: copyStreamRow(r)})
+		n++
+	}
+	return n
+}
+
+// take removes all the rows of the spill and returns them.
+func (s *streamSpill) take() []streamSpilledRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rows := s.rows
+	s.rows = nil
+	return rows
+}
+
+// copyStreamRow copies the row mapped to the shard, the rows of the batch are reused once it is written.
+func copyStreamRow(r *influx.Row) *influx.Row {
+	c := &influx.Row{Name: r.Name, Timestamp: r.Timestamp, StreamOnly: r.StreamOnly}
+	c.Tags = append(c.Tags, r.Tags...)
+	c.Fields = append(c.Fields, r.Fields...)
+	c.ShardKey = append(c.ShardKey, r.ShardKey...)
+	c.IndexOptions = append(c.IndexOptions, r.IndexOptions...)
+	c.StreamId = append(c.StreamId, r.StreamId...)
+	buildColumnToIndex(c)
+	return c
+}
+
+// isTransientWriteErr returns whether the write may succeed later, the shards missing from meta never come back.
+func isTransientWriteErr(err error) bool {
+	return IsRetryErrorForPtView(err) && !errno.Equal(err, errno.ShardMetaNotFound)
+}
+
+// failStreamShard counts the windows of the tasks failed to be written to the shard at now, the rows failed by the
+// transient errors are kept by the tasks spilling them.
+func failStreamShard(ss *streamShard, sh *meta2.ShardInfo, err error, now int64) {
+	for state, n := range ss.windows {
+		state.addFailedWindows(n)
+		state.breaker.fail(now)
+	}
+	if !isTransientWriteErr(err) {
+		return
+	}
+	for state, sp := range ss.spill {
+		if n := state.spill.add(sh, sp.rows, sp.limit); n > 0 {
+			state.stats.AddSpilledRows(int64(n))
+		}
+	}
+}
+
+// mapSpilledRows maps the rows kept by the current task to their shards again.
+func (s *injestionCtx) mapSpilledRows() {
+	state := s.streamWriter.state
+	for _, sr := range state.spill.take() {
+		s.addStreamShardIds(sr.sh, sr.row)
+		s.setStreamShardRow(sr.sh, sr.row)
+		s.addStreamWritten(state.stats, sr.row)
+	}
+}
+
+// addStreamShardIds maps the stream ids of the row kept by the task to its shard again.
+func (s *injestionCtx) addStreamShardIds(sh *meta2.ShardInfo, r *influx.Row) {
+	srcStreamDstShardIdMap := s.getSrcStreamDstShardIdMap()
+	for _, id := range r.StreamId {
+		m, ok := srcStreamDstShardIdMap[sh.ID]
+		if !ok {
+			m = map[uint64]uint64{}
+			srcStreamDstShardIdMap[sh.ID] = m
+		}
+		m[id] = sh.ID
+	}
+}
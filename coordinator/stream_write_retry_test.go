@@ -0,0 +1,94 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWriteRetry(t *testing.T) {
+	env := newStreamTestEnv()
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	setStreamTestOptions(si, &StreamTaskOptions{Errors: StreamErrorOptions{WriteRetries: 2, SpillRows: 1}})
+	state := env.pw.getStreamTaskState(si.Name)
+
+	var mu sync.Mutex
+	var calls int
+	var failures []error
+	var written []float64
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if len(failures) > 0 {
+			err := failures[0]
+			failures = failures[1:]
+			return err
+		}
+		for i := range ctx.Rows {
+			v, _ := fieldValue(&ctx.Rows[i], "sum_fk1")
+			written = append(written, v)
+		}
+		return nil
+	}
+	write := func(v float64, errs ...error) error {
+		calls, failures, written = 0, errs, written[:0]
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		_, err := ctx.stream.calculate([]*influx.Row{
+			newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", v)),
+		}, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		return env.pw.writeShardMap("db0", "rp0", ctx)
+	}
+	transient := errno.NewError(errno.NoConnectionAvailable)
+
+	// the transient errors are retried with the backoff
+	start := time.Now()
+	require.NoError(t, write(1, transient, transient))
+	require.Equal(t, 3, calls)
+	require.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	require.Equal(t, []float64{1}, written)
+	require.Equal(t, int64(0), state.failedWindows)
+
+	// the rows are spilled once the retries are exhausted, and written with the next batch
+	require.True(t, errno.Equal(write(2, transient, transient, transient), errno.NoConnectionAvailable))
+	require.Equal(t, 3, calls)
+	require.Equal(t, int64(1), state.failedWindows)
+	require.Equal(t, int64(1), state.stats.SpilledRows)
+	require.NoError(t, write(4))
+	require.ElementsMatch(t, []float64{2, 4}, written)
+	require.Empty(t, state.spill.take())
+
+	// the permanent errors are not retried, and the rows are dropped
+	require.True(t, errno.Equal(write(8, errno.NewError(errno.ShardMetaNotFound)), errno.ShardMetaNotFound))
+	require.Equal(t, 1, calls)
+	require.Equal(t, int64(2), state.failedWindows)
+	require.Empty(t, state.spill.take())
+
+	// the spill keeps the rows up to its limit
+	state.spill.add(nil, []*influx.Row{{}, {}}, 1)
+	require.Len(t, state.spill.take(), 1)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"fmt"
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// streamWriter is the stream task whose rows are mapped to the shards by the injestion context.
+type streamWriter struct {
+	state *streamTaskState
+	// timeout is the write timeout of the task, zero means the default one
+	timeout time.Duration
+	retries int
+	spill   int
+	// throttled tells whether the rows of the task are limited by the rates, the rows over them are held up to
+	// throttleRows
+	throttled    bool
+	rowsRate     int
+	bytesRate    int64
+	throttleRows int
+	// flushInterval is the interval of the flush times of the task offset by flushOffset, the rows of the task are
+	// held until them. 0 means the rows are written with every batch
+	flushInterval int64
+	flushOffset   int64
+	now           func() int64
+}
+
+// streamShard records the rows of the stream tasks mapped to a shard.
+type streamShard struct {
+	rows int
+	// timeoutRows are the rows of the tasks overriding the write timeout, timeout is the longest of them
+	timeoutRows int
+	timeout     time.Duration
+	// retries is the most retries of the tasks after the transient errors
+	retries int
+	// windows are the rows of the shard emitted by each task
+	windows map[*streamTaskState]int64
+	// spill are the rows of the tasks keeping the failed rows
+	spill map[*streamTaskState]*streamShardSpill
+}
+
+// checkStreamWriteTimeout checks the write timeout of the stream, zero means the default timeout of the writer.
+func checkStreamWriteTimeout(info *meta2.StreamInfo, opt *StreamTaskOptions) error {
+	if opt.Limits.WriteTimeout < 0 {
+		return fmt.Errorf("the write timeout %v of stream task %s is not positive", opt.Limits.WriteTimeout, info.Name)
+	}
+	return nil
+}
+
+// setStreamWriter sets the task whose rows are mapped by the context until resetStreamWriter is called.
+func (s *injestionCtx) setStreamWriter(si *meta2.StreamInfo, state *streamTaskState, opt *StreamTaskOptions) {
+	s.streamWriter = streamWriter{state: state, timeout: opt.Limits.WriteTimeout, retries: opt.Errors.WriteRetries, spill: opt.Errors.SpillRows}
+	if streamThrottled(opt) {
+		sw := &s.streamWriter
+		sw.throttled, sw.rowsRate, sw.bytesRate, sw.throttleRows = true, opt.Output.EmitRowsPerSecond, opt.Output.EmitBytesPerSecond, opt.Output.ThrottleRows
+		if sw.throttleRows <= 0 {
+			sw.throttleRows = defaultStreamThrottleRows
+		}
+	}
+	s.streamWriter.now = streamNow
+	if s.stream != nil && s.stream.now != nil {
+		s.streamWriter.now = s.stream.now
+	}
+}
+
+func (s *injestionCtx) resetStreamWriter() {
+	s.streamWriter = streamWriter{}
+}
+
+// setStreamShardRow maps the row emitted by the current stream task to the shard, and records the write options
+// of the task for the shard. The rows before the flush time or over the rates of the task are held instead.
+func (s *injestionCtx) setStreamShardRow(sh *meta2.ShardInfo, r *influx.Row) {
+	if s.streamWriter.flushInterval > 0 {
+		s.holdJitteredRow(sh, r)
+		return
+	}
+	s.admitStreamShardRow(sh, r)
+}
+
+// admitStreamShardRow maps the row of the current task to the shard if it is within the rates of the task.
+func (s *injestionCtx) admitStreamShardRow(sh *meta2.ShardInfo, r *influx.Row) {
+	if s.streamWriter.throttled && !s.admitStreamRow(sh, r) {
+		return
+	}
+	s.mapStreamShardRow(sh, r)
+}
+
+func (s *injestionCtx) mapStreamShardRow(sh *meta2.ShardInfo, r *influx.Row) {
+	s.setShardRow(sh, r)
+	sw := &s.streamWriter
+	if sw.state == nil {
+		return
+	}
+	if s.streamShards == nil {
+		s.streamShards = make(map[uint64]*streamShard)
+	}
+	ss, ok := s.streamShards[sh.ID]
+	if !ok {
+		ss = &streamShard{windows: make(map[*streamTaskState]int64)}
+		s.streamShards[sh.ID] = ss
+	}
+	ss.rows++
+	ss.windows[sw.state]++
+	if sw.timeout > 0 {
+		ss.timeoutRows++
+		if sw.timeout > ss.timeout {
+			ss.timeout = sw.timeout
+		}
+	}
+	if sw.retries > ss.retries {
+		ss.retries = sw.retries
+	}
+	if sw.spill > 0 {
+		ss.addSpillRow(sw.state, sw.spill, r)
+	}
+}
+
+// shardWriteTimeout returns the timeout writing the rows of the shard. The longest timeout of the tasks overrides
+// the default one if all the rows of the shard are emitted by them, the shard holding the other rows as well is
+// not written within a shorter timeout than the default one.
+func (s *injestionCtx) shardWriteTimeout(sr *ShardRow, timeout time.Duration) time.Duration {
+	ss, ok := s.streamShards[sr.shardInfo.ID]
+	if !ok || ss.timeoutRows == 0 {
+		return timeout
+	}
+	if ss.timeoutRows == len(sr.rows) || ss.timeout > timeout {
+		return ss.timeout
+	}
+	return timeout
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coordinator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/netstorage"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamWriteTimeout(t *testing.T) {
+	env := newStreamTestEnv()
+	var mu sync.Mutex
+	var timeouts []time.Duration
+	env.pw.TSDBStore.(*MockNetStore).WriteRowsFn = func(ctx *netstorage.WriteContext, nodeID uint64, pt uint32, database, rp string, timeout time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		timeouts = append(timeouts, timeout)
+		return nil
+	}
+	write := func(si *meta2.StreamInfo, origin bool) time.Duration {
+		timeouts = timeouts[:0]
+		ctx := env.prepare(t, si)
+		defer putInjestionCtx(ctx)
+		_, err := ctx.stream.calculate([]*influx.Row{
+			newStreamTestRow(env.base, []influx.Tag{{Key: "tk1", Value: "a"}}, floatField("fk1", 1)),
+		}, si, env.pw, ctx, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, ctx.shardRowMap.Len())
+		if origin {
+			// the shard holds a row of the batch as well
+			ctx.setShardRow(ctx.shardRowMap[0].shardInfo, newStreamTestRow(env.base, nil, floatField("fk1", 1)))
+		}
+		require.NoError(t, env.pw.writeShardMap("db0", "rp0", ctx))
+		require.NotEmpty(t, timeouts)
+		return timeouts[0]
+	}
+
+	si := newStreamTestInfo(&meta2.StreamCall{Call: "sum", Field: "fk1", Alias: "sum_fk1"})
+	require.Equal(t, 10*time.Second, write(si, false))
+
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{WriteTimeout: 3 * time.Second}})
+	require.Equal(t, 3*time.Second, write(si, false))
+	// the rows of the batch are not written within a shorter timeout
+	require.Equal(t, 10*time.Second, write(si, true))
+	setStreamTestOptions(si, &StreamTaskOptions{Limits: StreamLimitOptions{WriteTimeout: 30 * time.Second}})
+	require.Equal(t, 30*time.Second, write(si, true))
+
+	srcSchema, dstSchema := streamTestSchema(si)
+	_, err := newStreamTask(si, srcSchema, dstSchema, &StreamTaskOptions{Limits: StreamLimitOptions{WriteTimeout: -time.Second}})
+	require.EqualError(t, err, "the write timeout -1s of stream task t is not positive")
+}
@@ -608,7 +608,7 @@ func checkSchemaIsSameWithTag(msSchema record.Schemas, fields []influx.Field, ta
 
 func (t *MemTable) appendFieldToCol(col *record.ColVal, field *influx.Field, size *int64) error {
 	if field.Type == influx.Field_Type_Int || field.Type == influx.Field_Type_UInt {
-		col.AppendInteger(int64(field.NumValue))
+		col.AppendInteger(field.Int())
 		*size += int64(util.Int64SizeBytes)
 	} else if field.Type == influx.Field_Type_Float {
 		col.AppendFloat(field.NumValue)
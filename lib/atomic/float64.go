@@ -41,6 +41,11 @@ func LoadFloat64(a *float64) float64 {
 	return u
 }
 
+func StoreFloat64(a *float64, b float64) float64 {
+	atomic.StoreUint64((*uint64)(unsafe.Pointer(a)), math.Float64bits(b))
+	return b
+}
+
 func CompareAndSwapMaxFloat64(a *float64, b float64) float64 {
 	p := (*uint64)(unsafe.Pointer(a))
 	for {
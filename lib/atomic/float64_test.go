@@ -51,6 +51,14 @@ func TestMax(t *testing.T) {
 	}
 }
 
+func TestStore(t *testing.T) {
+	var a float64 = 2
+	r := StoreFloat64(&a, 1)
+	if a != 1.0 || r != 1.0 {
+		t.Error(fmt.Sprintf("expect %v ,got %v", 1.0, a))
+	}
+}
+
 func BenchmarkMin(t *testing.B) {
 	var a, b float64
 	for i := 0; i < t.N; i++ {
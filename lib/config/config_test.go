@@ -34,6 +34,8 @@ func TestConfig_Parse(t *testing.T) {
 [subscriber]
   enabled = true
   write-buffer-size = 150
+[coordinator]
+  shard-writers = 4
 `
 	configFile := t.TempDir() + "/sql.conf"
 	_ = os.WriteFile(configFile, []byte(txt), 0600)
@@ -53,6 +55,7 @@ func TestConfig_Parse(t *testing.T) {
 	assert.Equal(t, true, conf.GetSpdy().TLSEnable)
 	assert.Equal(t, true, conf.Subscriber.Enabled)
 	assert.Equal(t, 150, conf.Subscriber.WriteBufferSize)
+	assert.Equal(t, 4, conf.Coordinator.ShardWriters)
 }
 
 func TestLogger(t *testing.T) {
@@ -152,6 +155,11 @@ func TestTSSql(t *testing.T) {
 
 	conf.Common.MetaJoin = []string{""}
 	assert.EqualError(t, conf.Validate(), "comm meta-join must be specified")
+
+	conf = config.NewTSSql()
+	assert.Equal(t, 0, conf.Coordinator.ShardWriters)
+	conf.Coordinator.ShardWriters = -1
+	assert.EqualError(t, conf.Validate(), "coordinator shard-writers can not be negative")
 }
 
 func TestTSStore(t *testing.T) {
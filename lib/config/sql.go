@@ -229,6 +229,8 @@ type Coordinator struct {
 	LogQueriesAfter      toml.Duration `toml:"log-queries-after"`
 	ShardWriterTimeout   toml.Duration `toml:"shard-writer-timeout"`
 	ShardMapperTimeout   toml.Duration `toml:"shard-mapper-timeout"`
+	// Maximum number of shards written concurrently by a write, 0 writes all the shards at once
+	ShardWriters int `toml:"shard-writers"`
 	// Maximum number of memory bytes to use from the query
 	MaxQueryMem              toml.Size       `toml:"max-query-mem"`
 	MetaExecutorWriteTimeout toml.Duration   `toml:"meta-executor-write-timeout"`
@@ -277,6 +279,9 @@ func (c Coordinator) Validate() error {
 	if c.ShardMapperTimeout < 0 {
 		return errors.New("coordinator shard-mapper-timeout can not be negative")
 	}
+	if c.ShardWriters < 0 {
+		return errors.New("coordinator shard-writers can not be negative")
+	}
 	return nil
 }
 
@@ -287,6 +292,7 @@ func (c *Coordinator) ShowConfigs() map[string]interface{} {
 		"coordinator.log-queries-after":           c.LogQueriesAfter,
 		"coordinator.shard-writer-timeout":        c.ShardWriterTimeout,
 		"coordinator.shard-mapper-timeout":        c.ShardMapperTimeout,
+		"coordinator.shard-writers":               c.ShardWriters,
 		"coordinator.max-query-mem":               c.MaxQueryMem,
 		"coordinator.meta-executor-write-timeout": c.MetaExecutorWriteTimeout,
 		"coordinator.query-timeout":               c.QueryTimeout,
@@ -3136,7 +3136,7 @@ func (c *Client) GetDstStreamInfos(db, rp string, dstSis *[]*meta2.StreamInfo) b
 	i := 0
 	*dstSis = (*dstSis)[:cap(*dstSis)]
 	for _, si := range c.cacheData.Streams {
-		if si.SrcMst.Database == db && si.SrcMst.RetentionPolicy == rp {
+		if si.SrcMst.Database == db && si.HasSourceRP(rp) {
 			if len(*dstSis) < i+1 {
 				*dstSis = append(*dstSis, si)
 			} else {
@@ -3211,6 +3211,39 @@ func (c *Client) CreateStreamPolicy(info *meta2.StreamInfo) error {
 	return nil
 }
 
+// PauseStream pauses or resumes the stream, which keeps its definition and ID. The rows written while it is paused
+// are not aggregated, and the stream resumes with fresh windows.
+func (c *Client) PauseStream(name string, paused bool) error {
+	c.mu.RLock()
+	info, ok := c.cacheData.Streams[name]
+	c.mu.RUnlock()
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	pb := info.Marshal()
+	pb.Paused = proto.Bool(paused)
+	cmd := &proto2.CreateStreamCommand{
+		StreamInfo: pb,
+	}
+	return c.retryUntilExec(proto2.Command_CreateStreamCommand, proto2.E_CreateStreamCommand_Command, cmd)
+}
+
+// SetStreamOptions sets the options of the sql layer calculation of the stream, which keeps its definition and ID.
+func (c *Client) SetStreamOptions(name, options string) error {
+	c.mu.RLock()
+	info, ok := c.cacheData.Streams[name]
+	c.mu.RUnlock()
+	if !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	pb := info.Marshal()
+	pb.Options = proto.String(options)
+	cmd := &proto2.CreateStreamCommand{
+		StreamInfo: pb,
+	}
+	return c.retryUntilExec(proto2.Command_CreateStreamCommand, proto2.E_CreateStreamCommand_Command, cmd)
+}
+
 func (c *Client) GetStreamInfosStore() map[string]*meta2.StreamInfo {
 	return c.RetryGetStreamInfosStore()
 }
@@ -615,6 +615,8 @@ func TestClient_Stream(t *testing.T) {
 	err = c.DropStream("test")
 
 	c.cacheData.Streams = map[string]*meta2.StreamInfo{"test": info}
+	require.True(t, errno.Equal(c.PauseStream("none", true), errno.StreamNotFound))
+	require.True(t, errno.Equal(c.SetStreamOptions("none", ""), errno.StreamNotFound))
 	_, _ = c.ShowStreams("db0", false)
 	_, _ = c.ShowStreams("", true)
 
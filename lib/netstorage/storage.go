@@ -17,6 +17,7 @@ limitations under the License.
 package netstorage
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -42,6 +43,8 @@ var (
 
 const (
 	PackageTypeFast = byte(2)
+	// PackageTypeFastShards carries the rows of several shards of a pt, which are written by a single request
+	PackageTypeFastShards = byte(3)
 )
 
 type Storage interface {
@@ -210,6 +213,43 @@ func (s *NetStorage) WriteRows(ctx *WriteContext, nodeID uint64, pt uint32, data
 	return nil
 }
 
+// WriteShardsRows writes the rows of the shards of the pt by a single request, the shards write no stream rows.
+// The rows of the shards no longer existing are dropped as WriteRows does.
+func (s *NetStorage) WriteShardsRows(ctxs []*WriteContext, nodeID uint64, pt uint32, database, rpName string, timeout time.Duration) error {
+	writes := make([]*WriteContext, 0, len(ctxs))
+	for _, ctx := range ctxs {
+		if len(ctx.Rows) == 0 {
+			continue
+		}
+		db, rp, sgi := s.metaClient.ShardOwner(ctx.Shard.ID)
+		if sgi == nil {
+			continue
+		}
+		if db != database || rp != rpName {
+			return fmt.Errorf("exp db: %v, rp: %v, but got: %v, %v", database, rpName, db, rp)
+		}
+		writes = append(writes, ctx)
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+
+	pBuf, err := MarshalShardsRows(writes, database, rpName, pt)
+	if err != nil {
+		return err
+	}
+
+	r := NewRequester(0, nil, s.metaClient)
+	r.setToInsert()
+	r.setTimeout(timeout)
+	err = r.initWithNodeID(nodeID)
+	if err != nil {
+		return err
+	}
+	cb := &WritePointsCallback{}
+	return r.request(spdy.WritePointsRequest, NewWritePointsRequest(pBuf), cb)
+}
+
 func (s *NetStorage) ddlRequestWithNodeId(nodeID uint64, typ uint8, data codec.BinaryCodec) (interface{}, error) {
 	r := NewRequester(typ, data, s.metaClient)
 	err := r.initWithNodeID(nodeID)
@@ -477,6 +517,32 @@ func MarshalRows(ctx *WriteContext, db, rp string, pt uint32) ([]byte, error) {
 	return pBuf, err
 }
 
+// MarshalShardsRows marshals the rows of the shards of the pt into the buffer of the first context,
+// the rows of each shard follow its id and their size.
+func MarshalShardsRows(ctxs []*WriteContext, db, rp string, pt uint32) ([]byte, error) {
+	pBuf := append(ctxs[0].Buf[:0], PackageTypeFastShards)
+	pBuf = append(pBuf, uint8(len(db)))
+	pBuf = append(pBuf, db...)
+	pBuf = append(pBuf, uint8(len(rp)))
+	pBuf = append(pBuf, rp...)
+	pBuf = numenc.MarshalUint32(pBuf, pt)
+	pBuf = numenc.MarshalUint32(pBuf, uint32(len(ctxs)))
+
+	var err error
+	for _, ctx := range ctxs {
+		pBuf = numenc.MarshalUint64(pBuf, ctx.Shard.ID)
+		pos := len(pBuf)
+		pBuf = numenc.MarshalUint32(pBuf, 0)
+		pBuf, err = influx.FastMarshalMultiRows(pBuf, ctx.Rows)
+		if err != nil {
+			return nil, err
+		}
+		binary.BigEndian.PutUint32(pBuf[pos:], uint32(len(pBuf)-pos-4))
+	}
+	ctxs[0].Buf = pBuf
+	return pBuf, nil
+}
+
 func (s *NetStorage) GetQueriesOnNode(nodeID uint64) ([]*QueryExeInfo, error) {
 	req := &ShowQueriesRequest{}
 	v, err := s.ddlRequestWithNodeId(nodeID, ShowQueriesRequestMessage, req)
@@ -0,0 +1,343 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
+)
+
+// StreamTaskStats are the counters of a stream task calculated at the sql layer.
+type StreamTaskStats struct {
+	RowsIn            int64
+	RowsAggregated    int64
+	WindowsEmitted    int64
+	BytesWritten      int64
+	PartialErrors     int64
+	SchemaViolations  int64
+	ForcedCloses      int64
+	ClosedWindowRows  int64
+	LateRows          int64
+	GroupLimitRows    int64
+	GroupLimitFlushes int64
+	PointFlushes      int64
+	ExpiredWindows    int64
+	LazyTaskBuilds    int64
+	SchemaRebuilds    int64
+	FailedWindows     int64
+	SpilledRows       int64
+	PausedRows        int64
+	Paused            int64
+	ThrottledRows     int64
+	ThrottleDrops     int64
+	Throttled         int64
+	NonFiniteValues   int64
+	MalformedRows     int64
+	GroupSpills       int64
+	GroupSpillBytes   int64
+	IntOverflows      int64
+	DuplicateRows     int64
+	BreakerRows       int64
+	BreakerOpen       int64
+	Calculations      int64
+	CalculateDuration int64
+}
+
+func (s *StreamTaskStats) AddRowsIn(i int64) {
+	atomic.AddInt64(&s.RowsIn, i)
+}
+
+func (s *StreamTaskStats) AddRowsAggregated(i int64) {
+	atomic.AddInt64(&s.RowsAggregated, i)
+}
+
+func (s *StreamTaskStats) AddWindowsEmitted(i int64) {
+	atomic.AddInt64(&s.WindowsEmitted, i)
+}
+
+func (s *StreamTaskStats) AddBytesWritten(i int64) {
+	atomic.AddInt64(&s.BytesWritten, i)
+}
+
+func (s *StreamTaskStats) AddPartialErrors(i int64) {
+	atomic.AddInt64(&s.PartialErrors, i)
+}
+
+func (s *StreamTaskStats) AddSchemaViolations(i int64) {
+	atomic.AddInt64(&s.SchemaViolations, i)
+}
+
+func (s *StreamTaskStats) AddForcedCloses(i int64) {
+	atomic.AddInt64(&s.ForcedCloses, i)
+}
+
+func (s *StreamTaskStats) AddClosedWindowRows(i int64) {
+	atomic.AddInt64(&s.ClosedWindowRows, i)
+}
+
+func (s *StreamTaskStats) AddLateRows(i int64) {
+	atomic.AddInt64(&s.LateRows, i)
+}
+
+func (s *StreamTaskStats) AddGroupLimitRows(i int64) {
+	atomic.AddInt64(&s.GroupLimitRows, i)
+}
+
+func (s *StreamTaskStats) AddGroupLimitFlushes(i int64) {
+	atomic.AddInt64(&s.GroupLimitFlushes, i)
+}
+
+func (s *StreamTaskStats) AddPointFlushes(i int64) {
+	atomic.AddInt64(&s.PointFlushes, i)
+}
+
+func (s *StreamTaskStats) AddExpiredWindows(i int64) {
+	atomic.AddInt64(&s.ExpiredWindows, i)
+}
+
+func (s *StreamTaskStats) AddLazyTaskBuilds(i int64) {
+	atomic.AddInt64(&s.LazyTaskBuilds, i)
+}
+
+func (s *StreamTaskStats) AddSchemaRebuilds(i int64) {
+	atomic.AddInt64(&s.SchemaRebuilds, i)
+}
+
+func (s *StreamTaskStats) AddFailedWindows(i int64) {
+	atomic.AddInt64(&s.FailedWindows, i)
+}
+
+func (s *StreamTaskStats) AddSpilledRows(i int64) {
+	atomic.AddInt64(&s.SpilledRows, i)
+}
+
+func (s *StreamTaskStats) AddPausedRows(i int64) {
+	atomic.AddInt64(&s.PausedRows, i)
+}
+
+// SetPaused records whether the task is paused.
+func (s *StreamTaskStats) SetPaused(paused bool) {
+	var v int64
+	if paused {
+		v = 1
+	}
+	atomic.StoreInt64(&s.Paused, v)
+}
+
+func (s *StreamTaskStats) AddThrottledRows(i int64) {
+	atomic.AddInt64(&s.ThrottledRows, i)
+}
+
+func (s *StreamTaskStats) AddThrottleDrops(i int64) {
+	atomic.AddInt64(&s.ThrottleDrops, i)
+}
+
+// SetThrottled records whether the task holds the rows throttled by its emission rate.
+func (s *StreamTaskStats) SetThrottled(throttled bool) {
+	var v int64
+	if throttled {
+		v = 1
+	}
+	atomic.StoreInt64(&s.Throttled, v)
+}
+
+func (s *StreamTaskStats) AddNonFiniteValues(i int64) {
+	atomic.AddInt64(&s.NonFiniteValues, i)
+}
+
+func (s *StreamTaskStats) AddMalformedRows(i int64) {
+	atomic.AddInt64(&s.MalformedRows, i)
+}
+
+// AddGroupSpills records the groups spilled to disk and their bytes.
+func (s *StreamTaskStats) AddGroupSpills(groups, bytes int64) {
+	atomic.AddInt64(&s.GroupSpills, groups)
+	atomic.AddInt64(&s.GroupSpillBytes, bytes)
+}
+
+func (s *StreamTaskStats) AddIntOverflows(i int64) {
+	atomic.AddInt64(&s.IntOverflows, i)
+}
+
+func (s *StreamTaskStats) AddDuplicateRows(i int64) {
+	atomic.AddInt64(&s.DuplicateRows, i)
+}
+
+func (s *StreamTaskStats) AddBreakerRows(i int64) {
+	atomic.AddInt64(&s.BreakerRows, i)
+}
+
+// SetBreakerOpen records whether the breaker of the task is open.
+func (s *StreamTaskStats) SetBreakerOpen(open bool) {
+	var v int64
+	if open {
+		v = 1
+	}
+	atomic.StoreInt64(&s.BreakerOpen, v)
+}
+
+// AddCalculation records a calculation of a batch taking d nanoseconds.
+func (s *StreamTaskStats) AddCalculation(d int64) {
+	atomic.AddInt64(&s.Calculations, 1)
+	atomic.AddInt64(&s.CalculateDuration, d)
+}
+
+func (s *StreamTaskStats) values() map[string]interface{} {
+	return map[string]interface{}{
+		StatStreamTaskRowsIn:            atomic.LoadInt64(&s.RowsIn),
+		StatStreamTaskRowsAggregated:    atomic.LoadInt64(&s.RowsAggregated),
+		StatStreamTaskWindowsEmitted:    atomic.LoadInt64(&s.WindowsEmitted),
+		StatStreamTaskBytesWritten:      atomic.LoadInt64(&s.BytesWritten),
+		StatStreamTaskPartialErrors:     atomic.LoadInt64(&s.PartialErrors),
+		StatStreamTaskSchemaViolations:  atomic.LoadInt64(&s.SchemaViolations),
+		StatStreamTaskForcedCloses:      atomic.LoadInt64(&s.ForcedCloses),
+		StatStreamTaskClosedWindowRows:  atomic.LoadInt64(&s.ClosedWindowRows),
+		StatStreamTaskLateRows:          atomic.LoadInt64(&s.LateRows),
+		StatStreamTaskGroupLimitRows:    atomic.LoadInt64(&s.GroupLimitRows),
+		StatStreamTaskGroupLimitFlushes: atomic.LoadInt64(&s.GroupLimitFlushes),
+		StatStreamTaskPointFlushes:      atomic.LoadInt64(&s.PointFlushes),
+		StatStreamTaskExpiredWindows:    atomic.LoadInt64(&s.ExpiredWindows),
+		StatStreamTaskLazyTaskBuilds:    atomic.LoadInt64(&s.LazyTaskBuilds),
+		StatStreamTaskSchemaRebuilds:    atomic.LoadInt64(&s.SchemaRebuilds),
+		StatStreamTaskFailedWindows:     atomic.LoadInt64(&s.FailedWindows),
+		StatStreamTaskSpilledRows:       atomic.LoadInt64(&s.SpilledRows),
+		StatStreamTaskPausedRows:        atomic.LoadInt64(&s.PausedRows),
+		StatStreamTaskPaused:            atomic.LoadInt64(&s.Paused),
+		StatStreamTaskThrottledRows:     atomic.LoadInt64(&s.ThrottledRows),
+		StatStreamTaskThrottleDrops:     atomic.LoadInt64(&s.ThrottleDrops),
+		StatStreamTaskThrottled:         atomic.LoadInt64(&s.Throttled),
+		StatStreamTaskNonFiniteValues:   atomic.LoadInt64(&s.NonFiniteValues),
+		StatStreamTaskMalformedRows:     atomic.LoadInt64(&s.MalformedRows),
+		StatStreamTaskGroupSpills:       atomic.LoadInt64(&s.GroupSpills),
+		StatStreamTaskGroupSpillBytes:   atomic.LoadInt64(&s.GroupSpillBytes),
+		StatStreamTaskIntOverflows:      atomic.LoadInt64(&s.IntOverflows),
+		StatStreamTaskDuplicateRows:     atomic.LoadInt64(&s.DuplicateRows),
+		StatStreamTaskBreakerRows:       atomic.LoadInt64(&s.BreakerRows),
+		StatStreamTaskBreakerOpen:       atomic.LoadInt64(&s.BreakerOpen),
+		StatStreamTaskCalculations:      atomic.LoadInt64(&s.Calculations),
+		StatStreamTaskCalculateDuration: atomic.LoadInt64(&s.CalculateDuration),
+	}
+}
+
+// StreamTaskStatistics keeps the statistics of the stream tasks keyed by the name of the task
+type StreamTaskStatistics struct {
+	mu    sync.RWMutex
+	stats map[string]*StreamTaskStats
+	tags  map[string]string
+}
+
+const (
+	StatStreamTaskName              = "stream"
+	StatStreamTaskRowsIn            = "rowsIn"
+	StatStreamTaskRowsAggregated    = "rowsAggregated"
+	StatStreamTaskWindowsEmitted    = "windowsEmitted"
+	StatStreamTaskBytesWritten      = "bytesWritten"
+	StatStreamTaskPartialErrors     = "partialErrors"
+	StatStreamTaskSchemaViolations  = "schemaViolations"
+	StatStreamTaskForcedCloses      = "forcedCloses"
+	StatStreamTaskClosedWindowRows  = "closedWindowRows"
+	StatStreamTaskLateRows          = "lateRows"
+	StatStreamTaskGroupLimitRows    = "groupLimitRows"
+	StatStreamTaskGroupLimitFlushes = "groupLimitFlushes"
+	StatStreamTaskPointFlushes      = "pointFlushes"
+	StatStreamTaskExpiredWindows    = "expiredWindows"
+	StatStreamTaskLazyTaskBuilds    = "lazyTaskBuilds"
+	StatStreamTaskSchemaRebuilds    = "schemaRebuilds"
+	StatStreamTaskFailedWindows     = "failedWindows"
+	StatStreamTaskSpilledRows       = "spilledRows"
+	StatStreamTaskPausedRows        = "pausedRows"
+	StatStreamTaskPaused            = "paused"
+	StatStreamTaskThrottledRows     = "throttledRows"
+	StatStreamTaskThrottleDrops     = "throttleDrops"
+	StatStreamTaskThrottled         = "throttled"
+	StatStreamTaskNonFiniteValues   = "nonFiniteValues"
+	StatStreamTaskMalformedRows     = "malformedRows"
+	StatStreamTaskGroupSpills       = "groupSpills"
+	StatStreamTaskGroupSpillBytes   = "groupSpillBytes"
+	StatStreamTaskIntOverflows      = "intOverflows"
+	StatStreamTaskDuplicateRows     = "duplicateRows"
+	StatStreamTaskBreakerRows       = "breakerRows"
+	StatStreamTaskBreakerOpen       = "breakerOpen"
+	StatStreamTaskCalculations      = "calculations"
+	StatStreamTaskCalculateDuration = "calculateDuration"
+)
+
+var StreamTaskStat = NewStreamTaskStatistics()
+var StreamTaskStatisticsName = "stream_task"
+
+func NewStreamTaskStatistics() *StreamTaskStatistics {
+	return &StreamTaskStatistics{
+		stats: make(map[string]*StreamTaskStats),
+	}
+}
+
+func InitStreamTaskStatistics(tags map[string]string) {
+	StreamTaskStat.mu.Lock()
+	defer StreamTaskStat.mu.Unlock()
+	StreamTaskStat.tags = make(map[string]string, len(tags))
+	for k, v := range tags {
+		StreamTaskStat.tags[k] = v
+	}
+}
+
+// Task returns the statistics of the task, which are created if absent.
+func (s *StreamTaskStatistics) Task(name string) *StreamTaskStats {
+	s.mu.RLock()
+	stat, ok := s.stats[name]
+	s.mu.RUnlock()
+	if ok {
+		return stat
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stat, ok = s.stats[name]; !ok {
+		stat = &StreamTaskStats{}
+		s.stats[name] = stat
+	}
+	return stat
+}
+
+func (s *StreamTaskStatistics) Collect(buffer []byte) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for name, stat := range s.stats {
+		tagMap := make(map[string]string, len(s.tags)+1)
+		AllocTagMap(tagMap, s.tags)
+		tagMap[StatStreamTaskName] = name
+		buffer = AddPointToBuffer(StreamTaskStatisticsName, tagMap, stat.values(), buffer)
+	}
+	return buffer, nil
+}
+
+func (s *StreamTaskStatistics) CollectOps() []opsStat.OpsStatistic {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ops := make([]opsStat.OpsStatistic, 0, len(s.stats))
+	for name, stat := range s.stats {
+		tagMap := make(map[string]string, len(s.tags)+1)
+		AllocTagMap(tagMap, s.tags)
+		tagMap[StatStreamTaskName] = name
+		ops = append(ops, opsStat.OpsStatistic{
+			Name:   StreamTaskStatisticsName,
+			Tags:   tagMap,
+			Values: stat.values(),
+		})
+	}
+	return ops
+}
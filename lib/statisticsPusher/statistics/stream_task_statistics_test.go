@@ -0,0 +1,117 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTaskStatistics(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "127.0.0.1:8090",
+		"app":      "ts-sql",
+	}
+	statistics.InitStreamTaskStatistics(tags)
+	stat := statistics.StreamTaskStat.Task("task0")
+	require.True(t, stat == statistics.StreamTaskStat.Task("task0"))
+	stat.AddRowsIn(10)
+	stat.AddRowsAggregated(8)
+	stat.AddWindowsEmitted(3)
+	stat.AddBytesWritten(100)
+	stat.AddPartialErrors(1)
+	stat.AddSchemaViolations(1)
+	stat.AddForcedCloses(1)
+	stat.AddClosedWindowRows(2)
+	stat.AddLateRows(2)
+	stat.AddGroupLimitRows(3)
+	stat.AddGroupLimitFlushes(1)
+	stat.AddPointFlushes(2)
+	stat.AddExpiredWindows(4)
+	stat.AddLazyTaskBuilds(1)
+	stat.AddSchemaRebuilds(2)
+	stat.AddFailedWindows(3)
+	stat.AddSpilledRows(2)
+	stat.AddPausedRows(5)
+	stat.SetPaused(true)
+	stat.AddThrottledRows(6)
+	stat.AddThrottleDrops(1)
+	stat.SetThrottled(true)
+	stat.AddNonFiniteValues(2)
+	stat.AddMalformedRows(3)
+	stat.AddGroupSpills(2, 64)
+	stat.AddIntOverflows(4)
+	stat.AddDuplicateRows(5)
+	stat.AddBreakerRows(7)
+	stat.SetBreakerOpen(true)
+	stat.AddCalculation(1000)
+	stat.AddCalculation(500)
+
+	statistics.NewTimestamp().Init(time.Second)
+	buf, err := statistics.StreamTaskStat.Collect(nil)
+	require.NoError(t, err)
+
+	fields := map[string]interface{}{
+		"rowsIn":            int64(10),
+		"rowsAggregated":    int64(8),
+		"windowsEmitted":    int64(3),
+		"bytesWritten":      int64(100),
+		"partialErrors":     int64(1),
+		"schemaViolations":  int64(1),
+		"forcedCloses":      int64(1),
+		"closedWindowRows":  int64(2),
+		"lateRows":          int64(2),
+		"groupLimitRows":    int64(3),
+		"groupLimitFlushes": int64(1),
+		"pointFlushes":      int64(2),
+		"expiredWindows":    int64(4),
+		"lazyTaskBuilds":    int64(1),
+		"schemaRebuilds":    int64(2),
+		"failedWindows":     int64(3),
+		"spilledRows":       int64(2),
+		"pausedRows":        int64(5),
+		"paused":            int64(1),
+		"throttledRows":     int64(6),
+		"throttleDrops":     int64(1),
+		"throttled":         int64(1),
+		"nonFiniteValues":   int64(2),
+		"malformedRows":     int64(3),
+		"groupSpills":       int64(2),
+		"groupSpillBytes":   int64(64),
+		"intOverflows":      int64(4),
+		"duplicateRows":     int64(5),
+		"breakerRows":       int64(7),
+		"breakerOpen":       int64(1),
+		"calculations":      int64(2),
+		"calculateDuration": int64(1500),
+	}
+	expTags := map[string]string{
+		"hostname": "127.0.0.1:8090",
+		"app":      "ts-sql",
+		"stream":   "task0",
+	}
+	require.NoError(t, compareBuffer("stream_task", expTags, fields, buf))
+
+	ops := statistics.StreamTaskStat.CollectOps()
+	require.Equal(t, 1, len(ops))
+	require.Equal(t, "stream_task", ops[0].Name)
+	require.Equal(t, expTags, ops[0].Tags)
+	require.Equal(t, int64(3), ops[0].Values["windowsEmitted"])
+}
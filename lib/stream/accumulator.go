@@ -0,0 +1,729 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	// tDigestCompression bounds the centroids of a digest, about tDigestCompression centroids are kept at most
+	tDigestCompression = 100
+	tDigestBufferSize  = 4 * tDigestCompression
+
+	// the precision of a HyperLogLog is the bits of the hash indexing the registers, which takes 2^precision bytes
+	minHLLPrecision     = 4
+	maxHLLPrecision     = 16
+	defaultHLLPrecision = 12
+)
+
+// Accumulator aggregates the values of a window with a state richer than a single float64.
+type Accumulator interface {
+	Add(value float64, timestamp int64)
+	// Value returns the result of the values added so far, NaN if the values are not enough for a result
+	Value() float64
+}
+
+// StringAccumulator is an accumulator which selects a string value of the window as well.
+type StringAccumulator interface {
+	Accumulator
+	AddString(value string, timestamp int64)
+	// StringValue returns the selected string, Value returns NaN if no string is selected
+	StringValue() string
+}
+
+// IntAccumulator is an accumulator which aggregates the integers as integers, the floats round them beyond 2^53.
+type IntAccumulator interface {
+	Accumulator
+	AddInt(value int64, timestamp int64)
+	// IntValue returns the exact result, Value returns NaN if there is no result
+	IntValue() int64
+}
+
+// IsAccumulatorCall returns whether the call is aggregated by an accumulator.
+func IsAccumulatorCall(call string) bool {
+	switch call {
+	case "percentile", "median", "stddev", "variance", "rate", "derivative", "count_distinct", "min_ts", "max_ts", "first", "last":
+		return true
+	}
+	return IsBooleanCall(call) || isAggregatorCall(call)
+}
+
+// IsBooleanCall returns whether the call only aggregates the boolean fields.
+func IsBooleanCall(call string) bool {
+	switch call {
+	case "any", "all", "count_true":
+		return true
+	}
+	return false
+}
+
+// BuildAccumulator sets the accumulator of the call and validates the arguments of it.
+func BuildAccumulator(fieldCall *FieldCall) error {
+	var q float64
+	switch fieldCall.Call {
+	case "percentile":
+		if len(fieldCall.Args) != 1 {
+			return fmt.Errorf("the percentile call %s needs exactly one quantile argument", fieldCall.Alias)
+		}
+		var err error
+		q, err = strconv.ParseFloat(fieldCall.Args[0], 64)
+		if err != nil || q < 0 || q > 100 {
+			return fmt.Errorf("the quantile %s of the percentile call %s is not in [0, 100]", fieldCall.Args[0], fieldCall.Alias)
+		}
+	case "median":
+		if len(fieldCall.Args) != 0 {
+			return fmt.Errorf("the median call %s does not take arguments", fieldCall.Alias)
+		}
+		q = 50
+	case "stddev", "variance":
+		if len(fieldCall.Args) != 0 {
+			return fmt.Errorf("the %s call %s does not take arguments", fieldCall.Call, fieldCall.Alias)
+		}
+		stddev := fieldCall.Call == "stddev"
+		fieldCall.OutFieldType = influx.Field_Type_Float
+		fieldCall.NewAccumulator = func() Accumulator {
+			return &Welford{stddev: stddev}
+		}
+		return nil
+	case "rate", "derivative":
+		unit := time.Second
+		if len(fieldCall.Args) > 1 {
+			return fmt.Errorf("the %s call %s takes at most one unit argument", fieldCall.Call, fieldCall.Alias)
+		}
+		if len(fieldCall.Args) == 1 {
+			d, err := influxql.ParseDuration(fieldCall.Args[0])
+			if err != nil || d <= 0 {
+				return fmt.Errorf("the unit %s of the %s call %s is not a positive duration", fieldCall.Args[0], fieldCall.Call, fieldCall.Alias)
+			}
+			unit = d
+		}
+		counter := fieldCall.Call == "rate"
+		fieldCall.OutFieldType = influx.Field_Type_Float
+		fieldCall.NewAccumulator = func() Accumulator {
+			return &Delta{counter: counter, unit: float64(unit)}
+		}
+		return nil
+	case "min_ts", "max_ts":
+		if len(fieldCall.Args) != 0 {
+			return fmt.Errorf("the %s call %s does not take arguments", fieldCall.Call, fieldCall.Alias)
+		}
+		max := fieldCall.Call == "max_ts"
+		// the timestamp of the extremum is an integer for any value
+		fieldCall.OutFieldType = influx.Field_Type_Int
+		fieldCall.NewAccumulator = func() Accumulator {
+			return &ExtremumTime{max: max}
+		}
+		return nil
+	case "first", "last":
+		if len(fieldCall.Args) != 0 {
+			return fmt.Errorf("the %s call %s does not take arguments", fieldCall.Call, fieldCall.Alias)
+		}
+		last := fieldCall.Call == "last"
+		// the selected value keeps the type of the field, strings included
+		fieldCall.OutFieldType = fieldCall.InFieldType
+		fieldCall.NewAccumulator = func() Accumulator {
+			return &Selector{last: last}
+		}
+		return nil
+	case "count_distinct":
+		precision := defaultHLLPrecision
+		if len(fieldCall.Args) > 1 {
+			return fmt.Errorf("the count_distinct call %s takes at most one precision argument", fieldCall.Alias)
+		}
+		if len(fieldCall.Args) == 1 {
+			p, err := strconv.Atoi(fieldCall.Args[0])
+			if err != nil || p < minHLLPrecision || p > maxHLLPrecision {
+				return fmt.Errorf("the precision %s of the count_distinct call %s is not in [%d, %d]",
+					fieldCall.Args[0], fieldCall.Alias, minHLLPrecision, maxHLLPrecision)
+			}
+			precision = p
+		}
+		fieldCall.OutFieldType = influx.Field_Type_Int
+		fieldCall.NewAccumulator = func() Accumulator {
+			return NewHyperLogLog(precision)
+		}
+		return nil
+	case "any", "all", "count_true":
+		if len(fieldCall.Args) != 0 {
+			return fmt.Errorf("the %s call %s does not take arguments", fieldCall.Call, fieldCall.Alias)
+		}
+		call := fieldCall.Call
+		if call == "count_true" {
+			fieldCall.OutFieldType = influx.Field_Type_Int
+		} else {
+			fieldCall.OutFieldType = influx.Field_Type_Boolean
+		}
+		fieldCall.NewAccumulator = func() Accumulator {
+			return &Bool{call: call}
+		}
+		return nil
+	default:
+		// the other calls are aggregated by the registered aggregators, if any
+		_, err := buildAggregator(fieldCall)
+		return err
+	}
+	fieldCall.NewAccumulator = func() Accumulator {
+		return NewTDigest(q / 100)
+	}
+	return nil
+}
+
+// funcAccumulator aggregates the values by the single thread func of the call.
+type funcAccumulator struct {
+	call  *FieldCall
+	value float64
+}
+
+// NewFuncAccumulator returns the accumulator of the call aggregated by a single float64,
+// the count is kept by an int64 instead.
+func NewFuncAccumulator(call *FieldCall) Accumulator {
+	if call.Call == "count" {
+		return &Count{}
+	}
+	a := &funcAccumulator{call: call}
+	switch call.Call {
+	case "min":
+		a.value = math.MaxFloat64
+	case "max":
+		a.value = -math.MaxFloat64
+	}
+	return a
+}
+
+func (a *funcAccumulator) Add(value float64, _ int64) {
+	a.value = a.call.SingleThreadFunc(a.value, value)
+}
+
+func (a *funcAccumulator) Value() float64 {
+	return a.value
+}
+
+// Count counts the values of the window by an int64, a float64 count stops growing at 2^53 as adding one is rounded off.
+type Count struct {
+	n int64
+}
+
+func (c *Count) Add(float64, int64) {
+	c.n++
+}
+
+func (c *Count) AddInt(int64, int64) {
+	c.n++
+}
+
+func (c *Count) Value() float64 {
+	return float64(c.n)
+}
+
+// IntValue returns the exact count of the values.
+func (c *Count) IntValue() int64 {
+	return c.n
+}
+
+// Mean computes the mean of the values, the sum is kept as a float64 so that the integers never overflow.
+type Mean struct {
+	sum   float64
+	count float64
+}
+
+func (m *Mean) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	m.sum += value
+	m.count++
+}
+
+func (m *Mean) Value() float64 {
+	if m.count == 0 {
+		return 0
+	}
+	return m.sum / m.count
+}
+
+// Init implements Aggregator, the mean of the integers is a float as well.
+func (m *Mean) Init(args []string) error {
+	if len(args) != 0 {
+		return errNoArguments
+	}
+	*m = Mean{}
+	return nil
+}
+
+func (m *Mean) Merge(other Aggregator) {
+	if o, ok := other.(*Mean); ok {
+		m.sum += o.sum
+		m.count += o.count
+	}
+}
+
+func (m *Mean) Result() float64 {
+	return m.Value()
+}
+
+// SumSquares computes the sum of the squares of the values, which is merged with the sum and the count into
+// the variance of several windows. The values are squared as float64 so that the large integers never overflow,
+// and the sum is compensated so that the small squares are not lost by the large ones.
+type SumSquares struct {
+	sum          float64
+	compensation float64
+}
+
+func (s *SumSquares) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	s.add(value * value)
+}
+
+// add adds the term to the sum compensated.
+func (s *SumSquares) add(term float64) {
+	t := s.sum + term
+	if math.Abs(s.sum) >= math.Abs(term) {
+		s.compensation += (s.sum - t) + term
+	} else {
+		s.compensation += (term - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s *SumSquares) Value() float64 {
+	return s.sum + s.compensation
+}
+
+// Init implements Aggregator, the squares of the integers are floats as well.
+func (s *SumSquares) Init(args []string) error {
+	if len(args) != 0 {
+		return errNoArguments
+	}
+	*s = SumSquares{}
+	return nil
+}
+
+func (s *SumSquares) Merge(other Aggregator) {
+	if o, ok := other.(*SumSquares); ok {
+		s.add(o.sum)
+		s.compensation += o.compensation
+	}
+}
+
+func (s *SumSquares) Result() float64 {
+	return s.Value()
+}
+
+// Delta computes the change of the values per unit between the first and the last point of the window.
+// For the counters a decrease is a reset, the counter restarts from 0 and increases to the last value then.
+type Delta struct {
+	counter bool
+	unit    float64
+	count   int
+	firstTs int64
+	lastTs  int64
+	first   float64
+	last    float64
+}
+
+func (d *Delta) Add(value float64, timestamp int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	if d.count == 0 || timestamp < d.firstTs {
+		d.first, d.firstTs = value, timestamp
+	}
+	if d.count == 0 || timestamp >= d.lastTs {
+		d.last, d.lastTs = value, timestamp
+	}
+	d.count++
+}
+
+// Value returns NaN if the window has less than two points of different times.
+func (d *Delta) Value() float64 {
+	if d.count < 2 || d.lastTs == d.firstTs {
+		return math.NaN()
+	}
+	delta := d.last - d.first
+	if d.counter && delta < 0 {
+		delta = d.last
+	}
+	return delta * d.unit / float64(d.lastTs-d.firstTs)
+}
+
+// Bool aggregates the boolean values, which are 1 for true and 0 for false.
+type Bool struct {
+	call  string
+	count float64
+	trues float64
+}
+
+func (b *Bool) Add(value float64, _ int64) {
+	b.count++
+	if value != 0 {
+		b.trues++
+	}
+}
+
+// Value returns the number of the true values for count_true, 1 for true and 0 for false for any and all.
+func (b *Bool) Value() float64 {
+	switch b.call {
+	case "any":
+		if b.trues > 0 {
+			return 1
+		}
+	case "all":
+		if b.count > 0 && b.trues == b.count {
+			return 1
+		}
+	default:
+		return b.trues
+	}
+	return 0
+}
+
+// ExtremumTime keeps the timestamp of the row holding the min or the max value of the window,
+// the earliest timestamp wins among the rows of the same value whatever order they are added in.
+type ExtremumTime struct {
+	max   bool
+	count int
+	value float64
+	ts    int64
+}
+
+func (e *ExtremumTime) Add(value float64, timestamp int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	if e.count == 0 || (e.max && value > e.value) || (!e.max && value < e.value) || (value == e.value && timestamp < e.ts) {
+		e.value, e.ts = value, timestamp
+	}
+	e.count++
+}
+
+// Value returns NaN for no values.
+func (e *ExtremumTime) Value() float64 {
+	if e.count == 0 {
+		return math.NaN()
+	}
+	return float64(e.ts)
+}
+
+// Selector selects the value of the first or the last row of the window, the row added first wins among
+// the rows of the same time.
+type Selector struct {
+	last  bool
+	count int
+	ts    int64
+	value float64
+	str   string
+}
+
+func (s *Selector) selects(timestamp int64) bool {
+	return s.count == 0 || (s.last && timestamp > s.ts) || (!s.last && timestamp < s.ts)
+}
+
+func (s *Selector) Add(value float64, timestamp int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	if s.selects(timestamp) {
+		s.value, s.ts = value, timestamp
+	}
+	s.count++
+}
+
+func (s *Selector) AddString(value string, timestamp int64) {
+	if s.selects(timestamp) {
+		s.str, s.ts = value, timestamp
+	}
+	s.count++
+}
+
+// Value returns NaN for no rows, the value of the strings is 0.
+func (s *Selector) Value() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+	return s.value
+}
+
+func (s *Selector) StringValue() string {
+	return s.str
+}
+
+// Spread computes the difference between the max and the min of the values.
+type Spread struct {
+	min float64
+	max float64
+}
+
+// NewSpread returns the spread of no values, the min and the max start from the bounds as the min and max calls do.
+func NewSpread() *Spread {
+	return &Spread{min: math.MaxFloat64, max: -math.MaxFloat64}
+}
+
+func (s *Spread) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	s.min = math.Min(s.min, value)
+	s.max = math.Max(s.max, value)
+}
+
+// Value returns NaN for no values, the spread of a single value is 0.
+func (s *Spread) Value() float64 {
+	if s.min > s.max {
+		return math.NaN()
+	}
+	return s.max - s.min
+}
+
+// Init implements Aggregator, the spread follows the type of the destination.
+func (s *Spread) Init(args []string) error {
+	if len(args) != 0 {
+		return errNoArguments
+	}
+	*s = *NewSpread()
+	return nil
+}
+
+func (s *Spread) Merge(other Aggregator) {
+	if o, ok := other.(*Spread); ok {
+		s.min = math.Min(s.min, o.min)
+		s.max = math.Max(s.max, o.max)
+	}
+}
+
+func (s *Spread) Result() float64 {
+	return s.Value()
+}
+
+// HyperLogLog estimates the number of the distinct values with the registers of a fixed size,
+// the standard error of the estimate is about 1.04/sqrt(2^precision).
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func NewHyperLogLog(precision int) *HyperLogLog {
+	return &HyperLogLog{precision: uint8(precision), registers: make([]uint8, 1<<precision)}
+}
+
+func (h *HyperLogLog) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	if value == 0 {
+		// -0 and 0 are the same value
+		value = 0
+	}
+	x := hashFloat64(value)
+	idx := x >> (64 - h.precision)
+	// the guard bit bounds the rank when the rest bits are all zero
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// Merge adds the values of the other estimator of the same precision.
+func (h *HyperLogLog) Merge(other *HyperLogLog) {
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// Value returns the estimate rounded to an integer, the small cardinalities are counted by the empty registers.
+func (h *HyperLogLog) Value() float64 {
+	m := float64(len(h.registers))
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return math.Round(estimate)
+}
+
+// hashFloat64 mixes the bits of the value by the finalizer of splitmix64.
+func hashFloat64(v float64) uint64 {
+	x := math.Float64bits(v)
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Welford computes the sample variance or standard deviation of the values in a single pass.
+type Welford struct {
+	stddev bool
+	count  float64
+	mean   float64
+	m2     float64
+}
+
+func (w *Welford) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	w.count++
+	delta := value - w.mean
+	w.mean += delta / w.count
+	w.m2 += delta * (value - w.mean)
+}
+
+// Value returns 0 for less than two values.
+func (w *Welford) Value() float64 {
+	if w.count < 2 {
+		return 0
+	}
+	variance := w.m2 / (w.count - 1)
+	if w.stddev {
+		return math.Sqrt(variance)
+	}
+	return variance
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest is a merging t-digest which estimates the quantile of the values with bounded memory.
+// The result only depends on the values and the order they are added in.
+type TDigest struct {
+	quantile  float64
+	centroids []centroid
+	buffer    []centroid
+	count     float64
+	min       float64
+	max       float64
+}
+
+func NewTDigest(quantile float64) *TDigest {
+	return &TDigest{quantile: quantile, min: math.MaxFloat64, max: -math.MaxFloat64}
+}
+
+func (t *TDigest) Add(value float64, _ int64) {
+	if math.IsNaN(value) {
+		return
+	}
+	t.buffer = append(t.buffer, centroid{mean: value, weight: 1})
+	t.count++
+	if value < t.min {
+		t.min = value
+	}
+	if value > t.max {
+		t.max = value
+	}
+	if len(t.buffer) >= tDigestBufferSize {
+		t.compress()
+	}
+}
+
+// scale is the k1 scale function, two adjacent centroids are merged if they span at most 1 in it.
+func scale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.centroids, t.buffer...)
+	sort.SliceStable(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	merged := make([]centroid, 0, len(t.centroids)+1)
+	cur := all[0]
+	var before float64
+	kLeft := scale(0)
+	for _, c := range all[1:] {
+		if scale((before+cur.weight+c.weight)/t.count)-kLeft <= 1 {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		merged = append(merged, cur)
+		before += cur.weight
+		kLeft = scale(before / t.count)
+		cur = c
+	}
+	t.centroids = append(merged, cur)
+	t.buffer = t.buffer[:0]
+}
+
+// Value interpolates the quantile between the means of the centroids, which are placed at the middle of their weights.
+func (t *TDigest) Value() float64 {
+	return t.Quantile(t.quantile)
+}
+
+// Quantile estimates the quantile q in [0, 1] of the values, the digest is shared by the quantiles of the same values.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	if len(t.centroids) == 0 {
+		return 0
+	}
+	target := q * t.count
+	first := t.centroids[0]
+	if target <= first.weight/2 {
+		return interpolate(t.min, first.mean, 0, first.weight/2, target)
+	}
+	cum := first.weight / 2
+	for i := 1; i < len(t.centroids); i++ {
+		prev, c := t.centroids[i-1], t.centroids[i]
+		mid := cum + (prev.weight+c.weight)/2
+		if target <= mid {
+			return interpolate(prev.mean, c.mean, cum, mid, target)
+		}
+		cum = mid
+	}
+	return interpolate(t.centroids[len(t.centroids)-1].mean, t.max, cum, t.count, target)
+}
+
+func interpolate(left, right, from, to, at float64) float64 {
+	if to <= from {
+		return right
+	}
+	return left + (right-left)*(at-from)/(to-from)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTDigest(t *testing.T) {
+	d := NewTDigest(0.5)
+	for _, v := range []float64{5, 1, 4, 2, 3} {
+		d.Add(v, 0)
+	}
+	require.Equal(t, float64(3), d.Value())
+	d.Add(6, 0)
+	require.Equal(t, 3.5, d.Value())
+
+	const n = 100000
+	values := rand.New(rand.NewSource(1)).Perm(n)
+	for _, q := range []float64{0, 0.01, 0.5, 0.95, 0.99, 1} {
+		d1, d2 := NewTDigest(q), NewTDigest(q)
+		for _, v := range values {
+			d1.Add(float64(v), 0)
+			d2.Add(float64(v), 0)
+		}
+		require.InDelta(t, q*(n-1), d1.Value(), n*0.005, "quantile %v", q)
+		require.LessOrEqual(t, len(d1.centroids), 2*tDigestCompression)
+		require.Equal(t, d1.Value(), d2.Value())
+	}
+
+	// the quantiles of a digest are the values of the digests of them
+	shared := NewTDigest(0.5)
+	for _, v := range values {
+		shared.Add(float64(v), 0)
+	}
+	for _, q := range []float64{0.9, 0.5, 0.99} {
+		d := NewTDigest(q)
+		for _, v := range values {
+			d.Add(float64(v), 0)
+		}
+		require.Equal(t, d.Value(), shared.Quantile(q))
+	}
+}
+
+func TestWelford(t *testing.T) {
+	stddev, variance := &Welford{stddev: true}, &Welford{}
+	stddev.Add(3, 0)
+	require.Equal(t, float64(0), stddev.Value())
+	for _, v := range []float64{5, 7, 9} {
+		stddev.Add(v, 0)
+	}
+	for _, v := range []float64{3, 5, 7, 9} {
+		variance.Add(v, 0)
+	}
+	require.InDelta(t, 20.0/3, variance.Value(), 1e-9)
+	require.InDelta(t, math.Sqrt(20.0/3), stddev.Value(), 1e-9)
+}
+
+func TestSpread(t *testing.T) {
+	s := NewSpread()
+	require.True(t, math.IsNaN(s.Value()))
+	s.Add(-3, 0)
+	require.Equal(t, float64(0), s.Value())
+	s.Add(math.NaN(), 0)
+	s.Add(5, 0)
+	s.Add(1, 0)
+	require.Equal(t, float64(8), s.Value())
+}
+
+func TestExtremumTime(t *testing.T) {
+	min, max := &ExtremumTime{}, &ExtremumTime{max: true}
+	require.True(t, math.IsNaN(min.Value()))
+	for _, p := range []struct {
+		v  float64
+		ts int64
+	}{{3, 20}, {1, 30}, {5, 40}, {math.NaN(), 50}, {1, 10}, {5, 60}} {
+		min.Add(p.v, p.ts)
+		max.Add(p.v, p.ts)
+	}
+	// the earliest timestamp of the equal values wins
+	require.Equal(t, float64(10), min.Value())
+	require.Equal(t, float64(40), max.Value())
+}
+
+func TestSelector(t *testing.T) {
+	first, last := &Selector{}, &Selector{last: true}
+	require.True(t, math.IsNaN(first.Value()))
+	for _, s := range []*Selector{first, last} {
+		s.Add(3, 20)
+		s.Add(1, 10)
+		s.Add(math.NaN(), 5)
+		s.Add(5, 40)
+		// the row added first wins among the rows of the same time
+		s.Add(6, 40)
+		s.Add(2, 10)
+	}
+	require.Equal(t, float64(1), first.Value())
+	require.Equal(t, float64(5), last.Value())
+
+	str := &Selector{last: true}
+	str.AddString("b", 20)
+	str.AddString("a", 10)
+	require.Equal(t, "b", str.StringValue())
+	require.Equal(t, float64(0), str.Value())
+}
+
+func TestHyperLogLog(t *testing.T) {
+	h := NewHyperLogLog(defaultHLLPrecision)
+	require.Equal(t, float64(0), h.Value())
+	for i := 0; i < 3; i++ {
+		h.Add(1, 0)
+		h.Add(0, 0)
+		h.Add(math.Copysign(0, -1), 0)
+		h.Add(math.NaN(), 0)
+	}
+	require.Equal(t, float64(2), h.Value())
+
+	// the estimates of the large cardinalities are within 3 standard errors
+	const n = 100000
+	a, b := NewHyperLogLog(defaultHLLPrecision), NewHyperLogLog(defaultHLLPrecision)
+	for i := 0; i < n; i++ {
+		a.Add(float64(i), 0)
+		b.Add(float64(i+n/2), 0)
+	}
+	require.Len(t, a.registers, 1<<defaultHLLPrecision)
+	require.InEpsilon(t, float64(n), a.Value(), 3*1.04/64)
+	a.Merge(b)
+	require.InEpsilon(t, float64(n*3/2), a.Value(), 3*1.04/64)
+}
+
+func TestMean(t *testing.T) {
+	m := &Mean{}
+	m.Add(math.MaxInt64, 0)
+	m.Add(math.MaxInt64, 0)
+	require.Equal(t, float64(math.MaxInt64), m.Value())
+	m.Add(math.NaN(), 0)
+	require.Equal(t, float64(math.MaxInt64), m.Value())
+}
+
+func TestSumSquares(t *testing.T) {
+	s := &SumSquares{}
+	// the squares of the large integers are out of the range of int64
+	s.Add(math.MaxInt64, 0)
+	s.Add(-3, 0)
+	s.Add(math.NaN(), 0)
+	require.Equal(t, float64(math.MaxInt64)*float64(math.MaxInt64)+9, s.Value())
+
+	// the small squares are kept beside the large one
+	s = &SumSquares{}
+	s.Add(1e8, 0)
+	for i := 0; i < 1000; i++ {
+		s.Add(1, 0)
+	}
+	require.Equal(t, 1e16+1000, s.Value())
+}
+
+func TestDelta(t *testing.T) {
+	rate, derivative := &Delta{counter: true, unit: 1e9}, &Delta{unit: 1e9}
+	rate.Add(10, 0)
+	require.True(t, math.IsNaN(rate.Value()))
+	rate.Add(20, 0)
+	require.True(t, math.IsNaN(rate.Value()))
+	for _, d := range []*Delta{rate, derivative} {
+		// the points may arrive out of order
+		d.Add(40, 2e9)
+		d.Add(30, 1e9)
+		d.Add(10, 0)
+	}
+	require.Equal(t, float64(15), rate.Value())
+	require.Equal(t, float64(15), derivative.Value())
+
+	// the counter is reset to 0 and increases to 4
+	rate.Add(4, 4e9)
+	require.Equal(t, float64(1), rate.Value())
+	derivative.Add(4, 4e9)
+	require.Equal(t, -1.5, derivative.Value())
+}
+
+func TestBool(t *testing.T) {
+	for call, expect := range map[string]float64{"any": 1, "all": 0, "count_true": 2} {
+		b := &Bool{call: call}
+		for _, v := range []float64{1, 0, 1} {
+			b.Add(v, 0)
+		}
+		require.Equal(t, expect, b.Value(), call)
+	}
+	all := &Bool{call: "all"}
+	all.Add(1, 0)
+	require.Equal(t, float64(1), all.Value())
+}
+
+func TestFuncAccumulator(t *testing.T) {
+	for call, expect := range map[string]float64{"sum": 6, "min": 1, "max": 3, "count": 3} {
+		fieldCall, err := NewFieldCall(influx.Field_Type_Float, influx.Field_Type_Float, "v", "v", call, false)
+		require.NoError(t, err)
+		acc := NewFuncAccumulator(fieldCall)
+		for _, v := range []float64{2, 1, 3} {
+			acc.Add(v, 0)
+		}
+		require.Equal(t, expect, acc.Value(), call)
+	}
+}
+
+func TestCount(t *testing.T) {
+	fieldCall, err := NewFieldCall(influx.Field_Type_Float, influx.Field_Type_Int, "v", "v", "count", false)
+	require.NoError(t, err)
+	acc := NewFuncAccumulator(fieldCall)
+	require.Equal(t, float64(0), acc.Value())
+	// the values are counted whatever they are
+	for _, v := range []float64{0.5, 0, -1, math.MaxFloat64} {
+		acc.Add(v, 0)
+	}
+	require.Equal(t, float64(4), acc.Value())
+	require.Equal(t, int64(4), acc.(IntAccumulator).IntValue())
+
+	// a float64 count stops at 2^53, which the int64 count does not
+	const boundary = float64(int64(1) << 53)
+	require.Equal(t, boundary, fieldCall.SingleThreadFunc(boundary, 1))
+}
+
+func TestBuildAccumulator(t *testing.T) {
+	cases := []struct {
+		call string
+		args []string
+		err  string
+	}{
+		{call: "percentile", args: []string{"95"}},
+		{call: "percentile", args: []string{"99.9"}},
+		{call: "percentile", err: "the percentile call p needs exactly one quantile argument"},
+		{call: "percentile", args: []string{"101"}, err: "the quantile 101 of the percentile call p is not in [0, 100]"},
+		{call: "percentile", args: []string{"x"}, err: "the quantile x of the percentile call p is not in [0, 100]"},
+		{call: "median"},
+		{call: "median", args: []string{"1"}, err: "the median call p does not take arguments"},
+		{call: "stddev"},
+		{call: "mean"},
+		{call: "mean", args: []string{"1"}, err: "the mean call p does not take arguments"},
+		{call: "variance", args: []string{"1"}, err: "the variance call p does not take arguments"},
+		{call: "any"},
+		{call: "rate"},
+		{call: "derivative", args: []string{"1m"}},
+		{call: "rate", args: []string{"1m", "1s"}, err: "the rate call p takes at most one unit argument"},
+		{call: "derivative", args: []string{"x"}, err: "the unit x of the derivative call p is not a positive duration"},
+		{call: "derivative", args: []string{"0s"}, err: "the unit 0s of the derivative call p is not a positive duration"},
+		{call: "count_true", args: []string{"1"}, err: "the count_true call p does not take arguments"},
+		{call: "spread"},
+		{call: "count_distinct"},
+		{call: "count_distinct", args: []string{"16"}},
+		{call: "count_distinct", args: []string{"3"}, err: "the precision 3 of the count_distinct call p is not in [4, 16]"},
+		{call: "count_distinct", args: []string{"8", "1"}, err: "the count_distinct call p takes at most one precision argument"},
+		{call: "spread", args: []string{"1"}, err: "the spread call p does not take arguments"},
+		{call: "min_ts"},
+		{call: "sum_sq"},
+		{call: "sum_sq", args: []string{"1"}, err: "the sum_sq call p does not take arguments"},
+		{call: "first"},
+		{call: "last", args: []string{"1"}, err: "the last call p does not take arguments"},
+		{call: "max_ts", args: []string{"1"}, err: "the max_ts call p does not take arguments"},
+	}
+	for _, c := range cases {
+		call, err := NewFieldCallWithArgs(influx.Field_Type_Float, influx.Field_Type_Float, "v", "p", c.call, c.args, false)
+		if c.err != "" {
+			require.EqualError(t, err, c.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.NotNil(t, call.NewAccumulator)
+		require.Equal(t, float64(2), call.SingleThreadFunc(1, 2))
+	}
+
+	call, err := NewFieldCallWithArgs(influx.Field_Type_Int, influx.Field_Type_Int, "v", "m", "mean", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Float), call.OutFieldType)
+
+	call, err = NewFieldCallWithArgs(influx.Field_Type_Boolean, influx.Field_Type_Float, "v", "c", "count_true", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Int), call.OutFieldType)
+	call, err = NewFieldCallWithArgs(influx.Field_Type_Boolean, influx.Field_Type_Float, "v", "a", "all", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Boolean), call.OutFieldType)
+
+	call, err = NewFieldCallWithArgs(influx.Field_Type_Float, influx.Field_Type_Float, "v", "t", "max_ts", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Int), call.OutFieldType)
+
+	call, err = NewFieldCallWithArgs(influx.Field_Type_String, influx.Field_Type_Float, "v", "l", "last", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_String), call.OutFieldType)
+
+	call, err = NewFieldCallWithArgs(influx.Field_Type_Float, influx.Field_Type_Float, "v", "s", "sum", nil, true)
+	require.NoError(t, err)
+	require.Nil(t, call.NewAccumulator)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// Aggregator aggregates the values of a window for the calls of the name it is registered by. The calls of the
+// registered aggregators are aggregated at the sql layer as the builtin accumulators are.
+type Aggregator interface {
+	// Init prepares the aggregator of a window with the arguments of the call, the error rejecting the arguments
+	// follows the call in the message, such as "does not take arguments"
+	Init(args []string) error
+	Add(value float64, timestamp int64)
+	// Merge adds the values aggregated by the other aggregator of the same call
+	Merge(other Aggregator)
+	// Result returns the result of the values added so far, NaN if the values are not enough for a result
+	Result() float64
+}
+
+// errNoArguments rejects the arguments of the aggregators without arguments.
+var errNoArguments = errors.New("does not take arguments")
+
+type registeredAggregator struct {
+	newAggregator func() Aggregator
+	// outFieldType is the type of the results, Field_Type_Unknown follows the type of the destination
+	outFieldType int32
+	builtin      bool
+}
+
+var aggregators = struct {
+	mu    sync.RWMutex
+	calls map[string]registeredAggregator
+}{calls: make(map[string]registeredAggregator)}
+
+func init() {
+	registerBuiltinAggregator("mean", influx.Field_Type_Float, func() Aggregator { return &Mean{} })
+	registerBuiltinAggregator("sum_sq", influx.Field_Type_Float, func() Aggregator { return &SumSquares{} })
+	registerBuiltinAggregator("spread", influx.Field_Type_Unknown, func() Aggregator { return NewSpread() })
+}
+
+func registerBuiltinAggregator(name string, outFieldType int32, newAggregator func() Aggregator) {
+	aggregators.calls[name] = registeredAggregator{newAggregator: newAggregator, outFieldType: outFieldType, builtin: true}
+}
+
+// RegisterAggregator registers the aggregator of the calls of the name, which should be called before the stream
+// tasks calling it are created. The results of the calls are of the type, Field_Type_Unknown follows the type of
+// the destination. The builtin calls and the names registered already can not be registered.
+func RegisterAggregator(name string, outFieldType int32, newAggregator func() Aggregator) error {
+	if name == "" || newAggregator == nil {
+		return errors.New("the aggregator needs a name and a constructor")
+	}
+	if isBuiltinCall(name) {
+		return fmt.Errorf("the aggregator %s is a builtin call", name)
+	}
+	aggregators.mu.Lock()
+	defer aggregators.mu.Unlock()
+	if a, ok := aggregators.calls[name]; ok {
+		if a.builtin {
+			return fmt.Errorf("the aggregator %s is a builtin call", name)
+		}
+		return fmt.Errorf("the aggregator %s is registered already", name)
+	}
+	aggregators.calls[name] = registeredAggregator{newAggregator: newAggregator, outFieldType: outFieldType}
+	return nil
+}
+
+// UnregisterAggregator removes the aggregator registered by RegisterAggregator, the builtin calls are kept.
+func UnregisterAggregator(name string) {
+	aggregators.mu.Lock()
+	defer aggregators.mu.Unlock()
+	if a, ok := aggregators.calls[name]; ok && !a.builtin {
+		delete(aggregators.calls, name)
+	}
+}
+
+func lookupAggregator(name string) (registeredAggregator, bool) {
+	aggregators.mu.RLock()
+	defer aggregators.mu.RUnlock()
+	a, ok := aggregators.calls[name]
+	return a, ok
+}
+
+// isAggregatorCall returns whether the call is aggregated by a registered aggregator.
+func isAggregatorCall(name string) bool {
+	_, ok := lookupAggregator(name)
+	return ok
+}
+
+// isBuiltinCall returns whether the call is implemented by the stream, the aggregators of the builtin calls
+// included.
+func isBuiltinCall(name string) bool {
+	if a, ok := lookupAggregator(name); ok {
+		return a.builtin
+	}
+	return BuildSingleThreadFunc(&FieldCall{Call: name}) == nil
+}
+
+// buildAggregator sets the accumulator of the call aggregated by the registered aggregator, whose arguments are
+// checked by the aggregator of a window. It returns false if no aggregator is registered by the call.
+func buildAggregator(fieldCall *FieldCall) (bool, error) {
+	a, ok := lookupAggregator(fieldCall.Call)
+	if !ok {
+		return false, nil
+	}
+	args := fieldCall.Args
+	if err := a.newAggregator().Init(args); err != nil {
+		return true, fmt.Errorf("the %s call %s %v", fieldCall.Call, fieldCall.Alias, err)
+	}
+	if a.outFieldType != influx.Field_Type_Unknown {
+		fieldCall.OutFieldType = a.outFieldType
+	}
+	newAggregator := a.newAggregator
+	fieldCall.NewAccumulator = func() Accumulator {
+		agg := newAggregator()
+		// the arguments are checked when the call is built
+		_ = agg.Init(args)
+		return &aggregatorAccumulator{agg: agg}
+	}
+	return true, nil
+}
+
+// aggregatorAccumulator is the accumulator of a window aggregated by a registered aggregator.
+type aggregatorAccumulator struct {
+	agg Aggregator
+}
+
+func (a *aggregatorAccumulator) Add(value float64, timestamp int64) {
+	a.agg.Add(value, timestamp)
+}
+
+func (a *aggregatorAccumulator) Value() float64 {
+	return a.agg.Result()
+}
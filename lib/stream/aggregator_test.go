@@ -0,0 +1,115 @@
+/*
+Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+// weightedScore sums the values scaled by the weight argument.
+type weightedScore struct {
+	weight float64
+	sum    float64
+	n      int
+}
+
+func (s *weightedScore) Init(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("needs exactly one weight argument")
+	}
+	w, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return fmt.Errorf("has the invalid weight %s", args[0])
+	}
+	*s = weightedScore{weight: w}
+	return nil
+}
+
+func (s *weightedScore) Add(value float64, _ int64) {
+	s.sum += value * s.weight
+	s.n++
+}
+
+func (s *weightedScore) Merge(other Aggregator) {
+	o := other.(*weightedScore)
+	s.sum += o.sum
+	s.n += o.n
+}
+
+func (s *weightedScore) Result() float64 {
+	if s.n == 0 {
+		return math.NaN()
+	}
+	return s.sum
+}
+
+func TestRegisterAggregator(t *testing.T) {
+	newScore := func() Aggregator { return &weightedScore{} }
+	require.NoError(t, RegisterAggregator("score", influx.Field_Type_Float, newScore))
+	defer UnregisterAggregator("score")
+	require.EqualError(t, RegisterAggregator("score", influx.Field_Type_Float, newScore), "the aggregator score is registered already")
+	require.EqualError(t, RegisterAggregator("sum", influx.Field_Type_Float, newScore), "the aggregator sum is a builtin call")
+	require.EqualError(t, RegisterAggregator("mean", influx.Field_Type_Float, newScore), "the aggregator mean is a builtin call")
+	require.EqualError(t, RegisterAggregator("", influx.Field_Type_Float, newScore), "the aggregator needs a name and a constructor")
+	require.True(t, IsAccumulatorCall("score"))
+
+	_, err := NewFieldCallWithArgs(influx.Field_Type_Int, influx.Field_Type_Unknown, "v", "s", "score", nil, false)
+	require.EqualError(t, err, "the score call s needs exactly one weight argument")
+	call, err := NewFieldCallWithArgs(influx.Field_Type_Int, influx.Field_Type_Unknown, "v", "s", "score", []string{"0.5"}, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(influx.Field_Type_Float), call.OutFieldType)
+	require.Nil(t, call.MergeFunc)
+	acc := call.NewAccumulator()
+	require.True(t, math.IsNaN(acc.Value()))
+	acc.Add(4, 0)
+	acc.Add(2, 0)
+	require.Equal(t, float64(3), acc.Value())
+	// the windows are aggregated apart
+	require.True(t, math.IsNaN(call.NewAccumulator().Value()))
+
+	// the builtin aggregators are kept
+	UnregisterAggregator("mean")
+	require.True(t, IsAccumulatorCall("mean"))
+	UnregisterAggregator("score")
+	require.False(t, IsAccumulatorCall("score"))
+	_, err = NewFieldCallWithArgs(influx.Field_Type_Int, influx.Field_Type_Unknown, "v", "s", "score", []string{"0.5"}, false)
+	require.EqualError(t, err, "not support stream func score")
+}
+
+func TestBuiltinAggregators(t *testing.T) {
+	for name, result := range map[string]float64{"mean": 2.5, "sum_sq": 30, "spread": 3} {
+		a, ok := lookupAggregator(name)
+		require.True(t, ok)
+		agg, other := a.newAggregator(), a.newAggregator()
+		require.EqualError(t, agg.Init([]string{"1"}), "does not take arguments")
+		require.NoError(t, agg.Init(nil))
+		require.NoError(t, other.Init(nil))
+		// the values aggregated apart are merged into the result of all of them
+		agg.Add(1, 0)
+		agg.Add(2, 0)
+		other.Add(3, 0)
+		other.Add(4, 0)
+		agg.Merge(other)
+		require.Equal(t, result, agg.Result(), name)
+	}
+}
@@ -47,6 +47,13 @@ type FieldCall struct {
 	OutFieldType     int32
 	ConcurrencyFunc  func(*float64, float64) float64
 	SingleThreadFunc func(float64, float64) float64
+	Args             []string
+	// MergeFunc merges two partial results of the call aggregated apart,
+	// it is nil for the accumulator calls whose partial results are the accumulators
+	MergeFunc func(float64, float64) float64
+	// NewAccumulator creates the state of a window for the calls which can not be aggregated by a single float64,
+	// it is nil for the other calls
+	NewAccumulator func() Accumulator
 }
 
 func NewFieldCall(inFieldType, outFieldType int32, name, alias, call string, concurrency bool) (*FieldCall, error) {
@@ -68,6 +75,47 @@ func NewFieldCall(inFieldType, outFieldType int32, name, alias, call string, con
 			return nil, err
 		}
 	}
+	BuildMergeFunc(fieldCall)
+	return fieldCall, nil
+}
+
+// BuildMergeFunc builds the MergeFunc of the calls aggregated by a single float64.
+func BuildMergeFunc(fieldCall *FieldCall) {
+	switch fieldCall.Call {
+	case "min":
+		fieldCall.MergeFunc = func(f float64, f2 float64) float64 {
+			if f > f2 {
+				return f2
+			}
+			return f
+		}
+	case "max":
+		fieldCall.MergeFunc = func(f float64, f2 float64) float64 {
+			if f < f2 {
+				return f2
+			}
+			return f
+		}
+	case "sum", "count":
+		// the partial counts are summed up
+		fieldCall.MergeFunc = func(f float64, f2 float64) float64 {
+			return f + f2
+		}
+	default:
+		fieldCall.MergeFunc = nil
+	}
+}
+
+// NewFieldCallWithArgs returns the call with the arguments, the accumulator of the call is built as well.
+func NewFieldCallWithArgs(inFieldType, outFieldType int32, name, alias, call string, args []string, concurrency bool) (*FieldCall, error) {
+	fieldCall, err := NewFieldCall(inFieldType, outFieldType, name, alias, call, concurrency)
+	if err != nil {
+		return nil, err
+	}
+	fieldCall.Args = args
+	if err = BuildAccumulator(fieldCall); err != nil {
+		return nil, err
+	}
 	return fieldCall, nil
 }
 
@@ -75,13 +123,21 @@ func BuildConcurrencyFunc(fieldCall *FieldCall) error {
 	switch fieldCall.Call {
 	case "min":
 		fieldCall.ConcurrencyFunc = atomic2.CompareAndSwapMinFloat64
-	case "max":
+	case "max", "coverage":
+		// the coverage of a window never decreases, so the partial results are merged by max
 		fieldCall.ConcurrencyFunc = atomic2.CompareAndSwapMaxFloat64
 	case "sum":
 		fieldCall.ConcurrencyFunc = atomic2.AddFloat64
 	case "count":
 		fieldCall.ConcurrencyFunc = atomic2.AddFloat64
+	case "percentile", "median", "stddev", "variance", "any", "all", "count_true", "rate", "derivative", "count_distinct", "twa", "weighted_mean", "histogram", "min_ts", "max_ts", "first", "last":
+		// the partial result of an accumulator covers all the rows of the window seen so far, so the latest one wins
+		fieldCall.ConcurrencyFunc = atomic2.StoreFloat64
 	default:
+		if isAggregatorCall(fieldCall.Call) {
+			fieldCall.ConcurrencyFunc = atomic2.StoreFloat64
+			return nil
+		}
 		return fmt.Errorf("not support stream func %v", fieldCall.Call)
 	}
 	return nil
@@ -96,7 +152,7 @@ func BuildSingleThreadFunc(fieldCall *FieldCall) error {
 			}
 			return f
 		}
-	case "max":
+	case "max", "coverage":
 		fieldCall.SingleThreadFunc = func(f float64, f2 float64) float64 {
 			if f < f2 {
 				return f2
@@ -111,12 +167,23 @@ func BuildSingleThreadFunc(fieldCall *FieldCall) error {
 		fieldCall.SingleThreadFunc = func(f float64, f2 float64) float64 {
 			return f + f2
 		}
+	case "percentile", "median", "stddev", "variance", "any", "all", "count_true", "rate", "derivative", "count_distinct", "twa", "weighted_mean", "histogram", "min_ts", "max_ts", "first", "last":
+		fieldCall.SingleThreadFunc = lastValue
 	default:
+		if isAggregatorCall(fieldCall.Call) {
+			fieldCall.SingleThreadFunc = lastValue
+			return nil
+		}
 		return fmt.Errorf("not support stream func %v", fieldCall.Call)
 	}
 	return nil
 }
 
+// lastValue keeps the latest partial result of the accumulators.
+func lastValue(_ float64, f2 float64) float64 {
+	return f2
+}
+
 func NewBuilderPool() *BuilderPool {
 	p := &BuilderPool{}
 	return p
@@ -95,3 +95,22 @@ func Test_StringBuilder(t *testing.T) {
 		t.Fatal("unexpect", str2)
 	}
 }
+
+func TestMergeFunc(t *testing.T) {
+	for call, exp := range map[string]float64{"min": 1, "max": 3, "sum": 4, "count": 4} {
+		fc, err := NewFieldCall(0, 0, "f", "a", call, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := fc.MergeFunc(1, 3); v != exp {
+			t.Errorf("merge %s, exp %v, got %v", call, exp, v)
+		}
+	}
+	fc, err := NewFieldCall(0, 0, "f", "a", "percentile", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fc.MergeFunc != nil {
+		t.Error("the accumulator call has no merge func")
+	}
+}
@@ -64,7 +64,11 @@ const (
 	loggingLevel = "logging.level"
 )
 
-var streamSupportMap = map[string]bool{"min": true, "max": true, "sum": true, "count": true}
+var streamSupportMap = map[string]bool{"min": true, "max": true, "sum": true, "count": true, "percentile": true, "median": true, "stddev": true, "mean": true, "rate": true, "spread": true,
+	"coverage": true, "variance": true, "any": true, "all": true, "count_true": true, "derivative": true, "count_distinct": true, "twa": true, "histogram": true, "min_ts": true, "max_ts": true, "first": true, "last": true, "sum_sq": true, "weighted_mean": true, "percentiles": true}
+
+// streamOnlyCalls are the calls of the streams unknown to the query engine, which are prepared as the count of their fields
+var streamOnlyCalls = map[string]bool{"coverage": true, "variance": true, "any": true, "all": true, "count_true": true, "derivative": true, "count_distinct": true, "twa": true, "min_ts": true, "max_ts": true, "sum_sq": true, "weighted_mean": true, "percentiles": true}
 
 // StatementExecutor executes a statement in the query.
 type StatementExecutor struct {
@@ -2200,14 +2204,105 @@ func (e *StatementExecutor) executeCreateStreamStatement(stmt *influxql.CreateSt
 	mstInfo := stmt.Target.Measurement
 	proxy := newRowChanProxy()
 	opt := e.GetOptions(ctx.ExecutionOptions, proxy.rc)
-	s, er := query2.Prepare(selectStmt, e.ShardMapper, opt)
+	selectStmt, er := e.prepareStreamSelect(selectStmt, opt)
 	if er != nil {
 		return er
 	}
-	selectStmt = s.Statement()
 	if err := stmt.Check(selectStmt, streamSupportMap); err != nil {
 		return err
 	}
+	for _, d := range stmt.Destinations {
+		desMst := d.Target.Measurement
+		if desMst.Database == "" {
+			desMst.Database = mstInfo.Database
+		}
+		if desMst.RetentionPolicy == "" {
+			desMst.RetentionPolicy = mstInfo.RetentionPolicy
+		}
+	}
+	info := meta2.NewStreamInfo(stmt, selectStmt)
+	// the windows expiring from the destination before they are complete are rejected instead of failing the writes
+	if err := coordinator.CheckStreamDestinations(e.MetaClient, info, nil); err != nil {
+		return err
+	}
+	if err := e.createStreamMeasurement(mstInfo, selectStmt); err != nil {
+		return err
+	}
+	for _, d := range stmt.Destinations {
+		if err := e.createStreamMeasurement(d.Target.Measurement, selectStmt); err != nil {
+			return err
+		}
+	}
+	return e.MetaClient.CreateStreamPolicy(info)
+}
+
+// prepareStreamSelect prepares the select statement of the stream, the calls unknown to the query engine are
+// prepared as the count of their fields and restored in the prepared statement, as are the filters and the rounds of the calls.
+func (e *StatementExecutor) prepareStreamSelect(selectStmt *influxql.SelectStatement, opt query2.SelectOptions) (*influxql.SelectStatement, error) {
+	calls := make(map[int]*influxql.Call)
+	filters := make(map[int]*influxql.FilterExpr)
+	rounds := make(map[int]*influxql.Call)
+	for i, f := range selectStmt.Fields {
+		if expr, _, ok := influxql.StreamRoundCall(f.Expr); ok {
+			rounds[i] = f.Expr.(*influxql.Call)
+			f.Expr = expr
+		}
+		if fe, ok := f.Expr.(*influxql.FilterExpr); ok {
+			filters[i] = fe
+			f.Expr = fe.Call
+		}
+		c, ok := f.Expr.(*influxql.Call)
+		if !ok || !streamOnlyCalls[c.Name] || len(c.Args) == 0 {
+			continue
+		}
+		calls[i] = c
+		f.Expr = &influxql.Call{Name: "count", Args: c.Args[:1]}
+	}
+	defer func() {
+		for i, c := range calls {
+			selectStmt.Fields[i].Expr = c
+		}
+		for i, fe := range filters {
+			selectStmt.Fields[i].Expr = fe
+		}
+		for i, rc := range rounds {
+			selectStmt.Fields[i].Expr = rc
+		}
+	}()
+	s, err := query2.Prepare(selectStmt, e.ShardMapper, opt)
+	if err != nil {
+		return nil, err
+	}
+	prepared := s.Statement()
+	if len(calls) == 0 && len(filters) == 0 && len(rounds) == 0 {
+		return prepared, nil
+	}
+	if len(prepared.Fields) != len(selectStmt.Fields) {
+		return nil, errors.New("the fields of the stream can not be rewritten")
+	}
+	for i, c := range calls {
+		pc, ok := prepared.Fields[i].Expr.(*influxql.Call)
+		if !ok {
+			return nil, errors.New("the fields of the stream can not be rewritten")
+		}
+		pc.Name = c.Name
+		pc.Args = append(pc.Args[:1:1], c.Args[1:]...)
+	}
+	for i, fe := range filters {
+		pc, ok := prepared.Fields[i].Expr.(*influxql.Call)
+		if !ok {
+			return nil, errors.New("the fields of the stream can not be rewritten")
+		}
+		prepared.Fields[i].Expr = &influxql.FilterExpr{Call: pc, Condition: fe.Condition}
+	}
+	for i, rc := range rounds {
+		prepared.Fields[i].Expr = &influxql.Call{Name: rc.Name, Args: []influxql.Expr{prepared.Fields[i].Expr, rc.Args[1]}}
+	}
+	return prepared, nil
+}
+
+// createStreamMeasurement creates the destination measurement of the stream with the schema of the calls if it does not exist.
+func (e *StatementExecutor) createStreamMeasurement(mstInfo *influxql.Measurement, selectStmt *influxql.SelectStatement) error {
 	_, err := e.MetaClient.Measurement(mstInfo.Database, mstInfo.RetentionPolicy, mstInfo.Name)
 	if err != nil {
 		if err == meta2.ErrMeasurementNotFound {
@@ -2230,8 +2325,7 @@ func (e *StatementExecutor) executeCreateStreamStatement(stmt *influxql.CreateSt
 			return err
 		}
 	}
-	info := meta2.NewStreamInfo(stmt, selectStmt)
-	return e.MetaClient.CreateStreamPolicy(info)
+	return nil
 }
 
 func (e *StatementExecutor) executeShowStreamsStatement(stmt *influxql.ShowStreamsStatement) (models.Rows, error) {
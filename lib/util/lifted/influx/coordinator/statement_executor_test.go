@@ -363,3 +363,176 @@ func TestStatementExecutor_executeCreateContinuousQueryStatement(t *testing.T) {
 	cqQuery := stmt.String()
 	assert.Equal(t, `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 10m FOR 1h BEGIN SELECT "field"::integer INTO db1..mst1 FROM db0.rp0.mst0 GROUP BY time(1m) END`, cqQuery)
 }
+
+type streamShardGroup struct {
+	query.ShardGroup
+	fields map[string]influxql.DataType
+}
+
+func (g *streamShardGroup) FieldDimensions(m *influxql.Measurement) (map[string]influxql.DataType, map[string]struct{}, *influxql.Schema, error) {
+	return g.fields, map[string]struct{}{"tk": {}}, nil, nil
+}
+
+func (g *streamShardGroup) MapType(m *influxql.Measurement, field string) influxql.DataType {
+	if field == "tk" {
+		return influxql.Tag
+	}
+	return g.fields[field]
+}
+
+func (g *streamShardGroup) MapTypeBatch(m *influxql.Measurement, fields map[string]*influxql.FieldNameSpace, schema *influxql.Schema) error {
+	for k := range fields {
+		fields[k].DataType = g.MapType(m, k)
+	}
+	return nil
+}
+
+func (g *streamShardGroup) GetSeriesKey() []byte {
+	return nil
+}
+
+func (g *streamShardGroup) Close() error {
+	return nil
+}
+
+type streamShardMapper struct {
+	query.ShardMapper
+}
+
+func (m *streamShardMapper) MapShards(influxql.Sources, influxql.TimeRange, query.SelectOptions, influxql.Expr) (query.ShardGroup, error) {
+	return &streamShardGroup{fields: map[string]influxql.DataType{
+		"fv": influxql.Float, "iv": influxql.Integer, "bv": influxql.Boolean, "sv": influxql.String,
+	}}, nil
+}
+
+type streamMetaClient struct {
+	MockMetaClient
+	info *meta2.StreamInfo
+}
+
+func (m *streamMetaClient) Database(name string) (*meta2.DatabaseInfo, error) {
+	return &meta2.DatabaseInfo{Name: name, DefaultRetentionPolicy: "rp", RetentionPolicies: map[string]*meta2.RetentionPolicyInfo{
+		"rp": {Name: "rp"}, "rp2": {Name: "rp2"},
+	}}, nil
+}
+
+func (m *streamMetaClient) Measurement(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error) {
+	return &meta2.MeasurementInfo{Name: mstName}, nil
+}
+
+func (m *streamMetaClient) CreateStreamPolicy(info *meta2.StreamInfo) error {
+	m.info = info
+	return nil
+}
+
+func createStream(t *testing.T, sql string) (*meta2.StreamInfo, error) {
+	p := influxql.NewParser(strings.NewReader(sql))
+	defer p.Release()
+	yyParser := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yyParser.ParseTokens()
+	q, err := yyParser.GetQuery()
+	if !assert.NoError(t, err) {
+		return nil, err
+	}
+	stmt := q.Statements[0]
+	client := &streamMetaClient{}
+	e := &StatementExecutor{MetaClient: client, ShardMapper: &streamShardMapper{}, StmtExecLogger: Logger.NewLogger(errno.ModuleUnknown)}
+	err = e.executeCreateStreamStatement(stmt.(*influxql.CreateStreamStatement), &query.ExecutionContext{})
+	return client.info, err
+}
+
+func TestStatementExecutor_executeCreateStreamStatement(t *testing.T) {
+	info, err := createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum(fv) FROM db.rp.mst GROUP BY time(1m),tk DELAY 1m`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "sum", Field: "fv", Alias: "sum_fv"}}, info.Calls)
+	assert.Equal(t, []string{"tk"}, info.Dims)
+
+	// the other sources of the source measurement feed the stream from their retention policies
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum(fv) FROM db.rp.mst, db.rp2.mst, db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, "rp", info.SrcMst.RetentionPolicy)
+	assert.Equal(t, []string{"rp2"}, info.SrcRPs)
+	_, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum(fv) FROM db.rp.mst, db.rp.mst3 GROUP BY time(1m)`)
+	assert.EqualError(t, err, "the other sources of stream must be the source measurement in the other retention policies")
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum(fv) FROM db.rp.mst GROUP BY time(1m) DESTINATIONS db.rp.mst3 EVERY 1h, mst4 EVERY 1d`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamDestination{
+		{DesMst: &meta2.StreamMeasurementInfo{Name: "mst3", Database: "db", RetentionPolicy: "rp"}, Interval: time.Hour},
+		{DesMst: &meta2.StreamMeasurementInfo{Name: "mst4", Database: "db", RetentionPolicy: "rp"}, Interval: 24 * time.Hour},
+	}, info.Destinations)
+
+	_, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT top(fv, 3) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.EqualError(t, err, "unsupported call function in stream")
+
+	// the calls unknown to the query engine are kept with their args
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT coverage(fv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "coverage", Field: "fv", Alias: "coverage_fv"}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT variance(fv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "variance", Field: "fv", Alias: "variance_fv"}}, info.Calls)
+
+	// any and all are keywords, so they are quoted as the calls
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT "any"(bv), "all"(bv), count_true(bv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "any", Field: "bv", Alias: "any_bv"}, {Call: "all", Field: "bv", Alias: "all_bv"},
+		{Call: "count_true", Field: "bv", Alias: "count_true_bv"}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT derivative(fv, 1m) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "derivative", Field: "fv", Alias: "derivative_fv", Args: []string{"1m"}}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT count_distinct(sv, 12) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "count_distinct", Field: "sv", Alias: "count_distinct_sv", Args: []string{"12"}}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT twa(fv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "twa", Field: "fv", Alias: "twa_fv"}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT histogram(fv, 0.5, 1, 2) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "histogram", Field: "fv", Alias: "histogram_fv", Args: []string{"0.5", "1", "2"}}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT min_ts(fv), max_ts(fv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "min_ts", Field: "fv", Alias: "min_ts_fv"}, {Call: "max_ts", Field: "fv", Alias: "max_ts_fv"}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT first(sv), last(sv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "first", Field: "sv", Alias: "first_sv"}, {Call: "last", Field: "sv", Alias: "last_sv"}}, info.Calls)
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum_sq(iv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "sum_sq", Field: "iv", Alias: "sum_sq_iv"}}, info.Calls)
+
+	// the weight of the weighted mean is a field of its own
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT weighted_mean(fv, iv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "weighted_mean", Field: "fv", Alias: "weighted_mean_fv", Args: []string{"iv"}}}, info.Calls)
+
+	// the filters of the calls are kept as their conditions
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT sum(fv) FILTER (WHERE iv >= 500) AS sum_5xx, variance(fv) FILTER (WHERE tk = 'a'), max(fv) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{
+		{Call: "sum", Field: "fv", Alias: "sum_5xx", Condition: influxql.MustParseExpr("iv >= 500")},
+		{Call: "variance", Field: "fv", Alias: "variance_fv", Condition: influxql.MustParseExpr("tk = 'a'")},
+		{Call: "max", Field: "fv", Alias: "max_fv"},
+	}, info.Calls)
+
+	// the calls rounded by round keep their precisions
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT round(mean(fv), 3), round(sum(fv) FILTER (WHERE iv >= 500), 1) AS sum_5xx FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{
+		{Call: "mean", Field: "fv", Alias: "mean_fv", Precision: 3},
+		{Call: "sum", Field: "fv", Alias: "sum_5xx", Condition: influxql.MustParseExpr("iv >= 500"), Precision: 1},
+	}, info.Calls)
+	_, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT round(mean(fv), 16) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.EqualError(t, err, "the precision of the round call in stream must be in [0, 15]")
+
+	info, err = createStream(t, `CREATE STREAM s INTO db.rp.mst2 ON SELECT percentiles(fv, 50, 99.9) FROM db.rp.mst GROUP BY time(1m)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*meta2.StreamCall{{Call: "percentiles", Field: "fv", Alias: "percentiles_fv", Args: []string{"50", "99.9"}}}, info.Calls)
+}
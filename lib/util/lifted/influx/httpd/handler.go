@@ -170,6 +170,8 @@ type Handler struct {
 		RetryWritePointRows(database, retentionPolicy string, points []influx.Row) error
 	}
 
+	StreamManager StreamManager
+
 	RecordWriter interface {
 		RetryWriteLogRecord(database, retentionPolicy, measurement string, rec *record.Record) error
 	}
@@ -273,6 +275,22 @@ func NewHandler(c config.Config) *Handler {
 			"sysCtrl",
 			"POST", "/debug/ctrl", false, true, h.serveSysCtrl,
 		},
+		Route{ // stream tasks
+			"set-stream-options",
+			"PUT", "/api/v1/stream/:stream/options", false, true, h.serveSetStreamOptions,
+		},
+		Route{
+			"backfill-stream",
+			"POST", "/api/v1/stream/:stream/backfill", false, true, h.serveBackfillStream,
+		},
+		Route{
+			"pause-stream",
+			"POST", "/api/v1/stream/:stream/pause", false, true, h.servePauseStream,
+		},
+		Route{
+			"resume-stream",
+			"POST", "/api/v1/stream/:stream/resume", false, true, h.serveResumeStream,
+		},
 		// repository related operations
 		Route{
 			"create-repository",
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+// StreamManager manages the stream tasks calculated by the sql layer.
+type StreamManager interface {
+	// SetStreamOptions sets the JSON options of the stream task, empty restores the default options.
+	SetStreamOptions(name string, data []byte) error
+	// BackfillStream recomputes the output of the stream task over the time range [start, end) in nanoseconds.
+	BackfillStream(name string, start, end int64) error
+	// PauseStream pauses or resumes the stream task.
+	PauseStream(name string, paused bool) error
+}
+
+// curl -i -XPUT 'http://127.0.0.1:8086/api/v1/stream/mystream/options' -d '{"Output":{"SafeMode":true}}'
+func (h *Handler) serveSetStreamOptions(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeStream(w, user) {
+		return
+	}
+	stream := r.URL.Query().Get(":stream")
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.Logger.Info("set stream options", zap.String("stream", stream), zap.ByteString("options", data))
+	h.streamResponse(w, h.StreamManager.SetStreamOptions(stream, data))
+}
+
+// curl -i -XPOST 'http://127.0.0.1:8086/api/v1/stream/mystream/backfill?start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z'
+func (h *Handler) serveBackfillStream(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeStream(w, user) {
+		return
+	}
+	q := r.URL.Query()
+	stream := q.Get(":stream")
+	start, err := time.Parse(time.RFC3339Nano, q.Get("start"))
+	if err != nil {
+		h.httpError(w, "invalid start: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse(time.RFC3339Nano, q.Get("end"))
+	if err != nil {
+		h.httpError(w, "invalid end: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.Logger.Info("backfill stream", zap.String("stream", stream), zap.Time("start", start), zap.Time("end", end))
+	h.streamResponse(w, h.StreamManager.BackfillStream(stream, start.UnixNano(), end.UnixNano()))
+}
+
+// curl -i -XPOST 'http://127.0.0.1:8086/api/v1/stream/mystream/pause'
+func (h *Handler) servePauseStream(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	h.pauseStream(w, r, user, true)
+}
+
+// curl -i -XPOST 'http://127.0.0.1:8086/api/v1/stream/mystream/resume'
+func (h *Handler) serveResumeStream(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	h.pauseStream(w, r, user, false)
+}
+
+func (h *Handler) pauseStream(w http.ResponseWriter, r *http.Request, user meta2.User, paused bool) {
+	if !h.authorizeStream(w, user) {
+		return
+	}
+	stream := r.URL.Query().Get(":stream")
+	h.Logger.Info("pause stream", zap.String("stream", stream), zap.Bool("paused", paused))
+	h.streamResponse(w, h.StreamManager.PauseStream(stream, paused))
+}
+
+// authorizeStream checks that the streams are managed by the admin user only.
+func (h *Handler) authorizeStream(w http.ResponseWriter, user meta2.User) bool {
+	if h.StreamManager == nil {
+		h.httpError(w, "stream tasks are not managed by this node", http.StatusNotImplemented)
+		return false
+	}
+	if !h.Config.AuthEnabled {
+		return true
+	}
+	if user == nil {
+		h.httpError(w, "error authorizing stream: create admin user first or disable authentication", http.StatusForbidden)
+		return false
+	}
+	if !user.AuthorizeUnrestricted() {
+		h.httpError(w, "error authorizing, requires admin privilege only", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func (h *Handler) streamResponse(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil:
+		h.writeHeader(w, http.StatusNoContent)
+	case errno.Equal(err, errno.StreamNotFound):
+		h.httpError(w, err.Error(), http.StatusNotFound)
+	default:
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 Huawei Cloud Computing Technologies Co., Ltd.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+ http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStreamManager struct {
+	options   map[string]string
+	backfills [][2]int64
+	paused    map[string]bool
+}
+
+func (m *mockStreamManager) SetStreamOptions(name string, data []byte) error {
+	if _, ok := m.options[name]; !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	if strings.Contains(string(data), "Unknown") {
+		return fmt.Errorf("invalid stream task options")
+	}
+	m.options[name] = string(data)
+	return nil
+}
+
+func (m *mockStreamManager) BackfillStream(name string, start, end int64) error {
+	if _, ok := m.options[name]; !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	if start >= end {
+		return fmt.Errorf("invalid backfill time range [%d, %d)", start, end)
+	}
+	m.backfills = append(m.backfills, [2]int64{start, end})
+	return nil
+}
+
+func (m *mockStreamManager) PauseStream(name string, paused bool) error {
+	if _, ok := m.options[name]; !ok {
+		return errno.NewError(errno.StreamNotFound)
+	}
+	m.paused[name] = paused
+	return nil
+}
+
+func TestHandler_SetStreamOptions(t *testing.T) {
+	sm := &mockStreamManager{options: map[string]string{"s": ""}}
+	h := Handler{
+		Config:        &config.Config{AuthEnabled: true},
+		Logger:        logger.NewLogger(errno.ModuleHTTP),
+		StreamManager: sm,
+	}
+	admin := &meta.UserInfo{Name: "admin", Admin: true}
+	serve := func(stream, body string, user meta.User) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/stream/"+stream+"/options?:stream="+stream, strings.NewReader(body))
+		h.serveSetStreamOptions(w, req, user)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve("s", `{"Output":{"SafeMode":true}}`, admin))
+	assert.Equal(t, `{"Output":{"SafeMode":true}}`, sm.options["s"])
+	assert.Equal(t, http.StatusBadRequest, serve("s", `{"Unknown":1}`, admin))
+	assert.Equal(t, http.StatusNotFound, serve("none", ``, admin))
+
+	// the streams are managed by the admin user only
+	assert.Equal(t, http.StatusForbidden, serve("s", ``, nil))
+	assert.Equal(t, http.StatusForbidden, serve("s", ``, &meta.UserInfo{Name: "reader"}))
+	assert.Equal(t, `{"Output":{"SafeMode":true}}`, sm.options["s"])
+
+	h.StreamManager = nil
+	assert.Equal(t, http.StatusNotImplemented, serve("s", ``, admin))
+}
+
+func TestHandler_BackfillStream(t *testing.T) {
+	sm := &mockStreamManager{options: map[string]string{"s": ""}}
+	h := Handler{
+		Config:        &config.Config{AuthEnabled: true},
+		Logger:        logger.NewLogger(errno.ModuleHTTP),
+		StreamManager: sm,
+	}
+	admin := &meta.UserInfo{Name: "admin", Admin: true}
+	serve := func(stream, start, end string, user meta.User) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/stream/"+stream+"/backfill?:stream="+stream+"&start="+start+"&end="+end, nil)
+		h.serveBackfillStream(w, req, user)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve("s", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02.5Z", admin))
+	assert.Equal(t, [][2]int64{{1e9, 2.5e9}}, sm.backfills)
+	assert.Equal(t, http.StatusBadRequest, serve("s", "1", "1970-01-01T00:00:02Z", admin))
+	assert.Equal(t, http.StatusBadRequest, serve("s", "1970-01-01T00:00:01Z", "", admin))
+	assert.Equal(t, http.StatusBadRequest, serve("s", "1970-01-01T00:00:02Z", "1970-01-01T00:00:01Z", admin))
+	assert.Equal(t, http.StatusNotFound, serve("none", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z", admin))
+	assert.Equal(t, http.StatusForbidden, serve("s", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z", &meta.UserInfo{Name: "reader"}))
+	assert.Len(t, sm.backfills, 1)
+}
+
+func TestHandler_PauseStream(t *testing.T) {
+	sm := &mockStreamManager{options: map[string]string{"s": ""}, paused: map[string]bool{}}
+	h := Handler{
+		Config:        &config.Config{AuthEnabled: true},
+		Logger:        logger.NewLogger(errno.ModuleHTTP),
+		StreamManager: sm,
+	}
+	admin := &meta.UserInfo{Name: "admin", Admin: true}
+	serve := func(serve func(http.ResponseWriter, *http.Request, meta.User), stream string, user meta.User) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/stream/"+stream+"/pause?:stream="+stream, nil)
+		serve(w, req, user)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve(h.servePauseStream, "s", admin))
+	assert.True(t, sm.paused["s"])
+	assert.Equal(t, http.StatusNoContent, serve(h.serveResumeStream, "s", admin))
+	assert.False(t, sm.paused["s"])
+	assert.Equal(t, http.StatusNotFound, serve(h.servePauseStream, "none", admin))
+	assert.Equal(t, http.StatusForbidden, serve(h.servePauseStream, "s", &meta.UserInfo{Name: "reader"}))
+	assert.False(t, sm.paused["s"])
+}
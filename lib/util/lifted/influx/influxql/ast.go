@@ -7382,6 +7382,40 @@ func (p *CaseWhenExpr) String() string {
 	return fmt.Sprintf(s)
 }
 
+// FilterExpr represents a call aggregating only the rows matching its condition, it is only supported by the streams.
+type FilterExpr struct {
+	Call      *Call
+	Condition Expr
+}
+
+func (p *FilterExpr) RewriteNameSpace(alias, mst string) {
+	p.Call.RewriteNameSpace(alias, mst)
+	p.Condition.RewriteNameSpace(alias, mst)
+}
+
+func (p *FilterExpr) node() {}
+func (p *FilterExpr) expr() {}
+func (p *FilterExpr) String() string {
+	return fmt.Sprintf("%s FILTER (WHERE %s)", p.Call.String(), p.Condition.String())
+}
+
+// MaxStreamPrecision is the max number of the decimal places the results of the stream calls are rounded to.
+const MaxStreamPrecision = 15
+
+// StreamRoundCall returns the call rounded by round(call, precision) in the fields of the streams and its precision,
+// false means the expression is not rounded.
+func StreamRoundCall(expr Expr) (Expr, int, bool) {
+	c, ok := expr.(*Call)
+	if !ok || c.Name != "round" || len(c.Args) != 2 {
+		return expr, 0, false
+	}
+	p, ok := c.Args[1].(*IntegerLiteral)
+	if !ok {
+		return expr, 0, false
+	}
+	return c.Args[0], int(p.Val), true
+}
+
 type CreateDownSampleStatement struct {
 	DbName         string
 	RpName         string
@@ -7525,6 +7559,16 @@ type CreateStreamStatement struct {
 	Target *Target
 	Query  Statement
 	Delay  time.Duration
+	// Slide is the step of the sliding windows, the windows are tumbling if it is 0
+	Slide time.Duration
+	// Destinations are the other measurements the results are written to at their own intervals
+	Destinations []*StreamDestination
+}
+
+// StreamDestination is a target of a stream with the group by interval of it.
+type StreamDestination struct {
+	Target   *Target
+	Interval time.Duration
 }
 
 func (c *CreateStreamStatement) stmt() {}
@@ -7550,16 +7594,47 @@ func (c *CreateStreamStatement) String() string {
 
 func (c *CreateStreamStatement) Check(stmt *SelectStatement, supportTable map[string]bool) error {
 	for i := range stmt.Fields {
-		if c, ok := stmt.Fields[i].Expr.(*Call); ok && !supportTable[c.Name] {
+		expr, precision, _ := StreamRoundCall(stmt.Fields[i].Expr)
+		if precision < 0 || precision > MaxStreamPrecision {
+			return fmt.Errorf("the precision of the round call in stream must be in [0, %d]", MaxStreamPrecision)
+		}
+		if f, ok := expr.(*FilterExpr); ok {
+			expr = f.Call
+		}
+		if c, ok := expr.(*Call); ok && !supportTable[c.Name] {
 			return errors.New("unsupported call function in stream")
 		}
 	}
 	if stmt.groupByInterval == 0 {
 		return errors.New("should have group by interval time")
 	}
+	src, ok := stmt.Sources[0].(*Measurement)
+	if !ok {
+		return errors.New("the source of stream must be a measurement")
+	}
+	for _, s := range stmt.Sources[1:] {
+		if m, ok := s.(*Measurement); !ok || m.Name != src.Name || m.Database != src.Database {
+			return errors.New("the other sources of stream must be the source measurement in the other retention policies")
+		}
+	}
 	if stmt.groupByInterval*10 < c.Delay {
 		return errors.New("delay time must be smaller than 10 times of group by interval time")
 	}
+	if c.Slide < 0 || c.Slide > stmt.groupByInterval {
+		return errors.New("slide time must not be negative or larger than the group by interval time")
+	}
+	for _, d := range c.Destinations {
+		if d.Interval <= 0 {
+			return errors.New("the interval time of the destinations must be positive")
+		}
+		if d.Interval*10 < c.Delay {
+			return errors.New("delay time must be smaller than 10 times of the interval time of the destinations")
+		}
+		if d.Target.Measurement.Name == c.Target.Measurement.Name && d.Target.Measurement.Database == c.Target.Measurement.Database &&
+			d.Target.Measurement.RetentionPolicy == c.Target.Measurement.RetentionPolicy {
+			return errors.New("the destinations must differ from the target")
+		}
+	}
 	return nil
 }
 
@@ -62,7 +62,8 @@ func (s *Scanner) Scan() (tok Token, pos Pos, lit string) {
 		if tok != WS {
 			s.preToken = tok
 		}
-		if tok >= FROM && tok <= ON {
+		// the destinations of the streams are measurements as well, those of the subscriptions are strings
+		if tok >= FROM && tok <= ON || tok == DESTINATIONS {
 			s.checkDOT = true
 		} else if tok > ON && tok <= ASC {
 			s.checkDOT = false
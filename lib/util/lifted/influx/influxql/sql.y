@@ -101,6 +101,8 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     indexOption         *IndexOption
     databasePolicy      DatabasePolicy
     cmOption            *CreateMeasurementStatementOption
+    streamDestination   *StreamDestination
+    streamDestinations  []*StreamDestination
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -110,7 +112,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                 PRIMARYKEY SORTKEY PROPERTY COMPACT
                 CONTINUOUS DIAGNOSTICS QUERIES QUERIE SHARDS STATS SUBSCRIPTIONS SUBSCRIPTION GROUPS INDEXTYPE INDEXLIST SEGMENT KILL
                 EVERY RESAMPLE
-                DOWNSAMPLE DOWNSAMPLES SAMPLEINTERVAL TIMEINTERVAL STREAM DELAY STREAMS
+                DOWNSAMPLE DOWNSAMPLES SAMPLEINTERVAL TIMEINTERVAL STREAM DELAY STREAMS SLIDE FILTER
                 QUERY PARTITION
                 TOKEN TOKENIZERS MATCH LIKE MATCHPHRASE CONFIG CONFIGS CLUSTER
                 REPLICAS DETAIL DESTINATIONS
@@ -171,6 +173,9 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <indexType>                   INDEX_TYPE INDEX_TYPES CMOPTION_INDEXTYPE_TS CMOPTION_INDEXTYPE_CS
 %type <cqsp>                        SAMPLE_POLICY
 %type <tdurs>                       DURATIONVALS
+%type <tdur>                        STREAM_DELAY STREAM_SLIDE
+%type <streamDestination>           STREAM_DESTINATION
+%type <streamDestinations>          STREAM_DESTINATIONS STREAM_DESTINATION_LIST
 %type <cqsp>                        SAMPLE_POLICY
 %type <int64>                       INTEGERPARA
 %type <bool>                        ALLOW_TAG_ARRAY
@@ -680,6 +685,14 @@ COLUMN:
         cols := &Call{Name: strings.ToLower($1)}
         $$ = cols
     }
+    |IDENT LPAREN COLUMN_CLAUSES RPAREN FILTER LPAREN WHERE CONDITION RPAREN
+    {
+        cols := &Call{Name: strings.ToLower($1), Args: []Expr{}}
+        for i := range $3 {
+            cols.Args = append(cols.Args, $3[i].Expr)
+        }
+        $$ = &FilterExpr{Call: cols, Condition: $8}
+    }
     |SUB COLUMN %prec UMINUS
     {
         switch s := $2.(type) {
@@ -3196,12 +3209,14 @@ DURATIONVALS:
 
 
 CREATE_STREAM_STATEMENT:
-    CREATE STREAM STRING_TYPE INTO_CLAUSE ON SELECT_STATEMENT DELAY DURATIONVAL
+    CREATE STREAM STRING_TYPE INTO_CLAUSE ON SELECT_STATEMENT STREAM_DELAY STREAM_SLIDE STREAM_DESTINATIONS
     {
     	stmt := &CreateStreamStatement{
     	    Name: $3,
     	    Query: $6,
-    	    Delay: $8,
+    	    Delay: $7,
+    	    Slide: $8,
+    	    Destinations: $9,
     	}
         if len($4) > 1{
             yylex.Error("into clause only support one target")
@@ -3218,26 +3233,56 @@ CREATE_STREAM_STATEMENT:
         }
         $$ = stmt
     }
-    |CREATE STREAM STRING_TYPE INTO_CLAUSE ON SELECT_STATEMENT
+
+STREAM_DELAY:
+    DELAY DURATIONVAL
     {
-    	stmt := &CreateStreamStatement{
-    	    Name: $3,
-    	    Query: $6,
+    	$$ = $2
+    }
+    |
+    {
+    	$$ = 0
+    }
+
+STREAM_SLIDE:
+    SLIDE DURATIONVAL
+    {
+    	$$ = $2
+    }
+    |
+    {
+    	$$ = 0
+    }
+
+STREAM_DESTINATIONS:
+    DESTINATIONS STREAM_DESTINATION_LIST
+    {
+    	$$ = $2
+    }
+    |
+    {
+    	$$ = nil
+    }
+
+STREAM_DESTINATION_LIST:
+    STREAM_DESTINATION
+    {
+    	$$ = []*StreamDestination{$1}
+    }
+    |STREAM_DESTINATION COMMA STREAM_DESTINATION_LIST
+    {
+    	$$ = append([]*StreamDestination{$1},$3...)
+    }
+
+STREAM_DESTINATION:
+    TABLE_NAME_WITH_OPTION EVERY DURATIONVAL
+    {
+    	mst := $1
+    	mst.IsTarget = true
+    	$$ = &StreamDestination{
+    	    Target: &Target{Measurement: mst},
+    	    Interval: $3,
     	}
-        if len($4) > 1{
-            yylex.Error("into clause only support one target")
-        }
-        if len($4) == 1{
-            mst,ok := $4[0].(*Measurement)
-            if !ok{
-                 yylex.Error("into clause only support measurement clause")
-            }
-            mst.IsTarget = true
-            stmt.Target = &Target{
-            	Measurement: mst,
-            }
-        }
-        $$ = stmt
     }
 
 SHOW_STREAM_STATEMENT:
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
@@ -436,6 +437,71 @@ func TestSingleParserError(t *testing.T) {
 	}
 }
 
+func TestCreateStreamParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	c := []string{
+		"create stream s into db1.rp1.mst1 on select sum(f1) from mst0 group by tag1,time(10s)",
+		"create stream s into db1.rp1.mst1 on select sum(f1) from mst0 group by tag1,time(10s) delay 5s",
+		"create stream s into db1.rp1.mst1 on select sum(f1) from mst0 group by tag1,time(10s) slide 2s",
+		"create stream s into db1.rp1.mst1 on select sum(f1) from mst0 group by tag1,time(10s) delay 5s slide 2s",
+	}
+	cr := [][2]time.Duration{{0, 0}, {5 * time.Second, 0}, {0, 2 * time.Second}, {5 * time.Second, 2 * time.Second}}
+	for i, c := range c {
+		YyParser.Query = influxql.Query{}
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err, c)
+		}
+		stmt, ok := q.Statements[0].(*influxql.CreateStreamStatement)
+		if !ok {
+			t.Fatalf("unexpected statement %T with sql: %s", q.Statements[0], c)
+		}
+		if stmt.Delay != cr[i][0] || stmt.Slide != cr[i][1] {
+			t.Errorf("unexpected delay %s and slide %s with sql: %s", stmt.Delay, stmt.Slide, c)
+		}
+	}
+
+	YyParser.Query = influxql.Query{}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("create stream s into db1.rp1.mst1 on select sum(f1) from mst0 group by time(10s) " +
+		"delay 5s destinations db1.rp2.mst2 every 1m, mst3 every 1h"))
+	YyParser.ParseTokens()
+	q, err := YyParser.GetQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := q.Statements[0].(*influxql.CreateStreamStatement)
+	if len(stmt.Destinations) != 2 {
+		t.Fatalf("unexpected destinations %d", len(stmt.Destinations))
+	}
+	for i, exp := range []struct {
+		db, rp, mst string
+		interval    time.Duration
+	}{{"db1", "rp2", "mst2", time.Minute}, {"", "", "mst3", time.Hour}} {
+		d := stmt.Destinations[i]
+		m := d.Target.Measurement
+		if m.Database != exp.db || m.RetentionPolicy != exp.rp || m.Name != exp.mst || d.Interval != exp.interval || !m.IsTarget {
+			t.Errorf("unexpected destination %+v every %s", *m, d.Interval)
+		}
+	}
+
+	YyParser.Query = influxql.Query{}
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("create stream s into db1.rp1.mst1 on select sum(f1) filter (where code >= 500) as f1_5xx, " +
+		"count(f1) from mst0 group by time(10s)"))
+	YyParser.ParseTokens()
+	q, err = YyParser.GetQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := q.Statements[0].(*influxql.CreateStreamStatement).Query.(*influxql.SelectStatement).Fields
+	if s := fields.String(); s != "sum(f1) FILTER (WHERE code >= 500) AS f1_5xx, count(f1)" {
+		t.Errorf("unexpected fields %s", s)
+	}
+}
+
 func BenchmarkNewParser(b *testing.B) {
 	YyParser := &influxql.YyParser{
 		Query: influxql.Query{},
@@ -333,6 +333,8 @@ var tokens = [...]string{
 	STREAM:         "STREAM",
 	STREAMS:        "STREAMS",
 	DELAY:          "DELAY",
+	SLIDE:          "SLIDE",
+	FILTER:         "FILTER",
 	ATTRIBUTE:      "ATTRIBUTE",
 	REPLICAS:       "REPLICAS",
 	DETAIL:         "DETAIL",
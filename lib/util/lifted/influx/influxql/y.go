@@ -67,45 +67,47 @@ func deal_Fill(fill interface{}) (FillOption, interface{}, bool) {
 
 //line sql.y:65
 type yySymType struct {
-	yys              int
-	stmt             Statement
-	stmts            Statements
-	str              string
-	query            Query
-	field            *Field
-	fields           Fields
-	sources          Sources
-	source           Source
-	sortfs           SortFields
-	sortf            *SortField
-	ment             *Measurement
-	subQuery         *SubQuery
-	dimens           Dimensions
-	dimen            *Dimension
-	int              int
-	int64            int64
-	float64          float64
-	dataType         DataType
-	expr             Expr
-	tdur             time.Duration
-	tdurs            []time.Duration
-	bool             bool
-	groupByCondition *GroupByCondition
-	intSlice         []int
-	inter            interface{}
-	durations        *Durations
-	hints            Hints
-	strSlice         []string
-	strSlices        [][]string
-	location         *time.Location
-	indexType        *IndexType
-	cqsp             *cqSamplePolicyInfo
-	fieldOption      *fieldList
-	fieldOptions     []*fieldList
-	indexOptions     []*IndexOption
-	indexOption      *IndexOption
-	databasePolicy   DatabasePolicy
-	cmOption         *CreateMeasurementStatementOption
+	yys                int
+	stmt               Statement
+	stmts              Statements
+	str                string
+	query              Query
+	field              *Field
+	fields             Fields
+	sources            Sources
+	source             Source
+	sortfs             SortFields
+	sortf              *SortField
+	ment               *Measurement
+	subQuery           *SubQuery
+	dimens             Dimensions
+	dimen              *Dimension
+	int                int
+	int64              int64
+	float64            float64
+	dataType           DataType
+	expr               Expr
+	tdur               time.Duration
+	tdurs              []time.Duration
+	bool               bool
+	groupByCondition   *GroupByCondition
+	intSlice           []int
+	inter              interface{}
+	durations          *Durations
+	hints              Hints
+	strSlice           []string
+	strSlices          [][]string
+	location           *time.Location
+	indexType          *IndexType
+	cqsp               *cqSamplePolicyInfo
+	fieldOption        *fieldList
+	fieldOptions       []*fieldList
+	indexOptions       []*IndexOption
+	indexOption        *IndexOption
+	databasePolicy     DatabasePolicy
+	cmOption           *CreateMeasurementStatementOption
+	streamDestination  *StreamDestination
+	streamDestinations []*StreamDestination
 }
 
 const FROM = 57346
@@ -212,56 +214,58 @@ const TIMEINTERVAL = 57446
 const STREAM = 57447
 const DELAY = 57448
 const STREAMS = 57449
-const QUERY = 57450
-const PARTITION = 57451
-const TOKEN = 57452
-const TOKENIZERS = 57453
-const MATCH = 57454
-const LIKE = 57455
-const MATCHPHRASE = 57456
-const CONFIG = 57457
-const CONFIGS = 57458
-const CLUSTER = 57459
-const REPLICAS = 57460
-const DETAIL = 57461
-const DESTINATIONS = 57462
-const SCHEMA = 57463
-const INDEXES = 57464
-const DESC = 57465
-const ASC = 57466
-const COMMA = 57467
-const SEMICOLON = 57468
-const LPAREN = 57469
-const RPAREN = 57470
-const REGEX = 57471
-const EQ = 57472
-const NEQ = 57473
-const LT = 57474
-const LTE = 57475
-const GT = 57476
-const GTE = 57477
-const DOT = 57478
-const DOUBLECOLON = 57479
-const NEQREGEX = 57480
-const EQREGEX = 57481
-const IDENT = 57482
-const INTEGER = 57483
-const DURATIONVAL = 57484
-const STRING = 57485
-const NUMBER = 57486
-const HINT = 57487
-const BOUNDPARAM = 57488
-const AND = 57489
-const OR = 57490
-const ADD = 57491
-const SUB = 57492
-const BITWISE_OR = 57493
-const BITWISE_XOR = 57494
-const MUL = 57495
-const DIV = 57496
-const MOD = 57497
-const BITWISE_AND = 57498
-const UMINUS = 57499
+const SLIDE = 57450
+const FILTER = 57451
+const QUERY = 57452
+const PARTITION = 57453
+const TOKEN = 57454
+const TOKENIZERS = 57455
+const MATCH = 57456
+const LIKE = 57457
+const MATCHPHRASE = 57458
+const CONFIG = 57459
+const CONFIGS = 57460
+const CLUSTER = 57461
+const REPLICAS = 57462
+const DETAIL = 57463
+const DESTINATIONS = 57464
+const SCHEMA = 57465
+const INDEXES = 57466
+const DESC = 57467
+const ASC = 57468
+const COMMA = 57469
+const SEMICOLON = 57470
+const LPAREN = 57471
+const RPAREN = 57472
+const REGEX = 57473
+const EQ = 57474
+const NEQ = 57475
+const LT = 57476
+const LTE = 57477
+const GT = 57478
+const GTE = 57479
+const DOT = 57480
+const DOUBLECOLON = 57481
+const NEQREGEX = 57482
+const EQREGEX = 57483
+const IDENT = 57484
+const INTEGER = 57485
+const DURATIONVAL = 57486
+const STRING = 57487
+const NUMBER = 57488
+const HINT = 57489
+const BOUNDPARAM = 57490
+const AND = 57491
+const OR = 57492
+const ADD = 57493
+const SUB = 57494
+const BITWISE_OR = 57495
+const BITWISE_XOR = 57496
+const MUL = 57497
+const DIV = 57498
+const MOD = 57499
+const BITWISE_AND = 57500
+const UMINUS = 57501
 
 var yyToknames = [...]string{
 	"$end",
@@ -371,6 +375,8 @@ var yyToknames = [...]string{
 	"STREAM",
 	"DELAY",
 	"STREAMS",
+	"SLIDE",
+	"FILTER",
 	"QUERY",
 	"PARTITION",
 	"TOKEN",
@@ -429,7 +435,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3431
+//line sql.y:3476
 
 //line yacctab:1
 var yyExca = [...]int16{
@@ -437,252 +443,255 @@ var yyExca = [...]int16{
 	1, -1,
 	-2, 0,
 	-1, 70,
-	4, 92,
-	-2, 136,
+	4, 93,
+	-2, 137,
 	-1, 465,
-	113, 153,
-	130, 153,
-	131, 153,
-	132, 153,
-	133, 153,
-	134, 153,
-	135, 153,
-	138, 153,
-	139, 153,
-	-2, 142,
+	115, 154,
+	132, 154,
+	133, 154,
+	134, 154,
+	135, 154,
+	136, 154,
+	137, 154,
+	140, 154,
+	141, 154,
+	-2, 143,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1117
+const yyLast = 1136
 
 var yyAct = [...]int16{
-	785, 505, 890, 908, 767, 858, 688, 419, 880, 261,
-	389, 714, 784, 735, 641, 692, 709, 138, 702, 504,
-	4, 630, 545, 234, 70, 765, 546, 489, 380, 417,
-	204, 319, 244, 228, 626, 438, 316, 230, 2, 178,
-	74, 153, 58, 173, 160, 161, 165, 166, 387, 278,
-	162, 163, 167, 164, 160, 161, 165, 166, 841, 668,
-	707, 667, 80, 465, 345, 346, 842, 88, 84, 85,
-	162, 163, 167, 164, 160, 161, 165, 166, 492, 345,
-	346, 212, 345, 346, 627, 604, 605, 148, 154, 628,
-	840, 493, 233, 564, 88, 600, 156, 80, 609, 610,
-	88, 203, 611, 84, 85, 202, 88, 443, 205, 211,
-	919, 442, 212, 203, 205, 232, 168, 202, 172, 557,
-	205, 891, 159, 888, 210, 213, 75, 280, 88, 873,
-	345, 346, 181, 864, 832, 224, 88, 226, 831, 76,
-	82, 79, 83, 81, 216, 87, 782, 568, 211, 77,
-	205, 212, 73, 781, 762, 227, 211, 602, 857, 212,
-	603, 75, 211, 88, 268, 212, 855, 269, 256, 719,
-	673, 672, 671, 670, 76, 82, 79, 83, 81, 541,
-	87, 245, 265, 770, 77, 538, 539, 73, 644, 263,
-	844, 724, 723, 555, 279, 553, 291, 313, 264, 295,
-	289, 270, 271, 272, 273, 274, 275, 276, 277, 80,
-	58, 544, 500, 501, 770, 84, 85, 245, 287, 288,
-	503, 502, 542, 297, 298, 299, 526, 430, 306, 283,
-	525, 284, 311, 332, 329, 407, 201, 259, 305, 406,
-	145, 80, 304, 615, 219, 913, 143, 84, 85, 859,
-	769, 715, 330, 856, 176, 737, 703, 547, 247, 378,
-	348, 162, 163, 167, 164, 160, 161, 165, 166, 349,
-	350, 344, 343, 75, 632, 88, 496, 347, 379, 792,
-	759, 773, 758, 554, 750, 712, 76, 82, 79, 83,
-	81, 71, 87, 642, 643, 711, 77, 698, 282, 73,
-	657, 646, 645, 613, 656, 75, 393, 88, 620, 619,
-	599, 597, 392, 596, 594, 396, 398, 409, 76, 82,
-	79, 83, 81, 592, 87, 441, 579, 385, 77, 414,
-	174, 73, 451, 578, 577, 572, 394, 570, 455, 456,
-	556, 402, 703, 404, 543, 146, 528, 497, 411, 482,
-	412, 144, 416, 481, 470, 471, 444, 162, 163, 167,
-	164, 160, 161, 165, 166, 478, 477, 458, 391, 463,
-	464, 169, 468, 457, 377, 459, 376, 375, 372, 371,
-	171, 170, 370, 491, 158, 367, 245, 245, 472, 486,
-	365, 487, 336, 335, 334, 510, 245, 333, 328, 327,
-	326, 495, 321, 509, 314, 312, 514, 309, 292, 516,
-	285, 530, 512, 513, 258, 515, 220, 218, 214, 529,
-	200, 198, 524, 169, 537, 655, 86, 447, 498, 533,
-	535, 536, 171, 170, 364, 580, 448, 566, 527, 454,
-	441, 519, 565, 522, 445, 576, 405, 325, 540, 915,
-	531, 356, 357, 358, 359, 360, 361, 819, 818, 363,
-	362, 575, 552, 681, 485, 484, 415, 88, 921, 239,
-	238, 574, 571, 903, 567, 561, 569, 796, 562, 892,
-	795, 563, 69, 887, 461, 872, 601, 585, 871, 848,
-	588, 584, 834, 826, 582, 791, 790, 591, 593, 788,
-	787, 716, 704, 700, 699, 616, 80, 607, 686, 587,
-	462, 633, 84, 85, 347, 606, 637, 618, 449, 384,
-	916, 208, 635, 636, 829, 868, 638, 794, 739, 634,
-	687, 658, 614, 586, 654, 488, 639, 469, 466, 666,
-	652, 653, 629, 662, 354, 664, 665, 206, 353, 660,
-	661, 351, 663, 324, 710, 240, 342, 241, 69, 137,
-	340, 914, 904, 882, 669, 837, 206, 805, 783, 206,
-	236, 789, 88, 727, 728, 177, 691, 726, 612, 590,
-	589, 695, 206, 237, 82, 79, 83, 81, 581, 87,
-	705, 706, 157, 77, 317, 320, 431, 683, 221, 207,
-	763, 149, 151, 690, 911, 835, 778, 701, 685, 827,
-	193, 826, 80, 491, 680, 696, 678, 713, 84, 85,
-	225, 194, 708, 669, 907, 900, 885, 863, 179, 722,
-	807, 744, 766, 475, 320, 179, 410, 730, 731, 403,
-	717, 209, 318, 743, 729, 721, 401, 777, 307, 308,
-	302, 303, 191, 192, 310, 733, 749, 738, 732, 734,
-	296, 341, 747, 748, 754, 745, 756, 757, 650, 746,
-	752, 753, 640, 755, 764, 339, 75, 751, 88, 150,
-	518, 318, 184, 185, 186, 772, 215, 682, 432, 76,
-	82, 79, 83, 81, 58, 87, 760, 720, 718, 77,
-	300, 301, 320, 422, 423, 771, 865, 182, 183, 188,
-	3, 189, 780, 260, 420, 424, 426, 429, 617, 427,
-	428, 786, 266, 776, 267, 421, 386, 286, 176, 820,
-	866, 802, 797, 793, 257, 190, 710, 761, 245, 689,
-	120, 294, 798, 675, 551, 804, 425, 550, 801, 812,
-	813, 803, 206, 806, 815, 816, 811, 817, 808, 809,
-	800, 814, 549, 810, 147, 80, 206, 548, 206, 246,
-	217, 84, 85, 825, 426, 429, 119, 427, 428, 117,
-	152, 118, 823, 434, 199, 180, 833, 560, 828, 824,
-	140, 142, 693, 694, 775, 774, 139, 830, 139, 836,
-	867, 779, 742, 139, 676, 839, 846, 649, 838, 494,
-	573, 517, 290, 853, 845, 437, 854, 843, 366, 847,
-	852, 121, 849, 648, 383, 521, 850, 851, 124, 473,
-	400, 88, 141, 860, 322, 381, 122, 352, 467, 248,
-	123, 595, 76, 82, 79, 83, 81, 875, 87, 869,
-	870, 368, 77, 249, 879, 874, 250, 395, 397, 399,
-	877, 878, 479, 476, 460, 881, 408, 876, 369, 886,
-	206, 413, 206, 822, 889, 821, 624, 625, 799, 894,
-	895, 254, 506, 507, 252, 725, 893, 206, 897, 881,
-	896, 901, 902, 139, 390, 905, 508, 382, 253, 98,
-	139, 910, 390, 262, 140, 140, 155, 912, 583, 140,
-	197, 58, 58, 697, 374, 910, 918, 373, 917, 920,
-	179, 474, 59, 60, 453, 452, 112, 450, 446, 621,
-	622, 433, 65, 338, 62, 337, 93, 89, 331, 90,
-	91, 293, 255, 251, 63, 100, 223, 222, 196, 195,
-	155, 388, 598, 97, 511, 92, 483, 64, 480, 139,
-	187, 67, 520, 559, 523, 94, 61, 96, 130, 558,
-	436, 532, 534, 435, 440, 111, 108, 109, 110, 115,
-	101, 66, 104, 439, 99, 684, 105, 679, 677, 768,
-	898, 899, 909, 206, 883, 861, 102, 884, 135, 862,
-	906, 103, 68, 95, 128, 736, 418, 125, 206, 127,
-	106, 107, 608, 623, 129, 113, 114, 490, 631, 281,
-	355, 175, 78, 243, 126, 242, 58, 235, 499, 229,
-	231, 233, 1, 72, 116, 54, 59, 60, 53, 494,
-	52, 57, 56, 55, 51, 50, 65, 49, 62, 131,
-	323, 48, 47, 46, 45, 44, 136, 43, 63, 42,
-	41, 40, 39, 38, 132, 133, 37, 36, 134, 35,
-	34, 64, 740, 741, 33, 67, 32, 31, 30, 647,
-	61, 29, 651, 28, 27, 26, 25, 24, 23, 20,
-	19, 659, 21, 18, 22, 66, 17, 16, 15, 13,
-	14, 12, 11, 674, 7, 10, 9, 8, 315, 6,
-	5, 0, 0, 0, 0, 0, 68,
+	791, 904, 506, 229, 926, 771, 880, 86, 419, 869,
+	690, 717, 894, 790, 739, 643, 705, 695, 4, 712,
+	261, 546, 389, 628, 70, 769, 547, 204, 489, 417,
+	438, 234, 380, 319, 632, 244, 316, 230, 2, 228,
+	173, 851, 153, 278, 159, 160, 161, 165, 166, 852,
+	505, 80, 345, 346, 629, 670, 669, 84, 85, 630,
+	850, 601, 854, 178, 162, 163, 167, 164, 160, 161,
+	165, 166, 162, 163, 167, 164, 160, 161, 165, 166,
+	88, 345, 346, 58, 497, 148, 710, 558, 465, 610,
+	611, 492, 905, 612, 212, 387, 156, 922, 902, 74,
+	233, 887, 88, 875, 493, 345, 346, 345, 346, 203,
+	565, 211, 138, 202, 212, 88, 205, 75, 280, 88,
+	211, 937, 203, 212, 210, 213, 202, 845, 206, 205,
+	76, 82, 79, 83, 81, 224, 87, 226, 80, 268,
+	77, 88, 269, 73, 84, 85, 839, 206, 443, 838,
+	206, 88, 442, 788, 232, 205, 181, 785, 766, 605,
+	606, 722, 569, 206, 868, 205, 256, 162, 163, 167,
+	164, 160, 161, 165, 166, 168, 675, 172, 866, 345,
+	346, 211, 265, 154, 212, 162, 163, 167, 164, 160,
+	161, 165, 166, 674, 279, 263, 673, 313, 672, 264,
+	542, 646, 539, 540, 75, 774, 88, 283, 289, 284,
+	855, 774, 728, 727, 58, 287, 288, 76, 82, 79,
+	83, 81, 80, 87, 554, 545, 543, 77, 84, 85,
+	73, 556, 211, 603, 329, 212, 604, 501, 502, 216,
+	245, 430, 259, 219, 176, 504, 503, 297, 298, 299,
+	227, 527, 306, 330, 407, 526, 311, 145, 406, 378,
+	270, 271, 272, 273, 274, 275, 276, 277, 348, 305,
+	931, 344, 343, 304, 773, 201, 245, 143, 282, 870,
+	777, 349, 350, 718, 867, 741, 706, 548, 75, 634,
+	88, 291, 798, 763, 295, 762, 754, 247, 137, 715,
+	714, 76, 82, 79, 83, 81, 71, 87, 644, 645,
+	701, 77, 659, 658, 73, 622, 648, 647, 393, 621,
+	600, 598, 174, 555, 597, 441, 595, 593, 332, 409,
+	580, 579, 451, 206, 578, 573, 347, 571, 455, 456,
+	557, 544, 529, 498, 482, 481, 478, 206, 706, 206,
+	477, 458, 416, 444, 470, 471, 391, 377, 376, 375,
+	394, 372, 371, 370, 146, 402, 367, 404, 365, 336,
+	468, 335, 411, 379, 412, 334, 333, 463, 464, 457,
+	328, 459, 327, 491, 144, 169, 385, 326, 321, 314,
+	494, 472, 312, 309, 171, 170, 511, 292, 88, 285,
+	486, 258, 220, 487, 218, 214, 200, 392, 198, 169,
+	396, 398, 531, 616, 614, 510, 577, 515, 171, 170,
+	447, 517, 158, 657, 414, 215, 581, 567, 528, 448,
+	454, 530, 576, 445, 405, 538, 325, 933, 826, 825,
+	441, 683, 566, 485, 484, 245, 245, 415, 541, 939,
+	919, 206, 260, 206, 802, 245, 563, 801, 69, 564,
+	461, 908, 901, 886, 553, 520, 885, 523, 206, 575,
+	562, 568, 572, 570, 532, 859, 841, 797, 796, 794,
+	294, 793, 58, 719, 707, 586, 602, 499, 589, 833,
+	703, 585, 59, 60, 702, 688, 495, 594, 588, 462,
+	583, 449, 65, 384, 62, 592, 208, 513, 514, 934,
+	516, 623, 624, 635, 63, 879, 617, 525, 639, 607,
+	800, 743, 836, 689, 534, 536, 537, 64, 618, 615,
+	587, 67, 631, 660, 637, 638, 61, 488, 608, 640,
+	469, 668, 466, 354, 353, 351, 656, 324, 713, 340,
+	69, 66, 932, 920, 342, 664, 906, 666, 667, 896,
+	671, 847, 812, 383, 795, 731, 732, 844, 641, 730,
+	613, 591, 68, 347, 590, 206, 582, 694, 157, 789,
+	320, 177, 698, 431, 317, 149, 767, 221, 207, 151,
+	206, 708, 709, 496, 787, 685, 395, 397, 399, 693,
+	929, 842, 687, 233, 907, 408, 704, 834, 782, 833,
+	413, 682, 680, 620, 491, 193, 225, 194, 925, 671,
+	916, 494, 699, 711, 320, 636, 899, 874, 209, 318,
+	716, 814, 748, 770, 58, 475, 654, 655, 307, 308,
+	734, 735, 720, 726, 410, 662, 663, 403, 665, 781,
+	724, 302, 303, 191, 192, 744, 745, 733, 737, 753,
+	742, 341, 768, 401, 747, 150, 339, 758, 749, 760,
+	761, 310, 296, 318, 652, 751, 752, 179, 642, 179,
+	184, 185, 186, 756, 757, 188, 759, 189, 776, 519,
+	266, 736, 267, 512, 684, 432, 723, 780, 764, 364,
+	721, 521, 80, 524, 147, 120, 320, 775, 84, 85,
+	533, 535, 876, 619, 784, 386, 356, 357, 358, 359,
+	360, 361, 286, 176, 363, 362, 422, 423, 3, 827,
+	426, 429, 792, 427, 428, 877, 809, 420, 424, 426,
+	429, 119, 427, 428, 117, 257, 118, 803, 421, 300,
+	301, 182, 183, 807, 819, 820, 738, 804, 813, 822,
+	823, 811, 824, 815, 816, 808, 750, 799, 75, 425,
+	88, 818, 190, 713, 755, 765, 821, 805, 832, 691,
+	677, 76, 82, 79, 83, 81, 121, 87, 552, 830,
+	80, 77, 831, 124, 840, 835, 84, 85, 152, 837,
+	245, 122, 551, 550, 549, 123, 246, 846, 217, 199,
+	180, 849, 848, 434, 857, 561, 142, 696, 697, 649,
+	139, 864, 653, 140, 865, 245, 381, 858, 779, 778,
+	860, 661, 878, 856, 783, 746, 139, 139, 863, 678,
+	651, 574, 467, 518, 871, 290, 437, 650, 882, 366,
+	810, 322, 206, 352, 853, 596, 473, 141, 88, 889,
+	883, 884, 817, 522, 400, 888, 893, 479, 476, 76,
+	82, 79, 83, 81, 460, 87, 248, 895, 829, 77,
+	368, 828, 891, 892, 900, 80, 626, 627, 806, 903,
+	249, 84, 85, 250, 910, 911, 729, 369, 254, 507,
+	508, 252, 390, 98, 895, 509, 913, 918, 912, 917,
+	882, 909, 923, 921, 206, 253, 139, 928, 382, 262,
+	584, 139, 930, 140, 155, 390, 140, 140, 861, 862,
+	112, 725, 197, 928, 936, 58, 938, 935, 700, 374,
+	93, 89, 373, 90, 91, 179, 474, 453, 452, 100,
+	450, 75, 446, 88, 239, 238, 433, 97, 338, 92,
+	337, 331, 293, 255, 76, 82, 79, 83, 81, 94,
+	87, 96, 251, 890, 77, 223, 222, 73, 196, 111,
+	108, 109, 110, 115, 101, 195, 104, 155, 99, 388,
+	105, 80, 599, 483, 480, 139, 187, 84, 85, 560,
+	102, 559, 130, 436, 435, 103, 440, 439, 843, 881,
+	786, 692, 686, 681, 679, 58, 106, 107, 772, 914,
+	915, 113, 114, 927, 897, 59, 60, 872, 898, 873,
+	924, 95, 135, 740, 418, 65, 609, 62, 128, 625,
+	116, 125, 240, 127, 241, 490, 633, 63, 129, 281,
+	355, 175, 78, 243, 242, 235, 500, 236, 126, 88,
+	64, 231, 1, 72, 67, 54, 53, 52, 57, 61,
+	237, 82, 79, 83, 81, 56, 87, 55, 51, 50,
+	77, 49, 323, 131, 66, 48, 47, 46, 45, 44,
+	136, 43, 42, 41, 40, 39, 38, 37, 132, 133,
+	36, 35, 134, 34, 33, 68, 32, 31, 30, 29,
+	28, 27, 26, 25, 24, 23, 20, 19, 21, 18,
+	22, 17, 16, 15, 13, 14, 12, 11, 676, 7,
+	10, 9, 8, 315, 6, 5,
 }
 
 var yyPact = [...]int16{
-	1018, -1000, 432, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1007, -1000, 422, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 146, 894,
-	735, 963, 896, 786, 211, 205, 686, 564, 494, 1018,
-	900, 178, 467, 247, 112, 549, 296, 549, -1000, -1000,
-	190, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 456,
-	913, 738, 628, -1000, 608, 956, 635, 677, 573, -1000,
-	516, 533, 942, 941, -1000, -1000, -1000, 901, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 281, 736, 280,
-	-23, 491, 514, 8, 8, 278, 896, 722, 277, 103,
-	276, 490, 940, 939, 8, 528, 8, 895, -1000, -35,
-	443, 721, -23, 832, 936, 877, 935, 903, -1000, 676,
-	274, 96, -1000, 955, 892, -35, 944, 178, 651, 24,
-	549, 549, 549, 549, 549, 549, 549, 549, -79, -1,
-	158, 270, -1000, 661, 664, 664, 443, -1000, 781, 268,
-	934, 896, 580, 913, 913, 621, 571, 102, 913, 569,
-	267, 574, 913, -1000, -1000, 265, 8, 264, 563, 262,
-	803, 426, 311, 260, -1000, -1000, -1000, 259, 258, 178,
-	944, -1000, -1000, 931, -1000, 895, -1000, 257, -1000, -1000,
-	-1000, 254, 253, 252, -1000, 928, 926, -1000, -1000, 550,
-	536, -1000, -1000, 904, -83, -1000, 443, 244, 424, 810,
-	421, 417, -1000, -1000, 321, -99, 250, 787, 245, 844,
-	242, 239, 238, 910, 237, 236, -1000, 234, 8, -1000,
-	895, 806, 885, -1000, 955, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -109, -109, -109, -1000, -1000, -109, -1000, 391,
-	-1000, -1000, -1000, -1000, -1000, -1000, 549, 660, -1000, -17,
-	946, 881, -1000, 228, 895, 881, 913, 896, 896, 799,
-	566, 913, 559, 913, 310, 99, 889, 556, 913, -1000,
-	913, 896, -1000, -1000, 336, 524, -1000, 665, 86, 478,
-	616, 924, 746, 784, 8, -29, 308, 921, 300, 390,
-	920, 8, -1000, 918, 917, 303, -1000, 8, 8, -35,
-	227, -35, 841, 356, 382, 443, 443, -79, -65, 411,
-	813, 903, 410, 8, 8, 702, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 914, 552, 839, 226, 225,
-	-1000, 838, 954, 213, 209, -1000, 952, 335, 334, 892,
-	881, 408, -62, 895, -1000, 208, 207, 549, 82, 868,
-	884, -1000, 881, 868, 896, 895, 892, 895, 881, 780,
-	604, 913, 794, 913, 896, 90, 302, 206, 881, 868,
-	913, 896, 896, 895, 892, 45, -1000, -1000, 665, -1000,
-	37, 81, 204, 70, -1000, 117, 718, 713, 698, 695,
-	631, 54, 143, 200, -24, -1000, -1000, 755, -1000, 8,
-	353, 22, 301, 7, -1000, 7, 197, 178, 195, 779,
-	903, 325, 194, 193, 186, -1000, 299, -1000, 463, -1000,
-	-35, 898, -1000, -1000, -1000, -1000, 34, 406, 381, 903,
-	455, 454, -1000, 443, 183, 117, 174, 817, -1000, 173,
-	171, 948, -1000, 170, -48, 16, 806, 868, -42, -1000,
-	453, 166, 405, 106, -1000, 892, -1000, 650, -99, 895,
-	169, 168, 338, 338, -1000, 860, -57, -57, 134, 868,
-	-1000, 895, 892, 892, 868, 881, 868, 596, 163, 792,
-	776, 592, 896, 895, 892, 289, 164, 160, -1000, 868,
-	-1000, 896, 895, 892, 895, 892, 892, 868, -86, -88,
-	-1000, -1000, -1000, -1000, -1000, 439, -1000, -1000, 31, 30,
-	29, 28, -1000, -1000, -1000, -1000, 694, 773, 521, 519,
-	333, -1000, -1000, -1000, -1000, 614, 7, -1000, -1000, -1000,
-	508, 380, 403, 690, 497, 8, 757, -1000, -1000, -1000,
-	8, -35, 906, 157, 376, 375, 202, -1000, 374, 8,
-	8, -68, 665, 498, -1000, 155, -1000, -1000, 145, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 881, 111, 373, -1000,
-	-1000, -1000, -62, 627, 27, 626, 806, -1000, 881, -1000,
-	-1000, -1000, -1000, -1000, 51, 50, 870, -1000, -1000, -1000,
-	-1000, 452, 450, -1000, 892, 868, 868, -1000, 868, -1000,
-	163, 895, 115, 115, 401, 338, 338, 771, 567, 555,
-	163, 895, 892, 892, 868, 144, -1000, -1000, -1000, 895,
-	892, 892, 868, 892, 868, 868, -1000, 142, 140, 117,
-	-1000, -1000, -1000, -1000, 687, 12, 565, 551, 110, 551,
-	141, 761, -1000, -1000, 656, 548, 770, 178, -1000, 11,
-	4, 448, 8, -1000, -1000, -1000, -1000, 443, -1000, -1000,
-	-1000, 372, 371, 446, -1000, 368, 367, -1000, -1000, -1000,
-	139, -1000, -1000, 868, -1000, 400, -1000, -1000, -1000, 352,
-	-1000, 881, 868, 861, -1000, -57, 134, -1000, -1000, 868,
-	-1000, -1000, -1000, 895, 881, -1000, 442, -1000, -1000, 115,
-	-1000, -1000, 554, 163, 163, 895, 892, 868, 868, -1000,
-	-1000, 892, 868, 868, -1000, 868, -1000, -1000, 328, 327,
-	-1000, -1000, 669, 854, 852, 680, 117, -1000, 110, 515,
-	513, 680, -1000, 397, -1000, -1000, 903, -4, -8, 690,
-	364, 502, -1000, 757, -1000, 440, -83, -1000, -1000, 116,
-	-1000, -1000, -1000, 111, -53, -1000, -84, 868, -1000, 49,
-	-1000, -1000, -1000, 881, 868, 115, 361, 163, 895, 895,
-	892, 868, -1000, -1000, 868, -1000, -1000, -1000, 25, 113,
-	17, -1000, -1000, -1000, 439, -1000, 109, 109, 545, -9,
-	638, 672, -1000, -1000, 769, 398, 8, 8, -1000, -1000,
-	360, 357, -13, 111, -1000, 868, -1000, -1000, -1000, 895,
-	892, 892, 868, -1000, -1000, -1000, -1000, 723, -1000, 438,
-	-1000, 543, -1000, 109, 355, -1000, -19, 690, -21, -1000,
-	-1000, -1000, -1000, 351, -1000, -1000, 892, 868, 868, -1000,
-	-1000, 723, 109, 541, -1000, 109, -1000, 110, -1000, -1000,
-	345, 437, -1000, 868, -1000, -1000, -1000, -1000, 539, -1000,
-	8, -1000, -1000, 500, -21, -1000, -1000, 105, -1000, 436,
-	319, 393, -1000, -1000, 8, -31, -21, -1000, -1000, -1000,
-	340, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 159, 898,
+	700, 997, 917, 811, 242, 222, 626, 548, 479, 1007,
+	918, 822, 451, 283, 34, 639, 280, 639, -1000, -1000,
+	180, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 460,
+	938, 763, 672, -1000, 606, 992, 611, 714, 574, -1000,
+	521, 529, 978, 971, -1000, -1000, -1000, 923, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 266, 761, 264,
+	-16, 478, 499, -31, -31, 263, 917, 760, 262, 100,
+	260, 477, 969, 968, -31, 524, -31, 914, -1000, -29,
+	928, 758, -16, 869, 965, 894, 956, 927, -1000, 687,
+	259, 99, -1000, 991, 908, -29, 981, 822, 619, -3,
+	639, 639, 639, 639, 639, 639, 639, 639, -87, -12,
+	136, 257, -1000, 656, 659, 659, 928, -1000, 814, 255,
+	955, 917, 592, 938, 938, 670, 572, 131, 938, 559,
+	251, 591, 938, -1000, -1000, 250, -31, 247, 553, 246,
+	820, 418, 298, 245, -1000, -1000, -1000, 240, 238, 822,
+	981, -1000, -1000, 954, -1000, 914, -1000, 234, -1000, -1000,
+	-1000, 233, 229, 227, -1000, 953, 951, -1000, -1000, 539,
+	534, -1000, -1000, 474, -97, -1000, 928, 256, 416, 826,
+	415, 414, -1000, -1000, 584, -79, 226, 818, 224, 873,
+	221, 220, 219, 935, 217, 216, -1000, 215, -31, -1000,
+	914, 797, 906, -1000, 991, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -110, -110, -110, -1000, -1000, -110, -1000, 373,
+	-1000, -1000, -1000, -1000, -1000, -1000, 639, 649, -1000, 30,
+	984, 889, -1000, 214, 914, 889, 938, 917, 917, 833,
+	583, 938, 567, 938, 296, 116, 912, 564, 938, -1000,
+	938, 917, -1000, -1000, 315, 509, -1000, 688, 98, 463,
+	623, 949, 776, 815, -31, 10, 295, 945, 291, 371,
+	943, -31, -1000, 941, 940, 292, -1000, -31, -31, -29,
+	209, -29, 851, 330, 369, 928, 928, -87, -42, 413,
+	817, 927, 411, -31, -31, 727, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 939, 554, 844, 208, 204,
+	-1000, 843, 990, 203, 202, -1000, 989, 312, 311, 908,
+	889, 408, -51, 914, 484, 16, 201, 639, 105, 885,
+	893, -1000, 889, 885, 917, 914, 908, 914, 889, 812,
+	613, 938, 832, 938, 917, 113, 290, 200, 889, 885,
+	938, 917, 917, 914, 908, 60, -1000, -1000, 688, -1000,
+	56, 83, 199, 82, -1000, 145, 755, 754, 753, 739,
+	635, 81, 181, 198, -58, -1000, -1000, 783, -1000, -31,
+	329, 39, 289, 20, -1000, 20, 195, 822, 193, 810,
+	927, 294, 192, 189, 188, -1000, 288, -1000, 449, -1000,
+	-29, 910, -1000, -1000, -1000, -1000, 75, 401, 368, 927,
+	447, 444, -1000, 928, 185, 145, 184, 831, -1000, 182,
+	179, 988, -1000, 178, -84, 90, 797, 885, -53, -1000,
+	443, 275, 400, 274, -1000, 908, 399, -1000, 645, -79,
+	914, 177, 173, 267, 267, -1000, 870, -89, -89, 147,
+	885, -1000, 914, 908, 908, 885, 889, 885, 602, 176,
+	816, 809, 598, 917, 914, 908, 285, 171, 170, -1000,
+	885, -1000, 917, 914, 908, 914, 908, 908, 885, -93,
+	-94, -1000, -1000, -1000, -1000, -1000, 433, -1000, -1000, 54,
+	52, 49, 32, -1000, -1000, -1000, -1000, 731, 808, 517,
+	516, 309, -1000, -1000, -1000, -1000, 621, 20, -1000, -1000,
+	-1000, 502, 365, 394, 730, 493, -31, 782, -1000, -1000,
+	-1000, -31, -29, 931, 168, 364, 360, 206, -1000, 354,
+	-31, -31, -44, 688, 492, -1000, 158, -1000, -1000, 157,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 889, 141, 353,
+	-1000, -1000, -1000, -51, 629, 17, 625, 797, 922, -1000,
+	889, -1000, -1000, -1000, -1000, -1000, 70, 69, 881, -1000,
+	-1000, -1000, -1000, 442, 440, -1000, 908, 885, 885, -1000,
+	885, -1000, 176, 914, 143, 143, 392, 267, 267, 804,
+	588, 556, 176, 914, 908, 908, 885, 154, -1000, -1000,
+	-1000, 914, 908, 908, 885, 908, 885, 885, -1000, 153,
+	151, 145, -1000, -1000, -1000, -1000, 725, 14, 551, 552,
+	132, 552, 138, 795, -1000, -1000, 630, 550, 803, 822,
+	-1000, 13, 486, 9, 457, -31, -1000, -1000, -1000, -1000,
+	928, -1000, -1000, -1000, 351, 349, 437, -1000, 348, 347,
+	-1000, -1000, -1000, 150, -1000, -1000, 885, -1000, 391, -1000,
+	-1000, -1000, 327, -1000, 889, 928, 885, 871, -1000, -89,
+	147, -1000, -1000, 885, -1000, -1000, -1000, 914, 889, -1000,
+	435, -1000, -1000, 143, -1000, -1000, 555, 176, 176, 914,
+	908, 885, 885, -1000, -1000, 908, 885, 885, -1000, 885,
+	-1000, -1000, 307, 306, -1000, -1000, 669, 860, 857, 717,
+	145, -1000, 132, 513, 511, 717, -1000, 393, -1000, -1000,
+	927, 5, 2, 730, 346, 498, 445, -17, -1000, 782,
+	-1000, 434, -97, -1000, -1000, 144, -1000, -1000, -1000, 141,
+	-85, -1000, -103, 885, -68, -1000, 67, -1000, -1000, -1000,
+	889, 885, 143, 345, 176, 914, 914, 908, 885, -1000,
+	-1000, 885, -1000, -1000, -1000, 35, 142, 21, -1000, -1000,
+	-1000, 433, -1000, 137, 137, 545, -41, 644, 677, -1000,
+	-1000, 801, 386, -1000, -16, -1000, -31, -31, -1000, -1000,
+	336, 333, -43, 141, -1000, -1000, 885, -1000, -1000, -1000,
+	914, 908, 908, 885, -1000, -1000, -1000, -1000, 679, -1000,
+	432, -1000, 543, -1000, 137, 332, -1000, -46, 730, -52,
+	-1000, 429, 505, -1000, -1000, -1000, -1000, 331, -1000, -1000,
+	908, 885, 885, -1000, -1000, 679, 137, 536, -1000, 137,
+	-1000, 132, -1000, -1000, 320, 426, -16, -47, -1000, 885,
+	-1000, -1000, -1000, -1000, 533, -1000, -31, -1000, -1000, 496,
+	-52, -1000, -1000, -1000, -1000, 128, -1000, 425, 305, 380,
+	-1000, -1000, -31, -22, -52, -1000, -1000, -1000, 319, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 710, 1110, 1109, 1108, 1107, 20, 1106, 1105, 1104,
-	1103, 1102, 1101, 1100, 1099, 1098, 1097, 1096, 1094, 1093,
-	1092, 1090, 1089, 1088, 1087, 1086, 14, 1085, 1084, 1083,
-	1081, 1078, 1077, 1076, 1074, 1070, 1069, 1067, 1066, 1063,
-	1062, 1061, 1060, 1059, 1057, 6, 1055, 1054, 1053, 1052,
-	1051, 1050, 1047, 1045, 1044, 1043, 1042, 1041, 1040, 1038,
-	1035, 24, 18, 1033, 1032, 38, 559, 33, 37, 41,
-	1030, 30, 1029, 115, 1028, 17, 1027, 1025, 23, 1023,
-	1022, 40, 32, 13, 1021, 43, 1020, 1019, 21, 10,
-	1018, 9, 27, 1017, 19, 1, 1013, 28, 1012, 8,
-	7, 1006, 29, 426, 1005, 39, 16, 26, 0, 1003,
-	15, 1000, 22, 25, 5, 999, 997, 12, 995, 994,
-	3, 992, 991, 990, 11, 989, 4, 988, 987, 985,
-	2, 34, 31, 983, 974, 35, 36, 973, 970, 969,
-	963,
+	0, 728, 1135, 1134, 1133, 1132, 18, 1131, 1130, 1129,
+	1128, 1127, 1126, 1125, 1124, 1123, 1122, 1121, 1120, 1119,
+	1118, 1117, 1116, 1115, 1114, 1113, 15, 1112, 1111, 1110,
+	1109, 1108, 1107, 1106, 1104, 1103, 1101, 1100, 1097, 1096,
+	1095, 1094, 1093, 1092, 1091, 10, 1089, 1088, 1087, 1086,
+	1085, 1082, 1081, 1079, 1078, 1077, 1075, 1068, 1067, 1066,
+	1065, 24, 16, 1063, 1062, 38, 298, 39, 37, 42,
+	1061, 27, 3, 154, 1056, 112, 1055, 1054, 31, 1053,
+	1052, 99, 35, 14, 1051, 40, 1050, 1049, 34, 22,
+	1046, 20, 28, 1045, 50, 2, 1039, 32, 1036, 12,
+	8, 1034, 29, 7, 1033, 63, 19, 26, 0, 1031,
+	17, 1030, 21, 25, 9, 1029, 1028, 13, 1027, 1024,
+	4, 1023, 1020, 1019, 11, 1018, 5, 1014, 1013, 1012,
+	1, 1011, 1010, 1009, 1008, 6, 23, 33, 1007, 1006,
+	30, 36, 1004, 1003, 1001, 999,
 }
 
 var yyR1 = [...]uint8{
@@ -695,39 +704,40 @@ var yyR1 = [...]uint8{
 	6, 6, 61, 61, 63, 63, 63, 63, 63, 63,
 	85, 85, 84, 62, 62, 81, 81, 81, 81, 81,
 	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, 69, 69, 66, 67, 67, 67, 67, 67, 67,
-	67, 70, 68, 68, 68, 72, 73, 73, 73, 73,
-	73, 71, 71, 71, 91, 91, 92, 92, 108, 108,
-	93, 93, 93, 93, 93, 93, 93, 93, 124, 124,
-	97, 97, 98, 98, 98, 75, 75, 77, 77, 76,
-	76, 78, 78, 78, 78, 78, 78, 78, 78, 78,
-	78, 79, 82, 82, 86, 86, 86, 86, 86, 86,
-	86, 86, 86, 103, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 87, 87, 87, 89, 89, 88,
-	88, 90, 90, 90, 94, 131, 131, 95, 95, 95,
-	95, 96, 96, 96, 96, 2, 2, 3, 3, 136,
-	136, 136, 136, 136, 132, 132, 4, 102, 102, 101,
-	101, 101, 101, 101, 101, 101, 7, 7, 74, 74,
-	74, 74, 8, 8, 9, 9, 5, 5, 5, 10,
-	10, 99, 99, 100, 100, 100, 100, 11, 11, 12,
-	14, 13, 13, 15, 15, 16, 17, 19, 19, 19,
-	21, 21, 20, 20, 20, 22, 22, 18, 23, 23,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 52,
-	52, 52, 52, 52, 105, 105, 24, 24, 25, 25,
-	26, 26, 26, 26, 26, 83, 83, 104, 27, 27,
-	28, 28, 28, 28, 29, 29, 29, 29, 30, 30,
-	30, 30, 31, 31, 137, 137, 138, 127, 127, 128,
-	128, 128, 113, 113, 139, 139, 140, 118, 118, 119,
-	119, 123, 123, 111, 111, 51, 51, 135, 135, 133,
-	133, 134, 134, 134, 125, 125, 126, 126, 114, 114,
-	106, 106, 115, 116, 120, 120, 122, 121, 121, 121,
-	112, 112, 107, 32, 33, 34, 35, 35, 35, 35,
-	36, 36, 36, 36, 37, 38, 38, 39, 40, 41,
-	129, 129, 129, 129, 42, 43, 44, 44, 44, 46,
-	46, 46, 46, 47, 47, 45, 130, 130, 48, 48,
-	49, 49, 50, 53, 54, 117, 117, 110, 110, 58,
-	58, 59, 60, 60, 60, 60, 55, 56, 56, 56,
-	56, 56, 57, 57, 57, 57, 57,
+	81, 81, 69, 69, 66, 67, 67, 67, 67, 67,
+	67, 67, 70, 68, 68, 68, 72, 73, 73, 73,
+	73, 73, 71, 71, 71, 91, 91, 92, 92, 108,
+	108, 93, 93, 93, 93, 93, 93, 93, 93, 124,
+	124, 97, 97, 98, 98, 98, 75, 75, 77, 77,
+	76, 76, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 79, 82, 82, 86, 86, 86, 86, 86,
+	86, 86, 86, 86, 103, 80, 80, 80, 80, 80,
+	80, 80, 80, 80, 80, 87, 87, 87, 89, 89,
+	88, 88, 90, 90, 90, 94, 136, 136, 95, 95,
+	95, 95, 96, 96, 96, 96, 2, 2, 3, 3,
+	141, 141, 141, 141, 141, 137, 137, 4, 102, 102,
+	101, 101, 101, 101, 101, 101, 101, 7, 7, 74,
+	74, 74, 74, 8, 8, 9, 9, 5, 5, 5,
+	10, 10, 99, 99, 100, 100, 100, 100, 11, 11,
+	12, 14, 13, 13, 15, 15, 16, 17, 19, 19,
+	19, 21, 21, 20, 20, 20, 22, 22, 18, 23,
+	23, 109, 109, 109, 109, 109, 109, 109, 109, 109,
+	52, 52, 52, 52, 52, 105, 105, 24, 24, 25,
+	25, 26, 26, 26, 26, 26, 83, 83, 104, 27,
+	27, 28, 28, 28, 28, 29, 29, 29, 29, 30,
+	30, 30, 30, 31, 31, 142, 142, 143, 127, 127,
+	128, 128, 128, 113, 113, 144, 144, 145, 118, 118,
+	119, 119, 123, 123, 111, 111, 51, 51, 140, 140,
+	138, 138, 139, 139, 139, 125, 125, 126, 126, 114,
+	114, 106, 106, 115, 116, 120, 120, 122, 121, 121,
+	121, 112, 112, 107, 32, 33, 34, 35, 35, 35,
+	35, 36, 36, 36, 36, 37, 38, 38, 39, 40,
+	41, 129, 129, 129, 129, 42, 43, 44, 44, 44,
+	46, 46, 46, 46, 47, 47, 45, 130, 130, 48,
+	131, 131, 132, 132, 134, 134, 135, 135, 133, 49,
+	49, 50, 53, 54, 117, 117, 110, 110, 58, 58,
+	59, 60, 60, 60, 60, 55, 56, 56, 56, 56,
+	56, 57, 57, 57, 57, 57,
 }
 
 var yyR2 = [...]int8{
@@ -739,40 +749,41 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 10,
 	11, 8, 1, 3, 1, 3, 3, 1, 3, 3,
 	1, 2, 4, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 3, 2, 1, 1, 5,
-	6, 2, 0, 2, 1, 3, 1, 3, 3, 5,
-	1, 6, 3, 5, 3, 1, 5, 4, 4, 3,
-	1, 1, 1, 1, 3, 0, 1, 3, 1, 1,
-	1, 3, 4, 6, 7, 1, 3, 1, 4, 0,
-	4, 0, 1, 1, 1, 2, 0, 1, 3, 1,
-	3, 1, 3, 5, 5, 4, 6, 6, 5, 6,
-	6, 3, 1, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 1, 1, 1, 1,
-	1, 1, 3, 1, 1, 1, 1, 3, 0, 1,
-	3, 1, 2, 2, 2, 1, 1, 4, 2, 2,
-	0, 4, 2, 2, 0, 2, 3, 5, 4, 2,
-	1, 3, 3, 0, 3, 3, 2, 1, 2, 1,
-	2, 2, 2, 2, 1, 2, 9, 6, 2, 2,
-	2, 2, 5, 3, 7, 8, 6, 9, 9, 5,
-	4, 1, 2, 3, 3, 3, 3, 7, 6, 2,
-	3, 4, 3, 3, 2, 7, 6, 6, 7, 6,
-	5, 4, 6, 7, 6, 5, 4, 3, 8, 7,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
-	8, 7, 7, 6, 2, 0, 7, 6, 11, 10,
-	2, 2, 4, 2, 2, 1, 3, 1, 3, 2,
-	10, 9, 9, 8, 13, 12, 12, 11, 10, 9,
-	9, 8, 5, 5, 0, 5, 9, 0, 2, 0,
-	2, 6, 0, 2, 0, 3, 3, 0, 1, 0,
-	1, 0, 1, 0, 2, 2, 0, 2, 1, 2,
-	2, 2, 3, 2, 3, 3, 2, 0, 1, 3,
-	2, 0, 2, 2, 3, 1, 2, 3, 3, 0,
-	1, 3, 1, 3, 6, 4, 9, 8, 8, 7,
-	9, 8, 8, 7, 2, 7, 3, 3, 3, 10,
-	3, 3, 5, 0, 3, 6, 9, 11, 7, 4,
-	6, 2, 4, 2, 4, 10, 1, 3, 8, 6,
-	2, 4, 3, 2, 3, 1, 3, 1, 1, 10,
-	8, 2, 3, 5, 7, 5, 2, 6, 6, 6,
-	6, 6, 2, 6, 6, 10, 10,
+	3, 3, 3, 3, 4, 3, 9, 2, 1, 1,
+	5, 6, 2, 0, 2, 1, 3, 1, 3, 3,
+	5, 1, 6, 3, 5, 3, 1, 5, 4, 4,
+	3, 1, 1, 1, 1, 3, 0, 1, 3, 1,
+	1, 1, 3, 4, 6, 7, 1, 3, 1, 4,
+	0, 4, 0, 1, 1, 1, 2, 0, 1, 3,
+	1, 3, 1, 3, 5, 5, 4, 6, 6, 5,
+	6, 6, 3, 1, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 1, 3, 1, 1, 1, 1, 3, 0,
+	1, 3, 1, 2, 2, 2, 1, 1, 4, 2,
+	2, 0, 4, 2, 2, 0, 2, 3, 5, 4,
+	2, 1, 3, 3, 0, 3, 3, 2, 1, 2,
+	1, 2, 2, 2, 2, 1, 2, 9, 6, 2,
+	2, 2, 2, 5, 3, 7, 8, 6, 9, 9,
+	5, 4, 1, 2, 3, 3, 3, 3, 7, 6,
+	2, 3, 4, 3, 3, 2, 7, 6, 6, 7,
+	6, 5, 4, 6, 7, 6, 5, 4, 3, 8,
+	7, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 8, 7, 7, 6, 2, 0, 7, 6, 11,
+	10, 2, 2, 4, 2, 2, 1, 3, 1, 3,
+	2, 10, 9, 9, 8, 13, 12, 12, 11, 10,
+	9, 9, 8, 5, 5, 0, 5, 9, 0, 2,
+	0, 2, 6, 0, 2, 0, 3, 3, 0, 1,
+	0, 1, 0, 1, 0, 2, 2, 0, 2, 1,
+	2, 2, 2, 3, 2, 3, 3, 2, 0, 1,
+	3, 2, 0, 2, 2, 3, 1, 2, 3, 3,
+	0, 1, 3, 1, 3, 6, 4, 9, 8, 8,
+	7, 9, 8, 8, 7, 2, 7, 3, 3, 3,
+	10, 3, 3, 5, 0, 3, 6, 9, 11, 7,
+	4, 6, 2, 4, 2, 4, 10, 1, 3, 9,
+	2, 0, 2, 0, 2, 0, 1, 3, 3, 2,
+	4, 3, 2, 3, 1, 3, 1, 1, 10, 8,
+	2, 3, 5, 7, 5, 2, 6, 6, 6, 6,
+	6, 2, 6, 6, 10, 10,
 }
 
 var yyChk = [...]int16{
@@ -782,93 +793,94 @@ var yyChk = [...]int16{
 	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
 	-41, -42, -43, -44, -46, -47, -48, -49, -50, -52,
 	-53, -54, -58, -59, -60, -55, -56, -57, 8, 18,
-	19, 62, 30, 40, 53, 28, 77, 57, 98, 126,
-	-61, 145, -63, 153, -81, 127, 140, 150, -80, 142,
-	63, 144, 141, 143, 69, 70, -103, 146, 129, 43,
+	19, 62, 30, 40, 53, 28, 77, 57, 98, 128,
+	-61, 147, -63, 155, -81, 129, 142, 152, -80, 144,
+	63, 146, 143, 145, 69, 70, -103, 148, 131, 43,
 	45, 46, 61, 42, 71, -109, 73, 59, 5, 90,
-	51, 86, 102, 107, 88, 92, 116, 117, 82, 83,
-	84, 81, 32, 121, 122, 85, 140, 44, 46, 41,
+	51, 86, 102, 107, 88, 92, 118, 119, 82, 83,
+	84, 81, 32, 123, 124, 85, 142, 44, 46, 41,
 	5, 86, 101, 105, 93, 44, 61, 46, 41, 51,
 	5, 86, 101, 102, 105, 35, 93, -66, -75, 4,
-	9, 46, 5, 35, 140, 35, 140, 78, -6, 37,
-	115, 108, -1, -69, -75, 6, -61, 125, 137, 10,
-	153, 154, 149, 150, 152, 155, 156, 151, -81, 127,
-	137, 136, -81, -85, 140, -84, 64, 119, -105, 7,
+	9, 46, 5, 35, 142, 35, 142, 78, -6, 37,
+	117, 110, -1, -69, -75, 6, -61, 127, 139, 10,
+	155, 156, 151, 152, 154, 157, 158, 153, -81, 129,
+	139, 138, -81, -85, 142, -84, 64, 121, -105, 7,
 	47, -105, 79, 80, 74, 75, 76, 4, 74, 76,
-	58, 79, 80, 94, 88, 7, 7, 9, 140, 48,
-	140, -73, 140, 136, -71, 143, -103, 108, 7, 127,
-	-108, 140, 143, -108, 140, -66, -75, 48, 140, 141,
-	140, 108, 7, 7, -108, 92, -108, -75, -67, -72,
-	-68, -70, -73, 127, -78, -76, 127, 140, 27, 26,
-	112, 114, -77, -79, -82, -81, 48, -73, 7, 21,
-	24, 7, 7, 21, 4, 7, -6, 58, 140, 141,
-	-66, -91, 11, -67, -69, -61, 71, 73, 140, 143,
-	-81, -81, -81, -81, -81, -81, -81, -81, 128, -61,
-	128, -87, 140, 71, 73, 140, 66, -85, -85, -78,
-	31, -75, 140, 7, -66, -75, 80, -105, -105, -105,
-	79, 80, 79, 80, 140, 136, -105, 79, 80, 140,
-	80, -105, 140, -108, 140, -4, -136, 31, 118, -132,
-	71, 140, 31, -51, 127, 136, 140, 140, 140, -61,
-	-69, 7, -75, 140, 140, 140, 140, 7, 7, 125,
-	10, 125, 20, -65, -68, 147, 148, -81, -78, 25,
-	26, 127, 27, 127, 127, -86, 130, 131, 132, 133,
-	134, 135, 139, 138, 113, 140, 31, 140, 7, 24,
-	140, 140, 140, 7, 4, 140, 140, 140, -108, -75,
-	-97, 29, 12, -66, 128, -81, 66, 65, 5, -89,
-	13, 140, -75, -89, -105, -66, -75, -66, -75, -66,
-	31, 80, -105, 80, -105, 136, 140, 136, -66, -89,
-	80, -105, -105, -66, -75, 130, -136, -102, -101, -100,
+	58, 79, 80, 94, 88, 7, 7, 9, 142, 48,
+	142, -73, 142, 138, -71, 145, -103, 110, 7, 129,
+	-108, 142, 145, -108, 142, -66, -75, 48, 142, 143,
+	142, 110, 7, 7, -108, 92, -108, -75, -67, -72,
+	-68, -70, -73, 129, -78, -76, 129, 142, 27, 26,
+	114, 116, -77, -79, -82, -81, 48, -73, 7, 21,
+	24, 7, 7, 21, 4, 7, -6, 58, 142, 143,
+	-66, -91, 11, -67, -69, -61, 71, 73, 142, 145,
+	-81, -81, -81, -81, -81, -81, -81, -81, 130, -61,
+	130, -87, 142, 71, 73, 142, 66, -85, -85, -78,
+	31, -75, 142, 7, -66, -75, 80, -105, -105, -105,
+	79, 80, 79, 80, 142, 138, -105, 79, 80, 142,
+	80, -105, 142, -108, 142, -4, -141, 31, 120, -137,
+	71, 142, 31, -51, 129, 138, 142, 142, 142, -61,
+	-69, 7, -75, 142, 142, 142, 142, 7, 7, 127,
+	10, 127, 20, -65, -68, 149, 150, -81, -78, 25,
+	26, 129, 27, 129, 129, -86, 132, 133, 134, 135,
+	136, 137, 141, 140, 115, 142, 31, 142, 7, 24,
+	142, 142, 142, 7, 4, 142, 142, 142, -108, -75,
+	-97, 29, 12, -66, 130, -81, 66, 65, 5, -89,
+	13, 142, -75, -89, -105, -66, -75, -66, -75, -66,
+	31, 80, -105, 80, -105, 138, 142, 138, -66, -89,
+	80, -105, -105, -66, -75, 132, -141, -102, -101, -100,
 	49, 60, 38, 39, 50, 81, 51, 54, 55, 52,
-	141, 118, 72, 7, 37, -137, -138, 31, -135, -133,
-	-134, -108, 140, 136, -71, 136, 7, 127, 136, 128,
-	7, -108, 7, 7, 136, -108, -108, -67, 140, -67,
-	23, 128, 128, -78, -78, 128, 127, 25, -6, 127,
-	-108, -108, -82, 127, 7, 81, 24, 140, 140, 24,
-	4, 140, 140, 4, 130, 130, -91, -89, 127, -92,
-	-93, -108, 140, 153, -103, -75, 68, 140, -81, -74,
-	130, 131, 139, 138, -94, -95, 14, 15, 12, -89,
-	-95, -66, -75, -75, -91, -75, -89, 31, 76, -105,
-	-66, 31, -105, -66, -75, 140, 136, 136, 140, -89,
-	-95, -105, -66, -75, -66, -75, -75, -91, 140, 141,
-	-102, 142, 141, 140, 141, -112, -107, 140, 49, 49,
-	49, 49, -132, 141, 140, 50, 140, 143, -139, -140,
-	32, -135, 125, 128, 71, -108, 136, -71, 140, -71,
-	140, -61, 140, 31, -6, 136, 120, 140, 140, 140,
-	136, 125, -67, 10, -61, -6, 127, 128, -6, 125,
-	125, -78, 140, -112, 140, 24, 140, 140, 4, 140,
-	143, -108, 141, 144, 69, 70, -97, -94, -98, 140,
-	141, 144, 125, 137, 127, 137, -91, 68, -75, 140,
-	140, -103, -103, -96, 16, 17, -131, 141, 146, -131,
-	-88, -90, 140, -95, -75, -91, -91, -95, -89, -94,
-	76, -26, 130, 131, 25, 139, 138, -66, 31, 31,
-	76, -66, -75, -75, -91, 136, 140, 140, -95, -66,
-	-75, -75, -91, -75, -91, -91, -95, 147, 147, 125,
-	142, 142, 142, 142, -10, 49, 31, -127, 95, -128,
-	95, 130, 73, -71, -129, 100, 128, 127, -45, 49,
-	106, -108, -110, 35, 36, -108, -67, 7, 140, 128,
-	128, -6, -62, 140, 128, -108, -108, 128, -102, -106,
-	56, 140, 140, -89, -124, 140, 128, -92, 71, 142,
-	71, -97, -89, 141, 141, 15, 125, 123, 124, -91,
-	-95, -95, -94, -26, -75, -83, -104, 140, -83, 127,
-	-103, -103, 31, 76, 76, -26, -75, -91, -91, -95,
-	140, -75, -91, -91, -95, -91, -95, -95, 140, 140,
-	-107, 50, 142, 35, 109, -113, 81, -126, -125, 140,
-	73, -113, -126, 140, 34, 33, 67, 99, 58, 31,
-	-61, 142, 142, 120, -117, -108, -78, 128, 128, 125,
-	128, 128, 140, -94, 127, 128, 125, -89, -94, 17,
-	-131, -88, -95, -75, -89, 125, -83, 76, -26, -26,
-	-75, -91, -95, -95, -91, -95, -95, -95, 130, 130,
-	60, 21, 21, -106, -112, -126, 96, 96, -106, 127,
-	-6, 142, 142, -45, 128, 103, -110, 125, -62, -124,
-	143, 142, 150, -94, 141, -89, -95, -83, 128, -26,
-	-75, -75, -91, -95, -95, 141, 140, 141, -114, 140,
-	-114, -118, -115, 82, 142, 68, 58, 31, 127, -117,
-	-117, 128, 128, 142, -124, -95, -75, -91, -91, -95,
-	-99, -100, 125, -119, -116, 83, -114, 128, 142, -45,
-	-130, 142, 128, -91, -95, -95, -99, -114, -123, -122,
-	84, -114, -126, 128, 125, -95, -111, 85, -120, -121,
-	-108, 104, -130, 140, 125, 130, 127, -120, -108, 141,
-	-130, 128,
+	143, 120, 72, 7, 37, -142, -143, 31, -140, -138,
+	-139, -108, 142, 138, -71, 138, 7, 129, 138, 130,
+	7, -108, 7, 7, 138, -108, -108, -67, 142, -67,
+	23, 130, 130, -78, -78, 130, 129, 25, -6, 129,
+	-108, -108, -82, 129, 7, 81, 24, 142, 142, 24,
+	4, 142, 142, 4, 132, 132, -91, -89, 129, -92,
+	-93, -108, 142, 155, -103, -75, 109, 68, 142, -81,
+	-74, 132, 133, 141, 140, -94, -95, 14, 15, 12,
+	-89, -95, -66, -75, -75, -91, -75, -89, 31, 76,
+	-105, -66, 31, -105, -66, -75, 142, 138, 138, 142,
+	-89, -95, -105, -66, -75, -66, -75, -75, -91, 142,
+	143, -102, 144, 143, 142, 143, -112, -107, 142, 49,
+	49, 49, 49, -137, 143, 142, 50, 142, 145, -144,
+	-145, 32, -140, 127, 130, 71, -108, 138, -71, 142,
+	-71, 142, -61, 142, 31, -6, 138, 122, 142, 142,
+	142, 138, 127, -67, 10, -61, -6, 129, 130, -6,
+	127, 127, -78, 142, -112, 142, 24, 142, 142, 4,
+	142, 145, -108, 143, 146, 69, 70, -97, -94, -98,
+	142, 143, 146, 127, 139, 129, 139, -91, 129, 68,
+	-75, 142, 142, -103, -103, -96, 16, 17, -136, 143,
+	148, -136, -88, -90, 142, -95, -75, -91, -91, -95,
+	-89, -94, 76, -26, 132, 133, 25, 141, 140, -66,
+	31, 31, 76, -66, -75, -75, -91, 138, 142, 142,
+	-95, -66, -75, -75, -91, -75, -91, -91, -95, 149,
+	149, 127, 144, 144, 144, 144, -10, 49, 31, -127,
+	95, -128, 95, 132, 73, -71, -129, 100, 130, 129,
+	-45, 49, -131, 106, -108, -110, 35, 36, -108, -67,
+	7, 142, 130, 130, -6, -62, 142, 130, -108, -108,
+	130, -102, -106, 56, 142, 142, -89, -124, 142, 130,
+	-92, 71, 144, 71, -97, 9, -89, 143, 143, 15,
+	127, 125, 126, -91, -95, -95, -94, -26, -75, -83,
+	-104, 142, -83, 129, -103, -103, 31, 76, 76, -26,
+	-75, -91, -91, -95, 142, -75, -91, -91, -95, -91,
+	-95, -95, 142, 142, -107, 50, 144, 35, 111, -113,
+	81, -126, -125, 142, 73, -113, -126, 142, 34, 33,
+	67, 99, 58, 31, -61, 144, -132, 108, 144, 122,
+	-117, -108, -78, 130, 130, 127, 130, 130, 142, -94,
+	129, 130, 127, -89, -78, -94, 17, -136, -88, -95,
+	-75, -89, 127, -83, 76, -26, -26, -75, -91, -95,
+	-95, -91, -95, -95, -95, 132, 132, 60, 21, 21,
+	-106, -112, -126, 96, 96, -106, 129, -6, 144, 144,
+	-45, 130, 103, -134, 122, 144, -110, 127, -62, -124,
+	145, 144, 152, -94, 130, 143, -89, -95, -83, 130,
+	-26, -75, -75, -91, -95, -95, 143, 142, 143, -114,
+	142, -114, -118, -115, 82, 144, 68, 58, 31, 129,
+	-135, -133, -72, -117, -117, 130, 130, 144, -124, -95,
+	-75, -91, -91, -95, -99, -100, 127, -119, -116, 83,
+	-114, 130, 144, -45, -130, 144, 127, 99, 130, -91,
+	-95, -95, -99, -114, -123, -122, 84, -114, -126, 130,
+	127, -135, 144, -95, -111, 85, -120, -121, -108, 104,
+	-130, 142, 127, 132, 129, -120, -108, 143, -130, 130,
 }
 
 var yyDef = [...]int16{
@@ -878,93 +890,94 @@ var yyDef = [...]int16{
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
 	51, 52, 53, 54, 55, 56, 57, 58, 0, 0,
-	0, 0, 136, 0, 0, 0, 0, 0, 0, 3,
-	-2, 0, 62, 64, 67, 0, 164, 0, 87, 88,
-	0, 166, 167, 168, 169, 170, 171, 173, 163, 195,
-	275, 0, 275, 239, 0, 0, 0, 0, 0, 364,
-	0, 0, 383, 390, 393, 401, 406, 412, 260, 261,
-	262, 263, 264, 265, 266, 267, 268, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 136, 0, 0, 0,
-	0, 0, 0, 381, 0, 0, 0, 136, 244, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 4, 0, 115, 0, 92, 0, 0, 0,
+	0, 0, 137, 0, 0, 0, 0, 0, 0, 3,
+	-2, 0, 62, 64, 67, 0, 165, 0, 88, 89,
+	0, 167, 168, 169, 170, 171, 172, 174, 164, 196,
+	276, 0, 276, 240, 0, 0, 0, 0, 0, 365,
+	0, 0, 384, 399, 402, 410, 415, 421, 261, 262,
+	263, 264, 265, 266, 267, 268, 269, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 382, 0, 0, 0, 137, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 290, 0,
+	0, 0, 4, 0, 116, 0, 93, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 70, 0, 196, 136, 0,
-	223, 136, 0, 275, 275, 275, 0, 0, 275, 0,
-	0, 0, 275, 367, 374, 0, 0, 0, 203, 0,
-	0, 326, 111, 0, 110, 112, 113, 0, 0, 0,
-	92, 118, 119, 0, 240, 136, 242, 0, 257, 353,
-	368, 0, 0, 0, 392, 402, 0, 243, 93, 94,
-	96, 100, 105, 0, 135, 141, 0, 164, 0, 0,
-	0, 0, 139, 137, 0, 152, 0, 366, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 0, 394,
-	136, 131, 0, 91, 0, 63, 65, 66, 68, 69,
+	0, 0, 87, 0, 0, 70, 0, 197, 137, 0,
+	224, 137, 0, 276, 276, 276, 0, 0, 276, 0,
+	0, 0, 276, 368, 375, 0, 0, 0, 204, 0,
+	0, 327, 112, 0, 111, 113, 114, 0, 0, 0,
+	93, 119, 120, 0, 241, 137, 243, 0, 258, 354,
+	369, 0, 0, 0, 401, 411, 0, 244, 94, 95,
+	97, 101, 106, 0, 136, 142, 0, 165, 0, 0,
+	0, 0, 140, 138, 0, 153, 0, 367, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 403,
+	137, 132, 0, 92, 0, 63, 65, 66, 68, 69,
 	75, 76, 77, 78, 79, 80, 81, 82, 83, 0,
-	85, 165, 174, 175, 176, 172, 0, 0, 71, 0,
-	0, 178, 274, 0, 136, 178, 275, 136, 136, 0,
-	0, 275, 0, 275, 269, 0, 178, 0, 275, 355,
-	275, 136, 384, 391, 0, 203, 198, 0, 0, 200,
-	0, 0, 0, 304, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 379, 382, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 0, 0, 0, 0, 0, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 0, 0, 0, 0, 0,
-	251, 0, 0, 0, 0, 256, 0, 0, 0, 115,
-	178, 0, 0, 136, 84, 0, 0, 0, 0, 190,
-	0, 222, 178, 190, 136, 136, 115, 136, 178, 0,
-	0, 275, 0, 275, 136, 0, 0, 0, 178, 190,
-	275, 136, 136, 136, 115, 0, 197, 206, 207, 209,
-	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
-	199, 0, 0, 0, 0, 302, 303, 314, 325, 328,
-	0, 0, 111, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 403, 405, 95, 98, 97,
-	0, 102, 104, 138, 140, -2, 0, 0, 0, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 250, 0,
-	0, 0, 255, 0, 0, 0, 131, 190, 0, 114,
-	116, 120, 118, 125, 127, 115, 89, 0, 72, 136,
-	0, 0, 0, 0, 217, 194, 0, 0, 0, 190,
-	238, 136, 115, 115, 190, 178, 190, 0, 0, 0,
-	0, 0, 136, 136, 115, 0, 0, 0, 273, 190,
-	277, 136, 136, 115, 136, 115, 115, 190, 413, 414,
-	208, 210, 211, 212, 213, 215, 350, 352, 0, 0,
-	0, 0, 201, 202, 204, 205, 0, 226, 307, 309,
-	0, 327, 329, 330, 331, 333, 0, 108, 111, 107,
-	373, 0, 0, 0, 389, 0, 0, 246, 375, 380,
-	0, 0, 0, 0, 0, 0, 0, 145, 0, 0,
-	0, 0, 0, 341, 247, 0, 249, 252, 0, 254,
-	354, 407, 408, 409, 410, 411, 178, 129, 0, 132,
-	133, 134, 0, 0, 0, 0, 131, 90, 178, 218,
-	219, 220, 221, 184, 0, 0, 188, 185, 186, 189,
-	177, 179, 181, 237, 115, 190, 190, 363, 190, 259,
-	0, 136, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 115, 115, 190, 0, 271, 272, 276, 136,
-	115, 115, 190, 115, 190, 190, 359, 0, 0, 0,
-	233, 234, 235, 236, 224, 0, 0, 312, 337, 312,
-	337, 0, 332, 106, 0, 0, 0, 0, 378, 0,
-	0, 0, 0, 397, 398, 404, 99, 0, 103, 143,
-	144, 0, 0, 73, 148, 0, 0, 153, 245, 365,
-	0, 248, 253, 190, 61, 0, 130, 117, 121, 0,
-	126, 178, 190, 192, 193, 0, 0, 182, 183, 190,
-	361, 362, 258, 136, 178, 280, 285, 287, 281, 0,
-	283, 284, 0, 0, 0, 136, 115, 190, 190, 293,
-	270, 115, 190, 190, 301, 190, 357, 358, 0, 0,
-	351, 225, 0, 0, 0, 341, 0, 308, 337, 0,
-	0, 341, 310, 0, 315, 316, 0, 0, 0, 0,
-	0, 0, 388, 0, 400, 395, 101, 146, 147, 0,
-	149, 150, 340, 129, 0, 122, 0, 190, 216, 0,
-	187, 180, 360, 178, 190, 0, 0, 0, 136, 136,
-	115, 190, 291, 292, 190, 299, 300, 356, 0, 0,
-	0, 227, 228, 305, 313, 336, 0, 0, 317, 0,
-	0, 370, 371, 376, 0, 0, 0, 0, 74, 59,
-	0, 0, 0, 129, 191, 190, 279, 286, 282, 136,
-	115, 115, 190, 290, 298, 416, 415, 230, 334, 338,
-	335, 319, 318, 0, 0, 369, 0, 0, 0, 399,
-	396, 128, 123, 0, 60, 278, 115, 190, 190, 297,
-	229, 231, 0, 321, 320, 0, 342, 337, 372, 377,
-	0, 386, 124, 190, 295, 296, 232, 339, 323, 322,
-	349, 343, 311, 0, 0, 294, 306, 0, 346, 345,
-	0, 0, 387, 324, 349, 0, 0, 344, 347, 348,
-	0, 385,
+	85, 166, 175, 176, 177, 173, 0, 0, 71, 0,
+	0, 179, 275, 0, 137, 179, 276, 137, 137, 0,
+	0, 276, 0, 276, 270, 0, 179, 0, 276, 356,
+	276, 137, 385, 400, 0, 204, 199, 0, 0, 201,
+	0, 0, 0, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 380, 383, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 257, 0, 0, 0, 116,
+	179, 0, 0, 137, 84, 0, 0, 0, 0, 191,
+	0, 223, 179, 191, 137, 137, 116, 137, 179, 0,
+	0, 276, 0, 276, 137, 0, 0, 0, 179, 191,
+	276, 137, 137, 137, 116, 0, 198, 207, 208, 210,
+	0, 0, 0, 0, 215, 0, 0, 0, 0, 0,
+	200, 0, 0, 0, 0, 303, 304, 315, 326, 329,
+	0, 0, 112, 0, 110, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 412, 414, 96, 99, 98,
+	0, 103, 105, 139, 141, -2, 0, 0, 0, 0,
+	0, 0, 152, 0, 0, 0, 0, 0, 251, 0,
+	0, 0, 256, 0, 0, 0, 132, 191, 0, 115,
+	117, 121, 119, 126, 128, 116, 0, 90, 0, 72,
+	137, 0, 0, 0, 0, 218, 195, 0, 0, 0,
+	191, 239, 137, 116, 116, 191, 179, 191, 0, 0,
+	0, 0, 0, 137, 137, 116, 0, 0, 0, 274,
+	191, 278, 137, 137, 116, 137, 116, 116, 191, 422,
+	423, 209, 211, 212, 213, 214, 216, 351, 353, 0,
+	0, 0, 0, 202, 203, 205, 206, 0, 227, 308,
+	310, 0, 328, 330, 331, 332, 334, 0, 109, 112,
+	108, 374, 0, 0, 0, 391, 0, 0, 247, 376,
+	381, 0, 0, 0, 0, 0, 0, 0, 146, 0,
+	0, 0, 0, 0, 342, 248, 0, 250, 253, 0,
+	255, 355, 416, 417, 418, 419, 420, 179, 130, 0,
+	133, 134, 135, 0, 0, 0, 0, 132, 0, 91,
+	179, 219, 220, 221, 222, 185, 0, 0, 189, 186,
+	187, 190, 178, 180, 182, 238, 116, 191, 191, 364,
+	191, 260, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 137, 116, 116, 191, 0, 272, 273,
+	277, 137, 116, 116, 191, 116, 191, 191, 360, 0,
+	0, 0, 234, 235, 236, 237, 225, 0, 0, 313,
+	338, 313, 338, 0, 333, 107, 0, 0, 0, 0,
+	379, 0, 393, 0, 0, 0, 406, 407, 413, 100,
+	0, 104, 144, 145, 0, 0, 73, 149, 0, 0,
+	154, 246, 366, 0, 249, 254, 191, 61, 0, 131,
+	118, 122, 0, 127, 179, 0, 191, 193, 194, 0,
+	0, 183, 184, 191, 362, 363, 259, 137, 179, 281,
+	286, 288, 282, 0, 284, 285, 0, 0, 0, 137,
+	116, 191, 191, 294, 271, 116, 191, 191, 302, 191,
+	358, 359, 0, 0, 352, 226, 0, 0, 0, 342,
+	0, 309, 338, 0, 0, 342, 311, 0, 316, 317,
+	0, 0, 0, 0, 0, 0, 395, 0, 390, 0,
+	409, 404, 102, 147, 148, 0, 150, 151, 341, 130,
+	0, 123, 0, 191, 0, 217, 0, 188, 181, 361,
+	179, 191, 0, 0, 0, 137, 137, 116, 191, 292,
+	293, 191, 300, 301, 357, 0, 0, 0, 228, 229,
+	306, 314, 337, 0, 0, 318, 0, 0, 371, 372,
+	377, 0, 0, 389, 0, 392, 0, 0, 74, 59,
+	0, 0, 0, 130, 86, 192, 191, 280, 287, 283,
+	137, 116, 116, 191, 291, 299, 425, 424, 231, 335,
+	339, 336, 320, 319, 0, 0, 370, 0, 0, 0,
+	394, 396, 0, 408, 405, 129, 124, 0, 60, 279,
+	116, 191, 191, 298, 230, 232, 0, 322, 321, 0,
+	343, 338, 373, 378, 0, 387, 0, 0, 125, 191,
+	296, 297, 233, 340, 324, 323, 350, 344, 312, 0,
+	0, 397, 398, 295, 307, 0, 347, 346, 0, 0,
+	388, 325, 350, 0, 0, 345, 348, 349, 0, 386,
 }
 
 var yyTok1 = [...]int8{
@@ -987,7 +1000,7 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157,
+	152, 153, 154, 155, 156, 157, 158, 159,
 }
 
 var yyTok3 = [...]int8{
@@ -1333,19 +1346,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:188
+//line sql.y:193
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:194
+//line sql.y:199
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:198
+//line sql.y:203
 		{
 
 			if len(yyDollar[1].stmts) == 1 {
@@ -1356,337 +1369,337 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:207
+//line sql.y:212
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:215
+//line sql.y:220
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:219
+//line sql.y:224
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:223
+//line sql.y:228
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:227
+//line sql.y:232
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:231
+//line sql.y:236
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:235
+//line sql.y:240
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:239
+//line sql.y:244
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:243
+//line sql.y:248
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:247
+//line sql.y:252
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:251
+//line sql.y:256
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:255
+//line sql.y:260
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:259
+//line sql.y:264
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:263
+//line sql.y:268
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:267
+//line sql.y:272
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:271
+//line sql.y:276
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:275
+//line sql.y:280
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:279
+//line sql.y:284
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:283
+//line sql.y:288
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:287
+//line sql.y:292
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:291
+//line sql.y:296
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:295
+//line sql.y:300
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:299
+//line sql.y:304
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:303
+//line sql.y:308
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:307
+//line sql.y:312
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:311
+//line sql.y:316
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:315
+//line sql.y:320
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:319
+//line sql.y:324
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:323
+//line sql.y:328
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:327
+//line sql.y:332
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:331
+//line sql.y:336
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:335
+//line sql.y:340
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:339
+//line sql.y:344
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:343
+//line sql.y:348
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:347
+//line sql.y:352
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:351
+//line sql.y:356
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:355
+//line sql.y:360
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:359
+//line sql.y:364
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:363
+//line sql.y:368
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:367
+//line sql.y:372
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:371
+//line sql.y:376
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:375
+//line sql.y:380
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:379
+//line sql.y:384
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:383
+//line sql.y:388
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:387
+//line sql.y:392
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:391
+//line sql.y:396
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:395
+//line sql.y:400
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:399
+//line sql.y:404
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:408
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:407
+//line sql.y:412
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:411
+//line sql.y:416
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:420
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:419
+//line sql.y:424
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:423
+//line sql.y:428
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:427
+//line sql.y:432
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:433
+//line sql.y:438
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1728,7 +1741,7 @@ yydefault:
 		}
 	case 60:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:473
+//line sql.y:478
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1771,7 +1784,7 @@ yydefault:
 		}
 	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:514
+//line sql.y:519
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1800,61 +1813,61 @@ yydefault:
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:544
+//line sql.y:549
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
 	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:548
+//line sql.y:553
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:554
+//line sql.y:559
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
 	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:558
+//line sql.y:563
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
 	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:562
+//line sql.y:567
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
 	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:571
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
 	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:575
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:574
+//line sql.y:579
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
 	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:580
+//line sql.y:585
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:584
+//line sql.y:589
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
@@ -1863,7 +1876,7 @@ yydefault:
 		}
 	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:593
+//line sql.y:598
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
@@ -1872,73 +1885,73 @@ yydefault:
 		}
 	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:607
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
 	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:606
+//line sql.y:611
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
 	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:612
+//line sql.y:617
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:616
+//line sql.y:621
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:620
+//line sql.y:625
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:624
+//line sql.y:629
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:628
+//line sql.y:633
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:632
+//line sql.y:637
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:636
+//line sql.y:641
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:640
+//line sql.y:645
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
 	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:644
+//line sql.y:649
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:648
+//line sql.y:653
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -1971,14 +1984,24 @@ yydefault:
 		}
 	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:679
+//line sql.y:684
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
 	case 86:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:689
+		{
+			cols := &Call{Name: strings.ToLower(yyDollar[1].str), Args: []Expr{}}
+			for i := range yyDollar[3].fields {
+				cols.Args = append(cols.Args, yyDollar[3].fields[i].Expr)
+			}
+			yyVAL.expr = &FilterExpr{Call: cols, Condition: yyDollar[8].expr}
+		}
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:684
+//line sql.y:697
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -1992,98 +2015,98 @@ yydefault:
 			}
 
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:698
+//line sql.y:711
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:702
+//line sql.y:715
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:706
+//line sql.y:719
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:712
+//line sql.y:725
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:718
+//line sql.y:731
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:722
+//line sql.y:735
 		{
 			yyVAL.sources = nil
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:728
+//line sql.y:741
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:734
+//line sql.y:747
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:738
+//line sql.y:751
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:742
+//line sql.y:755
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:747
+//line sql.y:760
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:751
+//line sql.y:764
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:756
+//line sql.y:769
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:761
+//line sql.y:774
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:767
+//line sql.y:780
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2094,9 +2117,9 @@ yydefault:
 			join.Condition = yyDollar[6].expr
 			yyVAL.source = join
 		}
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:780
+//line sql.y:793
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2109,9 +2132,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:793
+//line sql.y:806
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2128,72 +2151,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:810
+//line sql.y:823
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:816
+//line sql.y:829
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:822
+//line sql.y:835
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:829
+//line sql.y:842
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:835
+//line sql.y:848
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:841
+//line sql.y:854
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:847
+//line sql.y:860
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:853
+//line sql.y:866
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:857
+//line sql.y:870
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:861
+//line sql.y:874
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2202,57 +2225,57 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:872
+//line sql.y:885
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 115:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:876
+//line sql.y:889
 		{
 			yyVAL.dimens = nil
 		}
-	case 116:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:895
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 117:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:899
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:892
+//line sql.y:905
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 119:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:896
+//line sql.y:909
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 120:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:915
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 121:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:906
+//line sql.y:919
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 122:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:910
+//line sql.y:923
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2260,9 +2283,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:918
+//line sql.y:931
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2270,9 +2293,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:926
+//line sql.y:939
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2280,21 +2303,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:934
+//line sql.y:947
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:938
+//line sql.y:951
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:942
+//line sql.y:955
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2302,9 +2325,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:953
+//line sql.y:966
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2315,93 +2338,93 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:964
+//line sql.y:977
 		{
 			yyVAL.location = nil
 		}
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:970
+//line sql.y:983
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 131:
+	case 132:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:974
+//line sql.y:987
 		{
 			yyVAL.inter = "null"
 		}
-	case 132:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:980
+//line sql.y:993
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 133:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:984
+//line sql.y:997
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 134:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:988
+//line sql.y:1001
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:994
+//line sql.y:1007
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 136:
+	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:998
+//line sql.y:1011
 		{
 			yyVAL.expr = nil
 		}
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1004
+//line sql.y:1017
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1008
+//line sql.y:1021
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1014
+//line sql.y:1027
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1018
+//line sql.y:1031
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1024
+//line sql.y:1037
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1028
+//line sql.y:1041
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1032
+//line sql.y:1045
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2415,39 +2438,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1046
+//line sql.y:1059
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 145:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1050
+//line sql.y:1063
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 146:
+	case 147:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1054
+//line sql.y:1067
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 147:
+	case 148:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1058
+//line sql.y:1071
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 148:
+	case 149:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1062
+//line sql.y:1075
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 149:
+	case 150:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1066
+//line sql.y:1079
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2455,9 +2478,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 150:
+	case 151:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1074
+//line sql.y:1087
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2465,9 +2488,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 151:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1084
+//line sql.y:1097
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2478,123 +2501,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 152:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1097
+//line sql.y:1110
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 153:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1101
+//line sql.y:1114
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 154:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1107
+//line sql.y:1120
 		{
 			yyVAL.int = EQ
 		}
-	case 155:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1111
+//line sql.y:1124
 		{
 			yyVAL.int = NEQ
 		}
-	case 156:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1115
+//line sql.y:1128
 		{
 			yyVAL.int = LT
 		}
-	case 157:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1119
+//line sql.y:1132
 		{
 			yyVAL.int = LTE
 		}
-	case 158:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1123
+//line sql.y:1136
 		{
 			yyVAL.int = GT
 		}
-	case 159:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1127
+//line sql.y:1140
 		{
 			yyVAL.int = GTE
 		}
-	case 160:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1131
+//line sql.y:1144
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 161:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1135
+//line sql.y:1148
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 162:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1139
+//line sql.y:1152
 		{
 			yyVAL.int = LIKE
 		}
-	case 163:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1145
+//line sql.y:1158
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 164:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1151
+//line sql.y:1164
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 165:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1155
+//line sql.y:1168
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 166:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1159
+//line sql.y:1172
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 167:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1176
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 168:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1180
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 169:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1184
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 170:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1188
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 171:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1192
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2602,21 +2625,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 172:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1187
+//line sql.y:1200
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 173:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1204
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 174:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1197
+//line sql.y:1210
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2637,75 +2660,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 175:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1231
 		{
 			yyVAL.dataType = Tag
 		}
-	case 176:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1222
+//line sql.y:1235
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 177:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1228
+//line sql.y:1241
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 178:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1232
+//line sql.y:1245
 		{
 			yyVAL.sortfs = nil
 		}
-	case 179:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1238
+//line sql.y:1251
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 180:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1242
+//line sql.y:1255
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 181:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1261
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 182:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1252
+//line sql.y:1265
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 183:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1256
+//line sql.y:1269
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 184:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1262
+//line sql.y:1275
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 185:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1268
+//line sql.y:1281
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 186:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1273
+//line sql.y:1286
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2713,69 +2736,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 187:
+	case 188:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1283
+//line sql.y:1296
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 188:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1287
+//line sql.y:1300
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 189:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1291
+//line sql.y:1304
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 190:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1295
+//line sql.y:1308
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 191:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1301
+//line sql.y:1314
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 192:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1305
+//line sql.y:1318
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 193:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1309
+//line sql.y:1322
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 194:
+	case 195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1313
+//line sql.y:1326
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1319
+//line sql.y:1332
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1323
+//line sql.y:1336
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1329
+//line sql.y:1342
 		{
 			sms := yyDollar[4].stmt
 
@@ -2783,9 +2806,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 198:
+	case 199:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1337
+//line sql.y:1350
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2793,54 +2816,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 199:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1347
+//line sql.y:1360
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 200:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1352
+//line sql.y:1365
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1357
+//line sql.y:1370
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1362
+//line sql.y:1375
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1366
+//line sql.y:1379
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1372
+//line sql.y:1385
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1379
+//line sql.y:1392
 		{
 			yyVAL.bool = false
 		}
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1386
+//line sql.y:1399
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2879,15 +2902,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1429
+//line sql.y:1442
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 208:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1433
+//line sql.y:1446
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -2960,22 +2983,22 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 209:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1508
+//line sql.y:1521
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 210:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1512
+//line sql.y:1525
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 211:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1517
+//line sql.y:1530
 		{
 			if yyDollar[2].int64 < 1 || yyDollar[2].int64 > 2 {
 				yylex.Error("REPLICATION must be 1 <= n <= 2")
@@ -2983,36 +3006,36 @@ yydefault:
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 212:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1525
+//line sql.y:1538
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 213:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1529
+//line sql.y:1542
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 214:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1533
+//line sql.y:1546
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 215:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1537
+//line sql.y:1550
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 216:
+	case 217:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1548
+//line sql.y:1561
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3023,9 +3046,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 217:
+	case 218:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1559
+//line sql.y:1572
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3035,21 +3058,21 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 218:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1572
+//line sql.y:1585
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 219:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1576
+//line sql.y:1589
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 220:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1580
+//line sql.y:1593
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3057,9 +3080,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 221:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:1601
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3067,32 +3090,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 222:
+	case 223:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1600
+//line sql.y:1613
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 223:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1606
+//line sql.y:1619
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 224:
+	case 225:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1613
+//line sql.y:1626
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 225:
+	case 226:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1620
+//line sql.y:1633
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3100,18 +3123,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 226:
+	case 227:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1630
+//line sql.y:1643
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 227:
+	case 228:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1637
+//line sql.y:1650
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3119,9 +3142,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 228:
+	case 229:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1645
+//line sql.y:1658
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3129,9 +3152,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 229:
+	case 230:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1656
+//line sql.y:1669
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3166,9 +3189,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 230:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1691
+//line sql.y:1704
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3178,15 +3201,15 @@ yydefault:
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 231:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1704
+//line sql.y:1717
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 232:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1708
+//line sql.y:1721
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3221,33 +3244,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 233:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1746
+//line sql.y:1759
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 234:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1750
+//line sql.y:1763
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 235:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1754
+//line sql.y:1767
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 236:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1758
+//line sql.y:1771
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 237:
+	case 238:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1766
+//line sql.y:1779
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3258,9 +3281,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 238:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1777
+//line sql.y:1790
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3270,57 +3293,57 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 239:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1789
+//line sql.y:1802
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 240:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1795
+//line sql.y:1808
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 241:
+	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1803
+//line sql.y:1816
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 242:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1810
+//line sql.y:1823
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 243:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1818
+//line sql.y:1831
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 244:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1825
+//line sql.y:1838
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 245:
+	case 246:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1834
+//line sql.y:1847
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3354,18 +3377,18 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 247:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1872
+//line sql.y:1885
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 248:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1881
+//line sql.y:1894
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3373,9 +3396,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 249:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1889
+//line sql.y:1902
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3383,9 +3406,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 250:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1897
+//line sql.y:1910
 		{
 			stmt := &GrantStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3400,21 +3423,21 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 250:
+	case 251:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1914
+//line sql.y:1927
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 251:
+	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1918
+//line sql.y:1931
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 252:
+	case 253:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1924
+//line sql.y:1937
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3422,9 +3445,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 253:
+	case 254:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1932
+//line sql.y:1945
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3432,9 +3455,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 254:
+	case 255:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1940
+//line sql.y:1953
 		{
 			stmt := &RevokeStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3449,27 +3472,27 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 255:
+	case 256:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1957
+//line sql.y:1970
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 256:
+	case 257:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1961
+//line sql.y:1974
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 257:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1967
+//line sql.y:1980
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 258:
+	case 259:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1973
+//line sql.y:1986
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3483,9 +3506,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 259:
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1987
+//line sql.y:2000
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3497,72 +3520,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 260:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2001
+//line sql.y:2014
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 261:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2005
+//line sql.y:2018
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 262:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2009
+//line sql.y:2022
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 263:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2013
+//line sql.y:2026
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 264:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2030
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 265:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2021
+//line sql.y:2034
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 266:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2025
+//line sql.y:2038
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 267:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2029
+//line sql.y:2042
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 268:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:2046
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 269:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2039
+//line sql.y:2052
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 270:
+	case 271:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2046
+//line sql.y:2059
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3571,9 +3594,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 271:
+	case 272:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2055
+//line sql.y:2068
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3581,9 +3604,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 272:
+	case 273:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2063
+//line sql.y:2076
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3591,30 +3614,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 273:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2071
+//line sql.y:2084
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 274:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2080
+//line sql.y:2093
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 275:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2084
+//line sql.y:2097
 		{
 			yyVAL.str = ""
 		}
-	case 276:
+	case 277:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2090
+//line sql.y:2103
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3624,9 +3647,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 277:
+	case 278:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2100
+//line sql.y:2113
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3635,9 +3658,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 278:
+	case 279:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2112
+//line sql.y:2125
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3650,9 +3673,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 279:
+	case 280:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2125
+//line sql.y:2138
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3663,36 +3686,36 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2138
+//line sql.y:2151
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 281:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2145
+//line sql.y:2158
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 282:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2152
+//line sql.y:2165
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2159
+//line sql.y:2172
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3703,9 +3726,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2170
+//line sql.y:2183
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3716,47 +3739,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 285:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2184
+//line sql.y:2197
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 286:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2189
+//line sql.y:2202
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 287:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2196
+//line sql.y:2209
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 288:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2204
+//line sql.y:2217
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 289:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2211
+//line sql.y:2224
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 290:
+	case 291:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2221
+//line sql.y:2234
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3768,9 +3791,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 292:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2233
+//line sql.y:2246
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3781,9 +3804,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 292:
+	case 293:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2244
+//line sql.y:2257
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3795,9 +3818,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 293:
+	case 294:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2256
+//line sql.y:2269
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3808,9 +3831,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 294:
+	case 295:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2272
+//line sql.y:2285
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3827,9 +3850,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 295:
+	case 296:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2289
+//line sql.y:2302
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3844,9 +3867,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 297:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2304
+//line sql.y:2317
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3863,9 +3886,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 297:
+	case 298:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2321
+//line sql.y:2334
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3880,9 +3903,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 298:
+	case 299:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2339
+//line sql.y:2352
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3894,9 +3917,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 299:
+	case 300:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2351
+//line sql.y:2364
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3907,9 +3930,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 300:
+	case 301:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2362
+//line sql.y:2375
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3921,9 +3944,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 301:
+	case 302:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2374
+//line sql.y:2387
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3934,9 +3957,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 303:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2388
+//line sql.y:2401
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -3955,9 +3978,9 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 303:
+	case 304:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2407
+//line sql.y:2420
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4041,18 +4064,18 @@ yydefault:
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2492
+//line sql.y:2505
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 305:
+	case 306:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2499
+//line sql.y:2512
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4066,9 +4089,9 @@ yydefault:
 			option.EngineType = yyDollar[2].str
 			yyVAL.cmOption = option
 		}
-	case 306:
+	case 307:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2515
+//line sql.y:2528
 		{
 			option := &CreateMeasurementStatementOption{}
 			if yyDollar[3].indexType != nil {
@@ -4098,15 +4121,15 @@ yydefault:
 			option.CompactType = yyDollar[9].str
 			yyVAL.cmOption = option
 		}
-	case 307:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2546
+//line sql.y:2559
 		{
 			yyVAL.indexType = nil
 		}
-	case 308:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2563
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4122,15 +4145,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 309:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2567
+//line sql.y:2580
 		{
 			yyVAL.indexType = nil
 		}
-	case 310:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2571
+//line sql.y:2584
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4146,9 +4169,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 311:
+	case 312:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2587
+//line sql.y:2600
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4176,83 +4199,83 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 312:
+	case 313:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2616
+//line sql.y:2629
 		{
 			yyVAL.strSlice = nil
 		}
-	case 313:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2620
+//line sql.y:2633
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 314:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2627
+//line sql.y:2640
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 315:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2631
+//line sql.y:2644
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 316:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2637
+//line sql.y:2650
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 317:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2642
+//line sql.y:2655
 		{
 			yyVAL.strSlice = nil
 		}
-	case 318:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2645
+//line sql.y:2658
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 319:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2650
+//line sql.y:2663
 		{
 			yyVAL.strSlice = nil
 		}
-	case 320:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2653
+//line sql.y:2666
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 321:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2658
+//line sql.y:2671
 		{
 			yyVAL.strSlices = nil
 		}
-	case 322:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2661
+//line sql.y:2674
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 323:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2666
+//line sql.y:2679
 		{
 			yyVAL.str = "row"
 		}
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2670
+//line sql.y:2683
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4261,9 +4284,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 325:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2681
+//line sql.y:2694
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4292,40 +4315,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 326:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2710
+//line sql.y:2723
 		{
 			yyVAL.stmt = nil
 		}
-	case 327:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2716
+//line sql.y:2729
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 328:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2722
+//line sql.y:2735
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 329:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2728
+//line sql.y:2741
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 330:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2733
+//line sql.y:2746
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 331:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2739
+//line sql.y:2752
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4333,9 +4356,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 332:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2748
+//line sql.y:2761
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4343,9 +4366,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 333:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2757
+//line sql.y:2770
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4353,27 +4376,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 334:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2767
+//line sql.y:2780
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 335:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2775
+//line sql.y:2788
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 336:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2784
+//line sql.y:2797
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4382,28 +4405,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2793
+//line sql.y:2806
 		{
 			yyVAL.indexType = nil
 		}
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2799
+//line sql.y:2812
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2803
+//line sql.y:2816
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2810
+//line sql.y:2823
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4412,27 +4435,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2819
+//line sql.y:2832
 		{
 			yyVAL.str = "hash"
 		}
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2825
+//line sql.y:2838
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2831
+//line sql.y:2844
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2837
+//line sql.y:2850
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4441,82 +4464,82 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2847
+//line sql.y:2860
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2853
+//line sql.y:2866
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2859
+//line sql.y:2872
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2863
+//line sql.y:2876
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2867
+//line sql.y:2880
 		{
 			yyVAL.strSlices = nil
 		}
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2873
+//line sql.y:2886
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2877
+//line sql.y:2890
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2882
+//line sql.y:2895
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2888
+//line sql.y:2901
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 354:
+	case 355:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2896
+//line sql.y:2909
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 355:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2907
+//line sql.y:2920
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 356:
+	case 357:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2915
+//line sql.y:2928
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4528,9 +4551,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 357:
+	case 358:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2927
+//line sql.y:2940
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4541,9 +4564,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 358:
+	case 359:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2938
+//line sql.y:2951
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4555,9 +4578,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 359:
+	case 360:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2950
+//line sql.y:2963
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4568,9 +4591,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 360:
+	case 361:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2964
+//line sql.y:2977
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4582,9 +4605,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 361:
+	case 362:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2976
+//line sql.y:2989
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4595,9 +4618,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 362:
+	case 363:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2987
+//line sql.y:3000
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4609,9 +4632,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 363:
+	case 364:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2999
+//line sql.y:3012
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4622,16 +4645,16 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 364:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3013
+//line sql.y:3026
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 365:
+	case 366:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3021
+//line sql.y:3034
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4642,9 +4665,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 366:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3032
+//line sql.y:3045
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4653,24 +4676,24 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3046
+//line sql.y:3059
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3053
+//line sql.y:3066
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 370:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3062
+//line sql.y:3075
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4683,64 +4706,64 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3077
+//line sql.y:3090
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 371:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3083
+//line sql.y:3096
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 372:
+	case 373:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3089
+//line sql.y:3102
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 373:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3096
+//line sql.y:3109
 		{
 			yyVAL.cqsp = nil
 		}
-	case 374:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3102
+//line sql.y:3115
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 375:
+	case 376:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3108
+//line sql.y:3121
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 376:
+	case 377:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3116
+//line sql.y:3129
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 378:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3123
+//line sql.y:3136
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4748,65 +4771,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 378:
+	case 379:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3131
+//line sql.y:3144
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 379:
+	case 380:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3139
+//line sql.y:3152
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 380:
+	case 381:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3145
+//line sql.y:3158
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 381:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3152
+//line sql.y:3165
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 382:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3158
+//line sql.y:3171
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 383:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3167
+//line sql.y:3180
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 384:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3171
+//line sql.y:3184
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 385:
+	case 386:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3179
+//line sql.y:3192
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -4814,26 +4837,28 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 386:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3189
+//line sql.y:3202
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 387:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3193
+//line sql.y:3206
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 388:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3200
+	case 389:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3213
 		{
 			stmt := &CreateStreamStatement{
-				Name:  yyDollar[3].str,
-				Query: yyDollar[6].stmt,
-				Delay: yyDollar[8].tdur,
+				Name:         yyDollar[3].str,
+				Query:        yyDollar[6].stmt,
+				Delay:        yyDollar[7].tdur,
+				Slide:        yyDollar[8].tdur,
+				Destinations: yyDollar[9].streamDestinations,
 			}
 			if len(yyDollar[4].sources) > 1 {
 				yylex.Error("into clause only support one target")
@@ -4850,135 +4875,171 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 389:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3222
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3239
 		{
-			stmt := &CreateStreamStatement{
-				Name:  yyDollar[3].str,
-				Query: yyDollar[6].stmt,
-			}
-			if len(yyDollar[4].sources) > 1 {
-				yylex.Error("into clause only support one target")
-			}
-			if len(yyDollar[4].sources) == 1 {
-				mst, ok := yyDollar[4].sources[0].(*Measurement)
-				if !ok {
-					yylex.Error("into clause only support measurement clause")
-				}
-				mst.IsTarget = true
-				stmt.Target = &Target{
-					Measurement: mst,
-				}
+			yyVAL.tdur = yyDollar[2].tdur
+		}
+	case 391:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3243
+		{
+			yyVAL.tdur = 0
+		}
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3249
+		{
+			yyVAL.tdur = yyDollar[2].tdur
+		}
+	case 393:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3253
+		{
+			yyVAL.tdur = 0
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3259
+		{
+			yyVAL.streamDestinations = yyDollar[2].streamDestinations
+		}
+	case 395:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3263
+		{
+			yyVAL.streamDestinations = nil
+		}
+	case 396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3269
+		{
+			yyVAL.streamDestinations = []*StreamDestination{yyDollar[1].streamDestination}
+		}
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3273
+		{
+			yyVAL.streamDestinations = append([]*StreamDestination{yyDollar[1].streamDestination}, yyDollar[3].streamDestinations...)
+		}
+	case 398:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3279
+		{
+			mst := yyDollar[1].ment
+			mst.IsTarget = true
+			yyVAL.streamDestination = &StreamDestination{
+				Target:   &Target{Measurement: mst},
+				Interval: yyDollar[3].tdur,
 			}
-			yyVAL.stmt = stmt
 		}
-	case 390:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3245
+//line sql.y:3290
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 391:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3249
+//line sql.y:3294
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 392:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3255
+//line sql.y:3300
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 393:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3260
+//line sql.y:3305
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 394:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3265
+//line sql.y:3310
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 395:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3271
+//line sql.y:3316
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 396:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3275
+//line sql.y:3320
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 397:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3281
+//line sql.y:3326
 		{
 			yyVAL.str = "ALL"
 		}
-	case 398:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3285
+//line sql.y:3330
 		{
 			yyVAL.str = "ANY"
 		}
-	case 399:
+	case 408:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3291
+//line sql.y:3336
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 400:
+	case 409:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3295
+//line sql.y:3340
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 401:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3301
+//line sql.y:3346
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 402:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3307
+//line sql.y:3352
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 403:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3311
+//line sql.y:3356
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 404:
+	case 413:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3315
+//line sql.y:3360
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 405:
+	case 414:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3319
+//line sql.y:3364
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 406:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3325
+//line sql.y:3370
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 407:
+	case 416:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3332
+//line sql.y:3377
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -4986,9 +5047,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 408:
+	case 417:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3340
+//line sql.y:3385
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -4996,9 +5057,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 409:
+	case 418:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3348
+//line sql.y:3393
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5006,9 +5067,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 410:
+	case 419:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3356
+//line sql.y:3401
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5016,9 +5077,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 411:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3364
+//line sql.y:3409
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5026,17 +5087,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 412:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3374
+//line sql.y:3419
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 413:
+	case 422:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3380
+//line sql.y:3425
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5047,9 +5108,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 414:
+	case 423:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3391
+//line sql.y:3436
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5059,9 +5120,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 415:
+	case 424:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3401
+//line sql.y:3446
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5076,9 +5137,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 416:
+	case 425:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3416
+//line sql.y:3461
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
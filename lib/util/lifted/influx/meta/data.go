@@ -1086,8 +1086,19 @@ func (data *Data) SetStream(info *StreamInfo) error {
 	if data.Streams == nil {
 		data.Streams = make(map[string]*StreamInfo)
 	}
-	if v := data.Streams[info.Name]; v != nil && !v.Equal(info) {
-		return errno.NewError(errno.StreamHasExist)
+	if v := data.Streams[info.Name]; v != nil {
+		if !v.Equal(info) {
+			return errno.NewError(errno.StreamHasExist)
+		}
+		if v.Paused == info.Paused && v.Options == info.Options {
+			return nil
+		}
+		// the stream is paused, resumed or given new options, which keeps its ID
+		updated := v.clone()
+		updated.Paused = info.Paused
+		updated.Options = info.Options
+		data.Streams[info.Name] = updated
+		return nil
 	}
 	info.ID = data.MaxStreamID
 	data.MaxStreamID++
@@ -2839,9 +2850,69 @@ func (data *Data) CreateStream(info *StreamInfo) error {
 	if info == nil {
 		return nil
 	}
+	if err := data.checkStreamCycle(info); err != nil {
+		return err
+	}
 	return data.SetStream(info)
 }
 
+// checkStreamCycle returns an error if the rows written by the stream come back to its source through the streams
+// reading its destinations, the rows of the streams in a cycle would be aggregated forever.
+func (data *Data) checkStreamCycle(info *StreamInfo) error {
+	type output struct {
+		key    string
+		writer string
+	}
+	sources := data.streamSourceKeys(info)
+	visited := make(map[string]bool)
+	var queue []output
+	push := func(s *StreamInfo) {
+		queue = append(queue, output{key: data.streamMstKey(s.DesMst), writer: s.Name})
+		for _, d := range s.Destinations {
+			queue = append(queue, output{key: data.streamMstKey(d.DesMst), writer: s.Name})
+		}
+	}
+	push(info)
+	for len(queue) > 0 {
+		o := queue[0]
+		queue = queue[1:]
+		if sources[o.key] {
+			return fmt.Errorf("the rows of stream task %s come back to its source %s through stream task %s", info.Name, o.key, o.writer)
+		}
+		if visited[o.key] {
+			continue
+		}
+		visited[o.key] = true
+		for _, s := range data.Streams {
+			if s.Name != info.Name && data.streamSourceKeys(s)[o.key] {
+				push(s)
+			}
+		}
+	}
+	return nil
+}
+
+// streamSourceKeys returns the keys of the source measurements of a stream in all its source retention policies.
+func (data *Data) streamSourceKeys(s *StreamInfo) map[string]bool {
+	keys := map[string]bool{data.streamMstKey(s.SrcMst): true}
+	for _, rp := range s.SrcRPs {
+		keys[data.streamMstKey(&StreamMeasurementInfo{Name: s.SrcMst.Name, Database: s.SrcMst.Database, RetentionPolicy: rp})] = true
+	}
+	return keys
+}
+
+// streamMstKey returns the key of the measurement of a stream, the retention policy is resolved to the default
+// retention policy of the database if it is empty.
+func (data *Data) streamMstKey(m *StreamMeasurementInfo) string {
+	rp := m.RetentionPolicy
+	if rp == "" {
+		if db := data.Databases[m.Database]; db != nil {
+			rp = db.DefaultRetentionPolicy
+		}
+	}
+	return m.Database + "." + rp + "." + m.Name
+}
+
 func (data *Data) ShowStreams(database string, showAll bool) (models.Rows, error) {
 	_, err := data.GetDatabase(database)
 	if err != nil && !showAll {
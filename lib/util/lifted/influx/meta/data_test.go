@@ -1520,3 +1520,142 @@ func TestInitDataNodePtView(t *testing.T) {
 		t.Fatalf("calculate ClusterPtNum failed")
 	}
 }
+
+func TestCreateStreamCycle(t *testing.T) {
+	data := &Data{Databases: map[string]*DatabaseInfo{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0"}}}
+	stream := func(name, src, dst string) *StreamInfo {
+		return &StreamInfo{
+			Name:   name,
+			SrcMst: &StreamMeasurementInfo{Name: src, Database: "db0", RetentionPolicy: "rp0"},
+			DesMst: &StreamMeasurementInfo{Name: dst, Database: "db0"},
+		}
+	}
+	require.NoError(t, data.CreateStream(stream("1m", "raw", "rollup_1m")))
+	require.NoError(t, data.CreateStream(stream("1h", "rollup_1m", "rollup_1h")))
+	require.NoError(t, data.CreateStream(stream("1d", "rollup_1h", "rollup_1d")))
+
+	require.EqualError(t, data.CreateStream(stream("back", "rollup_1d", "raw")),
+		"the rows of stream task back come back to its source db0.rp0.rollup_1d through stream task 1d")
+	require.EqualError(t, data.CreateStream(stream("self", "raw", "raw")),
+		"the rows of stream task self come back to its source db0.rp0.raw through stream task self")
+	// the destinations besides DesMst are followed as well
+	si := stream("dest", "rollup_1d", "rollup_1w")
+	si.Destinations = []*StreamDestination{{DesMst: &StreamMeasurementInfo{Name: "rollup_1m", Database: "db0"}}}
+	require.Error(t, data.CreateStream(si))
+	require.Len(t, data.Streams, 3)
+}
+
+func TestPauseStream(t *testing.T) {
+	data := &Data{Databases: map[string]*DatabaseInfo{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0"}}}
+	stream := func(paused bool) *StreamInfo {
+		return &StreamInfo{
+			Name:   "s",
+			SrcMst: &StreamMeasurementInfo{Name: "raw", Database: "db0", RetentionPolicy: "rp0"},
+			DesMst: &StreamMeasurementInfo{Name: "rollup", Database: "db0"},
+			Paused: paused,
+		}
+	}
+	require.NoError(t, data.CreateStream(stream(false)))
+	id, created := data.Streams["s"].ID, data.Streams["s"]
+
+	// the stream is paused and resumed by creating it again, which keeps its ID
+	require.NoError(t, data.CreateStream(stream(true)))
+	require.True(t, data.Streams["s"].Paused)
+	require.Equal(t, id, data.Streams["s"].ID)
+	require.False(t, created.Paused)
+	require.NoError(t, data.CreateStream(stream(false)))
+	require.False(t, data.Streams["s"].Paused)
+	require.Equal(t, id, data.Streams["s"].ID)
+
+	// pausing the paused stream again changes nothing
+	require.NoError(t, data.CreateStream(stream(true)))
+	paused, maxID := data.Streams["s"], data.MaxStreamID
+	require.NoError(t, data.CreateStream(stream(true)))
+	require.Same(t, paused, data.Streams["s"])
+	require.Equal(t, id, data.Streams["s"].ID)
+	require.Equal(t, maxID, data.MaxStreamID)
+
+	other := &StreamInfo{}
+	other.Unmarshal(stream(true).Marshal())
+	require.True(t, other.Paused)
+}
+
+func TestSetStreamOptions(t *testing.T) {
+	data := &Data{Databases: map[string]*DatabaseInfo{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0"}}}
+	stream := func(options string) *StreamInfo {
+		return &StreamInfo{
+			Name:    "s",
+			SrcMst:  &StreamMeasurementInfo{Name: "raw", Database: "db0", RetentionPolicy: "rp0"},
+			DesMst:  &StreamMeasurementInfo{Name: "rollup", Database: "db0"},
+			Options: options,
+		}
+	}
+	require.NoError(t, data.CreateStream(stream("")))
+	id := data.Streams["s"].ID
+
+	// the options are changed by creating the stream again, which keeps its ID
+	require.NoError(t, data.CreateStream(stream(`{"Output":{"SafeMode":true}}`)))
+	require.Equal(t, `{"Output":{"SafeMode":true}}`, data.Streams["s"].Options)
+	require.Equal(t, id, data.Streams["s"].ID)
+
+	other := &StreamInfo{}
+	other.Unmarshal(data.Streams["s"].Marshal())
+	require.Equal(t, data.Streams["s"].Options, other.Options)
+	require.Equal(t, other.Options, other.clone().Options)
+}
+
+func TestStreamSourceRPs(t *testing.T) {
+	data := &Data{Databases: map[string]*DatabaseInfo{"db0": {Name: "db0", DefaultRetentionPolicy: "rp0"}}}
+	si := &StreamInfo{
+		Name:   "tiers",
+		SrcMst: &StreamMeasurementInfo{Name: "raw", Database: "db0", RetentionPolicy: "hot"},
+		DesMst: &StreamMeasurementInfo{Name: "rollup", Database: "db0"},
+		SrcRPs: []string{"warm", "cold"},
+	}
+	require.True(t, si.HasSourceRP("hot"))
+	require.True(t, si.HasSourceRP("cold"))
+	require.False(t, si.HasSourceRP("rp0"))
+
+	other := &StreamInfo{}
+	other.Unmarshal(si.Marshal())
+	require.Equal(t, []string{"warm", "cold"}, other.SrcRPs)
+	require.True(t, si.Equal(other))
+	require.True(t, si.Equal(si.clone()))
+	other.SrcRPs = []string{"warm"}
+	require.False(t, si.Equal(other))
+
+	// the rows written to any source retention policy may come back
+	require.NoError(t, data.CreateStream(si))
+	back := &StreamInfo{
+		Name:   "back",
+		SrcMst: &StreamMeasurementInfo{Name: "rollup", Database: "db0", RetentionPolicy: "rp0"},
+		DesMst: &StreamMeasurementInfo{Name: "raw", Database: "db0", RetentionPolicy: "cold"},
+	}
+	require.EqualError(t, data.CreateStream(back),
+		"the rows of stream task back come back to its source db0.rp0.rollup through stream task tiers")
+}
+
+func TestStreamCallsEqual(t *testing.T) {
+	si := &StreamInfo{
+		Name:   "s",
+		SrcMst: &StreamMeasurementInfo{Name: "raw", Database: "db0", RetentionPolicy: "rp0"},
+		DesMst: &StreamMeasurementInfo{Name: "rollup", Database: "db0"},
+		Calls:  []*StreamCall{{Call: "percentile", Field: "v", Alias: "p99", Args: []string{"99"}}},
+	}
+	require.True(t, si.Equal(si.clone()))
+
+	// the streams with the same number of calls differ in the contents of the calls
+	for _, c := range []*StreamCall{
+		{Call: "percentile", Field: "v", Alias: "p99", Args: []string{"95"}},
+		{Call: "percentile", Field: "v", Alias: "p99"},
+		{Call: "percentile", Field: "w", Alias: "p99", Args: []string{"99"}},
+		{Call: "percentile", Field: "v", Alias: "p95", Args: []string{"99"}},
+		{Call: "max", Field: "v", Alias: "p99", Args: []string{"99"}},
+		{Call: "percentile", Field: "v", Alias: "p99", Args: []string{"99"}, Condition: influxql.MustParseExpr("code >= 500")},
+		{Call: "percentile", Field: "v", Alias: "p99", Args: []string{"99"}, Precision: 3},
+	} {
+		other := si.clone()
+		other.Calls = []*StreamCall{c}
+		require.False(t, si.Equal(other), c.String())
+	}
+}
@@ -2158,6 +2158,16 @@ type StreamInfo struct {
 	Delay                *int64                 `protobuf:"varint,6,req,name=Delay" json:"Delay,omitempty"`
 	Dims                 []string               `protobuf:"bytes,7,rep,name=Dims" json:"Dims,omitempty"`
 	Calls                []*StreamCall          `protobuf:"bytes,8,rep,name=Calls" json:"Calls,omitempty"`
+	Slide                *int64                 `protobuf:"varint,9,opt,name=Slide" json:"Slide,omitempty"`
+	Fill                 *int32                 `protobuf:"varint,10,opt,name=Fill" json:"Fill,omitempty"`
+	FillValue            *float64               `protobuf:"fixed64,11,opt,name=FillValue" json:"FillValue,omitempty"`
+	Condition            *string                `protobuf:"bytes,12,opt,name=Condition" json:"Condition,omitempty"`
+	Destinations         []*StreamDestination   `protobuf:"bytes,13,rep,name=Destinations" json:"Destinations,omitempty"`
+	Offset               *int64                 `protobuf:"varint,16,opt,name=Offset" json:"Offset,omitempty"`
+	TimeZone             *string                `protobuf:"bytes,17,opt,name=TimeZone" json:"TimeZone,omitempty"`
+	Paused               *bool                  `protobuf:"varint,21,opt,name=Paused" json:"Paused,omitempty"`
+	SrcRPs               []string               `protobuf:"bytes,22,rep,name=SrcRPs" json:"SrcRPs,omitempty"`
+	Options              *string                `protobuf:"bytes,23,opt,name=Options" json:"Options,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -2243,6 +2253,76 @@ func (m *StreamInfo) GetCalls() []*StreamCall {
 	return nil
 }
 
+func (m *StreamInfo) GetSlide() int64 {
+	if m != nil && m.Slide != nil {
+		return *m.Slide
+	}
+	return 0
+}
+
+func (m *StreamInfo) GetFill() int32 {
+	if m != nil && m.Fill != nil {
+		return *m.Fill
+	}
+	return 0
+}
+
+func (m *StreamInfo) GetFillValue() float64 {
+	if m != nil && m.FillValue != nil {
+		return *m.FillValue
+	}
+	return 0
+}
+
+func (m *StreamInfo) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
+func (m *StreamInfo) GetDestinations() []*StreamDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetOffset() int64 {
+	if m != nil && m.Offset != nil {
+		return *m.Offset
+	}
+	return 0
+}
+
+func (m *StreamInfo) GetTimeZone() string {
+	if m != nil && m.TimeZone != nil {
+		return *m.TimeZone
+	}
+	return ""
+}
+
+func (m *StreamInfo) GetPaused() bool {
+	if m != nil && m.Paused != nil {
+		return *m.Paused
+	}
+	return false
+}
+
+func (m *StreamInfo) GetSrcRPs() []string {
+	if m != nil {
+		return m.SrcRPs
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetOptions() string {
+	if m != nil && m.Options != nil {
+		return *m.Options
+	}
+	return ""
+}
+
 type StreamInfos struct {
 	Infos                []*StreamInfo `protobuf:"bytes,1,rep,name=Infos" json:"Infos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -2339,6 +2419,9 @@ type StreamCall struct {
 	Call                 *string  `protobuf:"bytes,1,req,name=Call" json:"Call,omitempty"`
 	Field                *string  `protobuf:"bytes,2,req,name=Field" json:"Field,omitempty"`
 	Alias                *string  `protobuf:"bytes,3,req,name=Alias" json:"Alias,omitempty"`
+	Args                 []string `protobuf:"bytes,4,rep,name=Args" json:"Args,omitempty"`
+	Condition            *string  `protobuf:"bytes,5,opt,name=Condition" json:"Condition,omitempty"`
+	Precision            *int32   `protobuf:"varint,6,opt,name=Precision" json:"Precision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2389,6 +2472,27 @@ func (m *StreamCall) GetAlias() string {
 	return ""
 }
 
+func (m *StreamCall) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *StreamCall) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
+func (m *StreamCall) GetPrecision() int32 {
+	if m != nil && m.Precision != nil {
+		return *m.Precision
+	}
+	return 0
+}
+
 type ColStoreInfo struct {
 	PrimaryKey           []string `protobuf:"bytes,1,rep,name=PrimaryKey" json:"PrimaryKey,omitempty"`
 	SortKey              []string `protobuf:"bytes,2,rep,name=SortKey" json:"SortKey,omitempty"`
@@ -8657,6 +8761,52 @@ func (m *UpdateMeasurementCommand) GetOptions() *Options {
 	return nil
 }
 
+type StreamDestination struct {
+	DesMst               *StreamMeasurementInfo `protobuf:"bytes,1,req,name=DesMst" json:"DesMst,omitempty"`
+	Interval             *int64                 `protobuf:"varint,2,req,name=Interval" json:"Interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *StreamDestination) Reset()         { *m = StreamDestination{} }
+func (m *StreamDestination) String() string { return proto.CompactTextString(m) }
+func (*StreamDestination) ProtoMessage()    {}
+func (*StreamDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{136}
+}
+func (m *StreamDestination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDestination.Unmarshal(m, b)
+}
+func (m *StreamDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDestination.Marshal(b, m, deterministic)
+}
+func (m *StreamDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDestination.Merge(m, src)
+}
+func (m *StreamDestination) XXX_Size() int {
+	return xxx_messageInfo_StreamDestination.Size(m)
+}
+func (m *StreamDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDestination proto.InternalMessageInfo
+
+func (m *StreamDestination) GetDesMst() *StreamMeasurementInfo {
+	if m != nil {
+		return m.DesMst
+	}
+	return nil
+}
+
+func (m *StreamDestination) GetInterval() int64 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
 var E_UpdateMeasurementCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
 	ExtensionType: (*UpdateMeasurementCommand)(nil),
@@ -8879,419 +9029,427 @@ func init() {
 	proto.RegisterType((*Options)(nil), "proto.Options")
 	proto.RegisterExtension(E_UpdateMeasurementCommand_Command)
 	proto.RegisterType((*UpdateMeasurementCommand)(nil), "proto.UpdateMeasurementCommand")
+	proto.RegisterType((*StreamDestination)(nil), "proto.StreamDestination")
 }
 
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 6532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x6d, 0x90, 0x64, 0x49,
-	0x51, 0x51, 0xaf, 0xbb, 0xe7, 0xa3, 0x66, 0x7b, 0x76, 0xb6, 0xf6, 0xab, 0x6f, 0x6e, 0x77, 0x6f,
-	0xf6, 0xb1, 0xc7, 0x0d, 0x07, 0xec, 0x71, 0x13, 0x70, 0x1c, 0x07, 0x1c, 0xec, 0x4c, 0xef, 0xed,
-	0x36, 0xb7, 0xb3, 0xd3, 0x57, 0x3d, 0xb7, 0xab, 0x80, 0x04, 0x6f, 0xa6, 0x6b, 0x67, 0x1e, 0xd3,
-	0xd3, 0xdd, 0xbc, 0xf7, 0x66, 0x6e, 0xe7, 0x02, 0x83, 0x03, 0x22, 0x34, 0x94, 0x30, 0x0c, 0xc3,
-	0x90, 0xaf, 0x50, 0x54, 0x04, 0x14, 0x15, 0x14, 0x04, 0x41, 0x3c, 0x50, 0x40, 0x23, 0x0c, 0x7f,
-	0xf8, 0xcf, 0x9f, 0xfa, 0x87, 0x7f, 0x86, 0x1a, 0xfa, 0x47, 0xc3, 0x08, 0x8d, 0x30, 0x32, 0xab,
-	0xea, 0x55, 0xd5, 0xfb, 0x9a, 0x9d, 0x8d, 0xd8, 0xfb, 0xd5, 0x5d, 0x99, 0x59, 0x55, 0x59, 0x59,
-	0x59, 0x59, 0x59, 0x59, 0x59, 0x8f, 0xd2, 0x5d, 0x91, 0x04, 0x97, 0xc7, 0xd1, 0x28, 0x19, 0xb1,
-	0x06, 0xfe, 0xf8, 0x3f, 0xa5, 0xb4, 0xde, 0x0e, 0x92, 0x80, 0x31, 0x5a, 0x5f, 0x17, 0xd1, 0x6e,
-	0x8b, 0x2c, 0x78, 0x8b, 0x75, 0x8e, 0xff, 0xd9, 0x29, 0xda, 0xe8, 0x0c, 0xfb, 0xe2, 0x6e, 0xcb,
-	0x43, 0xa0, 0x2c, 0xb0, 0x73, 0x74, 0x7a, 0x65, 0xb0, 0x17, 0x27, 0x22, 0xea, 0xb4, 0x5b, 0x35,
-	0xc4, 0x18, 0x00, 0x7b, 0x94, 0x36, 0x6e, 0x8e, 0xfa, 0x22, 0x6e, 0xd5, 0x17, 0x6a, 0x8b, 0x33,
-	0x4b, 0xc7, 0x65, 0x77, 0x97, 0x01, 0xd6, 0x19, 0xde, 0x19, 0x71, 0x89, 0x65, 0x4f, 0xd2, 0x69,
-	0xe8, 0x76, 0x23, 0x88, 0x45, 0xdc, 0x6a, 0x20, 0xe9, 0x49, 0x45, 0xaa, 0xe1, 0x48, 0x6e, 0xa8,
-	0xa0, 0xe5, 0x17, 0x63, 0x11, 0xc5, 0xad, 0x09, 0xa7, 0x65, 0x80, 0xc9, 0x96, 0x11, 0x0b, 0xec,
-	0xad, 0x06, 0x77, 0xb1, 0xbf, 0x76, 0x6b, 0x52, 0xb2, 0x97, 0x02, 0xd8, 0x22, 0x3d, 0xbe, 0x1a,
-	0xdc, 0xed, 0x6d, 0x07, 0x51, 0xff, 0x5a, 0x34, 0xda, 0x1b, 0x77, 0xda, 0xad, 0x29, 0xa4, 0xc9,
-	0x82, 0xd9, 0x05, 0x4a, 0x35, 0xa8, 0xd3, 0x6e, 0x4d, 0x23, 0x91, 0x05, 0x61, 0x6f, 0x96, 0x23,
-	0x90, 0x83, 0xa5, 0x0e, 0x4b, 0x1a, 0xce, 0x0d, 0x05, 0x90, 0xaf, 0x0a, 0x4d, 0x3e, 0x53, 0x2c,
-	0x1b, 0x43, 0xc1, 0x7c, 0x7a, 0x4c, 0xc9, 0xb4, 0x9b, 0xdc, 0xdc, 0xdb, 0x6d, 0xcd, 0x2e, 0x78,
-	0x8b, 0x4d, 0xee, 0xc0, 0xd8, 0x13, 0x74, 0xa2, 0x9b, 0xdc, 0x0a, 0xc5, 0x4b, 0xad, 0xe3, 0xd8,
-	0xde, 0x59, 0xab, 0xfb, 0xcb, 0x12, 0x73, 0x75, 0x98, 0x44, 0x07, 0x5c, 0x91, 0x41, 0xa3, 0x58,
-	0xb3, 0x2b, 0x22, 0xe8, 0xa5, 0x35, 0xb7, 0x40, 0xa0, 0x51, 0x1b, 0xa6, 0x04, 0x84, 0x33, 0xad,
-	0x05, 0x74, 0x22, 0x15, 0x90, 0x0d, 0x56, 0x02, 0x42, 0x50, 0xa7, 0xdd, 0x62, 0xa9, 0x80, 0x14,
-	0x04, 0x7a, 0x5b, 0x0d, 0xee, 0x5e, 0xdd, 0x17, 0xc3, 0x64, 0x6d, 0xdc, 0xe9, 0xb7, 0x4e, 0x2e,
-	0x90, 0xc5, 0x3a, 0x77, 0x60, 0xd0, 0xdb, 0x7a, 0xb0, 0x23, 0xd6, 0xf6, 0x45, 0x74, 0x75, 0x18,
-	0x6c, 0x0c, 0x44, 0xbf, 0x75, 0x6a, 0x81, 0x2c, 0x4e, 0xf1, 0x2c, 0x98, 0xbd, 0x9b, 0x36, 0x57,
-	0xc3, 0xad, 0x28, 0x48, 0x04, 0xd6, 0x8e, 0x5b, 0xa7, 0x9d, 0x31, 0xdb, 0x38, 0x94, 0xa5, 0x4b,
-	0x0d, 0x1d, 0x2d, 0x07, 0x83, 0x60, 0xb8, 0x69, 0x3a, 0x3a, 0x23, 0x3b, 0xca, 0x80, 0x95, 0x00,
-	0xda, 0xa3, 0x97, 0x86, 0xbd, 0x60, 0x77, 0x3c, 0x00, 0x2d, 0x3a, 0x8b, 0x9c, 0x67, 0xc1, 0xec,
-	0x8d, 0x74, 0xb2, 0x97, 0x44, 0x22, 0xd8, 0x8d, 0x5b, 0x2d, 0x64, 0xe6, 0x84, 0x62, 0x46, 0x42,
-	0x91, 0x0d, 0x4d, 0xc1, 0x16, 0xe8, 0x0c, 0x28, 0x8f, 0xc4, 0xb4, 0x5b, 0x0f, 0x61, 0x93, 0x36,
-	0x48, 0x29, 0xee, 0xca, 0x68, 0x38, 0xec, 0xf4, 0x5b, 0xf3, 0x88, 0x37, 0x00, 0xf6, 0x2c, 0x9d,
-	0x79, 0x61, 0x4f, 0x44, 0x07, 0x9d, 0x76, 0x67, 0x18, 0x26, 0xad, 0x87, 0xb1, 0xc3, 0x73, 0xf6,
-	0x8c, 0x5b, 0x68, 0x39, 0xed, 0x76, 0x05, 0xd6, 0xa6, 0x4d, 0x2e, 0xc6, 0x83, 0x70, 0x33, 0xc0,
-	0xf9, 0x8b, 0x5b, 0xe7, 0xb0, 0x85, 0x0b, 0x76, 0x0b, 0x0e, 0x81, 0x6c, 0xc3, 0xad, 0xc4, 0xde,
-	0x44, 0x4f, 0x00, 0xcb, 0x7b, 0x1b, 0xf1, 0x66, 0x14, 0x8e, 0x93, 0x70, 0x34, 0xec, 0xb4, 0x5b,
-	0xe7, 0x91, 0xd7, 0x3c, 0x82, 0x5d, 0xa2, 0x4d, 0x18, 0xc0, 0x0b, 0x2b, 0xdb, 0xc1, 0x70, 0x0b,
-	0x04, 0x79, 0x01, 0x29, 0x5d, 0xe0, 0xfc, 0xfb, 0xe8, 0x8c, 0xa5, 0xac, 0x6c, 0x8e, 0xd6, 0x76,
-	0xc4, 0x41, 0x8b, 0x2c, 0x90, 0xc5, 0x69, 0x0e, 0x7f, 0x61, 0xe1, 0xef, 0x07, 0x83, 0x3d, 0xd1,
-	0xf2, 0x16, 0x88, 0xbd, 0xca, 0x96, 0xbb, 0x72, 0xaa, 0x25, 0xf6, 0x19, 0xef, 0x69, 0x32, 0xff,
-	0x2c, 0x9d, 0xcb, 0x8a, 0xa1, 0xa0, 0xc1, 0x53, 0x76, 0x83, 0x75, 0xbb, 0xfe, 0x8b, 0x94, 0xe5,
-	0x85, 0x50, 0xd0, 0xc2, 0x1b, 0x5c, 0x96, 0xb4, 0xe9, 0x52, 0x75, 0x61, 0xf8, 0xb1, 0xd5, 0xac,
-	0xff, 0x4e, 0x7a, 0xcc, 0x46, 0xb1, 0x37, 0xd2, 0x09, 0x35, 0x0b, 0xc4, 0x31, 0x7d, 0x76, 0xdf,
-	0x5c, 0x91, 0xf8, 0xbf, 0x44, 0xd2, 0xda, 0x08, 0x61, 0xb3, 0xd4, 0xeb, 0xb4, 0xd1, 0x50, 0x37,
-	0xb9, 0xd7, 0x69, 0xb3, 0x79, 0x3a, 0xb5, 0x1a, 0x28, 0x7b, 0xec, 0x21, 0x34, 0x2d, 0xb3, 0x8b,
-	0xb4, 0xd1, 0x15, 0x60, 0x34, 0x6b, 0xd8, 0xd1, 0x8c, 0xea, 0x08, 0x60, 0x5c, 0x62, 0xd8, 0x19,
-	0x3a, 0xd1, 0x4b, 0x82, 0x64, 0x0f, 0x4c, 0x36, 0x54, 0x56, 0xa5, 0x74, 0x47, 0x68, 0x98, 0x1d,
-	0xc1, 0x7f, 0x9c, 0xd6, 0xa1, 0x52, 0x8e, 0x05, 0x46, 0xeb, 0x7c, 0x34, 0x10, 0xaa, 0x7b, 0xfc,
-	0xef, 0x5f, 0xa4, 0x93, 0xdd, 0x64, 0xed, 0xa5, 0xa1, 0x88, 0xa0, 0x0b, 0x65, 0x90, 0xe5, 0xf6,
-	0xa2, 0x4a, 0xfe, 0x2b, 0x04, 0x4c, 0x18, 0x4c, 0x22, 0xbb, 0x44, 0x1b, 0x48, 0x8b, 0x14, 0x33,
-	0x4b, 0xb3, 0x9a, 0x51, 0xd9, 0x02, 0x6f, 0xa4, 0x0d, 0x29, 0x5e, 0xbd, 0x2c, 0xaf, 0xdd, 0xa4,
-	0xd3, 0xc7, 0xed, 0xa8, 0xc9, 0xf1, 0x3f, 0xcc, 0xda, 0x2d, 0x11, 0xb5, 0xea, 0x38, 0xc7, 0xf0,
-	0x17, 0xb9, 0xbc, 0xd6, 0x69, 0xb7, 0x1a, 0x68, 0xf7, 0xf0, 0xbf, 0xff, 0x66, 0x3a, 0xa5, 0x15,
-	0x89, 0x5d, 0xa4, 0xf5, 0xf6, 0x46, 0x37, 0x51, 0x93, 0xd2, 0x4c, 0x59, 0x40, 0x2d, 0x43, 0x94,
-	0xff, 0xef, 0x84, 0x4e, 0x69, 0x7b, 0x6d, 0x49, 0xa1, 0xae, 0xa5, 0x70, 0x7d, 0x14, 0x27, 0xc8,
-	0xdb, 0x34, 0xc7, 0xff, 0xac, 0x45, 0x27, 0x79, 0x77, 0xe5, 0x4a, 0xbf, 0x1f, 0x61, 0xb7, 0xd3,
-	0x5c, 0x17, 0x01, 0xb3, 0xbe, 0xd2, 0xc5, 0x0a, 0x35, 0x89, 0x51, 0xc5, 0xcc, 0x8c, 0xd4, 0xd2,
-	0x51, 0x9e, 0xa2, 0x8d, 0x1b, 0xeb, 0xe1, 0xae, 0x68, 0x4d, 0xc8, 0xfd, 0x18, 0x0b, 0x60, 0x87,
-	0xaf, 0x8d, 0xe2, 0x38, 0x1c, 0x63, 0x27, 0x93, 0xd8, 0xb7, 0x05, 0x01, 0x83, 0xd6, 0x13, 0x5b,
-	0x91, 0xd8, 0x0a, 0x12, 0xa1, 0x9a, 0x9d, 0x92, 0x06, 0x2d, 0x03, 0x4e, 0x67, 0x91, 0x22, 0x3b,
-	0x72, 0x16, 0x05, 0x9d, 0xd2, 0x9b, 0x18, 0x7b, 0x84, 0x7a, 0x37, 0x43, 0x35, 0x41, 0xb9, 0xcd,
-	0xcb, 0xbb, 0x19, 0x02, 0xe3, 0x68, 0xae, 0xda, 0x6a, 0x65, 0xa9, 0x12, 0x18, 0xbf, 0x2b, 0x83,
-	0x70, 0x5f, 0x28, 0x64, 0x4d, 0x1a, 0x3f, 0x0b, 0xe4, 0x7f, 0xab, 0x46, 0x8f, 0xd9, 0x1b, 0x3f,
-	0xf0, 0x72, 0x33, 0xd8, 0x15, 0xd8, 0xdb, 0x34, 0xc7, 0xff, 0xec, 0x29, 0x7a, 0xa6, 0x2d, 0xee,
-	0x04, 0x7b, 0x83, 0x84, 0x8b, 0x44, 0x0c, 0x61, 0x2d, 0x75, 0x47, 0x83, 0x70, 0xf3, 0x40, 0x49,
-	0xbc, 0x04, 0xcb, 0xae, 0xd3, 0x13, 0x2e, 0x28, 0x14, 0x7a, 0x41, 0xcc, 0xa7, 0x2b, 0xcf, 0xa9,
-	0x82, 0x23, 0xca, 0x57, 0x82, 0x96, 0x56, 0x46, 0xc3, 0x24, 0x1c, 0xee, 0x8d, 0xf6, 0x62, 0xb0,
-	0x34, 0x61, 0xea, 0xe9, 0xe8, 0x96, 0x5c, 0xbc, 0x6a, 0x29, 0x57, 0x49, 0xee, 0x07, 0xd1, 0x4e,
-	0x5b, 0x0c, 0x44, 0x22, 0xfa, 0xa8, 0x1b, 0x53, 0xdc, 0x06, 0xb1, 0x27, 0xe8, 0x14, 0xfa, 0x1a,
-	0xcf, 0x8b, 0x83, 0xd6, 0x84, 0x63, 0x66, 0x34, 0x18, 0xdb, 0x4e, 0x89, 0xd8, 0xeb, 0xe9, 0xac,
-	0xdc, 0xc4, 0xd6, 0x83, 0xad, 0x2b, 0x51, 0x14, 0x1c, 0xb4, 0x26, 0xb1, 0xd5, 0x0c, 0x14, 0xec,
-	0x85, 0xb2, 0x27, 0x37, 0x51, 0x13, 0x6a, 0x3c, 0x2d, 0xc3, 0x9e, 0xb6, 0x86, 0xe6, 0x1b, 0x36,
-	0x58, 0x62, 0xed, 0x69, 0x6b, 0x1b, 0xb1, 0x42, 0x70, 0x4d, 0xe1, 0x7f, 0x87, 0xd0, 0x93, 0x19,
-	0xc1, 0xf5, 0xc6, 0x62, 0xd3, 0x9a, 0x3b, 0x92, 0xce, 0xdd, 0x3c, 0x9d, 0x6a, 0xef, 0x45, 0x68,
-	0xff, 0x50, 0x39, 0x6a, 0x3c, 0x2d, 0xb3, 0xcb, 0x94, 0x19, 0xd7, 0x2b, 0xa5, 0xaa, 0x21, 0x55,
-	0x01, 0xc6, 0x19, 0x40, 0x1d, 0xd7, 0xb2, 0x19, 0x80, 0x4f, 0x8f, 0xdd, 0x0e, 0xa2, 0xdd, 0xb4,
-	0x95, 0x06, 0xb6, 0xe2, 0xc0, 0xfc, 0x9f, 0xd6, 0xe8, 0xf1, 0x55, 0x11, 0xc4, 0x7b, 0x91, 0xd8,
-	0x55, 0xfe, 0x42, 0xa1, 0xbe, 0x3d, 0x49, 0xa7, 0xb5, 0x70, 0xc1, 0xe0, 0xd4, 0xca, 0xa6, 0xc0,
-	0x50, 0xb1, 0x67, 0xe8, 0x44, 0x6f, 0x73, 0x5b, 0xec, 0x06, 0x4a, 0xbf, 0x7c, 0xed, 0x9f, 0xb8,
-	0xdd, 0x5d, 0x96, 0x44, 0xca, 0x3d, 0x93, 0x85, 0xac, 0x4a, 0xd4, 0xf3, 0x2a, 0xf1, 0x0c, 0x6d,
-	0x86, 0xe0, 0x5d, 0x71, 0x31, 0x30, 0xa3, 0x9b, 0x59, 0x3a, 0xa5, 0x3a, 0xe9, 0xd8, 0x38, 0xee,
-	0x92, 0x82, 0x99, 0xb8, 0x3a, 0xdc, 0x0a, 0x87, 0x62, 0xfd, 0x60, 0x2c, 0x50, 0xa1, 0x9a, 0xdc,
-	0x82, 0xb0, 0xb7, 0xd3, 0x63, 0x2b, 0xa3, 0x41, 0x2f, 0x19, 0x45, 0xb8, 0x00, 0x51, 0x77, 0xcc,
-	0x78, 0x6d, 0x14, 0x77, 0x08, 0xd9, 0x93, 0x94, 0x1a, 0xe5, 0x40, 0x85, 0x2a, 0xd4, 0x1a, 0x8b,
-	0x88, 0x2d, 0x66, 0xb5, 0x4c, 0x9b, 0xfb, 0xac, 0x8a, 0xcd, 0xbf, 0x83, 0xce, 0x58, 0xa2, 0x3a,
-	0x6c, 0x2f, 0x6f, 0xd8, 0x9b, 0xee, 0x7f, 0x35, 0x72, 0xda, 0x59, 0x3a, 0xd3, 0xae, 0x76, 0x7a,
-	0xf7, 0xa4, 0x9d, 0xde, 0x3d, 0x69, 0xa7, 0xe7, 0x68, 0xe7, 0x33, 0xf4, 0x98, 0xa5, 0x09, 0xfa,
-	0xe4, 0x73, 0xa6, 0x58, 0x49, 0xb8, 0x43, 0xcb, 0x56, 0xe9, 0xcc, 0x6a, 0x9c, 0xdc, 0x12, 0x51,
-	0x8c, 0x82, 0x9b, 0xc5, 0xaa, 0x6f, 0x2c, 0xb7, 0x5f, 0x97, 0x2d, 0x6a, 0xe5, 0x10, 0x5a, 0x10,
-	0xf6, 0x76, 0x3a, 0x63, 0x98, 0xd7, 0x87, 0xaa, 0xd3, 0xb6, 0x7a, 0x4b, 0x47, 0x1f, 0x18, 0xb1,
-	0x29, 0xc1, 0x13, 0xb7, 0xfd, 0xbc, 0xb8, 0x35, 0xe9, 0x78, 0xe2, 0x8e, 0x0f, 0x88, 0x9e, 0xb8,
-	0x43, 0x9d, 0xd5, 0xf2, 0xa9, 0xbc, 0x96, 0x2f, 0xd0, 0x99, 0xeb, 0xa3, 0x24, 0x95, 0xf4, 0x34,
-	0x4a, 0xda, 0x06, 0xe5, 0x16, 0x39, 0x45, 0x12, 0x07, 0x06, 0xd3, 0x66, 0x8e, 0x2b, 0x29, 0xe5,
-	0x8c, 0x9c, 0xb6, 0x3c, 0x06, 0xe4, 0x61, 0xa0, 0x71, 0xeb, 0x98, 0x23, 0x0f, 0xeb, 0xe0, 0x83,
-	0xf2, 0xb0, 0x28, 0xd9, 0x1a, 0x3d, 0x65, 0x8e, 0x05, 0x46, 0xfc, 0xad, 0x26, 0x6a, 0xf6, 0xc3,
-	0xda, 0x5b, 0x2d, 0x20, 0xe1, 0x85, 0x15, 0xc1, 0x89, 0xcd, 0x4e, 0xdd, 0x61, 0x8a, 0xdf, 0xb4,
-	0x15, 0x3f, 0xa0, 0x27, 0x0b, 0x36, 0xa1, 0x42, 0xbd, 0x3f, 0x45, 0x1b, 0x48, 0xa0, 0x36, 0x50,
-	0x59, 0x80, 0x09, 0xb8, 0x11, 0xc4, 0x09, 0xdf, 0x1b, 0xa2, 0xb7, 0x21, 0x0d, 0xb1, 0x0d, 0xf2,
-	0xff, 0x97, 0xd0, 0x59, 0x57, 0x47, 0x72, 0xce, 0xd0, 0x39, 0x3a, 0xdd, 0x4b, 0x82, 0x28, 0xc1,
-	0x26, 0xe4, 0x9a, 0x32, 0x00, 0x70, 0x7e, 0xae, 0x0e, 0xfb, 0xaa, 0x79, 0xc0, 0xe9, 0x22, 0xd4,
-	0x53, 0x8a, 0x70, 0x25, 0x51, 0xfe, 0x8f, 0x01, 0xb0, 0x45, 0x3a, 0x81, 0xfd, 0xea, 0xa5, 0x33,
-	0x67, 0x2b, 0x2c, 0xca, 0x54, 0xe1, 0x61, 0x10, 0xeb, 0xd1, 0xde, 0x70, 0x33, 0x90, 0x2d, 0x4d,
-	0xc8, 0x41, 0x58, 0xa0, 0x8c, 0x45, 0x9c, 0xcc, 0x59, 0xc4, 0x16, 0x9d, 0xdc, 0x97, 0x93, 0xd0,
-	0x3a, 0x86, 0x48, 0x5d, 0xf4, 0x3f, 0xeb, 0xa9, 0x9d, 0xa1, 0x70, 0xe4, 0x17, 0xe8, 0x14, 0x7a,
-	0xab, 0x9d, 0xb6, 0xdc, 0x35, 0x9a, 0xcb, 0x5e, 0x8b, 0xf0, 0x14, 0x06, 0x73, 0xb9, 0x1a, 0x4a,
-	0x0b, 0x32, 0xcd, 0xe1, 0x2f, 0x42, 0x82, 0xbb, 0x38, 0x5a, 0x80, 0x04, 0x77, 0xd1, 0xf9, 0x0e,
-	0x45, 0x94, 0x3a, 0xdf, 0xa1, 0x40, 0x87, 0x51, 0x9f, 0xb6, 0xa5, 0x03, 0xa8, 0x8b, 0xe0, 0xe2,
-	0x19, 0x4d, 0xba, 0x21, 0xf6, 0xc5, 0x00, 0xfd, 0xc0, 0x1a, 0xcf, 0x82, 0x61, 0xe5, 0x38, 0x47,
-	0x5b, 0xe9, 0x09, 0x3a, 0x30, 0x69, 0xc0, 0x82, 0xfe, 0xda, 0x70, 0x70, 0xd0, 0x9a, 0xc6, 0xe5,
-	0x99, 0x96, 0xe5, 0xa1, 0x5f, 0x2f, 0x55, 0x74, 0x14, 0xa7, 0xb8, 0x05, 0xf1, 0x39, 0x3d, 0x66,
-	0x6f, 0x8d, 0xd0, 0x56, 0xea, 0xc4, 0x80, 0x5b, 0x3d, 0x6d, 0xf9, 0x2b, 0x30, 0x46, 0x90, 0xbc,
-	0x27, 0xdd, 0x04, 0x94, 0x39, 0xa3, 0xf5, 0xde, 0x56, 0xea, 0x22, 0xe2, 0x7f, 0xff, 0x43, 0x74,
-	0x2e, 0x6b, 0x54, 0x0a, 0x95, 0x99, 0xd1, 0xfa, 0xea, 0xa8, 0x2f, 0xb4, 0xfb, 0x0d, 0xff, 0x71,
-	0xbc, 0x22, 0x4e, 0xc2, 0xa1, 0x3c, 0x79, 0xe1, 0xae, 0x3c, 0xcd, 0x1d, 0x98, 0x7f, 0x89, 0x52,
-	0xe4, 0xa9, 0xfa, 0xac, 0xf2, 0x19, 0x42, 0xa7, 0x74, 0xac, 0xa9, 0xac, 0xfb, 0xeb, 0x41, 0xbc,
-	0x9d, 0x7a, 0xff, 0x41, 0xbc, 0x0d, 0xeb, 0xeb, 0x4a, 0x7f, 0x57, 0x4d, 0xf6, 0x14, 0x97, 0x05,
-	0xe8, 0x82, 0xbf, 0x04, 0x6d, 0xa9, 0x3d, 0x5e, 0x95, 0xd8, 0x5b, 0x29, 0xed, 0x46, 0xe1, 0x7e,
-	0x38, 0x10, 0x5b, 0x69, 0x54, 0xec, 0x94, 0x15, 0xe6, 0x4a, 0x91, 0xdc, 0xa2, 0xf3, 0x3b, 0xb4,
-	0xe9, 0x20, 0x71, 0x33, 0x53, 0xae, 0xb4, 0x62, 0x30, 0x2d, 0xc3, 0xea, 0x4a, 0x09, 0x91, 0xd3,
-	0x06, 0x37, 0x00, 0xff, 0x55, 0x42, 0x9b, 0x8e, 0x13, 0x01, 0x9a, 0xc9, 0xc3, 0xbe, 0x3a, 0xe9,
-	0xc1, 0x5f, 0x80, 0xac, 0x85, 0x7d, 0xa9, 0xd8, 0x1c, 0xfe, 0x42, 0x9b, 0x58, 0x09, 0x25, 0x22,
-	0x05, 0x6c, 0x00, 0xec, 0x2d, 0x94, 0x62, 0xe1, 0x46, 0x18, 0x27, 0xda, 0x57, 0x9e, 0xb3, 0xcd,
-	0x2a, 0x20, 0xb8, 0x45, 0x03, 0x9e, 0x08, 0x96, 0xb4, 0x8b, 0xe0, 0x86, 0x07, 0x6d, 0x14, 0x77,
-	0x08, 0xfd, 0x8b, 0x8a, 0x11, 0x68, 0x06, 0x83, 0x97, 0xf0, 0x47, 0xa9, 0x9d, 0x2c, 0xf8, 0x7d,
-	0xda, 0xe2, 0x63, 0x7b, 0x5b, 0x7d, 0x2e, 0x14, 0x83, 0x7e, 0x8c, 0x93, 0x7a, 0x9d, 0xce, 0x65,
-	0x76, 0x60, 0x7d, 0x3e, 0x3f, 0x97, 0xdf, 0xa0, 0x4d, 0x3d, 0x9e, 0xab, 0xe5, 0x8f, 0xe8, 0xe9,
-	0x42, 0x52, 0x58, 0xc2, 0xab, 0x71, 0x62, 0xa9, 0x8e, 0x2e, 0xb2, 0x77, 0x51, 0x0a, 0x0b, 0x40,
-	0xd2, 0x2a, 0x67, 0xb3, 0xa0, 0x5b, 0x43, 0xc3, 0x2d, 0x7a, 0x7f, 0xc5, 0xe9, 0xd0, 0x20, 0x40,
-	0xd5, 0x54, 0x93, 0x52, 0x0c, 0xaa, 0x64, 0xad, 0x3d, 0x30, 0x13, 0xf8, 0xdf, 0xff, 0xb4, 0x47,
-	0xa9, 0x09, 0x5d, 0x15, 0xea, 0xb8, 0x34, 0x75, 0x5e, 0x6a, 0xea, 0xde, 0x4a, 0x27, 0x7a, 0xd1,
-	0xe6, 0x2a, 0x1e, 0x61, 0x3d, 0x8b, 0x63, 0xd9, 0x4c, 0xd6, 0x9f, 0x51, 0xb4, 0x50, 0xab, 0x2d,
-	0x62, 0xa8, 0x55, 0xbf, 0x97, 0x5a, 0x92, 0x16, 0xd4, 0xba, 0x33, 0x4c, 0x44, 0xb4, 0x1f, 0x0c,
-	0xd0, 0x2c, 0xd6, 0x78, 0x5a, 0x86, 0xc9, 0x6e, 0x8b, 0x41, 0x70, 0x80, 0x86, 0xb1, 0xc6, 0x65,
-	0x01, 0x46, 0xd0, 0x0e, 0x77, 0xa5, 0x83, 0x32, 0xcd, 0xf1, 0x3f, 0x7b, 0x8c, 0x36, 0x56, 0x82,
-	0xc1, 0x00, 0x1c, 0xd5, 0x7c, 0xc8, 0x0e, 0x30, 0x5c, 0xe2, 0xfd, 0xa7, 0xe8, 0x8c, 0x11, 0x06,
-	0xd6, 0xb3, 0x35, 0xa2, 0x20, 0xd4, 0x27, 0xf1, 0xfe, 0x47, 0xe9, 0xe9, 0xc2, 0x71, 0x94, 0xfa,
-	0x9d, 0x7a, 0xa9, 0x7a, 0x99, 0xa5, 0xba, 0x48, 0x8f, 0x67, 0x8f, 0xb9, 0x72, 0xcb, 0xc8, 0x82,
-	0xfd, 0x1b, 0x7a, 0xde, 0x80, 0x73, 0xe8, 0x07, 0x7e, 0x75, 0x3f, 0x08, 0x3b, 0x45, 0x1b, 0x38,
-	0xf1, 0x7a, 0x9f, 0xc7, 0x02, 0x5a, 0xa7, 0x41, 0x18, 0xc4, 0xaa, 0x5d, 0x59, 0xf0, 0xff, 0x85,
-	0xb8, 0x27, 0x01, 0xb0, 0xf9, 0xdd, 0x28, 0xdc, 0x0d, 0xa2, 0x03, 0x63, 0xc5, 0x2d, 0x08, 0x28,
-	0x75, 0x6f, 0x14, 0x25, 0x80, 0xf4, 0x10, 0xa9, 0x8b, 0xb0, 0x07, 0x77, 0xa3, 0xd1, 0x58, 0x44,
-	0x09, 0x56, 0x95, 0xb6, 0xc1, 0x06, 0xb1, 0x4b, 0xb4, 0xa9, 0x8b, 0xb7, 0xd0, 0x9b, 0xa9, 0x23,
-	0x8d, 0x0b, 0x64, 0x6f, 0xa1, 0x27, 0xc1, 0x37, 0x50, 0xd1, 0xef, 0xcc, 0xd9, 0xae, 0x08, 0x05,
-	0x67, 0xe1, 0x95, 0xd1, 0xee, 0x38, 0xd8, 0x84, 0x52, 0x7a, 0xe2, 0x69, 0xf0, 0x0c, 0xd4, 0x7f,
-	0x49, 0x79, 0x7d, 0xd2, 0x84, 0xc0, 0x72, 0x59, 0x1f, 0xed, 0x88, 0x61, 0xac, 0x3c, 0x2d, 0x55,
-	0x02, 0x11, 0xe0, 0xbf, 0xf0, 0x65, 0x11, 0xc5, 0x6a, 0xc3, 0xb2, 0x20, 0x65, 0x0c, 0xd6, 0x4a,
-	0x19, 0xf4, 0x9f, 0x76, 0x8d, 0x1c, 0x5b, 0x74, 0xf5, 0x8b, 0xe5, 0xad, 0x9d, 0x56, 0xb0, 0xdf,
-	0x9c, 0xa3, 0x93, 0x2b, 0xa3, 0xdd, 0xdd, 0x60, 0xd8, 0x67, 0x8f, 0xd1, 0x7a, 0x02, 0x83, 0x83,
-	0xb9, 0x9e, 0xb5, 0x0e, 0x6b, 0x88, 0xbd, 0x0c, 0x23, 0xe4, 0x48, 0xe0, 0xff, 0xd3, 0x71, 0xb9,
-	0xe0, 0xd9, 0x43, 0xf4, 0xf4, 0x4a, 0x24, 0x82, 0x44, 0x68, 0x3d, 0x53, 0xc4, 0x73, 0x35, 0x76,
-	0x96, 0x9e, 0x6c, 0x47, 0xa3, 0x71, 0x16, 0x51, 0x67, 0x0b, 0xf4, 0x9c, 0xac, 0x93, 0x51, 0x3c,
-	0x4d, 0xd1, 0x60, 0x17, 0xe8, 0x3c, 0x54, 0x2d, 0xc1, 0x4f, 0xb0, 0x4b, 0x74, 0xa1, 0x27, 0x92,
-	0xe2, 0xf0, 0x8c, 0xa6, 0x9a, 0x84, 0x7e, 0x5e, 0x1c, 0xf7, 0xcb, 0xfb, 0x99, 0x62, 0x0f, 0xd3,
-	0xb3, 0x92, 0x13, 0xe3, 0x7c, 0x6a, 0xe4, 0x34, 0x20, 0xa5, 0x17, 0x92, 0x47, 0x52, 0x76, 0x9a,
-	0x9e, 0x90, 0x35, 0x61, 0xaf, 0xd4, 0xe0, 0x26, 0x3b, 0x49, 0x8f, 0x03, 0xe3, 0x36, 0x70, 0x16,
-	0x68, 0x25, 0x1f, 0x36, 0xf8, 0x38, 0xc8, 0xa7, 0x27, 0x92, 0x74, 0xb7, 0xd4, 0x88, 0x39, 0xc6,
-	0xe8, 0x2c, 0x8c, 0x2e, 0x48, 0x02, 0x0d, 0x3b, 0xc1, 0xce, 0xd1, 0x56, 0x4f, 0x24, 0xb8, 0xdf,
-	0xe7, 0x6a, 0x30, 0x76, 0x9e, 0x3e, 0xa4, 0xc6, 0x61, 0x39, 0x36, 0x1a, 0x7d, 0x1a, 0x47, 0x12,
-	0x8d, 0xc6, 0x45, 0xc8, 0x33, 0x66, 0x06, 0xf5, 0x6d, 0x91, 0x46, 0xb5, 0xdc, 0xc9, 0xb5, 0x51,
-	0x0f, 0x01, 0x4a, 0x8e, 0x29, 0x8b, 0x9a, 0x07, 0x94, 0x94, 0x5b, 0xb6, 0xc1, 0x87, 0x0d, 0x2a,
-	0x5b, 0xeb, 0x1c, 0x3b, 0x43, 0x59, 0x4f, 0x24, 0xd9, 0x2a, 0xe7, 0xd9, 0x29, 0x3a, 0x87, 0xbc,
-	0xc3, 0x1c, 0x68, 0xe8, 0x05, 0x18, 0x30, 0x7a, 0x89, 0x4a, 0xb7, 0x64, 0xa3, 0x1a, 0xfd, 0x08,
-	0x0c, 0x58, 0x72, 0x67, 0x1c, 0x31, 0x8d, 0x7c, 0x1d, 0x28, 0x0f, 0xd4, 0xcd, 0x28, 0x85, 0xdb,
-	0xc4, 0x63, 0x20, 0x70, 0x2d, 0x96, 0xd4, 0xee, 0x6a, 0xec, 0x93, 0xc0, 0xd5, 0x95, 0x41, 0x22,
-	0x22, 0xed, 0x7c, 0xae, 0xec, 0xf6, 0xe7, 0x96, 0x60, 0xa2, 0xb9, 0xec, 0x32, 0x1c, 0x6e, 0x69,
-	0xe2, 0xb7, 0xc2, 0x44, 0x2b, 0x6e, 0x30, 0xf4, 0xa0, 0x11, 0x6f, 0x03, 0x04, 0x17, 0xe3, 0x51,
-	0x94, 0xc8, 0x33, 0x86, 0x46, 0x3c, 0x05, 0xc2, 0xe8, 0x46, 0x7b, 0x43, 0x21, 0x8f, 0x84, 0x1a,
-	0xfe, 0x0e, 0xd0, 0x68, 0x60, 0xdd, 0x62, 0xc9, 0x65, 0xfb, 0x19, 0x36, 0x4f, 0xcf, 0x80, 0xb8,
-	0x0a, 0x98, 0x7e, 0x27, 0x30, 0x0d, 0xa6, 0x83, 0x07, 0x43, 0xa3, 0x3b, 0xef, 0x62, 0x2d, 0x7a,
-	0x0a, 0xbb, 0xd7, 0xa6, 0x44, 0x63, 0xde, 0x6d, 0x16, 0x80, 0x39, 0x9e, 0x6a, 0xe4, 0xb3, 0xb0,
-	0x44, 0x2d, 0x11, 0x83, 0x29, 0x81, 0x43, 0x85, 0xc6, 0xbf, 0xc7, 0x4c, 0x01, 0x4c, 0xa7, 0x0c,
-	0x08, 0x6b, 0xe4, 0x7b, 0x61, 0x7c, 0x52, 0xb8, 0x78, 0x9d, 0xa6, 0xe1, 0x57, 0x00, 0x2e, 0x2b,
-	0x39, 0xf0, 0x65, 0x23, 0x41, 0x19, 0x3c, 0xd7, 0x88, 0x15, 0xa8, 0xc0, 0xc5, 0xee, 0x68, 0xdf,
-	0xad, 0xd0, 0x66, 0x17, 0xe9, 0x79, 0xa5, 0xb9, 0x99, 0x13, 0xb1, 0x26, 0xb9, 0xca, 0x1e, 0xa1,
-	0x0f, 0xa3, 0x79, 0x2a, 0x21, 0x78, 0x0e, 0x46, 0x78, 0x4d, 0x24, 0x65, 0xf8, 0x6b, 0xd6, 0xea,
-	0xd8, 0x90, 0x17, 0x4e, 0x1a, 0x75, 0x9d, 0xbd, 0x81, 0x3e, 0x7a, 0x0d, 0x94, 0xd9, 0xd9, 0xb1,
-	0x6f, 0x87, 0xc9, 0x76, 0x08, 0x6d, 0x09, 0x9e, 0xca, 0xb1, 0x03, 0xda, 0x68, 0xc9, 0xd1, 0x3a,
-	0x38, 0x59, 0xe3, 0x7c, 0x1f, 0x08, 0x00, 0x26, 0x7e, 0x3d, 0xd8, 0x11, 0xa3, 0x7d, 0x23, 0xe6,
-	0xe7, 0x35, 0x42, 0xdf, 0x3a, 0x6a, 0xc4, 0x0d, 0x40, 0x28, 0x93, 0x20, 0xb7, 0x72, 0x85, 0x58,
-	0x05, 0x25, 0xc5, 0x05, 0xe5, 0x80, 0x6f, 0x32, 0x9f, 0x5e, 0xc8, 0xb3, 0x8c, 0x9b, 0xb6, 0xa6,
-	0x59, 0x83, 0x11, 0xdf, 0x12, 0x51, 0x78, 0xe7, 0x20, 0xbb, 0x7c, 0xbb, 0xd0, 0xdd, 0xd5, 0xbb,
-	0xe3, 0x60, 0xd8, 0x77, 0x55, 0xf6, 0x05, 0x50, 0x48, 0x3d, 0x75, 0x2a, 0x04, 0xa1, 0x71, 0x1c,
-	0xda, 0x03, 0x09, 0x2f, 0x2f, 0x47, 0xa1, 0xb8, 0x63, 0x0f, 0xb8, 0xa7, 0x84, 0x6f, 0x7b, 0xd6,
-	0x36, 0x7e, 0x1d, 0x56, 0x02, 0x17, 0x5b, 0x21, 0xec, 0x81, 0xea, 0x86, 0x6e, 0xed, 0xce, 0x9d,
-	0x58, 0xa4, 0x2a, 0xf0, 0xa2, 0xd9, 0x65, 0x32, 0xc1, 0x0b, 0x4d, 0x71, 0x0b, 0x6d, 0xea, 0x47,
-	0x07, 0x4b, 0x60, 0x73, 0xae, 0x8b, 0x20, 0x4a, 0x36, 0x44, 0x90, 0xd6, 0xbf, 0x8d, 0xf5, 0xdd,
-	0x9a, 0x72, 0xad, 0x6a, 0x8a, 0x9f, 0x51, 0x22, 0xcb, 0x10, 0xdd, 0x10, 0xd6, 0x5e, 0xf7, 0xb3,
-	0x7a, 0x27, 0x2b, 0xe1, 0xe1, 0xfd, 0xa0, 0x85, 0x37, 0x47, 0x49, 0x78, 0xe7, 0x60, 0xe5, 0x05,
-	0x59, 0x13, 0xaf, 0x31, 0x53, 0x4b, 0xf7, 0x01, 0xd0, 0xe4, 0x9e, 0x48, 0x70, 0x11, 0xb9, 0xd7,
-	0x2b, 0x9a, 0xe4, 0x83, 0xd2, 0xec, 0xc0, 0x22, 0xb0, 0xa7, 0xe4, 0xe7, 0x60, 0x78, 0x7a, 0xfb,
-	0x4b, 0xef, 0x0a, 0x35, 0xf6, 0x43, 0x06, 0x5b, 0x60, 0x2a, 0xc4, 0xe3, 0x53, 0x53, 0xfd, 0xb9,
-	0x57, 0x5e, 0x79, 0xe5, 0x15, 0xcf, 0xff, 0x47, 0xaf, 0x64, 0x87, 0x2f, 0x74, 0x40, 0xdb, 0x79,
-	0x27, 0x53, 0x5e, 0x69, 0x56, 0x5d, 0x8c, 0x64, 0xab, 0x80, 0x7b, 0xa4, 0x43, 0x9c, 0x7b, 0xbb,
-	0xe8, 0xf5, 0x34, 0xb9, 0x05, 0x61, 0x8f, 0xd2, 0x5a, 0x6f, 0x27, 0xc4, 0xd3, 0x6e, 0x49, 0x08,
-	0x1d, 0xf0, 0x05, 0x17, 0x18, 0x8d, 0xc2, 0x0b, 0x8c, 0xa3, 0x5c, 0x52, 0x2c, 0x3d, 0x47, 0x27,
-	0x37, 0x95, 0x00, 0x66, 0x5d, 0xff, 0xa8, 0xb5, 0x85, 0x95, 0xf5, 0xe9, 0xa3, 0x50, 0x68, 0x5c,
-	0x57, 0xf6, 0x47, 0x85, 0xde, 0x51, 0x91, 0x50, 0x97, 0xda, 0xe5, 0x5d, 0x6e, 0x3b, 0xc2, 0x2d,
-	0x68, 0xd0, 0x74, 0xf8, 0x6f, 0xa4, 0xda, 0xed, 0xaa, 0x3c, 0xe7, 0x17, 0xce, 0xab, 0x77, 0xd4,
-	0x79, 0xc5, 0x58, 0x9c, 0xf4, 0xd9, 0xba, 0x2a, 0x84, 0x61, 0x00, 0x4b, 0xab, 0xe5, 0xc3, 0x0c,
-	0x71, 0x98, 0xaf, 0x73, 0x24, 0x5b, 0x3c, 0x0a, 0x33, 0xde, 0xcf, 0x93, 0x2a, 0x27, 0xb2, 0x72,
-	0xb4, 0x7a, 0x12, 0x3c, 0x6b, 0x12, 0x9e, 0x2f, 0xe7, 0xee, 0x23, 0xc8, 0xdd, 0x45, 0x6b, 0x12,
-	0x0e, 0xe3, 0xed, 0x2b, 0xe4, 0x70, 0x07, 0xf6, 0xc8, 0x1c, 0xbe, 0x50, 0xce, 0xe1, 0x0e, 0x72,
-	0xf8, 0x98, 0x5e, 0x29, 0x87, 0xf4, 0x6c, 0xf8, 0xfc, 0x6e, 0xad, 0xda, 0x85, 0x3e, 0x2a, 0x8f,
-	0x70, 0xb6, 0xbb, 0x29, 0x5e, 0x52, 0x91, 0x1d, 0xbc, 0xa4, 0x56, 0x45, 0xe7, 0xca, 0xa4, 0x9e,
-	0xb9, 0xd0, 0xb3, 0xaf, 0x40, 0x1a, 0x99, 0x0b, 0xba, 0xe2, 0xeb, 0x94, 0x89, 0xd2, 0xcb, 0x3e,
-	0xbc, 0x2f, 0xd8, 0x11, 0x4a, 0x00, 0x18, 0xd7, 0xc4, 0xfb, 0x82, 0x14, 0x94, 0xbf, 0x2f, 0x20,
-	0x87, 0xdf, 0x17, 0x90, 0x7b, 0xbe, 0x2f, 0x20, 0xc5, 0xf7, 0x05, 0x55, 0xda, 0x3f, 0x70, 0xb4,
-	0xbf, 0x6a, 0x3e, 0xcc, 0xcc, 0xfd, 0x8a, 0x57, 0x7a, 0xb4, 0xa9, 0x9c, 0xb4, 0x33, 0x74, 0xc2,
-	0xb9, 0x03, 0x9f, 0x30, 0x4b, 0x17, 0x7c, 0xc7, 0x38, 0x09, 0x76, 0xc7, 0x2a, 0xc4, 0x6e, 0x00,
-	0x18, 0x9c, 0x87, 0x6e, 0x30, 0xc6, 0x5c, 0x97, 0x49, 0x72, 0x29, 0x20, 0x13, 0x18, 0x6f, 0x14,
-	0x05, 0xc6, 0x95, 0x6b, 0x80, 0xf2, 0x69, 0x72, 0x5d, 0x5c, 0xba, 0x5e, 0x2e, 0x94, 0x5d, 0x14,
-	0xca, 0x05, 0xc7, 0x24, 0xe4, 0x86, 0x6a, 0xe4, 0xf1, 0x3f, 0xa4, 0xf4, 0x34, 0x77, 0x5f, 0xf2,
-	0xf0, 0x55, 0x60, 0x5a, 0x27, 0xb5, 0xc9, 0xc4, 0x45, 0x07, 0xe6, 0x5e, 0x3d, 0x48, 0x8d, 0xb4,
-	0xae, 0x1e, 0x2e, 0x50, 0x2a, 0x0b, 0xe9, 0x75, 0x41, 0x83, 0x5b, 0x90, 0xaa, 0xb1, 0x0f, 0x9d,
-	0xb1, 0x97, 0x0c, 0xcb, 0x8c, 0xfd, 0xeb, 0xa4, 0xe0, 0xb0, 0xfa, 0x60, 0x62, 0xce, 0x4b, 0xcb,
-	0xe5, 0x5c, 0x7f, 0x14, 0xb9, 0x6e, 0x39, 0x33, 0x66, 0x31, 0x64, 0xf8, 0xdd, 0xca, 0x1d, 0xa2,
-	0x0b, 0xb7, 0xc5, 0xf7, 0x96, 0x77, 0x15, 0x61, 0x57, 0x67, 0x2c, 0x8b, 0x5c, 0xd8, 0xd1, 0xc7,
-	0x0b, 0x0e, 0xe6, 0xf7, 0x2a, 0x97, 0xaa, 0x91, 0xc6, 0xce, 0x48, 0x73, 0x5d, 0x18, 0x06, 0xbe,
-	0x49, 0x0a, 0x63, 0x00, 0xa0, 0x91, 0x40, 0x3f, 0x34, 0x7c, 0xa4, 0xe5, 0xca, 0x18, 0x9f, 0x13,
-	0x8e, 0xaf, 0x65, 0xc2, 0xf1, 0x55, 0x7e, 0x44, 0xe2, 0xf8, 0x11, 0x05, 0x2c, 0x19, 0x9e, 0xa3,
-	0x6c, 0x74, 0x82, 0x3d, 0x22, 0x73, 0x7e, 0x55, 0x26, 0xcf, 0x8c, 0x95, 0x02, 0xc8, 0x11, 0xb1,
-	0xf4, 0x9e, 0xf2, 0x8e, 0xf7, 0xb0, 0xe3, 0xd3, 0xd6, 0xce, 0x64, 0x1a, 0x36, 0x7d, 0x7e, 0x96,
-	0x94, 0x87, 0x3f, 0x2a, 0x85, 0x95, 0x2a, 0xaf, 0x67, 0x29, 0xef, 0x52, 0xa7, 0x9c, 0x9f, 0x7d,
-	0xe4, 0xe7, 0x11, 0xc3, 0x4f, 0x61, 0x9f, 0x8e, 0x5d, 0x29, 0x0f, 0xbd, 0x3c, 0xb8, 0x18, 0x6d,
-	0x7a, 0x39, 0x55, 0xaf, 0xb8, 0x9c, 0x6a, 0xe4, 0x2f, 0xa7, 0x96, 0xde, 0x57, 0x3e, 0xf4, 0x03,
-	0x1c, 0xfa, 0x82, 0x6b, 0x51, 0xf3, 0x83, 0x32, 0x63, 0xff, 0x01, 0x29, 0x8d, 0x2b, 0x3d, 0xb8,
-	0x91, 0x57, 0xd9, 0xc5, 0x97, 0x5d, 0xbb, 0x58, 0xcc, 0x9a, 0xe1, 0xff, 0x27, 0xa4, 0x24, 0xf4,
-	0x05, 0x9c, 0x5e, 0x5f, 0x5f, 0xef, 0x62, 0x06, 0x9c, 0x52, 0x29, 0x5d, 0xb6, 0x33, 0xf0, 0xa4,
-	0xf0, 0x33, 0x19, 0x78, 0x88, 0x91, 0xc3, 0xd3, 0x45, 0xcc, 0x84, 0x03, 0x06, 0xe5, 0x2e, 0x81,
-	0xff, 0xab, 0x0e, 0x12, 0x1f, 0x2b, 0x38, 0x48, 0x64, 0x58, 0x34, 0xa3, 0xf8, 0x1a, 0x29, 0x89,
-	0xd2, 0x1d, 0x36, 0x8a, 0x0a, 0x5e, 0x33, 0x59, 0x7b, 0x55, 0xbc, 0xfe, 0x7c, 0xc9, 0xa1, 0xa7,
-	0x90, 0xd7, 0xdb, 0xb4, 0xa9, 0x71, 0x18, 0xb0, 0x49, 0x53, 0x1c, 0x81, 0xbd, 0x63, 0x2a, 0xc5,
-	0xf1, 0x1c, 0x9d, 0x46, 0xa4, 0x75, 0xa1, 0x64, 0x00, 0x26, 0x69, 0xb1, 0x66, 0x25, 0x2d, 0xfa,
-	0xa3, 0x92, 0x98, 0x63, 0xf6, 0x32, 0xbd, 0x6a, 0x24, 0x1f, 0x77, 0x46, 0x52, 0xd8, 0x9c, 0x19,
-	0xc9, 0xb8, 0x24, 0x92, 0x99, 0xeb, 0xf0, 0x5a, 0x79, 0x87, 0xaf, 0x90, 0x82, 0x1e, 0x4b, 0x65,
-	0xf7, 0x1c, 0x38, 0xc1, 0xf1, 0x78, 0x34, 0x8c, 0xf1, 0xde, 0x6c, 0xed, 0x79, 0xec, 0x64, 0x8a,
-	0x7b, 0x6b, 0xcf, 0x83, 0x50, 0xae, 0x46, 0xd1, 0x28, 0x52, 0x57, 0x09, 0xb2, 0x60, 0xde, 0x5b,
-	0xc8, 0xdb, 0x6f, 0x59, 0xf0, 0x7f, 0x48, 0x8a, 0x22, 0xad, 0xaf, 0x89, 0xca, 0x57, 0x6c, 0x40,
-	0x9f, 0x90, 0xb2, 0x78, 0xc8, 0x18, 0xde, 0x52, 0xd1, 0xdf, 0xc9, 0x47, 0x84, 0x73, 0x52, 0xaf,
-	0xd8, 0x9c, 0x3f, 0x29, 0x7b, 0x3a, 0x6b, 0x5b, 0x09, 0xab, 0x29, 0xd3, 0xcf, 0xc7, 0x2a, 0x62,
-	0xcc, 0x85, 0x0e, 0x49, 0xc5, 0x11, 0xf1, 0x53, 0xc4, 0x31, 0xae, 0xa5, 0xed, 0x9a, 0xde, 0xff,
-	0x8e, 0x94, 0xc6, 0xb0, 0xf1, 0x86, 0x0c, 0xa3, 0xae, 0xf2, 0x26, 0xbd, 0xc6, 0x75, 0x11, 0x30,
-	0x32, 0x2f, 0xa4, 0xaf, 0x56, 0x8e, 0x2e, 0x82, 0xc3, 0xd6, 0xde, 0x50, 0x07, 0x2f, 0x74, 0x64,
-	0x65, 0x09, 0x1d, 0xb9, 0x31, 0xc2, 0xe5, 0xd4, 0xaa, 0x52, 0xd5, 0x1e, 0xf9, 0x8b, 0xc4, 0xb1,
-	0xb3, 0x25, 0x5c, 0x9a, 0xa1, 0x7c, 0x95, 0x1c, 0x1e, 0x71, 0x3f, 0xf2, 0x69, 0x97, 0x97, 0xf3,
-	0xf7, 0x69, 0xe2, 0x1c, 0x77, 0x0f, 0xeb, 0xda, 0x30, 0xfa, 0x8d, 0x5a, 0x79, 0xd0, 0x1f, 0x05,
-	0xb8, 0x6c, 0xcd, 0xb9, 0x2a, 0x59, 0x02, 0xf4, 0x6c, 0x01, 0xa6, 0x4c, 0xd7, 0xac, 0x1d, 0xf0,
-	0x1e, 0x03, 0x57, 0x97, 0xa8, 0xd7, 0xe1, 0x95, 0xc9, 0x98, 0x5e, 0x87, 0x3f, 0xb8, 0x0c, 0xcc,
-	0x25, 0x4a, 0xe5, 0x4d, 0x05, 0x56, 0x9b, 0x72, 0x2e, 0x10, 0xf1, 0xa6, 0x57, 0x62, 0xb9, 0x45,
-	0x65, 0xa7, 0x60, 0x4e, 0x57, 0xa6, 0x60, 0x56, 0x79, 0x20, 0xbf, 0x41, 0x1c, 0xef, 0xab, 0x6c,
-	0x2a, 0xcc, 0x84, 0xfd, 0x88, 0xe4, 0xef, 0x61, 0x5e, 0xc3, 0x89, 0xaa, 0x32, 0x33, 0x9f, 0x71,
-	0xcd, 0x4c, 0x96, 0x4b, 0x33, 0x86, 0xbf, 0x4f, 0x17, 0x7a, 0x7b, 0xa3, 0x9b, 0x38, 0xb1, 0x5d,
-	0xbc, 0x3f, 0x0e, 0xe2, 0x1d, 0x93, 0x3c, 0x24, 0x4b, 0x69, 0x52, 0x51, 0x5f, 0xe5, 0x4e, 0xa8,
-	0x12, 0x98, 0xc1, 0xf6, 0xb2, 0x1a, 0x88, 0xd7, 0x5e, 0x86, 0x72, 0x77, 0x5d, 0x65, 0x8d, 0x7a,
-	0xdd, 0x75, 0xb3, 0x4f, 0x34, 0xac, 0x7d, 0xa2, 0x6a, 0xa9, 0x7f, 0xb6, 0x68, 0xa9, 0xe7, 0xf8,
-	0x34, 0x83, 0xf9, 0x0f, 0x52, 0x70, 0x05, 0x76, 0xd8, 0x01, 0xbb, 0x70, 0x56, 0xee, 0xf1, 0x80,
-	0xdd, 0x1b, 0x0f, 0x42, 0x99, 0x13, 0xa8, 0x72, 0xfb, 0x52, 0x00, 0x5b, 0x50, 0x19, 0xa9, 0xcb,
-	0xa3, 0xbd, 0x61, 0x5f, 0x7b, 0xc3, 0x36, 0x68, 0x69, 0xa5, 0x7c, 0xe0, 0x9f, 0x23, 0xce, 0x19,
-	0x2e, 0x37, 0x26, 0x33, 0xe4, 0x7f, 0x25, 0x85, 0xd7, 0x7b, 0xf7, 0x35, 0xe8, 0x05, 0x3a, 0x63,
-	0xa9, 0xbb, 0x9a, 0x48, 0x1b, 0xc4, 0x9e, 0xa6, 0x4d, 0x5c, 0x82, 0xeb, 0x23, 0xb9, 0x3a, 0x54,
-	0x06, 0x54, 0xd1, 0xf2, 0x74, 0x09, 0x97, 0xae, 0x96, 0x0f, 0xf6, 0xf3, 0xc4, 0x39, 0xfe, 0x15,
-	0x8c, 0xc6, 0x0c, 0xb7, 0x43, 0x67, 0xac, 0x4e, 0x60, 0x0a, 0xb0, 0x68, 0xad, 0x37, 0x03, 0x48,
-	0xb1, 0xa9, 0x2b, 0xd7, 0xe0, 0x06, 0xe0, 0xdf, 0x56, 0xf9, 0x55, 0x85, 0x59, 0x8f, 0xf3, 0xd9,
-	0xac, 0x47, 0x2b, 0xe3, 0xd1, 0xcd, 0x1a, 0xac, 0xe5, 0xb2, 0x06, 0x7f, 0x4c, 0xe8, 0xac, 0x9b,
-	0x62, 0xfb, 0x1a, 0xa5, 0x93, 0x3e, 0xae, 0x52, 0x2a, 0x45, 0x36, 0x9f, 0x34, 0x1d, 0x27, 0xd7,
-	0x04, 0x87, 0x99, 0x6f, 0xff, 0x13, 0x44, 0xe9, 0xaf, 0x7a, 0x4d, 0x93, 0x6e, 0xfa, 0x7a, 0x18,
-	0xba, 0x98, 0x46, 0xdf, 0x7a, 0xe1, 0xcb, 0x42, 0x19, 0x04, 0x03, 0xc0, 0x65, 0x80, 0x6f, 0x44,
-	0x56, 0x46, 0x7b, 0x4a, 0xa7, 0x1a, 0xdc, 0x06, 0x61, 0x16, 0x59, 0x70, 0xd7, 0x5a, 0x44, 0xba,
-	0xe8, 0x7f, 0x80, 0x36, 0xf9, 0xd8, 0x66, 0xc2, 0x28, 0x2e, 0x71, 0x14, 0x77, 0x49, 0xe5, 0x3c,
-	0x02, 0x59, 0xac, 0xae, 0x06, 0x98, 0x6d, 0x36, 0x65, 0x7d, 0x6e, 0x51, 0xf9, 0x1f, 0xa6, 0xb4,
-	0xbd, 0xac, 0x2d, 0x89, 0x32, 0x5d, 0x24, 0x35, 0x5d, 0xf2, 0x09, 0x96, 0x7e, 0x81, 0x86, 0xff,
-	0xd9, 0x65, 0x3a, 0xc9, 0xc7, 0xb2, 0x8b, 0x9a, 0x93, 0xcd, 0xe8, 0x30, 0xc9, 0x35, 0x91, 0xff,
-	0xeb, 0x84, 0x9e, 0xb5, 0x2f, 0xd8, 0x6f, 0x8c, 0x82, 0xd4, 0x63, 0x94, 0x0f, 0xb5, 0xd6, 0x81,
-	0x30, 0x93, 0x83, 0x65, 0x98, 0xe2, 0x29, 0x49, 0x95, 0x8d, 0xfc, 0x82, 0x6b, 0x23, 0x4b, 0x3a,
-	0x34, 0x2b, 0xe8, 0x6f, 0x49, 0x71, 0x86, 0x37, 0x7b, 0x8b, 0xce, 0x25, 0x23, 0xce, 0x0b, 0x20,
-	0x43, 0xbb, 0x36, 0x16, 0x51, 0x90, 0x8c, 0xa2, 0x58, 0x25, 0x95, 0xb1, 0x6b, 0x94, 0x65, 0x5a,
-	0x0a, 0x85, 0xce, 0xf6, 0x3b, 0x5b, 0x92, 0x29, 0xce, 0x0b, 0xaa, 0x38, 0xd1, 0xf7, 0x5a, 0xe6,
-	0xc1, 0x82, 0xd9, 0x84, 0xe4, 0xdb, 0x37, 0x55, 0xf2, 0x3f, 0x46, 0xe7, 0xb2, 0x6d, 0xb3, 0xd7,
-	0xd3, 0x59, 0x7d, 0x7d, 0xad, 0x52, 0xeb, 0xa4, 0x83, 0x9a, 0x81, 0x82, 0x75, 0x07, 0x05, 0x4b,
-	0xa9, 0xe4, 0x0a, 0x74, 0x60, 0xa0, 0xd6, 0xb7, 0x83, 0x44, 0x44, 0xb0, 0xb0, 0x75, 0xc8, 0x39,
-	0x05, 0xf8, 0x1d, 0x7a, 0xb2, 0x40, 0x30, 0xc0, 0xec, 0x95, 0xad, 0xad, 0xb5, 0x71, 0x9a, 0xa0,
-	0x28, 0x4b, 0xda, 0x1a, 0x5b, 0x67, 0xca, 0xb4, 0xec, 0x7f, 0x9c, 0x9e, 0x2b, 0x9a, 0x8f, 0xdb,
-	0x61, 0xb2, 0xdd, 0xde, 0xe0, 0x63, 0xf6, 0x04, 0xad, 0xa3, 0x27, 0x24, 0xe3, 0x5b, 0x95, 0x19,
-	0xf8, 0x48, 0x68, 0xf9, 0xda, 0x5e, 0x89, 0xaf, 0x5d, 0xb3, 0x57, 0x8f, 0xff, 0x01, 0x7a, 0x21,
-	0x3f, 0x27, 0x0e, 0x0b, 0xef, 0x70, 0xd3, 0xb9, 0x5e, 0x57, 0xc1, 0x83, 0xae, 0xa3, 0xf3, 0xbb,
-	0xd6, 0xe9, 0x7c, 0x26, 0xb5, 0x40, 0xda, 0x77, 0x99, 0x87, 0xf8, 0x94, 0xdb, 0xf0, 0x82, 0xbd,
-	0x66, 0x8b, 0x6a, 0xe8, 0x56, 0x47, 0xf4, 0xa1, 0x52, 0x1a, 0xf6, 0x26, 0xda, 0xe8, 0xf4, 0x61,
-	0x03, 0x93, 0x12, 0x3b, 0xe3, 0x24, 0xd5, 0x03, 0x22, 0xbc, 0x13, 0x8a, 0x88, 0x4b, 0x22, 0x76,
-	0x89, 0x36, 0xad, 0xb4, 0xf2, 0x7d, 0xad, 0x0c, 0x2e, 0xd0, 0xff, 0x65, 0x52, 0x94, 0x13, 0x03,
-	0x56, 0xd4, 0xb8, 0x04, 0xea, 0x44, 0x6c, 0x41, 0xd2, 0x0c, 0x53, 0xa2, 0x0e, 0x86, 0x15, 0x47,
-	0xd0, 0xdf, 0x72, 0x8f, 0xa0, 0xf9, 0xce, 0xcc, 0x12, 0xfe, 0x1b, 0x52, 0x9d, 0x88, 0x73, 0x5f,
-	0x57, 0x0a, 0x87, 0x6e, 0xfe, 0x4b, 0x37, 0xcb, 0x99, 0xff, 0x22, 0x71, 0x2e, 0x89, 0xaa, 0x98,
-	0x33, 0xc3, 0xf8, 0x1e, 0x29, 0xcb, 0x16, 0x7a, 0x40, 0x03, 0xa8, 0x88, 0xdd, 0xfd, 0xb6, 0x1c,
-	0xc0, 0x79, 0xeb, 0x58, 0x5e, 0xe5, 0xf9, 0xff, 0x1f, 0xa1, 0x4d, 0x95, 0x59, 0x14, 0xc9, 0x7c,
-	0xd8, 0x73, 0xf2, 0x03, 0x0a, 0x32, 0xe2, 0x21, 0x77, 0x48, 0x03, 0xb0, 0xd2, 0xf0, 0x6d, 0x8f,
-	0xb9, 0x0d, 0x1e, 0x71, 0x37, 0xe9, 0xf4, 0xe5, 0x86, 0xd2, 0xe4, 0xb2, 0xc0, 0x9e, 0xa2, 0xd3,
-	0xda, 0xfc, 0xe9, 0x1c, 0xf3, 0x96, 0xb3, 0x32, 0x14, 0x52, 0x7d, 0x53, 0x42, 0x93, 0x9a, 0xe0,
-	0x54, 0xc3, 0x7e, 0x51, 0xfb, 0x0c, 0x9d, 0xb1, 0x72, 0x5c, 0xd4, 0xd3, 0xa8, 0x56, 0xe6, 0xf3,
-	0x14, 0x29, 0x9e, 0xdb, 0xc4, 0xc0, 0xf7, 0xa6, 0x7c, 0xc2, 0x3f, 0x29, 0x8d, 0xaf, 0x2c, 0xf9,
-	0x5f, 0x26, 0xf9, 0x64, 0xae, 0xfb, 0x9a, 0x34, 0xcb, 0xad, 0xa8, 0x39, 0x6e, 0x45, 0xd5, 0xe1,
-	0xe6, 0x77, 0xdc, 0xc3, 0x4d, 0x96, 0x11, 0x33, 0x4d, 0x5f, 0x24, 0xc5, 0xd9, 0x65, 0x26, 0x36,
-	0x45, 0xec, 0x6f, 0x81, 0xcc, 0xd1, 0x5a, 0x37, 0xd1, 0xfe, 0x1e, 0xfc, 0x05, 0xb6, 0x87, 0xf2,
-	0xa4, 0x23, 0x83, 0x58, 0xaa, 0x54, 0x15, 0xc7, 0xfb, 0x5d, 0xe2, 0xbc, 0x94, 0x2a, 0xea, 0xde,
-	0x8e, 0xe3, 0x31, 0x8d, 0x6b, 0x0b, 0x19, 0x2a, 0x1e, 0x45, 0x20, 0xc8, 0xf5, 0x50, 0x44, 0xeb,
-	0x3a, 0x17, 0xb6, 0xce, 0xd3, 0xb2, 0xdc, 0xba, 0xac, 0xa4, 0xdc, 0x74, 0xeb, 0xb2, 0xd2, 0x85,
-	0x2b, 0xb6, 0x53, 0xff, 0x27, 0x1e, 0x3d, 0x9e, 0xb1, 0x84, 0x15, 0xbe, 0x5d, 0xf6, 0x18, 0xe4,
-	0x15, 0x1c, 0x83, 0x74, 0xd0, 0xa7, 0xbd, 0xa1, 0xd6, 0x9c, 0x2e, 0xa6, 0x98, 0x6e, 0xa2, 0x0e,
-	0x81, 0xba, 0x68, 0xa9, 0x43, 0x23, 0x7b, 0xcf, 0x2b, 0x2f, 0x6e, 0xa5, 0x53, 0x8a, 0xfe, 0x7c,
-	0x0a, 0x28, 0x7e, 0x18, 0x44, 0x1e, 0xd0, 0xc3, 0x20, 0xcb, 0x3b, 0xa6, 0x39, 0xef, 0xf8, 0x1a,
-	0x6d, 0xa6, 0x5a, 0xa7, 0x97, 0xbf, 0x71, 0xe8, 0x49, 0x85, 0x43, 0xef, 0x39, 0x0e, 0xbd, 0xff,
-	0x29, 0x42, 0x8f, 0xa3, 0xf2, 0x59, 0xd3, 0x6f, 0xbd, 0x8c, 0x22, 0xee, 0xcb, 0x28, 0x5f, 0xa5,
-	0x59, 0x67, 0xa6, 0xc3, 0xf9, 0x90, 0xc9, 0x92, 0xbc, 0x2a, 0x47, 0xd6, 0xd4, 0x3b, 0x86, 0x53,
-	0xd9, 0x85, 0x22, 0x0d, 0x47, 0x5a, 0x84, 0x13, 0xcb, 0x89, 0x9c, 0x65, 0xb1, 0xf7, 0x51, 0x72,
-	0xf8, 0x3e, 0xfa, 0x6e, 0x7a, 0xcc, 0xae, 0xad, 0xbc, 0x70, 0xbd, 0x9d, 0xe5, 0xb5, 0x9c, 0x3b,
-	0xe4, 0xec, 0xbd, 0xb9, 0x47, 0xcc, 0xca, 0xc9, 0x2e, 0x7b, 0x4e, 0x9a, 0x25, 0xf7, 0xff, 0x99,
-	0xa8, 0x5c, 0x0c, 0x77, 0x66, 0x1c, 0x79, 0x90, 0x7b, 0x92, 0x07, 0x7b, 0x8a, 0x52, 0x79, 0xda,
-	0x4b, 0xbf, 0x17, 0x64, 0xf8, 0xc8, 0xcc, 0x16, 0xb7, 0x28, 0xd9, 0xb3, 0xb4, 0xe9, 0x88, 0x51,
-	0xc9, 0xbf, 0xdc, 0x78, 0xbb, 0xe4, 0xae, 0xfa, 0xd7, 0x31, 0x48, 0x62, 0x00, 0xfe, 0x2e, 0x3d,
-	0xed, 0x90, 0xa7, 0xf1, 0xf8, 0xea, 0xbd, 0xc7, 0xd9, 0x4d, 0xbc, 0x7b, 0xde, 0x4d, 0xfc, 0x57,
-	0x49, 0x69, 0x02, 0xee, 0xfd, 0xe6, 0x2c, 0x38, 0xca, 0x5b, 0xcb, 0x2b, 0x6f, 0xd5, 0x39, 0xe7,
-	0x4b, 0xa4, 0x20, 0xed, 0x20, 0xc7, 0x99, 0x13, 0xc1, 0xae, 0x48, 0x11, 0xae, 0xb0, 0x79, 0xfa,
-	0xb1, 0xa2, 0x67, 0x3d, 0x56, 0x3c, 0x6a, 0xf8, 0xfa, 0x46, 0xf9, 0x38, 0x7e, 0x8f, 0x38, 0xf9,
-	0x5a, 0xe5, 0x2c, 0x3a, 0x19, 0x09, 0x2b, 0x18, 0xfe, 0x09, 0x06, 0x61, 0x72, 0x70, 0xdf, 0x5a,
-	0xbd, 0x40, 0x67, 0xac, 0x66, 0xd4, 0xf8, 0x6c, 0x90, 0xff, 0x11, 0x3a, 0x6f, 0x7b, 0x3d, 0x99,
-	0x3e, 0x8b, 0x2e, 0x55, 0x9f, 0xce, 0xb6, 0x69, 0x2f, 0xd9, 0x4c, 0x03, 0x6e, 0x5f, 0x1f, 0xa6,
-	0x27, 0xad, 0x62, 0xaa, 0xcb, 0x6f, 0x77, 0x4f, 0x04, 0x17, 0xf3, 0xab, 0x3f, 0xdb, 0xaa, 0xa4,
-	0x87, 0xcd, 0xfb, 0x6a, 0xa4, 0xaf, 0xa0, 0xe0, 0x2f, 0x58, 0xb5, 0xb2, 0x24, 0xf0, 0x5c, 0x40,
-	0xc6, 0xfd, 0x14, 0x4b, 0xc3, 0xf9, 0x48, 0x49, 0x62, 0xdf, 0xf7, 0x25, 0xf9, 0x8f, 0x94, 0xd4,
-	0xb3, 0x1f, 0x29, 0xa9, 0x52, 0xe3, 0x2f, 0x17, 0x85, 0x34, 0x73, 0xfc, 0x99, 0xb9, 0xff, 0x6f,
-	0x22, 0x3f, 0xe3, 0x82, 0x11, 0x8a, 0x8d, 0x34, 0x42, 0xb1, 0xc1, 0xce, 0x53, 0xaf, 0x9b, 0x28,
-	0xdb, 0x94, 0xf9, 0xb8, 0x8b, 0xd7, 0x4d, 0xd8, 0x13, 0xe9, 0xd3, 0xe2, 0x9a, 0x7b, 0x1e, 0xdf,
-	0xe8, 0x26, 0x72, 0xdd, 0xc7, 0xfa, 0x7b, 0x0d, 0xf2, 0x85, 0x71, 0xc6, 0x4d, 0xac, 0x3b, 0x01,
-	0xc8, 0x6a, 0x37, 0x71, 0xbe, 0xa7, 0x62, 0x45, 0xf9, 0xe7, 0xdd, 0x75, 0xf9, 0xbc, 0xfb, 0xb2,
-	0xfb, 0x85, 0xa1, 0x72, 0xfb, 0x63, 0x3d, 0xfc, 0xfe, 0x8a, 0x47, 0xe7, 0xb2, 0x1f, 0xc2, 0x82,
-	0x65, 0x2b, 0xb0, 0xd0, 0x57, 0x6f, 0x9a, 0x74, 0x11, 0x8c, 0xa0, 0xb0, 0xee, 0x6d, 0xc9, 0x62,
-	0x83, 0x1b, 0x00, 0xe8, 0xee, 0x68, 0x9c, 0xba, 0x71, 0xf8, 0x9f, 0x9d, 0xa7, 0xb5, 0x71, 0xa2,
-	0xa3, 0xec, 0x33, 0x96, 0x7c, 0x38, 0xc0, 0xa1, 0xc1, 0xcd, 0xbd, 0x28, 0x82, 0x79, 0x91, 0x69,
-	0x63, 0x0d, 0x6e, 0x00, 0x60, 0x01, 0xc7, 0x91, 0x90, 0x48, 0xf9, 0x18, 0x2b, 0x2d, 0xc3, 0xf8,
-	0xe3, 0x68, 0x53, 0xb9, 0xcc, 0xf0, 0x17, 0xba, 0xef, 0x8b, 0x38, 0x51, 0x7e, 0x08, 0xfe, 0x87,
-	0x83, 0xe7, 0xe6, 0xb6, 0xd8, 0xdc, 0x59, 0x19, 0x0d, 0xef, 0x0c, 0xc2, 0xcd, 0x44, 0x39, 0x21,
-	0x2e, 0x10, 0x16, 0x6d, 0x90, 0x7e, 0x59, 0xa6, 0x8f, 0xae, 0x48, 0x9d, 0xdb, 0x20, 0xff, 0xd7,
-	0x48, 0xd1, 0x73, 0x06, 0xf6, 0x36, 0x25, 0x0f, 0x2b, 0x76, 0x50, 0xfa, 0x79, 0x31, 0x43, 0x59,
-	0x75, 0x42, 0xfd, 0x8a, 0x7b, 0x42, 0xcd, 0xf7, 0x69, 0xb4, 0x16, 0x78, 0xca, 0x3f, 0xa5, 0x78,
-	0x00, 0x3c, 0x7d, 0xd5, 0xe5, 0x29, 0xdf, 0xa7, 0x73, 0x5b, 0x53, 0xf4, 0x8c, 0xe3, 0xa8, 0x0b,
-	0xeb, 0x1c, 0x9d, 0xc6, 0x1d, 0x1f, 0xbf, 0x39, 0x27, 0xd5, 0xc9, 0x00, 0x9c, 0x8f, 0x1d, 0x11,
-	0xf3, 0x49, 0xa7, 0xaa, 0xf0, 0xf7, 0xef, 0x17, 0x85, 0xbf, 0x1d, 0x16, 0xcd, 0x18, 0x92, 0xa2,
-	0x07, 0x27, 0xee, 0xa2, 0xf0, 0xac, 0x45, 0x51, 0x25, 0xb9, 0x3f, 0x70, 0x25, 0x97, 0x6f, 0xd6,
-	0xf4, 0xfa, 0x9f, 0xe4, 0x90, 0xf7, 0x2c, 0xa5, 0x5f, 0x8d, 0xb8, 0x87, 0x98, 0x55, 0x71, 0x30,
-	0xb2, 0x2a, 0x59, 0x87, 0xd1, 0xfa, 0xd0, 0xba, 0x31, 0x83, 0xff, 0x4b, 0x6b, 0xe5, 0x03, 0xfd,
-	0x9a, 0x1c, 0xe8, 0x25, 0x37, 0x47, 0xa4, 0x78, 0x20, 0x66, 0xcc, 0xdf, 0x27, 0x95, 0x0f, 0x74,
-	0x0e, 0xf3, 0x80, 0x22, 0xe7, 0x7e, 0x45, 0x96, 0x60, 0x9e, 0xfa, 0xd1, 0x68, 0x7c, 0x65, 0x30,
-	0x50, 0xb7, 0x06, 0xba, 0x58, 0x95, 0x7e, 0xfb, 0x87, 0x92, 0x7d, 0xdf, 0x4e, 0xb2, 0x3f, 0x8c,
-	0xf9, 0x8f, 0x54, 0xbd, 0x1d, 0xaa, 0x72, 0x4e, 0xfe, 0xc8, 0x75, 0x4e, 0xca, 0x1b, 0x31, 0x7d,
-	0x7d, 0x8e, 0x94, 0x3c, 0x44, 0xb2, 0x9c, 0x26, 0xe2, 0x38, 0x4d, 0x17, 0x28, 0x8d, 0xcc, 0xfb,
-	0x0a, 0xf9, 0xc1, 0x0f, 0x0b, 0x52, 0x95, 0xb3, 0xf2, 0xc7, 0xa4, 0x28, 0xdf, 0xc7, 0xed, 0xd7,
-	0xb0, 0xf6, 0x0f, 0xe4, 0x1e, 0x1f, 0x42, 0x95, 0xb2, 0x5a, 0x76, 0x53, 0xa6, 0x3c, 0x6e, 0xd8,
-	0x5a, 0xe4, 0x06, 0x5b, 0xe3, 0x06, 0xb0, 0x74, 0xbb, 0x7c, 0x00, 0x5f, 0x97, 0x03, 0x78, 0x93,
-	0x11, 0xf0, 0xe1, 0xdc, 0x99, 0x01, 0x7d, 0x99, 0x1c, 0xfe, 0x5c, 0xeb, 0x68, 0xe1, 0xcf, 0xaa,
-	0x44, 0x86, 0x6f, 0xb8, 0x89, 0x0c, 0x87, 0x75, 0x6c, 0x5b, 0xa9, 0xa2, 0xe7, 0x62, 0x20, 0x4c,
-	0x81, 0x4f, 0x5f, 0x54, 0xa0, 0x54, 0x95, 0xaa, 0x6c, 0xe3, 0x9f, 0xb8, 0xb6, 0xb1, 0xa0, 0xd5,
-	0x5c, 0xaf, 0x99, 0xb7, 0x68, 0xf7, 0xd3, 0xeb, 0x9f, 0xe6, 0x7b, 0xcd, 0xb4, 0x6a, 0x7a, 0xfd,
-	0x55, 0x52, 0xf8, 0xd2, 0x8d, 0x3d, 0x69, 0x7f, 0x7d, 0x40, 0x4d, 0x45, 0xc1, 0x33, 0x7b, 0x8b,
-	0xa8, 0x8a, 0xa3, 0x6f, 0xba, 0x1c, 0x15, 0x74, 0x68, 0x38, 0x1a, 0x14, 0xbc, 0xb0, 0x2b, 0x4c,
-	0x18, 0xaa, 0xb8, 0x7f, 0xfe, 0x96, 0x7b, 0xff, 0x9c, 0x6b, 0xcf, 0xf4, 0xf6, 0x2a, 0x39, 0xec,
-	0xe5, 0xde, 0x91, 0x17, 0x97, 0xf5, 0x59, 0x89, 0x9a, 0xf3, 0x59, 0x89, 0xa5, 0x6e, 0x39, 0xc7,
-	0x7f, 0x26, 0x39, 0x7e, 0xb4, 0x74, 0x61, 0xd9, 0x2c, 0x19, 0xf6, 0xef, 0x96, 0xbc, 0x29, 0x2c,
-	0xfb, 0x70, 0x4a, 0x95, 0x71, 0xfa, 0xb6, 0x6b, 0x9c, 0x0a, 0xdb, 0x35, 0x3d, 0x7f, 0xb0, 0xf0,
-	0xc9, 0x62, 0x95, 0x12, 0x7c, 0xc7, 0x55, 0x82, 0x82, 0xda, 0xa6, 0xf5, 0x4f, 0x92, 0xb2, 0x87,
-	0x8f, 0x39, 0x7f, 0x67, 0x36, 0xf5, 0x77, 0x9a, 0xe0, 0xe0, 0x54, 0x45, 0xc9, 0xff, 0xdc, 0x8d,
-	0x92, 0x17, 0x77, 0x60, 0x98, 0xf8, 0x02, 0xa9, 0x7a, 0x46, 0x79, 0x54, 0xbd, 0xa8, 0xda, 0xb7,
-	0xbe, 0x9b, 0xdb, 0xb7, 0x4a, 0x3a, 0x35, 0xcc, 0xad, 0xd1, 0x13, 0xb9, 0x53, 0x4d, 0xe1, 0x11,
-	0x37, 0xff, 0x8e, 0x4f, 0x66, 0x73, 0x67, 0xa0, 0xfe, 0x2d, 0xe7, 0x83, 0x2b, 0xf2, 0x0b, 0x29,
-	0xcb, 0x79, 0x98, 0x3a, 0xd8, 0x96, 0x85, 0xb5, 0x72, 0xf4, 0x30, 0x95, 0x95, 0x8f, 0x4d, 0x9d,
-	0x2c, 0x56, 0xf5, 0xa1, 0xce, 0xaa, 0xbb, 0x9a, 0xef, 0xb9, 0x77, 0x35, 0x55, 0x4d, 0x1b, 0x69,
-	0x7d, 0x9b, 0x54, 0xbf, 0x67, 0x3d, 0xf2, 0x53, 0xac, 0xf4, 0x5b, 0x5d, 0x35, 0xeb, 0x5b, 0x5d,
-	0x55, 0x6c, 0xff, 0x05, 0x29, 0x78, 0x85, 0x57, 0xcc, 0x8c, 0x61, 0xfb, 0xe5, 0xf2, 0x37, 0xb6,
-	0x85, 0x62, 0xab, 0xc8, 0x0e, 0xfb, 0xbe, 0x9b, 0x1d, 0x56, 0xd6, 0xac, 0xa3, 0xfd, 0x95, 0x4f,
-	0x78, 0xd9, 0xe3, 0x74, 0x6a, 0xe5, 0x05, 0x3c, 0x31, 0xea, 0x68, 0x47, 0xda, 0xa7, 0x04, 0xf3,
-	0x14, 0x5f, 0x25, 0x98, 0xbf, 0xcc, 0x08, 0xa6, 0xa2, 0x4b, 0xc3, 0xdc, 0x7b, 0xe8, 0xa4, 0x6a,
-	0xbb, 0x50, 0xe7, 0x33, 0xdf, 0x4c, 0x93, 0x41, 0x6b, 0xe7, 0x9b, 0x69, 0xbf, 0x40, 0x0e, 0x7b,
-	0x7e, 0x5c, 0x28, 0xe0, 0x0a, 0x0b, 0xfe, 0x6a, 0xce, 0x82, 0x57, 0x34, 0xee, 0x1a, 0x99, 0xf2,
-	0x37, 0xce, 0x47, 0x7d, 0x09, 0x50, 0x65, 0x64, 0x7e, 0x40, 0x72, 0x2f, 0x2d, 0x0f, 0xd3, 0xbf,
-	0x41, 0xe5, 0xfb, 0xea, 0x2a, 0xb7, 0xff, 0x87, 0xae, 0xdb, 0x5f, 0xd1, 0x8a, 0xe9, 0xed, 0x4b,
-	0xe4, 0x90, 0xd7, 0xda, 0x60, 0x5a, 0x63, 0x79, 0x3c, 0x05, 0x85, 0xab, 0x73, 0x55, 0x82, 0x2d,
-	0x57, 0xde, 0x6c, 0xc9, 0x08, 0x71, 0x9d, 0xeb, 0x62, 0xd5, 0xc1, 0xea, 0xaf, 0xdc, 0x83, 0x55,
-	0x65, 0xcf, 0xf6, 0x03, 0x9e, 0xfc, 0x73, 0x71, 0xbb, 0x7f, 0xe2, 0xf6, 0x5f, 0xe1, 0xa4, 0xfc,
-	0x75, 0x36, 0x49, 0x2e, 0xd3, 0xaa, 0x73, 0x5d, 0x5b, 0xfa, 0x18, 0x1d, 0xb4, 0xa1, 0x9f, 0xb1,
-	0x5c, 0xba, 0xac, 0x8e, 0x2a, 0x32, 0x3a, 0xdd, 0x57, 0x7b, 0xa4, 0x05, 0x81, 0xba, 0xbb, 0xf2,
-	0xe3, 0xd4, 0x7d, 0xf5, 0x50, 0x3c, 0x2d, 0x9b, 0x8f, 0x55, 0xd7, 0x4b, 0x3f, 0x56, 0x3d, 0x4f,
-	0xa7, 0xa2, 0x2d, 0x15, 0x2f, 0x50, 0x2f, 0x4b, 0x75, 0xb9, 0xca, 0x14, 0xfd, 0xc8, 0x35, 0x45,
-	0x65, 0x23, 0x73, 0xee, 0x41, 0xed, 0x0f, 0x96, 0xe2, 0x75, 0x94, 0xfc, 0x6c, 0x3c, 0x91, 0xe7,
-	0x50, 0xfd, 0xb9, 0xf8, 0x0b, 0x94, 0x2e, 0xef, 0x6d, 0xee, 0x88, 0x44, 0xd9, 0x6b, 0xfc, 0x32,
-	0x90, 0x81, 0x80, 0xaf, 0x70, 0x65, 0x47, 0xbd, 0x9d, 0xf5, 0xae, 0xec, 0x40, 0xb9, 0xb7, 0xa3,
-	0x6e, 0x2a, 0xbc, 0xde, 0x0e, 0x0c, 0xe8, 0xea, 0xb0, 0x3f, 0x1e, 0x85, 0xc3, 0x44, 0x25, 0x79,
-	0xa6, 0x65, 0xc0, 0x2d, 0x07, 0xb1, 0xe8, 0x06, 0xc9, 0x36, 0x46, 0xcc, 0xa6, 0x79, 0x5a, 0xf6,
-	0x3f, 0xef, 0xa5, 0x09, 0xbc, 0x6c, 0x91, 0x1e, 0x5f, 0xc1, 0xef, 0x26, 0xf7, 0xc4, 0x30, 0x0e,
-	0x93, 0x70, 0x5f, 0x28, 0x2e, 0xb3, 0x60, 0xe0, 0xf6, 0xca, 0x78, 0x2c, 0x86, 0x7d, 0x30, 0xc4,
-	0xc8, 0xed, 0x14, 0xb7, 0x20, 0xb0, 0x73, 0xdf, 0x8e, 0xc2, 0x44, 0xac, 0x6f, 0x47, 0x22, 0xde,
-	0x1e, 0x0d, 0xe4, 0x1c, 0x35, 0x78, 0x06, 0xca, 0x2e, 0xd1, 0x26, 0x17, 0x41, 0xdf, 0x90, 0xd5,
-	0x91, 0xcc, 0x05, 0xe2, 0x97, 0xa7, 0x93, 0x51, 0x14, 0x6c, 0x89, 0x95, 0x60, 0x1c, 0x6c, 0x86,
-	0xc9, 0x81, 0x8a, 0x0a, 0x66, 0xc1, 0x69, 0x62, 0xe8, 0xca, 0x76, 0x10, 0xa9, 0xa1, 0x1a, 0x00,
-	0x9b, 0xa3, 0xb5, 0xf5, 0x44, 0xdf, 0x5c, 0xc2, 0x5f, 0x7c, 0xdd, 0x1a, 0x6c, 0xc5, 0x48, 0xa2,
-	0x1e, 0xbe, 0x18, 0x80, 0xff, 0x63, 0x52, 0xfe, 0xad, 0x84, 0x22, 0x67, 0x8e, 0x8f, 0x95, 0x51,
-	0xf3, 0xf8, 0x18, 0xbf, 0xc5, 0x18, 0x27, 0xe9, 0xd7, 0x19, 0xe3, 0xc4, 0x4e, 0x95, 0xae, 0x3b,
-	0x1f, 0x27, 0x3f, 0x4a, 0xaa, 0xf4, 0x8f, 0x8b, 0x34, 0xb0, 0x22, 0x61, 0x62, 0x99, 0xbe, 0x7f,
-	0xea, 0xf2, 0xe5, 0x27, 0x90, 0xfa, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xcf, 0xe0, 0xc8,
-	0xb9, 0x63, 0x00, 0x00,
+	// 6650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x6d, 0x8c, 0x65, 0x49,
+	0x55, 0xa9, 0xf7, 0xd1, 0xfd, 0x5e, 0xf5, 0xbc, 0x99, 0x9e, 0x9a, 0x8f, 0xbd, 0xdb, 0x3b, 0x3b,
+	0xdb, 0x7b, 0xd9, 0x75, 0x87, 0x05, 0x66, 0xd9, 0x0e, 0x2c, 0xcb, 0x02, 0x0b, 0xdd, 0xfd, 0x66,
+	0x67, 0x1e, 0x3b, 0x3d, 0xfd, 0xb6, 0x5e, 0xef, 0x8c, 0x02, 0x12, 0x6e, 0xf7, 0xab, 0xe9, 0xb9,
+	0xf4, 0xeb, 0xf7, 0x1e, 0xf7, 0xde, 0xee, 0x9d, 0xde, 0x60, 0x58, 0x20, 0xd1, 0xa8, 0x31, 0xc6,
+	0x10, 0xf9, 0x0a, 0xa2, 0x22, 0xa0, 0xa8, 0xa0, 0x20, 0x08, 0xe2, 0x82, 0xb2, 0x68, 0x62, 0xfc,
+	0xe1, 0x3f, 0x7f, 0xea, 0x1f, 0xfe, 0x19, 0x35, 0xfa, 0x47, 0x63, 0xa2, 0x89, 0x39, 0xa7, 0xaa,
+	0x6e, 0x55, 0xdd, 0xaf, 0x9e, 0x9e, 0x64, 0xf8, 0xf5, 0x5e, 0x9d, 0x73, 0xaa, 0xea, 0xd4, 0xa9,
+	0x53, 0xa7, 0x4e, 0x9d, 0x3a, 0x75, 0x29, 0xdd, 0x15, 0x49, 0x70, 0x71, 0x1a, 0x4d, 0x92, 0x09,
+	0x6b, 0xe2, 0x8f, 0xff, 0x13, 0x4a, 0x1b, 0xdd, 0x20, 0x09, 0x18, 0xa3, 0x8d, 0x0d, 0x11, 0xed,
+	0x7a, 0x64, 0xb1, 0x76, 0xa1, 0xc1, 0xf1, 0x3f, 0x3b, 0x4d, 0x9b, 0xbd, 0xf1, 0x50, 0xdc, 0xf6,
+	0x6a, 0x08, 0x94, 0x05, 0x76, 0x8e, 0xb6, 0x57, 0x47, 0x7b, 0x71, 0x22, 0xa2, 0x5e, 0xd7, 0xab,
+	0x23, 0xc6, 0x00, 0xd8, 0xa3, 0xb4, 0x79, 0x6d, 0x32, 0x14, 0xb1, 0xd7, 0x58, 0xac, 0x5f, 0x98,
+	0x5b, 0x3a, 0x21, 0xbb, 0xbb, 0x08, 0xb0, 0xde, 0xf8, 0xe6, 0x84, 0x4b, 0x2c, 0x7b, 0x92, 0xb6,
+	0xa1, 0xdb, 0xcd, 0x20, 0x16, 0xb1, 0xd7, 0x44, 0xd2, 0x53, 0x8a, 0x54, 0xc3, 0x91, 0xdc, 0x50,
+	0x41, 0xcb, 0x2f, 0xc6, 0x22, 0x8a, 0xbd, 0x19, 0xa7, 0x65, 0x80, 0xc9, 0x96, 0x11, 0x0b, 0xec,
+	0xad, 0x05, 0xb7, 0xb1, 0xbf, 0xae, 0x37, 0x2b, 0xd9, 0x4b, 0x01, 0xec, 0x02, 0x3d, 0xb1, 0x16,
+	0xdc, 0x1e, 0xdc, 0x0a, 0xa2, 0xe1, 0xe5, 0x68, 0xb2, 0x37, 0xed, 0x75, 0xbd, 0x16, 0xd2, 0x64,
+	0xc1, 0xec, 0x3c, 0xa5, 0x1a, 0xd4, 0xeb, 0x7a, 0x6d, 0x24, 0xb2, 0x20, 0xec, 0x4d, 0x72, 0x04,
+	0x72, 0xb0, 0xd4, 0x61, 0x49, 0xc3, 0xb9, 0xa1, 0x00, 0xf2, 0x35, 0xa1, 0xc9, 0xe7, 0x8a, 0x65,
+	0x63, 0x28, 0x98, 0x4f, 0x8f, 0x29, 0x99, 0xf6, 0x93, 0x6b, 0x7b, 0xbb, 0xde, 0xf1, 0xc5, 0xda,
+	0x85, 0x0e, 0x77, 0x60, 0xec, 0x09, 0x3a, 0xd3, 0x4f, 0xae, 0x87, 0xe2, 0x25, 0xef, 0x04, 0xb6,
+	0x77, 0x9f, 0xd5, 0xfd, 0x45, 0x89, 0xb9, 0x34, 0x4e, 0xa2, 0x03, 0xae, 0xc8, 0xa0, 0x51, 0xac,
+	0xd9, 0x17, 0x11, 0xf4, 0xe2, 0xcd, 0x2f, 0x12, 0x68, 0xd4, 0x86, 0x29, 0x01, 0xe1, 0x4c, 0x6b,
+	0x01, 0x9d, 0x4c, 0x05, 0x64, 0x83, 0x95, 0x80, 0x10, 0xd4, 0xeb, 0x7a, 0x2c, 0x15, 0x90, 0x82,
+	0x40, 0x6f, 0x6b, 0xc1, 0xed, 0x4b, 0xfb, 0x62, 0x9c, 0xac, 0x4f, 0x7b, 0x43, 0xef, 0xd4, 0x22,
+	0xb9, 0xd0, 0xe0, 0x0e, 0x0c, 0x7a, 0xdb, 0x08, 0x76, 0xc4, 0xfa, 0xbe, 0x88, 0x2e, 0x8d, 0x83,
+	0xcd, 0x91, 0x18, 0x7a, 0xa7, 0x17, 0xc9, 0x85, 0x16, 0xcf, 0x82, 0xd9, 0xbb, 0x68, 0x67, 0x2d,
+	0xdc, 0x8e, 0x82, 0x44, 0x60, 0xed, 0xd8, 0x3b, 0xe3, 0x8c, 0xd9, 0xc6, 0xa1, 0x2c, 0x5d, 0x6a,
+	0xe8, 0x68, 0x25, 0x18, 0x05, 0xe3, 0x2d, 0xd3, 0xd1, 0x59, 0xd9, 0x51, 0x06, 0xac, 0x04, 0xd0,
+	0x9d, 0xbc, 0x34, 0x1e, 0x04, 0xbb, 0xd3, 0x11, 0x68, 0xd1, 0x7d, 0xc8, 0x79, 0x16, 0xcc, 0xde,
+	0x40, 0x67, 0x07, 0x49, 0x24, 0x82, 0xdd, 0xd8, 0xf3, 0x90, 0x99, 0x93, 0x8a, 0x19, 0x09, 0x45,
+	0x36, 0x34, 0x05, 0x5b, 0xa4, 0x73, 0xa0, 0x3c, 0x12, 0xd3, 0xf5, 0xee, 0xc7, 0x26, 0x6d, 0x90,
+	0x52, 0xdc, 0xd5, 0xc9, 0x78, 0xdc, 0x1b, 0x7a, 0x0b, 0x88, 0x37, 0x00, 0xf6, 0x2c, 0x9d, 0x7b,
+	0x61, 0x4f, 0x44, 0x07, 0xbd, 0x6e, 0x6f, 0x1c, 0x26, 0xde, 0x03, 0xd8, 0xe1, 0x39, 0x7b, 0xc6,
+	0x2d, 0xb4, 0x9c, 0x76, 0xbb, 0x02, 0xeb, 0xd2, 0x0e, 0x17, 0xd3, 0x51, 0xb8, 0x15, 0xe0, 0xfc,
+	0xc5, 0xde, 0x39, 0x6c, 0xe1, 0xbc, 0xdd, 0x82, 0x43, 0x20, 0xdb, 0x70, 0x2b, 0xb1, 0x37, 0xd2,
+	0x93, 0xc0, 0xf2, 0xde, 0x66, 0xbc, 0x15, 0x85, 0xd3, 0x24, 0x9c, 0x8c, 0x7b, 0x5d, 0xef, 0x41,
+	0xe4, 0x35, 0x8f, 0x60, 0x8f, 0xd0, 0x0e, 0x0c, 0xe0, 0x85, 0xd5, 0x5b, 0xc1, 0x78, 0x1b, 0x04,
+	0x79, 0x1e, 0x29, 0x5d, 0xe0, 0xc2, 0x7b, 0xe9, 0x9c, 0xa5, 0xac, 0x6c, 0x9e, 0xd6, 0x77, 0xc4,
+	0x81, 0x47, 0x16, 0xc9, 0x85, 0x36, 0x87, 0xbf, 0xb0, 0xf0, 0xf7, 0x83, 0xd1, 0x9e, 0xf0, 0x6a,
+	0x8b, 0xc4, 0x5e, 0x65, 0x2b, 0x7d, 0x39, 0xd5, 0x12, 0xfb, 0x4c, 0xed, 0x69, 0xb2, 0xf0, 0x2c,
+	0x9d, 0xcf, 0x8a, 0xa1, 0xa0, 0xc1, 0xd3, 0x76, 0x83, 0x0d, 0xbb, 0xfe, 0x8b, 0x94, 0xe5, 0x85,
+	0x50, 0xd0, 0xc2, 0xeb, 0x5d, 0x96, 0xb4, 0xe9, 0x52, 0x75, 0x61, 0xf8, 0xb1, 0xd5, 0xac, 0xff,
+	0x0e, 0x7a, 0xcc, 0x46, 0xb1, 0x37, 0xd0, 0x19, 0x35, 0x0b, 0xc4, 0x31, 0x7d, 0x76, 0xdf, 0x5c,
+	0x91, 0xf8, 0xbf, 0x4c, 0xd2, 0xda, 0x08, 0x61, 0xc7, 0x69, 0xad, 0xd7, 0x45, 0x43, 0xdd, 0xe1,
+	0xb5, 0x5e, 0x97, 0x2d, 0xd0, 0xd6, 0x5a, 0xa0, 0xec, 0x71, 0x0d, 0xa1, 0x69, 0x99, 0x3d, 0x4c,
+	0x9b, 0x7d, 0x01, 0x46, 0xb3, 0x8e, 0x1d, 0xcd, 0xa9, 0x8e, 0x00, 0xc6, 0x25, 0x86, 0x9d, 0xa5,
+	0x33, 0x83, 0x24, 0x48, 0xf6, 0xc0, 0x64, 0x43, 0x65, 0x55, 0x4a, 0x77, 0x84, 0xa6, 0xd9, 0x11,
+	0xfc, 0xc7, 0x69, 0x03, 0x2a, 0xe5, 0x58, 0x60, 0xb4, 0xc1, 0x27, 0x23, 0xa1, 0xba, 0xc7, 0xff,
+	0xfe, 0xc3, 0x74, 0xb6, 0x9f, 0xac, 0xbf, 0x34, 0x16, 0x11, 0x74, 0xa1, 0x0c, 0xb2, 0xdc, 0x5e,
+	0x54, 0xc9, 0x7f, 0x85, 0xd0, 0x19, 0x39, 0x89, 0xec, 0x11, 0xda, 0x44, 0x5a, 0xa4, 0x98, 0x5b,
+	0x3a, 0xae, 0x19, 0x95, 0x2d, 0xf0, 0x66, 0xda, 0x90, 0xe2, 0xb5, 0x96, 0xe5, 0xb5, 0x9f, 0xf4,
+	0x86, 0xb8, 0x1d, 0x75, 0x38, 0xfe, 0x87, 0x59, 0xbb, 0x2e, 0x22, 0xaf, 0x81, 0x73, 0x0c, 0x7f,
+	0x91, 0xcb, 0xcb, 0xbd, 0xae, 0xd7, 0x44, 0xbb, 0x87, 0xff, 0xfd, 0x37, 0xd1, 0x96, 0x56, 0x24,
+	0xf6, 0x30, 0x6d, 0x74, 0x37, 0xfb, 0x89, 0x9a, 0x94, 0x4e, 0xca, 0x02, 0x20, 0x39, 0xa2, 0xfc,
+	0x7f, 0x27, 0xb4, 0xa5, 0xed, 0xb5, 0x25, 0x85, 0x86, 0x96, 0xc2, 0x95, 0x49, 0x9c, 0x20, 0x6f,
+	0x6d, 0x8e, 0xff, 0x99, 0x47, 0x67, 0x79, 0x7f, 0x75, 0x79, 0x38, 0x8c, 0xb0, 0xdb, 0x36, 0xd7,
+	0x45, 0xc0, 0x6c, 0xac, 0xf6, 0xb1, 0x42, 0x5d, 0x62, 0x54, 0x31, 0x33, 0x23, 0xf5, 0x74, 0x94,
+	0xa7, 0x69, 0xf3, 0xea, 0x46, 0xb8, 0x2b, 0xbc, 0x19, 0xb9, 0x1f, 0x63, 0x01, 0xec, 0xf0, 0xe5,
+	0x49, 0x1c, 0x87, 0x53, 0xec, 0x64, 0x16, 0xfb, 0xb6, 0x20, 0x60, 0xd0, 0x06, 0x62, 0x3b, 0x12,
+	0xdb, 0x41, 0x22, 0x54, 0xb3, 0x2d, 0x69, 0xd0, 0x32, 0xe0, 0x74, 0x16, 0x29, 0xb2, 0x23, 0x67,
+	0x51, 0xd0, 0x96, 0xde, 0xc4, 0xd8, 0x43, 0xb4, 0x76, 0x2d, 0x54, 0x13, 0x94, 0xdb, 0xbc, 0x6a,
+	0xd7, 0x42, 0x60, 0x1c, 0xcd, 0x55, 0x57, 0xad, 0x2c, 0x55, 0x02, 0xe3, 0xb7, 0x3c, 0x0a, 0xf7,
+	0x85, 0x42, 0xd6, 0xa5, 0xf1, 0xb3, 0x40, 0xfe, 0xb7, 0xea, 0xf4, 0x98, 0xbd, 0xf1, 0x03, 0x2f,
+	0xd7, 0x82, 0x5d, 0x81, 0xbd, 0xb5, 0x39, 0xfe, 0x67, 0x4f, 0xd1, 0xb3, 0x5d, 0x71, 0x33, 0xd8,
+	0x1b, 0x25, 0x5c, 0x24, 0x62, 0x0c, 0x6b, 0xa9, 0x3f, 0x19, 0x85, 0x5b, 0x07, 0x4a, 0xe2, 0x25,
+	0x58, 0x76, 0x85, 0x9e, 0x74, 0x41, 0xa1, 0xd0, 0x0b, 0x62, 0x21, 0x5d, 0x79, 0x4e, 0x15, 0x1c,
+	0x51, 0xbe, 0x12, 0xb4, 0xb4, 0x3a, 0x19, 0x27, 0xe1, 0x78, 0x6f, 0xb2, 0x17, 0x83, 0xa5, 0x09,
+	0x53, 0x4f, 0x47, 0xb7, 0xe4, 0xe2, 0x55, 0x4b, 0xb9, 0x4a, 0x72, 0x3f, 0x88, 0x76, 0xba, 0x62,
+	0x24, 0x12, 0x31, 0x44, 0xdd, 0x68, 0x71, 0x1b, 0xc4, 0x9e, 0xa0, 0x2d, 0xf4, 0x35, 0x9e, 0x17,
+	0x07, 0xde, 0x8c, 0x63, 0x66, 0x34, 0x18, 0xdb, 0x4e, 0x89, 0xd8, 0xcf, 0xd0, 0xe3, 0x72, 0x13,
+	0xdb, 0x08, 0xb6, 0x97, 0xa3, 0x28, 0x38, 0xf0, 0x66, 0xb1, 0xd5, 0x0c, 0x14, 0xec, 0x85, 0xb2,
+	0x27, 0xd7, 0x50, 0x13, 0xea, 0x3c, 0x2d, 0xc3, 0x9e, 0xb6, 0x8e, 0xe6, 0x1b, 0x36, 0x58, 0x62,
+	0xed, 0x69, 0xeb, 0x9b, 0xb1, 0x42, 0x70, 0x4d, 0xe1, 0x7f, 0x87, 0xd0, 0x53, 0x19, 0xc1, 0x0d,
+	0xa6, 0x62, 0xcb, 0x9a, 0x3b, 0x92, 0xce, 0xdd, 0x02, 0x6d, 0x75, 0xf7, 0x22, 0xb4, 0x7f, 0xa8,
+	0x1c, 0x75, 0x9e, 0x96, 0xd9, 0x45, 0xca, 0x8c, 0xeb, 0x95, 0x52, 0xd5, 0x91, 0xaa, 0x00, 0xe3,
+	0x0c, 0xa0, 0x81, 0x6b, 0xd9, 0x0c, 0xc0, 0xa7, 0xc7, 0x6e, 0x04, 0xd1, 0x6e, 0xda, 0x4a, 0x13,
+	0x5b, 0x71, 0x60, 0xfe, 0x4f, 0xea, 0xf4, 0xc4, 0x9a, 0x08, 0xe2, 0xbd, 0x48, 0xec, 0x2a, 0x7f,
+	0xa1, 0x50, 0xdf, 0x9e, 0xa4, 0x6d, 0x2d, 0x5c, 0x30, 0x38, 0xf5, 0xb2, 0x29, 0x30, 0x54, 0xec,
+	0x19, 0x3a, 0x33, 0xd8, 0xba, 0x25, 0x76, 0x03, 0xa5, 0x5f, 0xbe, 0xf6, 0x4f, 0xdc, 0xee, 0x2e,
+	0x4a, 0x22, 0xe5, 0x9e, 0xc9, 0x42, 0x56, 0x25, 0x1a, 0x79, 0x95, 0x78, 0x86, 0x76, 0x42, 0xf0,
+	0xae, 0xb8, 0x18, 0x99, 0xd1, 0xcd, 0x2d, 0x9d, 0x56, 0x9d, 0xf4, 0x6c, 0x1c, 0x77, 0x49, 0xc1,
+	0x4c, 0x5c, 0x1a, 0x6f, 0x87, 0x63, 0xb1, 0x71, 0x30, 0x15, 0xa8, 0x50, 0x1d, 0x6e, 0x41, 0xd8,
+	0xdb, 0xe8, 0xb1, 0xd5, 0xc9, 0x68, 0x90, 0x4c, 0x22, 0x5c, 0x80, 0xa8, 0x3b, 0x66, 0xbc, 0x36,
+	0x8a, 0x3b, 0x84, 0xec, 0x49, 0x4a, 0x8d, 0x72, 0x78, 0xad, 0x32, 0xad, 0xb1, 0x88, 0xd8, 0x85,
+	0xac, 0x96, 0x69, 0x73, 0x9f, 0x55, 0xb1, 0x85, 0xb7, 0xd3, 0x39, 0x4b, 0x54, 0x87, 0xed, 0xe5,
+	0x4d, 0x7b, 0xd3, 0xfd, 0xaf, 0x66, 0x4e, 0x3b, 0x4b, 0x67, 0xda, 0xd5, 0xce, 0xda, 0x1d, 0x69,
+	0x67, 0xed, 0x8e, 0xb4, 0xb3, 0xe6, 0x68, 0xe7, 0x33, 0xf4, 0x98, 0xa5, 0x09, 0xfa, 0xe4, 0x73,
+	0xb6, 0x58, 0x49, 0xb8, 0x43, 0xcb, 0xd6, 0xe8, 0xdc, 0x5a, 0x9c, 0x5c, 0x17, 0x51, 0x8c, 0x82,
+	0x3b, 0x8e, 0x55, 0xdf, 0x50, 0x6e, 0xbf, 0x2e, 0x5a, 0xd4, 0xca, 0x21, 0xb4, 0x20, 0xec, 0x6d,
+	0x74, 0xce, 0x30, 0xaf, 0x0f, 0x55, 0x67, 0x6c, 0xf5, 0x46, 0x0c, 0x32, 0x62, 0x53, 0x82, 0x27,
+	0x6e, 0xfb, 0x79, 0xb1, 0x37, 0xeb, 0x78, 0xe2, 0x36, 0x4e, 0x7a, 0xe2, 0x0e, 0x75, 0x56, 0xcb,
+	0x5b, 0x79, 0x2d, 0x5f, 0xa4, 0x73, 0x57, 0x26, 0x49, 0x2a, 0xe9, 0x36, 0x4a, 0xda, 0x06, 0xe5,
+	0x16, 0x39, 0x45, 0x12, 0x07, 0x06, 0xd3, 0x66, 0x8e, 0x2b, 0x29, 0xe5, 0x9c, 0x9c, 0xb6, 0x3c,
+	0x06, 0xe4, 0x61, 0xa0, 0xb1, 0x77, 0xcc, 0x91, 0x87, 0xc1, 0x48, 0x79, 0x58, 0x94, 0x6c, 0x9d,
+	0x9e, 0x36, 0xc7, 0x02, 0x23, 0x7e, 0xaf, 0x83, 0x9a, 0xfd, 0x80, 0xf6, 0x56, 0x0b, 0x48, 0x78,
+	0x61, 0x45, 0x70, 0x62, 0xb3, 0x53, 0x77, 0x98, 0xe2, 0x77, 0x6c, 0xc5, 0x0f, 0xe8, 0xa9, 0x82,
+	0x4d, 0xa8, 0x50, 0xef, 0x4f, 0xd3, 0x26, 0x12, 0xa8, 0x0d, 0x54, 0x16, 0x60, 0x02, 0xae, 0x06,
+	0x71, 0xc2, 0xf7, 0xc6, 0xe8, 0x6d, 0x48, 0x43, 0x6c, 0x83, 0xfc, 0xff, 0x25, 0xf4, 0xb8, 0xab,
+	0x23, 0x39, 0x67, 0xe8, 0x1c, 0x6d, 0x0f, 0x92, 0x20, 0x4a, 0xb0, 0x09, 0xb9, 0xa6, 0x0c, 0x00,
+	0x9c, 0x9f, 0x4b, 0xe3, 0xa1, 0x6a, 0x1e, 0x70, 0xba, 0x08, 0xf5, 0x94, 0x22, 0x2c, 0x27, 0xca,
+	0xff, 0x31, 0x00, 0x76, 0x81, 0xce, 0x60, 0xbf, 0x7a, 0xe9, 0xcc, 0xdb, 0x0a, 0x8b, 0x32, 0x55,
+	0x78, 0x18, 0xc4, 0x46, 0xb4, 0x37, 0xde, 0x0a, 0x64, 0x4b, 0x33, 0x72, 0x10, 0x16, 0x28, 0x63,
+	0x11, 0x67, 0x73, 0x16, 0xd1, 0xa3, 0xb3, 0xfb, 0x72, 0x12, 0xbc, 0x63, 0x88, 0xd4, 0x45, 0xff,
+	0x33, 0x35, 0xda, 0x4e, 0x7b, 0xcc, 0x8d, 0xfc, 0x3c, 0x6d, 0xa1, 0xb7, 0xda, 0xeb, 0xca, 0x5d,
+	0xa3, 0xb3, 0x52, 0xf3, 0x08, 0x4f, 0x61, 0x30, 0x97, 0x6b, 0xa1, 0xb4, 0x20, 0x6d, 0x0e, 0x7f,
+	0x11, 0x12, 0xdc, 0xf6, 0x1a, 0x0a, 0x12, 0xdc, 0x46, 0xe7, 0x3b, 0x14, 0x51, 0xea, 0x7c, 0x87,
+	0x02, 0x1d, 0x46, 0x7d, 0xda, 0x96, 0x0e, 0xa0, 0x2e, 0x82, 0x8b, 0x67, 0x34, 0xe9, 0xaa, 0xd8,
+	0x17, 0x23, 0xf4, 0x03, 0xeb, 0x3c, 0x0b, 0x86, 0x95, 0xe3, 0x1c, 0x6d, 0xa5, 0x27, 0xe8, 0xc0,
+	0xa4, 0x01, 0x0b, 0x86, 0xeb, 0xe3, 0xd1, 0x81, 0xd7, 0xc6, 0xe5, 0x99, 0x96, 0xe5, 0xa1, 0x5f,
+	0x2f, 0x55, 0x74, 0x14, 0x5b, 0xdc, 0x82, 0xf8, 0x9c, 0x1e, 0xb3, 0xb7, 0x46, 0x68, 0x4b, 0x97,
+	0xd1, 0xad, 0x6e, 0x5b, 0xfe, 0x0a, 0x8c, 0xf1, 0x60, 0x2a, 0x15, 0xb8, 0xcd, 0xf1, 0x3f, 0xc0,
+	0x06, 0xdb, 0xa9, 0x8b, 0x88, 0xff, 0xfd, 0x0f, 0xd2, 0xf9, 0xac, 0x51, 0x29, 0x54, 0x66, 0x46,
+	0x1b, 0x6b, 0x93, 0xa1, 0xd0, 0xee, 0x37, 0xfc, 0xc7, 0xf1, 0x8a, 0x38, 0x09, 0xc7, 0xf2, 0xe4,
+	0x85, 0xbb, 0x72, 0x9b, 0x3b, 0x30, 0xff, 0x11, 0x4a, 0x91, 0xa7, 0xea, 0xb3, 0xca, 0xa7, 0x09,
+	0x6d, 0xe9, 0x58, 0x53, 0x59, 0xf7, 0x57, 0x82, 0xf8, 0x56, 0xea, 0xfd, 0x07, 0xf1, 0x2d, 0x58,
+	0x5f, 0xcb, 0xc3, 0x5d, 0x35, 0xd9, 0x2d, 0x2e, 0x0b, 0xd0, 0x05, 0x7f, 0x09, 0xda, 0x52, 0x7b,
+	0xbc, 0x2a, 0xb1, 0xb7, 0x50, 0xda, 0x8f, 0xc2, 0xfd, 0x70, 0x24, 0xb6, 0xd3, 0xa8, 0xd8, 0x69,
+	0x2b, 0xcc, 0x95, 0x22, 0xb9, 0x45, 0xe7, 0xf7, 0x68, 0xc7, 0x41, 0xe2, 0x66, 0xa6, 0x5c, 0x69,
+	0xc5, 0x60, 0x5a, 0x86, 0xd5, 0x95, 0x12, 0x22, 0xa7, 0x4d, 0x6e, 0x00, 0xfe, 0xab, 0x84, 0x76,
+	0x1c, 0x27, 0x02, 0x34, 0x93, 0x87, 0x43, 0x75, 0xd2, 0x83, 0xbf, 0x00, 0x59, 0x0f, 0x87, 0x52,
+	0xb1, 0x39, 0xfc, 0x85, 0x36, 0xb1, 0x12, 0x4a, 0x44, 0x0a, 0xd8, 0x00, 0xd8, 0x9b, 0x29, 0xc5,
+	0xc2, 0xd5, 0x30, 0x4e, 0xb4, 0xaf, 0x3c, 0x6f, 0x9b, 0x55, 0x40, 0x70, 0x8b, 0x06, 0x3c, 0x11,
+	0x2c, 0x69, 0x17, 0xc1, 0x0d, 0x0f, 0xda, 0x28, 0xee, 0x10, 0xfa, 0x0f, 0xd3, 0x76, 0xda, 0x0c,
+	0x06, 0x2f, 0xe1, 0x8f, 0x52, 0x3b, 0x59, 0xf0, 0x87, 0xd4, 0xe3, 0x53, 0x7b, 0x5b, 0x7d, 0x2e,
+	0x14, 0xa3, 0x61, 0x8c, 0x93, 0x7a, 0x85, 0xce, 0x67, 0x76, 0x60, 0x7d, 0x3e, 0x3f, 0x97, 0xdf,
+	0xa0, 0x4d, 0x3d, 0x9e, 0xab, 0xe5, 0x4f, 0xe8, 0x99, 0x42, 0x52, 0x58, 0xc2, 0x6b, 0x71, 0x62,
+	0xa9, 0x8e, 0x2e, 0xb2, 0x77, 0x52, 0x0a, 0x0b, 0x40, 0xd2, 0x7a, 0xb5, 0xb2, 0x6e, 0x0d, 0x0d,
+	0xb7, 0xe8, 0xfd, 0x55, 0xa7, 0x43, 0x83, 0x00, 0x55, 0x53, 0x4d, 0x4a, 0x31, 0xa8, 0x92, 0xb5,
+	0xf6, 0xc0, 0x4c, 0xe0, 0x7f, 0xff, 0x53, 0x0d, 0x4a, 0x4d, 0xe8, 0xaa, 0x50, 0xc7, 0xa5, 0xa9,
+	0xab, 0xa5, 0xa6, 0xee, 0x2d, 0x74, 0x66, 0x10, 0x6d, 0xad, 0xe1, 0x11, 0xb6, 0x66, 0x71, 0x2c,
+	0x9b, 0xc9, 0xfa, 0x33, 0x8a, 0x16, 0x6a, 0x75, 0x45, 0x0c, 0xb5, 0x1a, 0x77, 0x52, 0x4b, 0xd2,
+	0x82, 0x5a, 0xf7, 0xc6, 0x89, 0x88, 0xf6, 0x83, 0x11, 0x9a, 0xc5, 0x3a, 0x4f, 0xcb, 0x30, 0xd9,
+	0x5d, 0x31, 0x0a, 0x0e, 0xd0, 0x30, 0xd6, 0xb9, 0x2c, 0xc0, 0x08, 0xba, 0xe1, 0xae, 0x74, 0x50,
+	0xda, 0x1c, 0xff, 0xb3, 0xc7, 0x68, 0x73, 0x35, 0x18, 0x8d, 0xc0, 0x51, 0xcd, 0x87, 0xec, 0x00,
+	0xc3, 0x25, 0x1e, 0x9a, 0x1c, 0x8c, 0xc2, 0xa1, 0x40, 0x13, 0x58, 0xe7, 0xb2, 0x00, 0x4d, 0x3e,
+	0x17, 0x8e, 0x46, 0x68, 0xf9, 0x9a, 0x1c, 0xff, 0x83, 0xfe, 0xc3, 0xef, 0x75, 0xdc, 0x8d, 0xe7,
+	0x16, 0xc9, 0x05, 0xc2, 0x0d, 0x00, 0xb0, 0xab, 0x93, 0xf1, 0x30, 0x4c, 0xf4, 0x3e, 0xd2, 0xe6,
+	0x06, 0xc0, 0xde, 0x99, 0xb1, 0x4f, 0x1d, 0xe4, 0xca, 0x73, 0xb8, 0xb2, 0x08, 0x5c, 0xcb, 0x05,
+	0xb3, 0xbb, 0x7e, 0xf3, 0x66, 0x2c, 0x12, 0x0c, 0xe5, 0xd6, 0xb9, 0x2a, 0x81, 0xa8, 0x60, 0x2f,
+	0x7d, 0xdf, 0x64, 0x2c, 0xbc, 0x93, 0xd8, 0x65, 0x5a, 0x86, 0x3a, 0xfd, 0x60, 0x2f, 0x16, 0x43,
+	0x74, 0xbd, 0x5b, 0x5c, 0x95, 0x00, 0x3e, 0x88, 0xb6, 0x78, 0x3f, 0xf6, 0xce, 0x4a, 0x4d, 0x91,
+	0x25, 0xff, 0x29, 0x3a, 0x67, 0x94, 0x02, 0xe5, 0x67, 0xaf, 0x8c, 0x82, 0x90, 0xa7, 0xc4, 0xfb,
+	0x1f, 0xa1, 0x67, 0x0a, 0xe7, 0xb3, 0xd4, 0xff, 0xd6, 0x26, 0xab, 0x96, 0x31, 0x59, 0x17, 0xe8,
+	0x89, 0xec, 0x71, 0x5f, 0x6e, 0x9d, 0x59, 0xb0, 0xff, 0x05, 0xa2, 0x15, 0x18, 0xa6, 0x10, 0x3a,
+	0x82, 0x5f, 0xdd, 0x11, 0xc2, 0x4e, 0xd3, 0x26, 0xae, 0x00, 0xed, 0xf0, 0x60, 0x01, 0xcd, 0xf4,
+	0x28, 0x0c, 0x62, 0xd5, 0xb0, 0x2c, 0x40, 0xfd, 0xe5, 0x68, 0x5b, 0xda, 0xac, 0x36, 0xc7, 0xff,
+	0xee, 0x6c, 0x36, 0xb3, 0xb3, 0x89, 0xd6, 0x55, 0x6c, 0x85, 0xe8, 0x33, 0xcc, 0xa0, 0x8a, 0x18,
+	0x80, 0xff, 0x2f, 0xc4, 0x3d, 0x62, 0xc1, 0x66, 0xda, 0x8f, 0xc2, 0xdd, 0x20, 0x3a, 0x30, 0xdb,
+	0xa3, 0x05, 0x01, 0x6b, 0x31, 0x98, 0x44, 0x09, 0x20, 0x6b, 0x88, 0xd4, 0x45, 0x70, 0x6e, 0xfa,
+	0xd1, 0x64, 0x2a, 0xa2, 0x04, 0xab, 0x4a, 0xa3, 0x6b, 0x83, 0x20, 0xf6, 0xaa, 0x8b, 0x52, 0x31,
+	0xe5, 0x28, 0x5c, 0x20, 0x7b, 0x33, 0x3d, 0x05, 0x8a, 0xa1, 0xae, 0x15, 0x32, 0x87, 0xe6, 0x22,
+	0x14, 0x04, 0x19, 0x56, 0x27, 0xbb, 0xd3, 0x60, 0x0b, 0x4a, 0xe9, 0x51, 0xb2, 0xc9, 0x33, 0x50,
+	0xff, 0x25, 0x3a, 0x67, 0xd9, 0x66, 0xd0, 0xae, 0x8d, 0xc9, 0x8e, 0x18, 0xc7, 0xca, 0x85, 0x55,
+	0x25, 0x10, 0x01, 0xfe, 0x0b, 0x5f, 0x86, 0x20, 0xa5, 0xf4, 0x04, 0x2c, 0x48, 0x19, 0x83, 0xf5,
+	0x52, 0x06, 0xfd, 0xa7, 0xdd, 0xdd, 0x83, 0x5d, 0x70, 0x15, 0x96, 0xe5, 0xb7, 0x11, 0xad, 0xb1,
+	0x5f, 0x98, 0xa7, 0xb3, 0xab, 0x93, 0xdd, 0xdd, 0x60, 0x3c, 0x64, 0x8f, 0xd1, 0x46, 0x02, 0x83,
+	0x03, 0xdd, 0x39, 0x6e, 0x9d, 0x82, 0x11, 0x7b, 0x11, 0x46, 0xc8, 0x91, 0xc0, 0xff, 0xa7, 0x13,
+	0xd2, 0x92, 0xb2, 0xfb, 0xe9, 0x99, 0xd5, 0x48, 0x04, 0x89, 0xd0, 0x8a, 0xab, 0x88, 0xe7, 0xeb,
+	0xec, 0x3e, 0x7a, 0xaa, 0x1b, 0x4d, 0xa6, 0x59, 0x44, 0x83, 0x2d, 0xd2, 0x73, 0xb2, 0x4e, 0x46,
+	0x93, 0x35, 0x45, 0x93, 0x9d, 0xa7, 0x0b, 0x50, 0xb5, 0x04, 0x3f, 0xc3, 0x1e, 0xa1, 0x8b, 0x03,
+	0x91, 0x14, 0xc7, 0xbd, 0x34, 0xd5, 0x2c, 0xf4, 0xf3, 0xe2, 0x74, 0x58, 0xde, 0x4f, 0x8b, 0x3d,
+	0x40, 0xef, 0x93, 0x9c, 0x18, 0xaf, 0x5e, 0x23, 0xdb, 0x80, 0x94, 0xee, 0x5d, 0x1e, 0x49, 0xd9,
+	0x19, 0x7a, 0x52, 0xd6, 0x04, 0x27, 0x44, 0x83, 0x3b, 0xec, 0x14, 0x3d, 0x01, 0x8c, 0xdb, 0xc0,
+	0xe3, 0x40, 0x2b, 0xf9, 0xb0, 0xc1, 0x27, 0x40, 0x3e, 0x03, 0x91, 0xa4, 0x6e, 0x88, 0x46, 0xcc,
+	0x33, 0x46, 0x8f, 0xc3, 0xe8, 0x82, 0x24, 0xd0, 0xb0, 0x93, 0xec, 0x1c, 0xf5, 0x06, 0x22, 0x41,
+	0x47, 0x2a, 0x57, 0x83, 0xb1, 0x07, 0xe9, 0xfd, 0x6a, 0x1c, 0x96, 0xc7, 0xa8, 0xd1, 0x67, 0x70,
+	0x24, 0xd1, 0x64, 0x5a, 0x84, 0x3c, 0x6b, 0x66, 0x50, 0x5f, 0xc3, 0x69, 0x94, 0xe7, 0x4e, 0xae,
+	0x8d, 0xba, 0x1f, 0x50, 0x72, 0x4c, 0x59, 0xd4, 0x02, 0xa0, 0xa4, 0xdc, 0xb2, 0x0d, 0x3e, 0x60,
+	0x50, 0xd9, 0x5a, 0xe7, 0xd8, 0x59, 0xca, 0x06, 0x22, 0xc9, 0x56, 0x79, 0x90, 0x9d, 0xa6, 0xf3,
+	0xc8, 0x3b, 0xcc, 0x81, 0x86, 0x9e, 0x87, 0x01, 0xa3, 0xfb, 0xad, 0x74, 0x4b, 0x36, 0xaa, 0xd1,
+	0x0f, 0xc1, 0x80, 0x25, 0x77, 0xc6, 0xc3, 0xd5, 0xc8, 0xd7, 0x81, 0xf2, 0x40, 0xdd, 0x8c, 0x52,
+	0xb8, 0x4d, 0x3c, 0x06, 0x02, 0xd7, 0x62, 0x49, 0x0d, 0xb9, 0xc6, 0x3e, 0x09, 0x5c, 0x2d, 0x8f,
+	0x12, 0x11, 0x69, 0xaf, 0x7e, 0x75, 0x77, 0x38, 0xbf, 0x04, 0x13, 0xcd, 0x65, 0x97, 0xe1, 0x78,
+	0x5b, 0x13, 0xbf, 0x05, 0x26, 0x5a, 0x71, 0x83, 0x31, 0x1d, 0x8d, 0x78, 0x2b, 0x20, 0xb8, 0x98,
+	0x4e, 0xa2, 0x04, 0xeb, 0xc4, 0x1a, 0xf1, 0x14, 0x08, 0xa3, 0x1f, 0xed, 0x8d, 0x85, 0x3c, 0x6b,
+	0x6b, 0xf8, 0xdb, 0x41, 0xa3, 0x81, 0x75, 0x8b, 0x25, 0x97, 0xed, 0x67, 0xd8, 0x02, 0x3d, 0x0b,
+	0xe2, 0x2a, 0x60, 0xfa, 0x1d, 0xc0, 0x34, 0x98, 0x0e, 0x0e, 0x37, 0x50, 0x1a, 0xfa, 0x4e, 0xe6,
+	0xd1, 0xd3, 0xd8, 0xbd, 0x36, 0x25, 0x1a, 0xf3, 0x2e, 0xb3, 0x00, 0xcc, 0xb9, 0x5f, 0x23, 0x9f,
+	0x85, 0x25, 0x6a, 0x89, 0x18, 0x4c, 0x09, 0x9c, 0xd6, 0x34, 0xfe, 0xdd, 0x66, 0x0a, 0x60, 0x3a,
+	0x65, 0xa4, 0x5d, 0x23, 0xdf, 0x03, 0xe3, 0x93, 0xc2, 0xc5, 0x7b, 0x4a, 0x0d, 0x5f, 0x06, 0xb8,
+	0xac, 0xe4, 0xc0, 0x57, 0x8c, 0x04, 0xe5, 0xad, 0x84, 0x46, 0xac, 0x42, 0x05, 0x2e, 0x76, 0x27,
+	0xfb, 0x6e, 0x05, 0xb8, 0x00, 0x7a, 0x50, 0x69, 0x6e, 0x26, 0xd4, 0xa0, 0x49, 0x2e, 0xb1, 0x87,
+	0xe8, 0x03, 0x68, 0x9e, 0x4a, 0x08, 0x9e, 0x83, 0x11, 0x5e, 0x16, 0x49, 0x19, 0xfe, 0xb2, 0xb5,
+	0x3a, 0x36, 0xe5, 0x4d, 0x9e, 0x46, 0x5d, 0x61, 0xaf, 0xa7, 0x8f, 0x5e, 0x16, 0x89, 0x35, 0x09,
+	0xc0, 0xf5, 0x8d, 0x30, 0xb9, 0x15, 0x42, 0x5b, 0x82, 0xa7, 0x72, 0xec, 0x81, 0x36, 0x5a, 0x72,
+	0x34, 0xbd, 0xd9, 0xe3, 0x7c, 0x2f, 0x08, 0x00, 0x26, 0x1e, 0xae, 0x87, 0x27, 0xfb, 0x46, 0xcc,
+	0xcf, 0x6b, 0x84, 0xbe, 0xce, 0xd5, 0x88, 0xab, 0x80, 0x50, 0x26, 0x41, 0xba, 0x06, 0x0a, 0xb1,
+	0x06, 0x4a, 0x8a, 0x0b, 0xca, 0x01, 0x43, 0x04, 0xf9, 0x7c, 0x9e, 0x65, 0xdc, 0xb4, 0x35, 0xcd,
+	0x3a, 0x8c, 0xf8, 0xba, 0x88, 0xc2, 0x9b, 0x07, 0xd9, 0xe5, 0xdb, 0x87, 0xee, 0x2e, 0xdd, 0x9e,
+	0x06, 0xe3, 0xa1, 0xab, 0xb2, 0x2f, 0x80, 0x42, 0xea, 0xa9, 0x53, 0xb1, 0x1d, 0x8d, 0xe3, 0xd0,
+	0x1e, 0x48, 0x78, 0x65, 0x25, 0x0a, 0xc5, 0x4d, 0x7b, 0xc0, 0x03, 0x25, 0x7c, 0xfb, 0xc8, 0x62,
+	0xe3, 0x37, 0x60, 0x25, 0x70, 0xb1, 0x1d, 0xc2, 0x1e, 0xa8, 0xae, 0x3e, 0xa5, 0x13, 0xa8, 0x29,
+	0x5e, 0x34, 0xbb, 0x4c, 0x26, 0x2a, 0xa4, 0x29, 0xae, 0xa3, 0x4d, 0xfd, 0xc8, 0x68, 0x09, 0x6c,
+	0xce, 0x15, 0x11, 0x44, 0xc9, 0xa6, 0x08, 0xd2, 0xfa, 0x37, 0xb0, 0xbe, 0x5b, 0x53, 0xae, 0x55,
+	0x4d, 0xf1, 0xb3, 0x4a, 0x64, 0x19, 0xa2, 0xab, 0xc2, 0xda, 0xeb, 0x7e, 0x4e, 0xef, 0x64, 0x25,
+	0x3c, 0xbc, 0x0f, 0xb4, 0xf0, 0xda, 0x24, 0x09, 0x6f, 0x1e, 0xac, 0xbe, 0x20, 0x6b, 0xe2, 0xfd,
+	0x70, 0x6a, 0xe9, 0xde, 0x0f, 0x9a, 0x3c, 0x10, 0x09, 0x2e, 0x22, 0xf7, 0xde, 0x4a, 0x93, 0x7c,
+	0x40, 0x9a, 0x1d, 0x58, 0x04, 0xf6, 0x94, 0xfc, 0x3c, 0x0c, 0x4f, 0x6f, 0x7f, 0xe9, 0x25, 0xac,
+	0xc6, 0x7e, 0xd0, 0x60, 0x0b, 0x4c, 0x85, 0x78, 0xbc, 0xd5, 0x1a, 0xce, 0xbf, 0xf2, 0xca, 0x2b,
+	0xaf, 0xd4, 0xfc, 0x7f, 0xac, 0x95, 0xec, 0xf0, 0x85, 0x1e, 0x6d, 0x37, 0xef, 0xb5, 0xca, 0xbb,
+	0xe2, 0xaa, 0x1b, 0xa7, 0x6c, 0x15, 0x70, 0x8f, 0x74, 0xec, 0x78, 0x6f, 0x17, 0xbd, 0x9e, 0x0e,
+	0xb7, 0x20, 0xec, 0x51, 0x5a, 0x1f, 0xec, 0x84, 0x18, 0x46, 0x28, 0xb9, 0x9b, 0x00, 0x7c, 0xc1,
+	0xcd, 0x50, 0xb3, 0xf0, 0x66, 0xe8, 0x28, 0xb7, 0x3f, 0x4b, 0xcf, 0xd1, 0xd9, 0x2d, 0x25, 0x80,
+	0xe3, 0xae, 0x7f, 0xe4, 0x6d, 0x2f, 0x12, 0xeb, 0x58, 0x57, 0x28, 0x34, 0xae, 0x2b, 0xfb, 0x93,
+	0x42, 0xef, 0xa8, 0x48, 0xa8, 0x4b, 0xdd, 0xf2, 0x2e, 0x6f, 0x39, 0xc2, 0x2d, 0x68, 0xd0, 0x74,
+	0xf8, 0x6f, 0xa4, 0xda, 0xed, 0xaa, 0x0c, 0xa0, 0x14, 0xce, 0x6b, 0xed, 0xa8, 0xf3, 0x8a, 0x41,
+	0x4e, 0xe9, 0xb3, 0xf5, 0x55, 0x6c, 0xc8, 0x00, 0x96, 0xd6, 0xca, 0x87, 0x19, 0xe2, 0x30, 0x5f,
+	0xe7, 0x48, 0xb6, 0x78, 0x14, 0x66, 0xbc, 0x9f, 0x23, 0x55, 0x4e, 0x64, 0xe5, 0x68, 0xf5, 0x24,
+	0xd4, 0xac, 0x49, 0x78, 0xbe, 0x9c, 0xbb, 0x0f, 0x23, 0x77, 0x0f, 0x5b, 0x93, 0x70, 0x18, 0x6f,
+	0x5f, 0x21, 0x87, 0x3b, 0xb0, 0x47, 0xe6, 0xf0, 0x85, 0x72, 0x0e, 0x77, 0x90, 0xc3, 0xc7, 0xf4,
+	0x4a, 0x39, 0xa4, 0x67, 0xc3, 0xe7, 0x77, 0xeb, 0xd5, 0x2e, 0xf4, 0x51, 0x79, 0x84, 0xb3, 0xdd,
+	0x35, 0xf1, 0x92, 0x0a, 0x99, 0xe1, 0xed, 0xbf, 0x2a, 0x3a, 0x77, 0x51, 0x8d, 0xcc, 0x4d, 0xa9,
+	0x7d, 0xb7, 0xd4, 0xcc, 0xdc, 0x7c, 0x16, 0xdf, 0x53, 0xcd, 0x94, 0xde, 0xa2, 0xe2, 0x45, 0xcc,
+	0x8e, 0x50, 0x02, 0xc0, 0x80, 0x71, 0x8b, 0xdb, 0xa0, 0xfc, 0x45, 0x0c, 0x39, 0xfc, 0x22, 0x86,
+	0xdc, 0xf1, 0x45, 0x0c, 0x29, 0xbe, 0x88, 0xa9, 0xd2, 0xfe, 0x91, 0xa3, 0xfd, 0x55, 0xf3, 0x61,
+	0x66, 0xee, 0xd7, 0x6a, 0xa5, 0x47, 0x9b, 0xca, 0x49, 0x83, 0x38, 0x89, 0x9d, 0x5c, 0x30, 0x63,
+	0x96, 0x2e, 0xf8, 0x8e, 0x71, 0x12, 0xec, 0x4e, 0xd5, 0xdd, 0x85, 0x01, 0x00, 0x16, 0xbb, 0xc1,
+	0xe0, 0x7d, 0x43, 0x66, 0x1f, 0xa6, 0x80, 0xcc, 0x8d, 0x43, 0xb3, 0xe8, 0xc6, 0x41, 0xb9, 0x06,
+	0x28, 0x9f, 0x0e, 0xd7, 0xc5, 0xa5, 0x2b, 0xe5, 0x42, 0xd9, 0x5d, 0x24, 0x56, 0x22, 0x57, 0xc9,
+	0x50, 0x8d, 0x3c, 0xfe, 0x87, 0x94, 0x9e, 0xe6, 0xee, 0x4a, 0x1e, 0x3e, 0x3d, 0x66, 0x1a, 0x4a,
+	0x33, 0x42, 0x1d, 0x98, 0x7b, 0xa7, 0x23, 0x35, 0xd2, 0x00, 0x40, 0x2a, 0xb2, 0x90, 0xde, 0xc3,
+	0x34, 0xb9, 0x05, 0xa9, 0x1a, 0xfb, 0xd8, 0x19, 0x7b, 0xc9, 0xb0, 0xcc, 0xd8, 0xbf, 0x4e, 0x0a,
+	0x0e, 0xab, 0xf7, 0x26, 0x98, 0xbf, 0xb4, 0x52, 0xce, 0xf5, 0x47, 0x16, 0x89, 0x15, 0xe4, 0xcb,
+	0x31, 0x64, 0xf8, 0xdd, 0xce, 0x1d, 0xa2, 0x0b, 0xb7, 0xc5, 0xf7, 0x94, 0x77, 0x15, 0x2d, 0x12,
+	0xeb, 0x82, 0x39, 0xd3, 0x98, 0xe9, 0xe8, 0x63, 0x05, 0x07, 0xf3, 0x3b, 0x95, 0x4b, 0xd5, 0x48,
+	0x63, 0x67, 0xa4, 0xb9, 0x2e, 0x0c, 0x03, 0xdf, 0x24, 0x85, 0x31, 0x00, 0xd0, 0x48, 0xa0, 0x1f,
+	0x1b, 0x3e, 0xd2, 0x72, 0x65, 0xd0, 0xd0, 0xb9, 0xe7, 0xa8, 0x67, 0xee, 0x39, 0xaa, 0xfc, 0x88,
+	0xc4, 0xf1, 0x23, 0x0a, 0x58, 0x32, 0x3c, 0x47, 0xd9, 0xe8, 0x04, 0x7b, 0x48, 0x26, 0x53, 0xab,
+	0x14, 0xa9, 0x39, 0x2b, 0xb7, 0x92, 0x23, 0x62, 0xe9, 0xdd, 0xe5, 0x1d, 0xef, 0x2d, 0x12, 0xeb,
+	0xc2, 0xd9, 0x6d, 0xd8, 0xf4, 0xf9, 0x19, 0x52, 0x1e, 0xfe, 0xa8, 0x14, 0x56, 0xaa, 0xbc, 0x35,
+	0x4b, 0x79, 0x97, 0x7a, 0xe5, 0xfc, 0xec, 0x23, 0x3f, 0x0f, 0x19, 0x7e, 0x0a, 0xfb, 0x74, 0xec,
+	0x4a, 0x79, 0xe8, 0xe5, 0xde, 0x05, 0x7d, 0xd3, 0x5b, 0xbf, 0x46, 0xc5, 0xad, 0x5f, 0x33, 0x7f,
+	0xeb, 0xb7, 0xf4, 0xde, 0xf2, 0xa1, 0x1f, 0xe0, 0xd0, 0x17, 0x5d, 0x8b, 0x9a, 0x1f, 0x94, 0x19,
+	0xfb, 0x0f, 0x48, 0x69, 0x5c, 0xe9, 0xde, 0x8d, 0xbc, 0xca, 0x2e, 0xbe, 0xec, 0xda, 0xc5, 0x62,
+	0xd6, 0x0c, 0xff, 0x3f, 0x26, 0x25, 0xa1, 0x2f, 0xe0, 0xf4, 0xca, 0xc6, 0x46, 0x1f, 0x53, 0x0b,
+	0x95, 0x4a, 0xe9, 0xb2, 0x9d, 0xda, 0x28, 0x85, 0x9f, 0x49, 0x6d, 0x44, 0x8c, 0x1c, 0x9e, 0x2e,
+	0x82, 0x34, 0x38, 0x30, 0x28, 0x77, 0x09, 0xfc, 0x5f, 0x75, 0x90, 0xf8, 0x68, 0xc1, 0x41, 0x22,
+	0xc3, 0xa2, 0x19, 0xc5, 0xd7, 0x48, 0x49, 0x94, 0xee, 0xb0, 0x51, 0x54, 0xf0, 0x9a, 0x49, 0x87,
+	0xac, 0xe2, 0xf5, 0x17, 0x4a, 0x0e, 0x3d, 0x85, 0xbc, 0xde, 0xa0, 0x1d, 0x8d, 0xc3, 0x80, 0x4d,
+	0x9a, 0x3b, 0x0a, 0xec, 0x1d, 0x53, 0xb9, 0xa3, 0xe7, 0x68, 0x1b, 0x91, 0xd6, 0x4d, 0x9d, 0x01,
+	0x98, 0x6c, 0xd0, 0xba, 0x95, 0x0d, 0x0a, 0x57, 0x8f, 0x85, 0x31, 0xc7, 0x6c, 0x96, 0x42, 0xd5,
+	0x48, 0x3e, 0xe6, 0x8c, 0xa4, 0xb0, 0x39, 0x33, 0x92, 0x69, 0x49, 0x24, 0x33, 0xd7, 0xe1, 0xe5,
+	0xf2, 0x0e, 0x5f, 0x21, 0x05, 0x3d, 0x96, 0xca, 0xee, 0x39, 0x70, 0x82, 0xe3, 0xe9, 0x64, 0x1c,
+	0xe3, 0x85, 0xe4, 0xfa, 0xf3, 0xd8, 0x49, 0x8b, 0xd7, 0xd6, 0x9f, 0x07, 0xa1, 0x5c, 0x8a, 0xa2,
+	0x49, 0xa4, 0xae, 0x12, 0x64, 0xc1, 0x3c, 0x64, 0x91, 0x69, 0x05, 0xb2, 0xe0, 0xff, 0x90, 0x14,
+	0x45, 0x5a, 0x7f, 0x2a, 0x2a, 0x5f, 0xb1, 0x01, 0x7d, 0x5c, 0xca, 0xe2, 0x7e, 0x63, 0x78, 0x4b,
+	0x45, 0x7f, 0x33, 0x1f, 0x11, 0xce, 0x49, 0xbd, 0x62, 0x73, 0xfe, 0x84, 0xec, 0xe9, 0x3e, 0xdb,
+	0x4a, 0x58, 0x4d, 0x99, 0x7e, 0x3e, 0x5a, 0x11, 0x63, 0x2e, 0x74, 0x48, 0x2a, 0x8e, 0x88, 0x9f,
+	0x24, 0x8e, 0x71, 0x2d, 0x6d, 0xd7, 0xf4, 0xfe, 0x77, 0xa4, 0x34, 0x86, 0x8d, 0x37, 0x64, 0x00,
+	0xec, 0xc9, 0x14, 0x85, 0x3a, 0xd7, 0x45, 0xc0, 0x20, 0x65, 0x6f, 0xa8, 0x56, 0x8e, 0x2e, 0x82,
+	0xc3, 0xd6, 0xdd, 0x54, 0x07, 0x2f, 0x74, 0x64, 0x65, 0x09, 0xe0, 0x7c, 0x8a, 0x70, 0x39, 0xb5,
+	0xaa, 0x54, 0xb5, 0x47, 0xfe, 0x12, 0x71, 0xec, 0x6c, 0x09, 0x97, 0x66, 0x28, 0x5f, 0x25, 0x87,
+	0x47, 0xdc, 0x8f, 0x7c, 0xda, 0xe5, 0xe5, 0xfc, 0xfd, 0x2a, 0x71, 0x8e, 0xbb, 0x87, 0x75, 0x6d,
+	0x18, 0xfd, 0x46, 0xbd, 0x3c, 0xe8, 0x8f, 0x02, 0x5c, 0xb1, 0xe6, 0x5c, 0x95, 0x2c, 0x01, 0xd6,
+	0x6c, 0x01, 0xa6, 0x4c, 0xd7, 0xad, 0x1d, 0xf0, 0x0e, 0x03, 0x57, 0x8f, 0xd0, 0x5a, 0x8f, 0x57,
+	0x66, 0xb9, 0xd6, 0x7a, 0xfc, 0xde, 0xa5, 0xb6, 0x2e, 0x51, 0x2a, 0x6f, 0x2a, 0xb0, 0x5a, 0xcb,
+	0xb9, 0x40, 0xc4, 0x9b, 0x63, 0x89, 0xe5, 0x16, 0x95, 0x9d, 0xdb, 0xda, 0xae, 0xcc, 0x6d, 0xad,
+	0xf2, 0x40, 0x7e, 0x93, 0x38, 0xde, 0x57, 0xd9, 0x54, 0x98, 0x09, 0xfb, 0x11, 0xc9, 0xdf, 0xc3,
+	0xfc, 0x14, 0x27, 0xaa, 0xca, 0xcc, 0x7c, 0xda, 0x35, 0x33, 0x59, 0x2e, 0xcd, 0x18, 0xfe, 0x3e,
+	0x5d, 0xe8, 0x70, 0x8f, 0xe0, 0xc4, 0x76, 0xf1, 0xfe, 0x38, 0x88, 0x77, 0x4c, 0x56, 0x96, 0x2c,
+	0xa5, 0xd9, 0x5a, 0x43, 0x95, 0x94, 0xa2, 0x4a, 0x60, 0x06, 0xbb, 0x2b, 0x6a, 0x20, 0xb5, 0xee,
+	0x0a, 0x94, 0xfb, 0x1b, 0x2a, 0x1d, 0xb7, 0xd6, 0xdf, 0x30, 0xfb, 0x44, 0xd3, 0xda, 0x27, 0xaa,
+	0x96, 0xfa, 0x67, 0x8a, 0x96, 0x7a, 0x8e, 0x4f, 0x33, 0x98, 0xff, 0x20, 0x05, 0x57, 0x60, 0x87,
+	0x1d, 0xb0, 0x0b, 0x67, 0xe5, 0x0e, 0x0f, 0xd8, 0x83, 0xe9, 0x28, 0x94, 0xc9, 0x96, 0x2a, 0x69,
+	0x32, 0x05, 0x40, 0x1c, 0x07, 0xa9, 0x57, 0x26, 0x7b, 0xe3, 0xa1, 0xf6, 0x86, 0x6d, 0xd0, 0xd2,
+	0x6a, 0xf9, 0xc0, 0x3f, 0x4b, 0x9c, 0x33, 0x5c, 0x6e, 0x4c, 0x66, 0xc8, 0xff, 0x4a, 0x0a, 0xaf,
+	0xf7, 0xee, 0x6a, 0xd0, 0x10, 0x9c, 0x32, 0xea, 0xae, 0x26, 0xd2, 0x06, 0xb1, 0xa7, 0x69, 0x07,
+	0x97, 0xe0, 0xc6, 0x44, 0xae, 0x0e, 0xaf, 0x51, 0xba, 0x3c, 0x5d, 0xc2, 0xa5, 0x4b, 0xe5, 0x83,
+	0xfd, 0x1c, 0x71, 0x8e, 0x7f, 0x05, 0xa3, 0x31, 0xc3, 0xed, 0xd1, 0x39, 0xab, 0x13, 0x99, 0x05,
+	0x24, 0x46, 0x43, 0x6b, 0xbd, 0x19, 0x40, 0x8a, 0x4d, 0x5d, 0xb9, 0x26, 0x37, 0x00, 0xff, 0x86,
+	0x4a, 0x5c, 0x2b, 0x4c, 0x27, 0x5d, 0xc8, 0xa6, 0x93, 0x5a, 0xa9, 0xa4, 0x6e, 0x3a, 0x66, 0x3d,
+	0x97, 0x8e, 0xf9, 0x1a, 0xa1, 0xc7, 0xdd, 0xdc, 0xe5, 0x9f, 0x52, 0x9e, 0xee, 0xe3, 0x2a, 0x57,
+	0x55, 0x64, 0x13, 0x75, 0xd3, 0x71, 0x72, 0x4d, 0x70, 0x98, 0xf9, 0xf6, 0x3f, 0x4e, 0x94, 0xfe,
+	0xaa, 0x67, 0x4a, 0xe9, 0xa6, 0xaf, 0x87, 0xa1, 0x8b, 0x69, 0xf4, 0x6d, 0x10, 0xbe, 0x2c, 0x94,
+	0x41, 0x30, 0x00, 0x5c, 0x06, 0xf8, 0xf8, 0x66, 0x75, 0xb2, 0xa7, 0x74, 0xaa, 0xc9, 0x6d, 0x10,
+	0xb4, 0xbc, 0x16, 0xdc, 0xb6, 0x16, 0x91, 0x2e, 0xfa, 0xef, 0xa7, 0x1d, 0x3e, 0xb5, 0x99, 0x30,
+	0x8a, 0x4b, 0x1c, 0xc5, 0x5d, 0xa2, 0x34, 0x25, 0x8b, 0xd5, 0xd5, 0x00, 0xb3, 0xcd, 0xa6, 0xac,
+	0xcf, 0x2d, 0x2a, 0xff, 0x43, 0x94, 0xc2, 0x1b, 0x34, 0xd5, 0xb2, 0x34, 0x5d, 0x24, 0x35, 0x5d,
+	0xf2, 0x6d, 0x9b, 0x7e, 0xda, 0x87, 0xff, 0xd9, 0x45, 0x3a, 0xcb, 0xa7, 0xb2, 0x8b, 0xba, 0x93,
+	0x26, 0xea, 0x30, 0xc9, 0x35, 0x91, 0xff, 0x29, 0x42, 0xef, 0xb3, 0x2f, 0xd8, 0xaf, 0x4e, 0x82,
+	0xd4, 0x63, 0x94, 0x2f, 0xe0, 0x36, 0x80, 0x30, 0x93, 0xd4, 0x65, 0x98, 0xe2, 0x29, 0x49, 0x95,
+	0x8d, 0xfc, 0xbc, 0x6b, 0x23, 0x4b, 0x3a, 0x34, 0x2b, 0xe8, 0x6f, 0x49, 0x71, 0xea, 0x3c, 0x7b,
+	0xb3, 0x4e, 0xd2, 0x23, 0xce, 0xd3, 0x2a, 0x43, 0xbb, 0x3e, 0x15, 0x51, 0x90, 0x4c, 0xa2, 0x58,
+	0x67, 0xeb, 0x5d, 0xa6, 0x2c, 0xd3, 0x52, 0x28, 0xe4, 0x72, 0xb1, 0x1c, 0xdc, 0x4c, 0x57, 0xbc,
+	0xa0, 0x8a, 0x13, 0x7d, 0xaf, 0x67, 0x5e, 0x82, 0x98, 0x4d, 0x48, 0x3e, 0x2a, 0x54, 0x25, 0xff,
+	0xa3, 0x74, 0x3e, 0xdb, 0x36, 0x5c, 0xb9, 0xe9, 0xeb, 0x6b, 0x95, 0xb3, 0x28, 0x1d, 0xd4, 0x0c,
+	0x14, 0xac, 0x3b, 0x28, 0x58, 0x4a, 0x25, 0x57, 0xa0, 0x03, 0x03, 0xb5, 0xbe, 0x11, 0x24, 0x22,
+	0x82, 0x85, 0xad, 0x43, 0xce, 0x29, 0xc0, 0xef, 0xd1, 0x53, 0x05, 0x82, 0x01, 0x66, 0x97, 0xb7,
+	0xb7, 0xd7, 0xa7, 0x69, 0xe6, 0xa7, 0x2c, 0x69, 0x6b, 0x6c, 0x9d, 0x29, 0xd3, 0xb2, 0xff, 0x31,
+	0x7a, 0xae, 0x68, 0x3e, 0xe0, 0xbe, 0xbe, 0xbb, 0xc9, 0xa7, 0xec, 0x09, 0xda, 0x80, 0xb2, 0x8a,
+	0x6f, 0x55, 0x3e, 0x6d, 0x40, 0x42, 0xcb, 0xd7, 0xae, 0x95, 0xf8, 0xda, 0x75, 0x7b, 0xf5, 0xf8,
+	0xef, 0xa7, 0xe7, 0xf3, 0x73, 0xe2, 0xb0, 0xf0, 0x76, 0x37, 0x9d, 0xeb, 0x75, 0x15, 0x3c, 0xe8,
+	0x3a, 0x3a, 0xbf, 0x6b, 0x83, 0x2e, 0x64, 0x52, 0x0b, 0xa4, 0x7d, 0x47, 0x2c, 0x7b, 0xca, 0x6d,
+	0x78, 0xd1, 0x5e, 0xb3, 0x45, 0x35, 0x74, 0xab, 0x13, 0x7a, 0x7f, 0x29, 0x0d, 0x7b, 0x23, 0x6d,
+	0xf6, 0x86, 0xb0, 0x81, 0x49, 0x89, 0x9d, 0xb5, 0x1b, 0x45, 0x44, 0x78, 0x33, 0x84, 0xd7, 0xad,
+	0xf8, 0x1f, 0x72, 0xf6, 0xac, 0x7c, 0xfd, 0x7d, 0xad, 0x0c, 0x2e, 0xd0, 0xff, 0x15, 0x52, 0x94,
+	0x13, 0x03, 0x56, 0xd4, 0xb8, 0x04, 0xea, 0x44, 0x6c, 0x41, 0xd2, 0xd4, 0x5d, 0xa2, 0x0e, 0x86,
+	0x15, 0x47, 0xd0, 0xdf, 0x72, 0x8f, 0xa0, 0xf9, 0xce, 0xcc, 0x12, 0xfe, 0x1b, 0x52, 0x9d, 0x88,
+	0x73, 0x57, 0x57, 0x0a, 0x87, 0x6e, 0xfe, 0x4b, 0xd7, 0xca, 0x99, 0xff, 0x22, 0x71, 0x2e, 0x89,
+	0xaa, 0x98, 0x33, 0xc3, 0xf8, 0x1e, 0x29, 0xcb, 0x16, 0xba, 0x47, 0x03, 0xa8, 0x88, 0xdd, 0xfd,
+	0xb6, 0x1c, 0xc0, 0x83, 0xd6, 0xb1, 0xbc, 0xca, 0xf3, 0xff, 0x3f, 0x42, 0x3b, 0x2a, 0xb3, 0x28,
+	0x92, 0x09, 0xb6, 0xe7, 0xe4, 0x97, 0x29, 0x64, 0xc4, 0x43, 0xee, 0x90, 0x06, 0x60, 0xbd, 0x6f,
+	0xb0, 0x3d, 0xe6, 0x2e, 0x78, 0xc4, 0xf0, 0x6c, 0x5a, 0x6e, 0x28, 0x1d, 0x2e, 0x0b, 0xec, 0x29,
+	0xda, 0xd6, 0xe6, 0x4f, 0x27, 0xef, 0x7b, 0xce, 0xca, 0x50, 0x48, 0xf5, 0xb1, 0x0e, 0x4d, 0x6a,
+	0x82, 0x53, 0x4d, 0xfb, 0xa9, 0xf2, 0x33, 0x74, 0xce, 0xca, 0x71, 0xf1, 0x66, 0x9c, 0xf6, 0xb4,
+	0x54, 0x53, 0x3c, 0xb7, 0x89, 0x81, 0xef, 0x2d, 0xf9, 0x6d, 0x84, 0x59, 0x69, 0x7c, 0x65, 0xc9,
+	0xff, 0x32, 0xc9, 0x27, 0x73, 0xdd, 0xd5, 0xa4, 0x59, 0x6e, 0x45, 0xdd, 0x71, 0x2b, 0xaa, 0x0e,
+	0x37, 0xbf, 0xe3, 0x1e, 0x6e, 0xb2, 0x8c, 0x98, 0x69, 0xfa, 0x22, 0x29, 0xce, 0x2e, 0x33, 0xb1,
+	0x29, 0x62, 0x7f, 0x64, 0x65, 0x9e, 0xd6, 0xfb, 0x89, 0xf6, 0xf7, 0xe0, 0x2f, 0xb0, 0x3d, 0x96,
+	0x27, 0x1d, 0x19, 0xc4, 0x52, 0xa5, 0xaa, 0x38, 0xde, 0xef, 0x12, 0xe7, 0x09, 0x5a, 0x51, 0xf7,
+	0x76, 0x1c, 0x8f, 0x69, 0x5c, 0x57, 0xc8, 0x50, 0xf1, 0x24, 0x92, 0x99, 0xe4, 0x22, 0xda, 0xd0,
+	0xb9, 0xb0, 0x0d, 0x9e, 0x96, 0xe5, 0xd6, 0x65, 0x25, 0xe5, 0xa6, 0x5b, 0x97, 0x81, 0x55, 0x6d,
+	0xa7, 0xfe, 0x8f, 0x6b, 0xf4, 0x44, 0xc6, 0x12, 0x56, 0xf8, 0x76, 0xd9, 0x63, 0x50, 0xad, 0xe0,
+	0x18, 0xa4, 0x83, 0x3e, 0xdd, 0x4d, 0xb5, 0xe6, 0x74, 0x31, 0xc5, 0xf4, 0x13, 0x75, 0x08, 0xd4,
+	0x45, 0x4b, 0x1d, 0x9a, 0xd9, 0x7b, 0x5e, 0x79, 0x71, 0x2b, 0x9d, 0x52, 0x40, 0x19, 0x40, 0xf1,
+	0x8b, 0x2b, 0x72, 0x8f, 0x5e, 0x5c, 0x59, 0xde, 0x31, 0xcd, 0x79, 0xc7, 0x97, 0x69, 0x27, 0xd5,
+	0x3a, 0xbd, 0xfc, 0x8d, 0x43, 0x4f, 0x2a, 0x1c, 0xfa, 0x9a, 0xe3, 0xd0, 0xfb, 0x9f, 0x24, 0xf4,
+	0x04, 0x2a, 0x9f, 0x35, 0xfd, 0xd6, 0x93, 0x33, 0xe2, 0x3e, 0x39, 0xf3, 0x55, 0x9a, 0x75, 0x66,
+	0x3a, 0x6c, 0x18, 0x5b, 0xa2, 0xed, 0x94, 0x35, 0xf5, 0x40, 0xe4, 0x74, 0x76, 0xa1, 0x48, 0xc3,
+	0x91, 0x16, 0xe1, 0xc4, 0x72, 0x32, 0x67, 0x59, 0xec, 0x7d, 0x94, 0x1c, 0xbe, 0x8f, 0xbe, 0x8b,
+	0x1e, 0xb3, 0x6b, 0x2b, 0x2f, 0x5c, 0x6f, 0x67, 0x79, 0x2d, 0xe7, 0x0e, 0x39, 0x7b, 0x4f, 0xee,
+	0x75, 0xb8, 0x72, 0xb2, 0xcb, 0xde, 0xe9, 0x66, 0xc9, 0xfd, 0x7f, 0x26, 0x2a, 0x17, 0xc3, 0x9d,
+	0x19, 0x47, 0x1e, 0xe4, 0x8e, 0xe4, 0xc1, 0x9e, 0xa2, 0x54, 0x9e, 0xf6, 0xd2, 0x0f, 0x31, 0x19,
+	0x3e, 0x32, 0xb3, 0xc5, 0x2d, 0x4a, 0xf6, 0x2c, 0xed, 0x38, 0x62, 0x54, 0xf2, 0x2f, 0x37, 0xde,
+	0x2e, 0xb9, 0xab, 0xfe, 0x0d, 0xf9, 0xd0, 0x21, 0x05, 0xf8, 0xbb, 0xf4, 0x8c, 0x43, 0x9e, 0xc6,
+	0xe3, 0xab, 0xf7, 0x1e, 0x67, 0x37, 0xa9, 0xdd, 0xf1, 0x6e, 0xe2, 0xbf, 0x9a, 0xe6, 0x2c, 0xe4,
+	0x12, 0x70, 0xef, 0x36, 0x67, 0xc1, 0x51, 0xde, 0x7a, 0x5e, 0x79, 0xab, 0xce, 0x39, 0x5f, 0x22,
+	0x05, 0x69, 0x07, 0x39, 0xce, 0x9c, 0x08, 0x76, 0x45, 0x8a, 0x70, 0x85, 0xcd, 0xd3, 0xaf, 0x40,
+	0x6b, 0xd6, 0x2b, 0xd0, 0xa3, 0x86, 0xaf, 0xaf, 0x96, 0x8f, 0xe3, 0xf7, 0x88, 0x93, 0xaf, 0x55,
+	0xce, 0xa2, 0x93, 0x91, 0xb0, 0x8a, 0xe1, 0x9f, 0x60, 0x14, 0x26, 0x07, 0x77, 0xad, 0xd5, 0x8b,
+	0x74, 0xce, 0x6a, 0x46, 0x8d, 0xcf, 0x06, 0xf9, 0x1f, 0xa6, 0x0b, 0xb6, 0xd7, 0x93, 0xe9, 0xb3,
+	0xe8, 0x52, 0xf5, 0xe9, 0x6c, 0x9b, 0xf6, 0x92, 0xcd, 0x34, 0xe0, 0xf6, 0xf5, 0x21, 0x7a, 0xca,
+	0x2a, 0xa6, 0xba, 0xfc, 0x36, 0xf7, 0x44, 0xf0, 0x70, 0x7e, 0xf5, 0x67, 0x5b, 0x95, 0xf4, 0xb0,
+	0x79, 0x5f, 0x8a, 0xf4, 0x15, 0x14, 0xfc, 0xf5, 0x5f, 0x4b, 0x43, 0x9b, 0xb9, 0x24, 0xf0, 0x5c,
+	0x40, 0xc6, 0xfd, 0xc6, 0x4d, 0xd3, 0xf9, 0xfa, 0x4b, 0x62, 0xdf, 0xf7, 0x25, 0xf9, 0xaf, 0xbf,
+	0x34, 0xb2, 0x5f, 0x7f, 0xa9, 0x52, 0xe3, 0x2f, 0x17, 0x85, 0x34, 0x73, 0xfc, 0x99, 0xb9, 0xff,
+	0x6f, 0x22, 0xbf, 0x8f, 0x83, 0x11, 0x8a, 0xcd, 0x34, 0x42, 0xb1, 0xc9, 0x1e, 0xa4, 0xb5, 0x7e,
+	0xa2, 0x6c, 0x53, 0xe6, 0xab, 0x39, 0xb5, 0x7e, 0x02, 0xdf, 0x29, 0x53, 0x6f, 0xb6, 0xeb, 0xee,
+	0x79, 0x7c, 0xb3, 0x9f, 0xc8, 0x75, 0x1f, 0xeb, 0x0f, 0x61, 0x60, 0x21, 0xeb, 0x26, 0x36, 0x9c,
+	0x00, 0x64, 0xb5, 0x9b, 0xb8, 0x30, 0xa0, 0x73, 0x56, 0x93, 0xf6, 0xbb, 0xf9, 0x86, 0x7c, 0x37,
+	0x7f, 0xd1, 0xfd, 0x74, 0x53, 0xb9, 0xfd, 0xb1, 0x5e, 0xd4, 0x7f, 0xa5, 0x46, 0xe7, 0xb3, 0x5f,
+	0x18, 0x83, 0x65, 0x2b, 0xb0, 0x30, 0x54, 0x6f, 0x9a, 0x74, 0x11, 0x8c, 0xa0, 0xb0, 0xee, 0x6d,
+	0xf1, 0x19, 0x58, 0x0a, 0x00, 0xdd, 0x9d, 0x4c, 0x53, 0x37, 0x0e, 0xff, 0xb3, 0x07, 0x69, 0x7d,
+	0x9a, 0xe8, 0x28, 0xfb, 0x9c, 0x25, 0x1f, 0x0e, 0x70, 0x68, 0x70, 0x6b, 0x2f, 0x8a, 0x60, 0x5e,
+	0x64, 0xda, 0x58, 0x93, 0x1b, 0x00, 0x58, 0xc0, 0x69, 0x24, 0x24, 0x52, 0x3e, 0xc6, 0x4a, 0xcb,
+	0x30, 0xfe, 0x38, 0xda, 0x52, 0x2e, 0x33, 0xfc, 0x85, 0xee, 0x87, 0x22, 0x4e, 0x94, 0x1f, 0x82,
+	0xff, 0xe1, 0xe0, 0xb9, 0x75, 0x4b, 0x6c, 0xed, 0xac, 0x4e, 0xc6, 0x37, 0x47, 0xe1, 0x56, 0xa2,
+	0x9c, 0x10, 0x17, 0x08, 0x8b, 0x36, 0x48, 0x3f, 0xd9, 0x33, 0x44, 0x57, 0xa4, 0xc1, 0x6d, 0x90,
+	0xff, 0x1b, 0xa4, 0xe8, 0x39, 0x03, 0x7b, 0xab, 0x92, 0x87, 0x15, 0x3b, 0x28, 0xfd, 0x6e, 0x9b,
+	0xa1, 0xac, 0x3a, 0xa1, 0x7e, 0xc5, 0x3d, 0xa1, 0xe6, 0xfb, 0x34, 0x5a, 0x0b, 0x3c, 0xe5, 0x9f,
+	0x52, 0xdc, 0x03, 0x9e, 0xbe, 0xea, 0xf2, 0x94, 0xef, 0xd3, 0xb9, 0xad, 0x29, 0x7a, 0xc6, 0x71,
+	0xd4, 0x85, 0x75, 0x8e, 0xb6, 0x71, 0xc7, 0x87, 0x35, 0xab, 0xd4, 0xc9, 0x00, 0x9c, 0xaf, 0x48,
+	0x11, 0xf3, 0xad, 0xac, 0xaa, 0xf0, 0xf7, 0xef, 0x17, 0x85, 0xbf, 0x1d, 0x16, 0xcd, 0x18, 0x92,
+	0xa2, 0x07, 0x27, 0xee, 0xa2, 0xa8, 0x59, 0x8b, 0xa2, 0x4a, 0x72, 0x7f, 0xe0, 0x4a, 0x2e, 0xdf,
+	0xac, 0xe9, 0xf5, 0x3f, 0xc9, 0x21, 0xef, 0x59, 0x4a, 0x3f, 0xc7, 0x71, 0x07, 0x31, 0xab, 0xc2,
+	0x8a, 0x95, 0xc9, 0x3a, 0x8c, 0x36, 0xc6, 0xd6, 0x8d, 0x19, 0xfc, 0x5f, 0x5a, 0x2f, 0x1f, 0xe8,
+	0xd7, 0xe4, 0x40, 0x1f, 0x71, 0x73, 0x44, 0x8a, 0x07, 0x62, 0xc6, 0xfc, 0x7d, 0x52, 0xf9, 0x40,
+	0xe7, 0x30, 0x0f, 0x28, 0x72, 0xee, 0x57, 0x64, 0x09, 0xe6, 0x69, 0x18, 0x4d, 0xa6, 0xcb, 0xa3,
+	0x91, 0xba, 0x35, 0xd0, 0xc5, 0xaa, 0xf4, 0xdb, 0x3f, 0x94, 0xec, 0xfb, 0x76, 0x92, 0xfd, 0x61,
+	0xcc, 0x7f, 0xb8, 0xea, 0xed, 0x50, 0x95, 0x73, 0xf2, 0x47, 0xae, 0x73, 0x52, 0xde, 0x88, 0xe9,
+	0xeb, 0xb3, 0xa4, 0xe4, 0x21, 0x92, 0xe5, 0x34, 0x11, 0xc7, 0x69, 0x3a, 0x4f, 0x69, 0x64, 0xde,
+	0x57, 0xc8, 0x2f, 0xa9, 0x58, 0x90, 0xaa, 0x9c, 0x95, 0x3f, 0x26, 0x45, 0xf9, 0x3e, 0x6e, 0xbf,
+	0x86, 0xb5, 0x7f, 0x20, 0x77, 0xf8, 0x10, 0xaa, 0x94, 0xd5, 0xb2, 0x9b, 0x32, 0xe5, 0x71, 0xc3,
+	0xd6, 0x22, 0x37, 0xd8, 0x3a, 0x37, 0x80, 0xa5, 0x1b, 0xe5, 0x03, 0xf8, 0xba, 0x1c, 0xc0, 0x1b,
+	0x8d, 0x80, 0x0f, 0xe7, 0xce, 0x0c, 0xe8, 0xcb, 0xe4, 0xf0, 0xe7, 0x5a, 0x47, 0x0b, 0x7f, 0x56,
+	0x25, 0x32, 0x7c, 0xc3, 0x4d, 0x64, 0x38, 0xac, 0x63, 0xdb, 0x4a, 0x15, 0x3d, 0x17, 0x03, 0x61,
+	0x0a, 0x7c, 0xfa, 0xa2, 0x02, 0xa5, 0xaa, 0x54, 0x65, 0x1b, 0xff, 0xc4, 0xb5, 0x8d, 0x05, 0xad,
+	0xe6, 0x7a, 0xcd, 0xbc, 0x45, 0xbb, 0x9b, 0x5e, 0xff, 0x34, 0xdf, 0x6b, 0xa6, 0x55, 0xd3, 0xeb,
+	0xaf, 0x93, 0xc2, 0x97, 0x6e, 0xf0, 0x81, 0x2e, 0xf3, 0x3c, 0x5f, 0x4d, 0x45, 0xc1, 0xbb, 0x7d,
+	0x8b, 0xa8, 0x8a, 0xa3, 0x6f, 0xba, 0x1c, 0x15, 0x74, 0x68, 0x38, 0x1a, 0x15, 0xbc, 0xb0, 0x2b,
+	0x4c, 0x18, 0xaa, 0xb8, 0x7f, 0xfe, 0x96, 0x7b, 0xff, 0x9c, 0x6b, 0xcf, 0xf4, 0xf6, 0x2a, 0x39,
+	0xec, 0xe5, 0xde, 0x91, 0x17, 0x97, 0xf5, 0xbd, 0x8e, 0xba, 0xf3, 0xbd, 0x8e, 0xa5, 0x7e, 0x39,
+	0xc7, 0x7f, 0x26, 0x39, 0x7e, 0xb4, 0x74, 0x61, 0xd9, 0x2c, 0x19, 0xf6, 0x6f, 0x97, 0xbc, 0x29,
+	0x2c, 0xfb, 0x22, 0x4d, 0x95, 0x71, 0xfa, 0xb6, 0x6b, 0x9c, 0x0a, 0xdb, 0x35, 0x3d, 0x7f, 0xa0,
+	0xf0, 0xc9, 0x62, 0x95, 0x12, 0x7c, 0xc7, 0x55, 0x82, 0x82, 0xda, 0xa6, 0xf5, 0x4f, 0x90, 0xb2,
+	0x87, 0x8f, 0x39, 0x7f, 0xe7, 0x78, 0xea, 0xef, 0x40, 0x96, 0x46, 0x65, 0x94, 0xfc, 0xcf, 0xdd,
+	0x28, 0x79, 0x71, 0x07, 0x86, 0x89, 0xcf, 0x93, 0xaa, 0x67, 0x94, 0x47, 0xd5, 0x8b, 0xaa, 0x7d,
+	0xeb, 0xbb, 0xb9, 0x7d, 0xab, 0xa4, 0x53, 0xc3, 0xdc, 0x3a, 0x3d, 0x99, 0x3b, 0xd5, 0x14, 0x1e,
+	0x71, 0xf3, 0xef, 0xf8, 0x64, 0x36, 0x77, 0x06, 0xea, 0x5f, 0xa7, 0xf3, 0xd9, 0x4e, 0xd9, 0x4a,
+	0x1e, 0xa6, 0x0e, 0xb6, 0x65, 0x61, 0xad, 0x1c, 0x3d, 0x4c, 0x65, 0xe5, 0x63, 0x53, 0x27, 0x8b,
+	0x55, 0x7d, 0x01, 0xb5, 0xea, 0xae, 0xe6, 0x7b, 0xee, 0x5d, 0x4d, 0x55, 0xd3, 0x46, 0x5a, 0xdf,
+	0x26, 0xd5, 0xef, 0x59, 0x8f, 0xfc, 0x14, 0x2b, 0xfd, 0x08, 0x5a, 0xdd, 0xfa, 0x08, 0x5a, 0x15,
+	0xdb, 0x7f, 0x41, 0x0a, 0x5e, 0xe1, 0x15, 0x33, 0x63, 0xd8, 0x7e, 0xb9, 0xfc, 0x8d, 0x6d, 0xa1,
+	0xd8, 0x2a, 0xb2, 0xc3, 0xbe, 0xef, 0x66, 0x87, 0x95, 0x35, 0xeb, 0x68, 0x7f, 0xe5, 0x13, 0x5e,
+	0xf6, 0x38, 0x6d, 0xad, 0xbe, 0x80, 0x27, 0x46, 0x1d, 0xed, 0x48, 0xfb, 0x94, 0x60, 0x9e, 0xe2,
+	0xab, 0x04, 0xf3, 0x97, 0x19, 0xc1, 0x54, 0x74, 0x69, 0x98, 0x7b, 0x37, 0x9d, 0x55, 0x6d, 0x17,
+	0xea, 0x7c, 0xe6, 0x63, 0x74, 0x32, 0x68, 0x6d, 0x83, 0xfc, 0x5f, 0x24, 0x87, 0x3d, 0x3f, 0x2e,
+	0x14, 0x70, 0x85, 0x05, 0x7f, 0x35, 0x67, 0xc1, 0x2b, 0x1a, 0x77, 0x8d, 0x4c, 0xf9, 0x1b, 0xe7,
+	0xa3, 0xbe, 0x04, 0xa8, 0x32, 0x32, 0x3f, 0x20, 0xb9, 0x97, 0x96, 0x87, 0xe9, 0xdf, 0xa8, 0xf2,
+	0x7d, 0x75, 0x95, 0xdb, 0xff, 0x43, 0xd7, 0xed, 0xaf, 0x68, 0xc5, 0xf4, 0xf6, 0x25, 0x72, 0xc8,
+	0x6b, 0x6d, 0x30, 0xad, 0x31, 0x02, 0x50, 0xe1, 0x1a, 0x5c, 0x95, 0x60, 0xcb, 0x95, 0x37, 0x5b,
+	0x32, 0x42, 0xdc, 0xe0, 0xba, 0x58, 0x75, 0xb0, 0xfa, 0x2b, 0xf7, 0x60, 0x55, 0xd9, 0xb3, 0xfd,
+	0x80, 0x27, 0xff, 0x5c, 0xdc, 0xee, 0x9f, 0xb8, 0xfd, 0x57, 0x38, 0x29, 0x7f, 0x9d, 0x4d, 0x92,
+	0xcb, 0xb4, 0xea, 0x5c, 0xd7, 0x96, 0x3e, 0x46, 0x07, 0x6d, 0x18, 0x66, 0x2c, 0x97, 0x2e, 0xab,
+	0xa3, 0x8a, 0x8c, 0x4e, 0x0f, 0xd5, 0x1e, 0x69, 0x41, 0xa0, 0xee, 0xae, 0xfc, 0xea, 0xf7, 0x50,
+	0x3d, 0x14, 0x4f, 0xcb, 0xe6, 0x2b, 0xe0, 0x8d, 0xd2, 0xaf, 0x80, 0x2f, 0xd0, 0x56, 0xb4, 0xad,
+	0xe2, 0x05, 0xea, 0x65, 0xa9, 0x2e, 0x57, 0x99, 0xa2, 0x1f, 0xb9, 0xa6, 0xa8, 0x6c, 0x64, 0xce,
+	0x3d, 0xa8, 0xfd, 0x25, 0x58, 0xbc, 0x8e, 0x92, 0xdf, 0xe3, 0x27, 0xf2, 0x1c, 0xaa, 0x8a, 0x30,
+	0xde, 0x95, 0xbd, 0xad, 0x1d, 0x91, 0x28, 0x7b, 0x8d, 0x5f, 0x06, 0x32, 0x10, 0xf0, 0x15, 0x96,
+	0x77, 0xd4, 0xdb, 0xd9, 0xda, 0xf2, 0x0e, 0x94, 0x07, 0x3b, 0xea, 0xa6, 0xa2, 0x36, 0xd8, 0x81,
+	0x01, 0x5d, 0x1a, 0x0f, 0xa7, 0x93, 0x70, 0x9c, 0xa8, 0x24, 0xcf, 0xb4, 0x0c, 0xb8, 0x95, 0x20,
+	0x16, 0xfd, 0x20, 0xb9, 0x85, 0x11, 0xb3, 0x36, 0x4f, 0xcb, 0xfe, 0xe7, 0x6a, 0x69, 0x02, 0x2f,
+	0xdc, 0xf2, 0xad, 0xe2, 0x07, 0xa9, 0x07, 0x62, 0x1c, 0x87, 0x49, 0xb8, 0x2f, 0x14, 0x97, 0x59,
+	0x30, 0x70, 0xbb, 0x3c, 0x9d, 0x8a, 0xf1, 0x10, 0x0c, 0x31, 0x72, 0xdb, 0xe2, 0x16, 0x04, 0x76,
+	0xee, 0x1b, 0x51, 0x98, 0x88, 0x8d, 0x5b, 0x91, 0x88, 0x6f, 0x4d, 0x46, 0x72, 0x8e, 0x9a, 0x3c,
+	0x03, 0x85, 0x48, 0x1c, 0x17, 0xc1, 0xd0, 0x90, 0x35, 0x90, 0xcc, 0x05, 0x02, 0x5f, 0xe0, 0x43,
+	0x06, 0xdb, 0x62, 0x35, 0x98, 0x06, 0x5b, 0x10, 0xee, 0x96, 0x51, 0xc1, 0x2c, 0x38, 0x4d, 0x0c,
+	0x5d, 0xbd, 0x15, 0x44, 0x6a, 0xa8, 0x06, 0x00, 0xd1, 0xc1, 0x8d, 0x44, 0xdf, 0x5c, 0xc2, 0x5f,
+	0xa0, 0xdf, 0x08, 0xb6, 0x63, 0x24, 0x51, 0x0f, 0x5f, 0x0c, 0xc0, 0x7f, 0x2d, 0x55, 0xde, 0x82,
+	0x44, 0x89, 0x02, 0x67, 0x8e, 0x4f, 0x95, 0x51, 0xab, 0xf1, 0x29, 0x74, 0xa6, 0x3f, 0x14, 0x07,
+	0x1f, 0xb9, 0x8c, 0x13, 0x3b, 0x55, 0xba, 0xe1, 0x7c, 0xf5, 0xfd, 0x28, 0xa9, 0xd2, 0xaf, 0x15,
+	0x69, 0x60, 0x55, 0xc2, 0x84, 0xa0, 0x27, 0x73, 0xdf, 0x55, 0xb3, 0x3e, 0x49, 0x47, 0xee, 0xf2,
+	0x93, 0x74, 0x35, 0xf7, 0x93, 0x74, 0x2b, 0xf4, 0x7d, 0xad, 0x8b, 0x17, 0x9f, 0xc0, 0x56, 0xfe,
+	0x7f, 0x00, 0x5d, 0xc7, 0xfb, 0x2e, 0x79, 0x65, 0x00, 0x00,
 }
@@ -18,6 +18,7 @@ package meta
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,12 +38,61 @@ type StreamInfo struct {
 	Dims     []string
 	Calls    []*StreamCall
 	Delay    time.Duration
+	// Slide is the step of the sliding windows of the length Interval, the windows are tumbling if it is 0
+	Slide time.Duration
+	// Fill is how the empty windows of the groups are filled, FillValue is the value of the number fill
+	Fill      influxql.FillOption
+	FillValue float64
+	// Condition filters the rows of the source measurement, nil means all the rows are aggregated
+	Condition influxql.Expr
+	// Destinations are the measurements the calls are written to at other intervals besides DesMst
+	Destinations []*StreamDestination
+	// Offset shifts the boundaries of the windows, TimeZone is the location the windows are aligned in,
+	// which keeps the windows of the days aligned to the local time across the daylight saving time changes
+	Offset   time.Duration
+	TimeZone string
+	// Paused stops the task from aggregating the rows without dropping it, the rows written while it is paused
+	// are not aggregated. It is not compared by Equal, so the stream is paused and resumed by creating it again.
+	Paused bool
+	// SrcRPs are the other retention policies of the source database whose source measurements feed the stream
+	// besides SrcMst.RetentionPolicy, such as the ones the same data is split across by the ingestion tiers.
+	// The schema of the source measurement in SrcMst.RetentionPolicy is the schema of all of them.
+	SrcRPs []string
+	// Options are the options of the sql layer calculation of the task encoded as JSON, empty means the defaults.
+	// They are not compared by Equal either, so they are changed by creating the stream again.
+	Options string
+}
+
+// HasSourceRP returns whether the rows of the retention policy of the source database feed the stream.
+func (s *StreamInfo) HasSourceRP(rp string) bool {
+	if s.SrcMst.RetentionPolicy == rp {
+		return true
+	}
+	for _, r := range s.SrcRPs {
+		if r == rp {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamDestination is a measurement the results of the windows of the interval are written to.
+type StreamDestination struct {
+	DesMst   *StreamMeasurementInfo
+	Interval time.Duration
 }
 
 type StreamCall struct {
 	Call  string
 	Field string
 	Alias string
+	// Args are the arguments of the call after the field, such as the quantile of percentile
+	Args []string
+	// Condition filters the rows aggregated by the call, nil means all the rows aggregated by the stream
+	Condition influxql.Expr
+	// Precision is the number of the decimal places the float results of the call are rounded to half to even,
+	// 0 means the results are not rounded
+	Precision int
 }
 
 type StreamMeasurementInfo struct {
@@ -55,6 +105,17 @@ func NewStreamInfo(stmt *influxql.CreateStreamStatement, selectStmt *influxql.Se
 	info := &StreamInfo{
 		Name:  stmt.Name,
 		Delay: stmt.Delay,
+		Slide: stmt.Slide,
+	}
+	for _, d := range stmt.Destinations {
+		info.Destinations = append(info.Destinations, &StreamDestination{
+			DesMst: &StreamMeasurementInfo{
+				Name:            d.Target.Measurement.Name,
+				Database:        d.Target.Measurement.Database,
+				RetentionPolicy: d.Target.Measurement.RetentionPolicy,
+			},
+			Interval: d.Interval,
+		})
 	}
 	srcMst := selectStmt.Sources[0].(*influxql.Measurement)
 	info.SrcMst = &StreamMeasurementInfo{
@@ -62,6 +123,12 @@ func NewStreamInfo(stmt *influxql.CreateStreamStatement, selectStmt *influxql.Se
 		Database:        srcMst.Database,
 		RetentionPolicy: srcMst.RetentionPolicy,
 	}
+	// the other sources are the source measurement in the other retention policies
+	for _, s := range selectStmt.Sources[1:] {
+		if m, ok := s.(*influxql.Measurement); ok && !info.HasSourceRP(m.RetentionPolicy) {
+			info.SrcRPs = append(info.SrcRPs, m.RetentionPolicy)
+		}
+	}
 	desMst := stmt.Target.Measurement
 	info.DesMst = &StreamMeasurementInfo{
 		Name:            desMst.Name,
@@ -70,14 +137,29 @@ func NewStreamInfo(stmt *influxql.CreateStreamStatement, selectStmt *influxql.Se
 	}
 	info.Calls = make([]*StreamCall, 0, len(selectStmt.Fields))
 	for i := range selectStmt.Fields {
-		f, ok := selectStmt.Fields[i].Expr.(*influxql.Call)
+		expr, precision, _ := influxql.StreamRoundCall(selectStmt.Fields[i].Expr)
+		var cond influxql.Expr
+		if fe, ok := expr.(*influxql.FilterExpr); ok {
+			expr, cond = fe.Call, fe.Condition
+		}
+		f, ok := expr.(*influxql.Call)
 		if !ok {
 			panic("should be call")
 		}
 		call := &StreamCall{
-			Call:  f.Name,
-			Alias: selectStmt.Fields[i].Alias,
-			Field: f.Args[0].(*influxql.VarRef).Val,
+			Call:      f.Name,
+			Alias:     selectStmt.Fields[i].Alias,
+			Field:     f.Args[0].(*influxql.VarRef).Val,
+			Condition: cond,
+			Precision: precision,
+		}
+		for _, arg := range f.Args[1:] {
+			// the numbers are kept in their shortest form, as they name the buckets of the histograms
+			if n, ok := arg.(*influxql.NumberLiteral); ok {
+				call.Args = append(call.Args, strconv.FormatFloat(n.Val, 'f', -1, 64))
+				continue
+			}
+			call.Args = append(call.Args, arg.String())
 		}
 		if call.Alias == "" {
 			call.Alias = f.Name + "_" + call.Field
@@ -93,6 +175,18 @@ func NewStreamInfo(stmt *influxql.CreateStreamStatement, selectStmt *influxql.Se
 		info.Dims = append(info.Dims, d.Val)
 	}
 	info.Interval, _ = selectStmt.GroupByInterval()
+	info.Offset, _ = selectStmt.GroupByOffset()
+	if selectStmt.Location != nil {
+		info.TimeZone = selectStmt.Location.String()
+	}
+	info.Fill = selectStmt.Fill
+	info.Condition = selectStmt.Condition
+	switch v := selectStmt.FillValue.(type) {
+	case float64:
+		info.FillValue = v
+	case int64:
+		info.FillValue = float64(v)
+	}
 	sort.Strings(info.Dims)
 	sort.Slice(info.Calls, func(i, j int) bool { return info.Calls[i].Field < info.Calls[j].Field })
 	return info
@@ -119,6 +213,34 @@ func (s *StreamInfo) Marshal() *proto2.StreamInfo {
 			pb.Calls = append(pb.Calls, s.Calls[i].marshal())
 		}
 	}
+	if s.Slide > 0 {
+		pb.Slide = proto.Int64(int64(s.Slide))
+	}
+	if s.Fill != influxql.NullFill {
+		pb.Fill = proto.Int32(int32(s.Fill))
+		pb.FillValue = proto.Float64(s.FillValue)
+	}
+	if s.Condition != nil {
+		pb.Condition = proto.String(s.Condition.String())
+	}
+	for _, d := range s.Destinations {
+		pb.Destinations = append(pb.Destinations, d.marshal())
+	}
+	if s.Offset != 0 {
+		pb.Offset = proto.Int64(int64(s.Offset))
+	}
+	if s.TimeZone != "" {
+		pb.TimeZone = proto.String(s.TimeZone)
+	}
+	if s.Paused {
+		pb.Paused = proto.Bool(true)
+	}
+	if len(s.SrcRPs) > 0 {
+		pb.SrcRPs = append(pb.SrcRPs, s.SrcRPs...)
+	}
+	if s.Options != "" {
+		pb.Options = proto.String(s.Options)
+	}
 	return pb
 }
 
@@ -127,11 +249,24 @@ func (s *StreamInfo) Unmarshal(pb *proto2.StreamInfo) {
 	s.ID = pb.GetID()
 	s.Interval = time.Duration(pb.GetInterval())
 	s.Delay = time.Duration(pb.GetDelay())
+	s.Slide = time.Duration(pb.GetSlide())
+	s.Fill = influxql.FillOption(pb.GetFill())
+	s.FillValue = pb.GetFillValue()
+	s.Offset = time.Duration(pb.GetOffset())
+	s.TimeZone = pb.GetTimeZone()
+	s.Paused = pb.GetPaused()
+	s.Options = pb.GetOptions()
+	s.Condition = nil
+	if cond := pb.GetCondition(); cond != "" {
+		// the condition is the string of a parsed expression
+		s.Condition, _ = influxql.ParseExpr(cond)
+	}
 	s.SrcMst = &StreamMeasurementInfo{}
 	s.SrcMst.unmarshal(pb.SrcMst)
 	s.DesMst = &StreamMeasurementInfo{}
 	s.DesMst.unmarshal(pb.DesMst)
 	s.Dims = pb.GetDims()
+	s.SrcRPs = pb.GetSrcRPs()
 	if len(pb.Calls) > 0 {
 		s.Calls = make([]*StreamCall, len(pb.Calls))
 		for i := range s.Calls {
@@ -139,14 +274,28 @@ func (s *StreamInfo) Unmarshal(pb *proto2.StreamInfo) {
 			s.Calls[i].unmarshal(pb.Calls[i])
 		}
 	}
+	s.Destinations = nil
+	for _, d := range pb.GetDestinations() {
+		dest := &StreamDestination{}
+		dest.unmarshal(d)
+		s.Destinations = append(s.Destinations, dest)
+	}
 }
 
 func (s StreamInfo) clone() *StreamInfo {
 	other := &StreamInfo{
-		Name:     s.Name,
-		ID:       s.ID,
-		Interval: s.Interval,
-		Delay:    s.Delay,
+		Name:      s.Name,
+		ID:        s.ID,
+		Interval:  s.Interval,
+		Delay:     s.Delay,
+		Slide:     s.Slide,
+		Fill:      s.Fill,
+		FillValue: s.FillValue,
+		Condition: influxql.CloneExpr(s.Condition),
+		Offset:    s.Offset,
+		TimeZone:  s.TimeZone,
+		Paused:    s.Paused,
+		Options:   s.Options,
 	}
 	other.SrcMst = s.SrcMst.Clone()
 	other.DesMst = s.DesMst.Clone()
@@ -158,6 +307,12 @@ func (s StreamInfo) clone() *StreamInfo {
 	for i := range other.Dims {
 		other.Dims[i] = s.Dims[i]
 	}
+	if len(s.SrcRPs) > 0 {
+		other.SrcRPs = append([]string(nil), s.SrcRPs...)
+	}
+	for _, d := range s.Destinations {
+		other.Destinations = append(other.Destinations, d.Clone())
+	}
 	return other
 }
 
@@ -183,12 +338,42 @@ func (s *StreamInfo) Equal(d *StreamInfo) bool {
 	if s.Delay != d.Delay {
 		return false
 	}
+	if s.Slide != d.Slide {
+		return false
+	}
+	if s.Fill != d.Fill || s.FillValue != d.FillValue {
+		return false
+	}
+	if s.Offset != d.Offset || s.TimeZone != d.TimeZone {
+		return false
+	}
+	if (s.Condition == nil) != (d.Condition == nil) || (s.Condition != nil && s.Condition.String() != d.Condition.String()) {
+		return false
+	}
 	if len(s.Calls) != len(d.Calls) {
 		return false
 	}
-	if len(s.Dims) != len(d.Dims) {
+	for i := range s.Calls {
+		if !s.Calls[i].Equal(d.Calls[i]) {
+			return false
+		}
+	}
+	if len(s.Dims) != len(d.Dims) || len(s.SrcRPs) != len(d.SrcRPs) {
+		return false
+	}
+	for i := range s.SrcRPs {
+		if s.SrcRPs[i] != d.SrcRPs[i] {
+			return false
+		}
+	}
+	if len(s.Destinations) != len(d.Destinations) {
 		return false
 	}
+	for i := range s.Destinations {
+		if !s.Destinations[i].Equal(d.Destinations[i]) {
+			return false
+		}
+	}
 	if !s.SrcMst.Equal(d.SrcMst) {
 		return false
 	}
@@ -230,11 +415,39 @@ func (m *StreamMeasurementInfo) Equal(s *StreamMeasurementInfo) bool {
 	return true
 }
 
+func (d *StreamDestination) marshal() *proto2.StreamDestination {
+	return &proto2.StreamDestination{
+		DesMst:   d.DesMst.marshal(),
+		Interval: proto.Int64(int64(d.Interval)),
+	}
+}
+
+func (d *StreamDestination) unmarshal(pb *proto2.StreamDestination) {
+	d.DesMst = &StreamMeasurementInfo{}
+	d.DesMst.unmarshal(pb.GetDesMst())
+	d.Interval = time.Duration(pb.GetInterval())
+}
+
+func (d StreamDestination) Clone() *StreamDestination {
+	return &StreamDestination{DesMst: d.DesMst.Clone(), Interval: d.Interval}
+}
+
+func (d *StreamDestination) Equal(o *StreamDestination) bool {
+	return d.Interval == o.Interval && d.DesMst.Equal(o.DesMst)
+}
+
 func (c *StreamCall) marshal() *proto2.StreamCall {
 	pb := &proto2.StreamCall{
 		Call:  proto.String(c.Call),
 		Alias: proto.String(c.Alias),
 		Field: proto.String(c.Field),
+		Args:  c.Args,
+	}
+	if c.Condition != nil {
+		pb.Condition = proto.String(c.Condition.String())
+	}
+	if c.Precision != 0 {
+		pb.Precision = proto.Int32(int32(c.Precision))
 	}
 	return pb
 }
@@ -243,6 +456,27 @@ func (c *StreamCall) unmarshal(pb *proto2.StreamCall) {
 	c.Call = pb.GetCall()
 	c.Alias = pb.GetAlias()
 	c.Field = pb.GetField()
+	c.Args = pb.GetArgs()
+	c.Condition = nil
+	if cond := pb.GetCondition(); cond != "" {
+		c.Condition, _ = influxql.ParseExpr(cond)
+	}
+	c.Precision = int(pb.GetPrecision())
+}
+
+func (c *StreamCall) Equal(o *StreamCall) bool {
+	if c.Call != o.Call || c.Field != o.Field || c.Alias != o.Alias || c.Precision != o.Precision || len(c.Args) != len(o.Args) {
+		return false
+	}
+	if (c.Condition == nil) != (o.Condition == nil) || (c.Condition != nil && c.Condition.String() != o.Condition.String()) {
+		return false
+	}
+	for i := range c.Args {
+		if c.Args[i] != o.Args[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (c *StreamCall) String() string {
@@ -251,5 +485,10 @@ func (c *StreamCall) String() string {
 
 func (c StreamCall) Clone() *StreamCall {
 	other := c
+	if len(c.Args) > 0 {
+		other.Args = make([]string, len(c.Args))
+		copy(other.Args, c.Args)
+	}
+	other.Condition = influxql.CloneExpr(c.Condition)
 	return &other
 }
@@ -55,7 +55,8 @@ const (
 )
 
 const (
-	MessageVersion = 1
+	// MessageVersion 2 marshals the integer fields as int64, which keeps the integers beyond 2^53
+	MessageVersion = 2
 )
 
 var (
@@ -457,7 +458,7 @@ func FastUnmarshalMultiRows(src []byte, rows []Row, tagPool []Tag, fieldPool []F
 	indexKeyPool []byte) ([]Row, []Tag, []Field, []IndexOption, []byte, error) {
 	pointsN := int(encoding.UnmarshalUint32(src))
 	src = src[4:]
-	//version := src[0]
+	version := src[0]
 	src = src[1:]
 
 	if pointsN > cap(rows) {
@@ -473,7 +474,7 @@ func FastUnmarshalMultiRows(src []byte, rows []Row, tagPool []Tag, fieldPool []F
 
 		row.StreamOnly = false
 		src, tagPool, fieldPool, indexOptionPool, indexKeyPool, err =
-			row.FastUnmarshalBinary(src, tagPool, fieldPool, indexOptionPool, indexKeyPool)
+			row.fastUnmarshalBinary(src, version, tagPool, fieldPool, indexOptionPool, indexKeyPool)
 		if err != nil {
 			return rows[:0], tagPool, fieldPool, indexOptionPool, indexKeyPool, err
 		}
@@ -486,6 +487,11 @@ func FastUnmarshalMultiRows(src []byte, rows []Row, tagPool []Tag, fieldPool []F
 }
 
 func (r *Row) FastUnmarshalBinary(src []byte, tagpool []Tag, fieldpool []Field, indexOptionPool []IndexOption, indexKeypool []byte) ([]byte, []Tag, []Field, []IndexOption, []byte, error) {
+	return r.fastUnmarshalBinary(src, MessageVersion, tagpool, fieldpool, indexOptionPool, indexKeypool)
+}
+
+// fastUnmarshalBinary unmarshals the row marshaled by the version of the message.
+func (r *Row) fastUnmarshalBinary(src []byte, version uint8, tagpool []Tag, fieldpool []Field, indexOptionPool []IndexOption, indexKeypool []byte) ([]byte, []Tag, []Field, []IndexOption, []byte, error) {
 	if len(src) < 1 {
 		return nil, tagpool, fieldpool, indexOptionPool, indexKeypool, errors.New("too small bytes for row binary")
 	}
@@ -515,7 +521,7 @@ func (r *Row) FastUnmarshalBinary(src []byte, tagpool []Tag, fieldpool []Field,
 		return nil, tagpool, fieldpool, indexOptionPool, indexKeypool, errors.New("too small bytes for row field count")
 	}
 
-	src, fieldpool, err = r.unmarshalFields(src, fieldpool)
+	src, fieldpool, err = r.unmarshalFields(src, fieldpool, version)
 	if err != nil {
 		return nil, tagpool, fieldpool, indexOptionPool, indexKeypool, err
 	}
@@ -611,6 +617,8 @@ func (r *Row) marshalFields(dst []byte) ([]byte, error) {
 		if fields[i].Type == Field_Type_String {
 			dst = encoding.MarshalUint64(dst, uint64(len(fields[i].StrValue)))
 			dst = append(dst, fields[i].StrValue...)
+		} else if fields[i].Type == Field_Type_Int {
+			dst = encoding.MarshalInt64(dst, fields[i].Int())
 		} else {
 			dst = numberenc.MarshalFloat64(dst, fields[i].NumValue)
 		}
@@ -618,7 +626,7 @@ func (r *Row) marshalFields(dst []byte) ([]byte, error) {
 	return dst, nil
 }
 
-func (r *Row) unmarshalFields(src []byte, fieldpool []Field) ([]byte, []Field, error) {
+func (r *Row) unmarshalFields(src []byte, fieldpool []Field, version uint8) ([]byte, []Field, error) {
 	fieldN := int(encoding.UnmarshalUint32(src[:4]))
 	src = src[4:]
 	start := len(fieldpool)
@@ -668,7 +676,12 @@ func (r *Row) unmarshalFields(src []byte, fieldpool []Field) ([]byte, []Field, e
 				fieldpool = fieldpool[:len(fieldpool)-1]
 				return nil, fieldpool, errors.New("too small for field")
 			}
-			fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			fd.IntValue = 0
+			if fd.Type == Field_Type_Int && version >= 2 {
+				fd.SetInt(encoding.UnmarshalInt64(src[:8]))
+			} else {
+				fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			}
 			src = src[8:]
 		}
 	}
@@ -1231,6 +1244,22 @@ type Field struct {
 	NumValue float64
 	StrValue string
 	Type     int32
+	// IntValue is the exact value of the integer field, which NumValue rounds beyond 2^53
+	IntValue int64
+}
+
+// SetInt sets the value of the integer field, NumValue keeps it as a float for the readers of the floats.
+func (f *Field) SetInt(v int64) {
+	f.NumValue, f.IntValue = float64(v), v
+}
+
+// Int returns the value of the integer field. The fields set by NumValue alone have no exact value,
+// which is taken from NumValue then.
+func (f *Field) Int() int64 {
+	if float64(f.IntValue) == f.NumValue {
+		return f.IntValue
+	}
+	return int64(f.NumValue)
 }
 
 type Fields []Field
@@ -1279,11 +1308,12 @@ func (f *Field) unmarshal(s string, noEscapeChars, hasQuotedFields bool) error {
 		f.Type = Field_Type_String
 		return nil
 	}
-	v, t, err := parseFieldNumValue(s[n+1:])
+	v, iv, t, err := parseFieldNumValue(s[n+1:])
 	if err != nil {
 		return fmt.Errorf("cannot parse field value for %q: %w", f.Key, err)
 	}
 	f.NumValue = v
+	f.IntValue = iv
 	f.Type = t
 	return nil
 }
@@ -1413,9 +1443,10 @@ func unescapeTagValue(s string, noEscapeChars bool) string {
 	}
 }
 
-func parseFieldNumValue(s string) (float64, int32, error) {
+// parseFieldNumValue returns the value of the number field, with the exact value of the integer field.
+func parseFieldNumValue(s string) (float64, int64, int32, error) {
 	if len(s) == 0 {
-		return 0, Field_Type_Unknown, fmt.Errorf("field value cannot be empty")
+		return 0, 0, Field_Type_Unknown, fmt.Errorf("field value cannot be empty")
 	}
 	ch := s[len(s)-1]
 	if ch == 'i' {
@@ -1423,37 +1454,37 @@ func parseFieldNumValue(s string) (float64, int32, error) {
 		ss := s[:len(s)-1]
 		n, err := fastfloat.ParseInt64(ss)
 		if err != nil {
-			return 0, Field_Type_Unknown, err
+			return 0, 0, Field_Type_Unknown, err
 		}
-		return float64(n), Field_Type_Int, nil
+		return float64(n), n, Field_Type_Int, nil
 	}
 	if ch == 'u' {
 		// Unsigned integer value
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid number")
+		return 0, 0, Field_Type_Unknown, fmt.Errorf("invalid number")
 	}
 	if ch == 'f' {
 		// Unsigned integer value
 		ss := s[:len(s)-1]
 		n := fastfloat.ParseBestEffort(ss)
-		return n, Field_Type_Float, nil
+		return n, 0, Field_Type_Float, nil
 	}
 	if s == "t" || s == "T" || s == "true" || s == "True" || s == "TRUE" {
-		return 1, Field_Type_Boolean, nil
+		return 1, 0, Field_Type_Boolean, nil
 	}
 	if s == "f" || s == "F" || s == "false" || s == "False" || s == "FALSE" {
-		return 0, Field_Type_Boolean, nil
+		return 0, 0, Field_Type_Boolean, nil
 	}
 
 	if !IsValidNumber(s) {
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid field value")
+		return 0, 0, Field_Type_Unknown, fmt.Errorf("invalid field value")
 	}
 
 	f := fastfloat.ParseBestEffort(s)
 	if math.IsNaN(f) || math.IsInf(f, 0) {
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid number")
+		return 0, 0, Field_Type_Unknown, fmt.Errorf("invalid number")
 	}
 
-	return f, Field_Type_Float, nil
+	return f, 0, Field_Type_Float, nil
 }
 
 func parseFieldStrValue(s string) (string, error) {
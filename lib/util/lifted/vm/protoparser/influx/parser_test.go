@@ -9,6 +9,7 @@ Copyright 2022 Huawei Cloud Computing Technologies Co., Ltd.
 */
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -165,6 +166,36 @@ func TestIndexOptionsMarshalUnmarshal(t *testing.T) {
 	funcMarshal(dst, rows, indexOpt)
 }
 
+func TestIntFieldMarshalUnmarshal(t *testing.T) {
+	// the integers beyond 2^53 are parsed and marshaled exactly
+	rows, _, _, err := unmarshalRows(nil, "cpu,host=h1 v=1152921504606846977i,f=1.5 1622851200000000000", nil, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<60+1), rows[0].Fields[0].Int())
+
+	buf, err := FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+	rs, _, fields, _, _, err := FastUnmarshalMultiRows(buf, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<60+1), rs[0].Fields[0].Int())
+	require.Equal(t, float64(1<<60), rs[0].Fields[0].NumValue)
+	require.Equal(t, 1.5, rs[0].Fields[1].NumValue)
+
+	// the messages of version 1 carry the integers as floats
+	rows[0].Fields = rows[0].Fields[:1]
+	rows[0].Fields[0] = Field{Key: "v", NumValue: 3, Type: Field_Type_Float}
+	buf, err = FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+	buf[4] = 1
+	buf[bytes.IndexByte(buf, 'v')+1] = Field_Type_Int
+	rs, _, _, _, _, err = FastUnmarshalMultiRows(buf, rs, nil, fields[:0], nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), rs[0].Fields[0].Int())
+
+	// the fields set by NumValue alone take the integer from it
+	f := Field{Type: Field_Type_Int, NumValue: 7}
+	require.Equal(t, int64(7), f.Int())
+}
+
 func TestGetNameWithVersion(t *testing.T) {
 	assert.Equal(t, "mst_0001", GetNameWithVersion("mst", 1))
 	assert.Equal(t, "mst_000a", GetNameWithVersion("mst", 10))